@@ -13,11 +13,22 @@ import (
 	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/api/init/router"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/config"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/db/postgresql"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/notification"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/s3"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/channel/push"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/broker/local"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation"
 
+	activityRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity/repository/postgresql"
 	albumRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/repository/postgresql"
 	artistRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/repository/postgresql"
+	commentRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment/repository/postgresql"
+	notificationRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/repository/postgresql"
+	playerRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/player/repository/postgresql"
 	playlistRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/repository/postgresql"
+	recommendationRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation/repository/postgresql"
+	shareRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/share/repository/postgresql"
 	trackRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/repository/postgresql"
 	userRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/repository/postgresql"
 
@@ -32,18 +43,33 @@ import (
 	userProto "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/microservices/user/proto/generated"
 	userAgent "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/client/grpc"
 
+	activityUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity/usecase"
 	albumUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/usecase"
 	artistUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/usecase"
+	commentUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment/usecase"
+	notificationUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/usecase"
+	playerUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/player/usecase"
 	playlistUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/usecase"
+	realtimeUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/usecase"
+	recommendationUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation/usecase"
+	shareUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/share/usecase"
 	tokenUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/token/usecase"
 	trackUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/usecase"
+	userUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/usecase"
 
+	activityDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity/delivery/http"
 	albumDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/delivery/http"
 	artistDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/delivery/http"
 	authDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/auth/delivery/http"
+	commentDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment/delivery/http"
 	csrfDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/csrf/delivery/http"
+	notificationDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/delivery/http"
+	playerDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/player/delivery/http"
 	playlistDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/delivery/http"
+	realtimeDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/delivery/http"
+	recommendationDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation/delivery/http"
 	searchDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search/delivery/http"
+	shareDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/share/delivery/http"
 	trackDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/delivery/http"
 	userDelivery "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/delivery/http"
 
@@ -64,6 +90,12 @@ func Init(db *sqlx.DB, tables postgresql.PostgreSQLTables, logger logger.Logger)
 	artistRepo := artistRepository.NewPostgreSQL(db, tables)
 	trackRepo := trackRepository.NewPostgreSQL(db, tables)
 	userRepo := userRepository.NewPostgreSQL(db, tables)
+	activityRepo := activityRepository.NewPostgreSQL(db, tables)
+	recommendationRepo := recommendationRepository.NewPostgreSQL(db, tables, recommendation.DefaultConfig())
+	commentRepo := commentRepository.NewPostgreSQL(db, tables)
+	notificationRepo := notificationRepository.NewPostgreSQL(db, tables)
+	playerRepo := playerRepository.NewPostgreSQL(db, tables)
+	shareRepo := shareRepository.NewPostgreSQL(db, tables)
 
 	agents, err := makeAgents()
 	if err != nil {
@@ -76,20 +108,48 @@ func Init(db *sqlx.DB, tables postgresql.PostgreSQLTables, logger logger.Logger)
 	}
 	playlistS3 := playlistS3.NewS3PlaylistCoverSaver(os.Getenv(config.S3BucketParam), os.Getenv(config.S3PlaylistCoversFolderParam), s3Client)
 
-	albumUsecase := albumUsecase.NewUsecase(albumRepo, artistRepo)
-	playlistUsecase := playlistUsecase.NewUsecase(playlistRepo, trackRepo, userRepo, playlistS3)
+	// Events are published and listened within this process only
+	realtimeBroker := local.NewBroker()
+
+	notificationChannels, err := notification.MakeChannels(notificationRepo, userRepo, logger)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating notification channels: %v", err)
+	}
+	notificationChannels = append(notificationChannels, push.NewChannel(realtimeBroker))
+
+	notificationUsecase := notificationUsecase.NewUsecase(notificationRepo, logger, notificationChannels...)
+	albumUsecase := albumUsecase.NewUsecase(albumRepo, artistRepo, activityRepo, notificationUsecase, logger)
+	playlistUsecase := playlistUsecase.NewUsecase(playlistRepo, trackRepo, albumRepo, userRepo,
+		activityRepo, notificationUsecase, realtimeBroker, playlistS3, logger)
 	artistUsecase := artistUsecase.NewUsecase(artistRepo)
-	trackUsecase := trackUsecase.NewUsecase(trackRepo, artistRepo, albumRepo, playlistRepo)
+	trackUsecase := trackUsecase.NewUsecase(trackRepo, artistRepo, albumRepo, playlistRepo, activityRepo, logger)
 	tokenUsecase := tokenUsecase.NewUsecase()
+	activityUsecase := activityUsecase.NewUsecase(activityRepo)
+	recommendationUsecase := recommendationUsecase.NewUsecase(recommendationRepo, trackRepo, artistRepo)
+	realtimeUsecase := realtimeUsecase.NewUsecase(realtimeBroker, playlistRepo)
+	playerUsecase := playerUsecase.NewUsecase(playerRepo, trackRepo, realtimeBroker, logger)
+	shareUsecase := shareUsecase.NewUsecase(shareRepo, trackRepo, albumRepo, artistRepo, playlistRepo)
+	commentUsecase := commentUsecase.NewUsecase(commentRepo, trackRepo, albumRepo, playlistRepo, comment.DefaultConfig())
+	userUsecase := userUsecase.NewPublishingUsecase(agents.UserAgent, realtimeBroker, logger)
 
 	albumHandler := albumDelivery.NewHandler(albumUsecase, artistUsecase, logger)
 	playlistHandler := playlistDelivery.NewHandler(playlistUsecase, trackUsecase, agents.UserAgent, logger)
 	artistHandler := artistDelivery.NewHandler(artistUsecase, logger)
 	authHandler := authDelivery.NewHandler(agents.AuthAgent, tokenUsecase, logger)
 	trackHandler := trackDelivery.NewHandler(trackUsecase, artistUsecase, logger)
-	userHandler := userDelivery.NewHandler(agents.UserAgent, logger)
+	userHandler := userDelivery.NewHandler(userUsecase, logger)
 	searchHandler := searchDelivery.NewHandler(agents.SearchAgent,
 		albumUsecase, artistUsecase, trackUsecase, playlistUsecase, agents.UserAgent, logger)
+	activityHandler := activityDelivery.NewHandler(activityUsecase,
+		albumUsecase, artistUsecase, trackUsecase, playlistUsecase, agents.UserAgent, logger)
+	recommendationHandler := recommendationDelivery.NewHandler(recommendationUsecase, trackUsecase, artistUsecase, logger)
+	commentHandler := commentDelivery.NewHandler(commentUsecase, agents.UserAgent, logger)
+	notificationHandler := notificationDelivery.NewHandler(notificationUsecase,
+		albumUsecase, artistUsecase, playlistUsecase, agents.UserAgent, logger)
+	realtimeHandler := realtimeDelivery.NewHandler(realtimeUsecase, logger)
+	playerHandler := playerDelivery.NewHandler(playerUsecase, logger)
+	shareHandler := shareDelivery.NewHandler(shareUsecase, trackUsecase, albumUsecase, artistUsecase,
+		playlistUsecase, agents.UserAgent, os.Getenv(config.SiteURLParam), os.Getenv(config.MediaURLParam), logger)
 	csrfHandler := csrfDelivery.NewHandler(tokenUsecase, logger)
 
 	authMiddlware := authMiddlware.NewMiddleware(agents.AuthAgent, tokenUsecase, logger)
@@ -108,6 +168,13 @@ func Init(db *sqlx.DB, tables postgresql.PostgreSQLTables, logger logger.Logger)
 		csrfHandler,
 		csrfMiddlware,
 		searchHandler,
+		activityHandler,
+		recommendationHandler,
+		commentHandler,
+		notificationHandler,
+		realtimeHandler,
+		playerHandler,
+		shareHandler,
 		logger,
 	), nil
 }
@@ -9,14 +9,21 @@ import (
 	commonHttp "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http/middleware"
 
+	activity "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity/delivery/http"
 	album "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/delivery/http"
 	artist "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/delivery/http"
 	auth "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/auth/delivery/http"
 	authM "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/auth/delivery/http/middleware"
+	comment "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment/delivery/http"
 	csrf "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/csrf/delivery/http"
 	csrfM "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/csrf/delivery/http/middleware"
+	notification "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/delivery/http"
+	player "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/player/delivery/http"
 	playlist "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/delivery/http"
+	realtime "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/delivery/http"
+	recommendation "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation/delivery/http"
 	search "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search/delivery/http"
+	share "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/share/delivery/http"
 	track "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/delivery/http"
 	user "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/delivery/http"
 	userM "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/delivery/http/middleware"
@@ -29,6 +36,12 @@ const (
 	playlistIdRoute = "/{" + commonHttp.PlaylistIdUrlParam + "}"
 	artistIdRoute   = "/{" + commonHttp.ArtistIdUrlParam + "}"
 	trackIdRoute    = "/{" + commonHttp.TrackIdUrlParam + "}"
+	revisionIdRoute = "/{" + commonHttp.RevisionIdUrlParam + "}"
+	commentIdRoute  = "/{" + commonHttp.CommentIdUrlParam + "}"
+
+	recentSearchIdRoute = "/{" + commonHttp.RecentSearchIdUrlParam + "}"
+	notificationIdRoute = "/{" + commonHttp.NotificationIdUrlParam + "}"
+	shareCodeRoute      = "/{" + commonHttp.ShareCodeUrlParam + "}"
 )
 
 // InitRouter describes all app's endpoints and their handlers
@@ -44,6 +57,13 @@ func InitRouter(
 	csrfH *csrf.Handler,
 	csrfM *csrfM.Middleware,
 	searchH *search.Handler,
+	activityH *activity.Handler,
+	recommendationH *recommendation.Handler,
+	commentH *comment.Handler,
+	notificationH *notification.Handler,
+	realtimeH *realtime.Handler,
+	playerH *player.Handler,
+	shareH *share.Handler,
 	loggger logger.Logger) *chi.Mux {
 
 	r := chi.NewRouter()
@@ -57,25 +77,61 @@ func InitRouter(
 	r.Get("/metrics", promhttp.Handler().ServeHTTP)
 	r.Get("/swagger/*", swagger.WrapHandler)
 
+	// Link previews are public: messengers fetch them without cookies
+	r.Route("/share", func(r chi.Router) {
+		r.Get(shareCodeRoute, shareH.Open)
+		r.Get("/tracks"+trackIdRoute, shareH.TrackPreview)
+		r.Get("/albums"+albumIdRoute, shareH.AlbumPreview)
+		r.Get("/artists"+artistIdRoute, shareH.ArtistPreview)
+		r.Get("/playlists"+playlistIdRoute, shareH.PlaylistPreview)
+	})
+
 	r.Route("/api", func(r chi.Router) {
+		r.With(authM.Authorization).Post("/search", searchH.Search)
+		r.With(authM.Authorization).Get("/search/suggest", searchH.Suggest)
+		r.With(authM.Authorization).Route("/search/recent", func(r chi.Router) {
+			r.Get("/", searchH.RecentSearches)
+
+			r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
+				r.Post("/", searchH.AddRecentSearch)
+				r.Delete("/", searchH.ClearRecentSearches)
+				r.Delete(recentSearchIdRoute, searchH.DeleteRecentSearch)
+			})
+		})
 
-		r.Route("/users", func(r chi.Router) {
-			r.With(authM.Authorization, userM.CheckUserAuthAndResponce).Route(userIdRoute, func(r chi.Router) {
-				r.Get("/playlists", playlistH.GetByUser)
+		r.With(authM.Authorization).Get("/feed/activity", activityH.GetActivity)
+		r.With(authM.Authorization).Get("/radio", recommendationH.Radio)
+		r.With(authM.Authorization).Route("/recommendations", func(r chi.Router) {
+			r.Get("/because-you-liked", recommendationH.BecauseYouLiked)
+			r.Get("/tracks", recommendationH.Tracks)
+		})
 
-				r.Get("/", userH.Get)
-				r.Get("/playlists", playlistH.GetByUser)
+		r.Route("/users", func(r chi.Router) {
+			r.With(authM.Authorization).Route(userIdRoute, func(r chi.Router) {
+				r.Get("/profile", userH.GetProfile)
+				r.Get("/followers", userH.GetFollowers)
+				r.Get("/following", userH.GetFollowing)
 
 				r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
-					r.Post("/update", userH.UpdateInfo)
-					r.With(middleware.RequestBodyMaxSize(user.MaxAvatarMemory)).Post("/avatar", userH.UploadAvatar)
+					r.Post("/follow", userH.Follow)
+					r.Post("/unfollow", userH.Unfollow)
 				})
 
-				r.Route("/favorite", func(r chi.Router) {
-					r.Get("/tracks", trackH.GetFavorite)
-					r.Get("/albums", albumH.GetFavorite)
-					r.Get("/playlists", playlistH.GetFavorite)
-					r.Get("/artists", artistH.GetFavorite)
+				r.With(userM.CheckUserAuthAndResponce).Group(func(r chi.Router) {
+					r.Get("/", userH.Get)
+					r.Get("/playlists", playlistH.GetByUser)
+
+					r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
+						r.Post("/update", userH.UpdateInfo)
+						r.With(middleware.RequestBodyMaxSize(user.MaxAvatarMemory)).Post("/avatar", userH.UploadAvatar)
+					})
+
+					r.Route("/favorite", func(r chi.Router) {
+						r.Get("/tracks", trackH.GetFavorite)
+						r.Get("/albums", albumH.GetFavorite)
+						r.Get("/playlists", playlistH.GetFavorite)
+						r.Get("/artists", artistH.GetFavorite)
+					})
 				})
 			})
 		})
@@ -89,11 +145,13 @@ func InitRouter(
 
 				r.Group(func(r chi.Router) {
 					r.Get("/tracks", trackH.GetByAlbum)
+					r.Get("/comments", commentH.GetByEntity)
 
 					r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
 						r.Delete("/", albumH.Delete)
 						r.Post("/like", albumH.Like)
 						r.Post("/unlike", albumH.UnLike)
+						r.Post("/comments", commentH.Create)
 					})
 				})
 			})
@@ -106,19 +164,29 @@ func InitRouter(
 			r.With(csrfM.CheckCSRFToken).Post("/", playlistH.Create)
 			r.Route(playlistIdRoute, func(r chi.Router) {
 				r.Get("/", playlistH.Get)
+				r.Get("/history", playlistH.History)
+				r.Get("/comments", commentH.GetByEntity)
 
 				r.Group(func(r chi.Router) {
 					r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
 						r.Post("/update", playlistH.Update)
+						r.Post("/rules", playlistH.UpdateRules)
 						r.Post("/cover", playlistH.UploadCover)
 						r.Delete("/", playlistH.Delete)
+						r.Post("/fork", playlistH.Fork)
+						r.Post("/history"+revisionIdRoute+"/restore", playlistH.Restore)
 
 						r.Post("/like", playlistH.Like)
 						r.Post("/unlike", playlistH.UnLike)
+						r.Post("/comments", commentH.Create)
 					})
 
 					r.Route("/tracks", func(r chi.Router) {
 						r.Get("/", trackH.GetByPlaylist)
+						r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
+							r.Post("/", playlistH.AddTracks)
+							r.Post("/delete", playlistH.DeleteTracks)
+						})
 						r.Route(trackIdRoute, func(r chi.Router) {
 							r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
 								r.Post("/", playlistH.AddTrack)
@@ -141,6 +209,7 @@ func InitRouter(
 
 				r.Group(func(r chi.Router) {
 					r.Get("/tracks", trackH.GetByArtist)
+					r.Get("/related", artistH.GetRelated)
 
 					r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
 						r.Delete("/", artistH.Delete)
@@ -159,16 +228,56 @@ func InitRouter(
 			r.Post("/", trackH.Create)
 			r.Route(trackIdRoute, func(r chi.Router) {
 				r.Get("/", trackH.Get)
+				r.Get("/lyrics", trackH.GetLyrics)
+				r.Get("/comments", commentH.GetByEntity)
 
 				r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
+					r.Put("/lyrics", trackH.SetLyrics)
 					r.Delete("/", trackH.Delete)
 					r.Post("/like", trackH.Like)
 					r.Post("/unlike", trackH.UnLike)
+					r.Post("/comments", commentH.Create)
 				})
 			})
 			r.Get("/feed", trackH.Feed)
 		})
 
+		r.With(authM.Authorization).Route("/comments", func(r chi.Router) {
+			r.Route(commentIdRoute, func(r chi.Router) {
+				r.Get("/replies", commentH.GetReplies)
+
+				r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
+					r.Post("/replies", commentH.Reply)
+					r.Post("/update", commentH.Update)
+					r.Delete("/", commentH.Delete)
+					r.Post("/like", commentH.Like)
+					r.Post("/unlike", commentH.UnLike)
+				})
+			})
+		})
+
+		r.With(authM.Authorization).Route("/notifications", func(r chi.Router) {
+			r.Get("/", notificationH.GetInbox)
+			r.Get("/unread", notificationH.UnreadCount)
+
+			r.With(csrfM.CheckCSRFToken).Group(func(r chi.Router) {
+				r.Post("/read", notificationH.MarkAllRead)
+				r.Post(notificationIdRoute+"/read", notificationH.MarkRead)
+			})
+		})
+
+		r.With(authM.Authorization).Get("/realtime", realtimeH.Connect)
+
+		r.With(authM.Authorization).Route("/player", func(r chi.Router) {
+			r.Get("/", playerH.GetState)
+			r.With(csrfM.CheckCSRFToken).Put("/", playerH.UpdateState)
+		})
+
+		r.Route("/share", func(r chi.Router) {
+			r.With(authM.Authorization, csrfM.CheckCSRFToken).Post("/", shareH.GetLink)
+			r.Get(shareCodeRoute, shareH.Resolve)
+		})
+
 		r.Route("/auth", func(r chi.Router) {
 			r.Post("/login", authH.Login)
 			r.Post("/signup", authH.SignUp)
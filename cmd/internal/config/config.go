@@ -9,6 +9,17 @@ const (
 	SearchListenParam  = "SEARCH_LISTEN_ENDPOINT"
 	SearchConnectParam = "SEARCH_CONNECT_ENDPOINT"
 
+	SearchFuzzyThresholdParam   = "SEARCH_FUZZY_THRESHOLD"
+	SearchFuzzyFallbackMinParam = "SEARCH_FUZZY_FALLBACK_MIN"
+	SearchListensWeightParam    = "SEARCH_LISTENS_WEIGHT"
+	SearchLikesWeightParam      = "SEARCH_LIKES_WEIGHT"
+	SearchPersonalWeightParam   = "SEARCH_PERSONAL_WEIGHT"
+	SearchBackendParam          = "SEARCH_BACKEND"
+	SearchIndexPathParam        = "SEARCH_INDEX_PATH"
+
+	RecommenderIntervalParam  = "RECOMMENDER_INTERVAL"
+	RecommenderNeighborsParam = "RECOMMENDER_NEIGHBORS_PER_TRACK"
+
 	UserListenParam  = "USER_LISTEN_ENDPOINT"
 	UserConnectParam = "USER_CONNECT_ENDPOINT"
 
@@ -21,6 +32,15 @@ const (
 	S3SecretKeyParam = "S3_SECRET_KEY"
 	S3BucketParam    = "S3_BUCKET"
 
+	SMTPAddrParam     = "SMTP_ADDR"
+	SMTPUserParam     = "SMTP_USER"
+	SMTPPasswordParam = "SMTP_PASSWORD"
+	SMTPFromParam     = "SMTP_FROM"
+	SiteURLParam      = "SITE_URL"
+
+	// MediaURLParam is where covers and avatars are served from, e.g. in link previews
+	MediaURLParam = "MEDIA_URL"
+
 	S3AvatarFolderParam         = "S3_AVATAR_FOLDER"
 	S3PlaylistCoversFolderParam = "S3_PLAYLIST_COVERS_FOLDER"
 )
@@ -98,6 +98,10 @@ func (pt PostgreSQLTables) ArtistsTracks() string {
 	return "Artists_Tracks"
 }
 
+func (pt PostgreSQLTables) Lyrics() string {
+	return "Lyrics"
+}
+
 func (pt PostgreSQLTables) Listens() string {
 	return "Listens"
 }
@@ -122,6 +126,14 @@ func (pt PostgreSQLTables) PlaylistsTracks() string {
 	return "Playlists_Tracks"
 }
 
+func (pt PostgreSQLTables) SmartPlaylists() string {
+	return "Smart_playlists"
+}
+
+func (pt PostgreSQLTables) PlaylistsHistory() string {
+	return "Playlists_history"
+}
+
 func (pt PostgreSQLTables) LikedAlbums() string {
 	return "Liked_albums"
 }
@@ -137,3 +149,51 @@ func (pt PostgreSQLTables) LikedTracks() string {
 func (pt PostgreSQLTables) LikedPlaylists() string {
 	return "Liked_playlists"
 }
+
+func (pt PostgreSQLTables) RecentSearches() string {
+	return "Recent_searches"
+}
+
+func (pt PostgreSQLTables) Followers() string {
+	return "Followers"
+}
+
+func (pt PostgreSQLTables) Activities() string {
+	return "Activities"
+}
+
+func (pt PostgreSQLTables) ActivityTimeline() string {
+	return "Activity_timeline"
+}
+
+func (pt PostgreSQLTables) TrackNeighbors() string {
+	return "Track_neighbors"
+}
+
+func (pt PostgreSQLTables) RelatedArtists() string {
+	return "Related_artists"
+}
+
+func (pt PostgreSQLTables) Moderators() string {
+	return "Moderators"
+}
+
+func (pt PostgreSQLTables) Comments() string {
+	return "Comments"
+}
+
+func (pt PostgreSQLTables) LikedComments() string {
+	return "Liked_comments"
+}
+
+func (pt PostgreSQLTables) Notifications() string {
+	return "Notifications"
+}
+
+func (pt PostgreSQLTables) PlayerStates() string {
+	return "Player_states"
+}
+
+func (pt PostgreSQLTables) ShareLinks() string {
+	return "Share_links"
+}
@@ -17,7 +17,8 @@ CREATE TABLE Artists
     id         SERIAL      PRIMARY KEY,
     user_id    INT         REFERENCES Users(id) ON DELETE SET NULL,
     name       VARCHAR(30)                                         NOT NULL,
-    avatar_src TEXT                                                NOT NULL
+    avatar_src TEXT                                                NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()                           NOT NULL
 );
 
 CREATE TABLE Albums
@@ -25,7 +26,8 @@ CREATE TABLE Albums
     id          SERIAL        PRIMARY KEY,
     name        VARCHAR(40)               NOT NULL,
     description VARCHAR(2000),
-    cover_src   TEXT                      NOT NULL
+    cover_src   TEXT                      NOT NULL,
+    created_at  TIMESTAMPTZ DEFAULT NOW() NOT NULL
 );
 
 CREATE TABLE Artists_Albums
@@ -46,10 +48,20 @@ CREATE TABLE Tracks
     record_src     TEXT                                                 NOT NULL,
     duration       INT                                                  NOT NULL,
     listens        INT         DEFAULT 0                                NOT NULL,
+    created_at     TIMESTAMPTZ DEFAULT NOW()                            NOT NULL,
 
     UNIQUE(album_id, album_position)
 );
 
+CREATE TABLE Lyrics
+(
+    track_id   INT         REFERENCES Tracks(id) ON DELETE CASCADE PRIMARY KEY,
+    text       TEXT                                                NOT NULL,
+    synced     TEXT,
+    lang       REGCONFIG   DEFAULT 'english'::regconfig            NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW()                           NOT NULL
+);
+
 CREATE TABLE Listens
 (
     id          SERIAL      PRIMARY KEY,
@@ -71,7 +83,9 @@ CREATE TABLE Playlists
     id          SERIAL        PRIMARY KEY,
     name        VARCHAR(60)               NOT NULL,
     description VARCHAR(2000),
-    cover_src   TEXT
+    cover_src   TEXT,
+    forked_from INT REFERENCES Playlists(id) ON DELETE SET NULL,
+    created_at  TIMESTAMPTZ DEFAULT NOW()                           NOT NULL
 );
 
 CREATE TABLE Users_Playlists
@@ -92,6 +106,30 @@ CREATE TABLE Playlists_Tracks
     PRIMARY KEY(playlist_id, track_id)
 );
 
+CREATE TABLE Playlists_history
+(
+    id               SERIAL                                         PRIMARY KEY,
+    playlist_id      INT REFERENCES Playlists(id) ON DELETE CASCADE NOT NULL,
+    user_id          INT REFERENCES Users(id)     ON DELETE SET NULL,
+    action           VARCHAR(20)                                    NOT NULL,
+    track_id         INT REFERENCES Tracks(id)    ON DELETE SET NULL,
+    track_added_at   TIMESTAMPTZ,
+    prev_name        VARCHAR(60),
+    prev_description VARCHAR(2000),
+    prev_cover_src   TEXT,
+    created_at       TIMESTAMPTZ DEFAULT NOW()                      NOT NULL
+);
+
+CREATE INDEX idx_btree_playlists_history ON Playlists_history USING btree (playlist_id, id);
+
+CREATE TABLE Smart_playlists
+(
+    playlist_id INT REFERENCES Playlists(id) ON DELETE CASCADE PRIMARY KEY,
+    owner_id    INT REFERENCES Users(id)     ON DELETE CASCADE NOT NULL,
+    rules       JSONB                                          NOT NULL,
+    updated_at  TIMESTAMPTZ DEFAULT NOW()                      NOT NULL
+);
+
 CREATE TABLE Liked_albums
 (
     user_id   INT REFERENCES Users(id)  ON DELETE CASCADE NOT NULL,
@@ -101,6 +139,9 @@ CREATE TABLE Liked_albums
     PRIMARY KEY(user_id, album_id)
 );
 
+CREATE INDEX idx_btree_liked_albums ON Liked_albums USING btree (user_id, liked_at);
+CREATE INDEX idx_btree_liked_albums_album ON Liked_albums USING btree (album_id);
+
 CREATE TABLE Liked_artists
 (
     user_id    INT REFERENCES Users(id)   ON DELETE CASCADE NOT NULL,
@@ -110,6 +151,9 @@ CREATE TABLE Liked_artists
     PRIMARY KEY(user_id, artist_id)
 );
 
+CREATE INDEX idx_btree_liked_artists ON Liked_artists USING btree (user_id, liked_at);
+CREATE INDEX idx_btree_liked_artists_artist ON Liked_artists USING btree (artist_id);
+
 CREATE TABLE Liked_tracks
 (
     user_id   INT REFERENCES Users(id)  ON DELETE CASCADE NOT NULL,
@@ -119,6 +163,9 @@ CREATE TABLE Liked_tracks
     PRIMARY KEY(user_id, track_id)
 );
 
+CREATE INDEX idx_btree_liked_tracks ON Liked_tracks USING btree (user_id, liked_at);
+CREATE INDEX idx_btree_liked_tracks_track ON Liked_tracks USING btree (track_id);
+
 CREATE TABLE Liked_playlists
 (
     user_id     INT REFERENCES Users(id)     ON DELETE CASCADE NOT NULL,
@@ -128,6 +175,164 @@ CREATE TABLE Liked_playlists
     PRIMARY KEY(user_id, playlist_id)
 );
 
+CREATE INDEX idx_btree_liked_playlists ON Liked_playlists USING btree (user_id, liked_at);
+CREATE INDEX idx_btree_liked_playlists_playlist ON Liked_playlists USING btree (playlist_id);
+
+CREATE TABLE Followers
+(
+    follower_id INT REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+    user_id     INT REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+    followed_at TIMESTAMPTZ DEFAULT NOW()                  NOT NULL,
+
+    PRIMARY KEY(follower_id, user_id),
+    CHECK (follower_id <> user_id)
+);
+
+CREATE INDEX idx_btree_followers ON Followers USING btree (user_id, followed_at);
+
+CREATE TABLE Activities
+(
+    id          SERIAL      PRIMARY KEY,
+    type        VARCHAR(20)                                 NOT NULL,
+    user_id     INT REFERENCES Users(id)     ON DELETE CASCADE,
+    album_id    INT REFERENCES Albums(id)    ON DELETE CASCADE,
+    track_id    INT REFERENCES Tracks(id)    ON DELETE CASCADE,
+    playlist_id INT REFERENCES Playlists(id) ON DELETE CASCADE,
+    created_at  TIMESTAMPTZ DEFAULT NOW()                   NOT NULL
+);
+
+CREATE INDEX idx_btree_activities_user ON Activities USING btree (user_id, type);
+
+CREATE TABLE Activity_timeline
+(
+    user_id     INT REFERENCES Users(id)      ON DELETE CASCADE NOT NULL,
+    activity_id INT REFERENCES Activities(id) ON DELETE CASCADE NOT NULL,
+    created_at  TIMESTAMPTZ                                     NOT NULL,
+
+    PRIMARY KEY(user_id, activity_id)
+);
+
+CREATE INDEX idx_btree_activity_timeline ON Activity_timeline USING btree (user_id, created_at);
+
+CREATE TABLE Track_neighbors
+(
+    track_id    INT REFERENCES Tracks(id) ON DELETE CASCADE NOT NULL,
+    neighbor_id INT REFERENCES Tracks(id) ON DELETE CASCADE NOT NULL,
+    score       REAL                                         NOT NULL,
+
+    PRIMARY KEY(track_id, neighbor_id),
+    CHECK (track_id <> neighbor_id)
+);
+
+CREATE INDEX idx_btree_track_neighbors ON Track_neighbors USING btree (track_id, score DESC);
+
+CREATE TABLE Related_artists
+(
+    artist_id  INT REFERENCES Artists(id) ON DELETE CASCADE NOT NULL,
+    related_id INT REFERENCES Artists(id) ON DELETE CASCADE NOT NULL,
+    score      REAL                                          NOT NULL,
+
+    PRIMARY KEY(artist_id, related_id),
+    CHECK (artist_id <> related_id)
+);
+
+CREATE INDEX idx_btree_related_artists ON Related_artists USING btree (artist_id, score DESC);
+
+-- Query is stored html-escaped, so it's up to 5 times longer than 200 characters typed by user
+CREATE TABLE Recent_searches
+(
+    id          SERIAL        PRIMARY KEY,
+    user_id     INT REFERENCES Users(id) ON DELETE CASCADE NOT NULL,
+    query       VARCHAR(1000)                              NOT NULL,
+    entity_type VARCHAR(10),
+    entity_id   INT,
+    searched_at TIMESTAMPTZ DEFAULT NOW()                  NOT NULL
+);
+
+CREATE INDEX idx_btree_recent_searches ON Recent_searches USING btree (user_id, searched_at);
+
+CREATE TABLE Moderators
+(
+    user_id    INT REFERENCES Users(id) ON DELETE CASCADE PRIMARY KEY,
+    granted_at TIMESTAMPTZ DEFAULT NOW()                  NOT NULL
+);
+
+-- Comment belongs to exactly one of track, album and playlist.
+-- Text is stored html-escaped, so it's up to 5 times longer than 2000 characters typed by user
+CREATE TABLE Comments
+(
+    id          SERIAL        PRIMARY KEY,
+    track_id    INT REFERENCES Tracks(id)    ON DELETE CASCADE,
+    album_id    INT REFERENCES Albums(id)    ON DELETE CASCADE,
+    playlist_id INT REFERENCES Playlists(id) ON DELETE CASCADE,
+    parent_id   INT REFERENCES Comments(id)  ON DELETE CASCADE,
+    user_id     INT REFERENCES Users(id)     ON DELETE SET NULL,
+    text        VARCHAR(10000)                                 NOT NULL,
+    created_at  TIMESTAMPTZ DEFAULT NOW()                      NOT NULL,
+    edited_at   TIMESTAMPTZ,
+    deleted_at  TIMESTAMPTZ,
+
+    CHECK (num_nonnulls(track_id, album_id, playlist_id) = 1)
+);
+
+CREATE INDEX idx_btree_comments_track ON Comments USING btree (track_id, id) WHERE parent_id IS NULL;
+CREATE INDEX idx_btree_comments_album ON Comments USING btree (album_id, id) WHERE parent_id IS NULL;
+CREATE INDEX idx_btree_comments_playlist ON Comments USING btree (playlist_id, id) WHERE parent_id IS NULL;
+CREATE INDEX idx_btree_comments_parent ON Comments USING btree (parent_id, id);
+CREATE INDEX idx_btree_comments_user ON Comments USING btree (user_id, created_at);
+
+CREATE TABLE Liked_comments
+(
+    user_id    INT REFERENCES Users(id)    ON DELETE CASCADE NOT NULL,
+    comment_id INT REFERENCES Comments(id) ON DELETE CASCADE NOT NULL,
+    liked_at   TIMESTAMPTZ DEFAULT NOW()                     NOT NULL,
+
+    PRIMARY KEY(user_id, comment_id)
+);
+
+CREATE INDEX idx_btree_liked_comments_comment ON Liked_comments USING btree (comment_id);
+
+CREATE TABLE Notifications
+(
+    id          SERIAL      PRIMARY KEY,
+    user_id     INT REFERENCES Users(id)     ON DELETE CASCADE NOT NULL,
+    type        VARCHAR(20)                                    NOT NULL,
+    actor_id    INT REFERENCES Users(id)     ON DELETE CASCADE,
+    album_id    INT REFERENCES Albums(id)    ON DELETE CASCADE,
+    playlist_id INT REFERENCES Playlists(id) ON DELETE CASCADE,
+    is_read     BOOLEAN     DEFAULT FALSE                      NOT NULL,
+    created_at  TIMESTAMPTZ DEFAULT NOW()                      NOT NULL
+);
+
+CREATE INDEX idx_btree_notifications_user ON Notifications USING btree (user_id, created_at);
+CREATE INDEX idx_btree_notifications_unread ON Notifications USING btree (user_id) WHERE NOT is_read;
+
+-- Escaped device name takes up to 5 characters per typed one, 64 of which are allowed
+CREATE TABLE Player_states
+(
+    user_id     INT REFERENCES Users(id)  ON DELETE CASCADE PRIMARY KEY,
+    track_id    INT REFERENCES Tracks(id) ON DELETE SET NULL,
+    position_ms INT         DEFAULT 0                       NOT NULL,
+    queue       INT[]       DEFAULT '{}'                    NOT NULL,
+    shuffle     BOOLEAN     DEFAULT FALSE                   NOT NULL,
+    repeat_mode VARCHAR(10) DEFAULT 'off'                   NOT NULL,
+    device_id   VARCHAR(64)                                 NOT NULL,
+    device_name VARCHAR(320) DEFAULT ''                     NOT NULL,
+    version     INT         DEFAULT 1                       NOT NULL,
+    updated_at  TIMESTAMPTZ DEFAULT NOW()                   NOT NULL
+);
+
+CREATE TABLE Share_links
+(
+    code        VARCHAR(8)  PRIMARY KEY,
+    entity_type VARCHAR(10)                NOT NULL,
+    entity_id   INT                        NOT NULL,
+    clicks      INT         DEFAULT 0      NOT NULL,
+    created_at  TIMESTAMPTZ DEFAULT NOW()  NOT NULL,
+
+    UNIQUE(entity_type, entity_id)
+);
+
 
 -- Text Search
 
@@ -157,3 +362,14 @@ CREATE INDEX idx_btree_tracks ON Tracks USING btree (LOWER(name) varchar_pattern
 
 CREATE INDEX idx_gin_playlists ON Playlists USING gin (to_tsvector(lang, name));
 CREATE INDEX idx_btree_playlists ON Playlists USING btree (LOWER(name) varchar_pattern_ops);
+
+CREATE INDEX idx_gin_lyrics ON Lyrics USING gin (to_tsvector(lang, text));
+
+-- Fuzzy Search
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_gin_trgm_artists ON Artists USING gin (LOWER(name) gin_trgm_ops);
+CREATE INDEX idx_gin_trgm_albums ON Albums USING gin (LOWER(name) gin_trgm_ops);
+CREATE INDEX idx_gin_trgm_tracks ON Tracks USING gin (LOWER(name) gin_trgm_ops);
+CREATE INDEX idx_gin_trgm_playlists ON Playlists USING gin (LOWER(name) gin_trgm_ops);
@@ -0,0 +1,42 @@
+package notification
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/config"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/channel/async"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/channel/email"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/channel/inapp"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+)
+
+const (
+	emailQueueSize   = 1000
+	emailSendTimeout = time.Minute
+)
+
+// MakeChannels returns delivery channels of notifications. In-app inbox is always used,
+// email is used only if SMTP server is configured. Emails are sent in background,
+// so requests don't wait for SMTP server.
+func MakeChannels(r notification.Repository, ur user.Repository, l logger.Logger) ([]notification.Channel, error) {
+	channels := []notification.Channel{inapp.NewChannel(r)}
+
+	smtpAddr := os.Getenv(config.SMTPAddrParam)
+	if smtpAddr == "" {
+		return channels, nil
+	}
+
+	mailer, err := email.NewSMTPMailer(smtpAddr,
+		os.Getenv(config.SMTPUserParam), os.Getenv(config.SMTPPasswordParam), os.Getenv(config.SMTPFromParam))
+	if err != nil {
+		return nil, fmt.Errorf("can't create mailer: %w", err)
+	}
+
+	emailChannel := email.NewChannel(mailer, ur, os.Getenv(config.SiteURLParam))
+
+	return append(channels, async.NewChannel(emailChannel, emailQueueSize, emailSendTimeout, l)), nil
+}
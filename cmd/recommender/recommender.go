@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv" // load environment
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/config"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/db/postgresql"
+	commonHttp "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+
+	artistRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/repository/postgresql"
+	recommendationRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation/repository/postgresql"
+	trackRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/repository/postgresql"
+
+	recommendationUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation/usecase"
+)
+
+const defaultRecomputeInterval = time.Hour
+
+// Recommender is offline batch job which periodically recomputes
+// similarity of tracks and related artists used by recommendations
+func main() {
+	once := flag.Bool("once", false, "recompute neighbors of tracks and related artists once and exit")
+	flag.Parse()
+
+	logger, err := logger.NewLogger(commonHttp.GetReqIDFromContext)
+	if err != nil {
+		log.Fatalf("Logger can not be defined: %v\n", err)
+	}
+
+	db, tables, err := postgresql.InitPostgresDB()
+	if err != nil {
+		logger.Errorf("Error while connecting to database: %v", err)
+		return
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Errorf("Error while closing DB connection: %v", err)
+		}
+	}()
+
+	recommendationRepo := recommendationRepository.NewPostgreSQL(db, tables, recommenderConfig(logger))
+	trackRepo := trackRepository.NewPostgreSQL(db, tables)
+	artistRepo := artistRepository.NewPostgreSQL(db, tables)
+	recommendationUsecase := recommendationUsecase.NewUsecase(recommendationRepo, trackRepo, artistRepo)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if *once {
+		if err := recompute(ctx, recommendationUsecase, logger); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
+	interval := recomputeInterval(logger)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logger.Infof("Starting recommender, recomputation runs every %s", interval)
+	for {
+		// Errors are logged, next recomputation can succeed
+		_ = recompute(ctx, recommendationUsecase, logger)
+
+		select {
+		case <-ctx.Done():
+			logger.Info("Recommender gracefully shutting down...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func recompute(ctx context.Context, u *recommendationUsecase.Usecase, logger logger.Logger) error {
+	start := time.Now()
+	if err := u.RecomputeNeighbors(ctx); err != nil {
+		logger.Errorf("Error while recomputing neighbors of tracks: %v", err)
+		return err
+	}
+	logger.Infof("Neighbors of tracks are recomputed in %s", time.Since(start))
+
+	start = time.Now()
+	if err := u.RecomputeRelatedArtists(ctx); err != nil {
+		logger.Errorf("Error while recomputing related artists: %v", err)
+		return err
+	}
+	logger.Infof("Related artists are recomputed in %s", time.Since(start))
+
+	return nil
+}
+
+// recomputeInterval returns interval between recomputations set by environment or default one
+func recomputeInterval(logger logger.Logger) time.Duration {
+	param := os.Getenv(config.RecommenderIntervalParam)
+	if param == "" {
+		return defaultRecomputeInterval
+	}
+
+	interval, err := time.ParseDuration(param)
+	if err != nil || interval <= 0 {
+		logger.Errorf("Invalid recompute interval %q, default is used", param)
+		return defaultRecomputeInterval
+	}
+
+	return interval
+}
+
+// recommenderConfig overrides default recommendation parameters by environment ones
+func recommenderConfig(logger logger.Logger) recommendation.Config {
+	cfg := recommendation.DefaultConfig()
+
+	if param := os.Getenv(config.RecommenderNeighborsParam); param != "" {
+		neighbors, err := strconv.ParseUint(param, 10, 32)
+		if err != nil || neighbors == 0 {
+			logger.Errorf("Invalid amount of neighbors per track %q, default is used", param)
+		} else {
+			cfg.NeighborsPerTrack = uint32(neighbors)
+		}
+	}
+
+	return cfg
+}
+
+func init() {
+	_ = godotenv.Load()
+}
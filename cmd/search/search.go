@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"sync"
 	"syscall"
 	"time"
@@ -21,12 +24,32 @@ import (
 	commonHttp "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
 	searchGRPC "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/microservices/search/delivery/grpc"
 	searchProto "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/microservices/search/proto/generated"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
 
+	searchEmbedded "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search/repository/embedded"
 	searchRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search/repository/postgresql"
 	searchUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search/usecase"
 )
 
+const (
+	suggestionsRefreshInterval = time.Minute
+	suggestionsRebuildPeriod   = 60 // in refreshes
+)
+
+// Backends of search
+const (
+	searchBackendPostgres = "postgres"
+	searchBackendEmbedded = "embedded"
+)
+
+const (
+	defaultSearchIndexPath = "search.index"
+
+	searchIndexUpdateInterval = time.Minute
+	searchIndexRebuildPeriod  = 60 // in updates
+)
+
 const (
 	maxHeaderBytesHTTP = 1 << 20
 	readTimeoutHTTP    = 10 * time.Second
@@ -39,6 +62,9 @@ var (
 )
 
 func main() {
+	reindex := flag.Bool("reindex", false, "rebuild embedded search index and exit")
+	flag.Parse()
+
 	logger, err := logger.NewLogger(commonHttp.GetReqIDFromContext)
 	if err != nil {
 		log.Fatalf("Logger can not be defined: %v\n", err)
@@ -55,9 +81,60 @@ func main() {
 		}
 	}()
 
-	searchRepo := searchRepository.NewPostgreSQL(db, tables)
+	searchCfg := searchConfig(logger)
+
+	postgresRepo := searchRepository.NewPostgreSQL(db, tables, searchCfg.Rank)
+	var searchRepo search.Repository = postgresRepo
+
+	switch backend := os.Getenv(config.SearchBackendParam); backend {
+	case "", searchBackendPostgres:
+		if *reindex {
+			logger.Errorf("Only %s search backend has index to rebuild", searchBackendEmbedded)
+			os.Exit(1)
+		}
+
+	case searchBackendEmbedded:
+		indexPath := os.Getenv(config.SearchIndexPathParam)
+		if indexPath == "" {
+			indexPath = defaultSearchIndexPath
+		}
+		embeddedRepo := searchEmbedded.NewRepository(postgresRepo, searchCfg.Rank, indexPath)
+
+		if *reindex {
+			if err := embeddedRepo.Reindex(context.Background()); err != nil {
+				logger.Errorf("Error while rebuilding search index: %v", err)
+				os.Exit(1)
+			}
+			logger.Infof("Search index %s is rebuilt", indexPath)
+			return
+		}
+
+		if err := embeddedRepo.Open(context.Background()); err != nil {
+			logger.Errorf("Error while opening search index: %v", err)
+			return
+		}
+
+		updateCtx, stopUpdate := context.WithCancel(context.Background())
+		defer stopUpdate()
+		go updateSearchIndex(updateCtx, embeddedRepo, logger)
+
+		searchRepo = embeddedRepo
+
+	default:
+		logger.Errorf("Unknown search backend %q", backend)
+		return
+	}
+
+	searchUsecase := searchUsecase.NewUsecase(searchRepo, searchCfg)
+
+	if err := searchUsecase.RefreshSuggestions(context.Background(), true); err != nil {
+		logger.Errorf("Error while building autocomplete index: %v", err)
+		return
+	}
 
-	searchUsecase := searchUsecase.NewUsecase(searchRepo)
+	refreshCtx, stopRefresh := context.WithCancel(context.Background())
+	defer stopRefresh()
+	go refreshSuggestions(refreshCtx, searchUsecase, logger)
 
 	listener, err := net.Listen("tcp", os.Getenv(config.SearchListenParam))
 	defer func() {
@@ -116,6 +193,93 @@ func main() {
 	wg.Wait()
 }
 
+// refreshSuggestions adds new entities to autocomplete index
+// and periodically rebuilds it to forget deleted and renamed ones
+func refreshSuggestions(ctx context.Context, u *searchUsecase.Usecase, logger logger.Logger) {
+	ticker := time.NewTicker(suggestionsRefreshInterval)
+	defer ticker.Stop()
+
+	for refreshes := 1; ; refreshes++ {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			full := refreshes%suggestionsRebuildPeriod == 0
+			if err := u.RefreshSuggestions(ctx, full); err != nil {
+				logger.Errorf("Error while refreshing autocomplete index: %v", err)
+			}
+		}
+	}
+}
+
+// updateSearchIndex adds new entities to embedded search index
+// and periodically rebuilds it to handle changed and deleted ones
+func updateSearchIndex(ctx context.Context, r *searchEmbedded.Repository, logger logger.Logger) {
+	ticker := time.NewTicker(searchIndexUpdateInterval)
+	defer ticker.Stop()
+
+	for updates := 1; ; updates++ {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			var err error
+			if updates%searchIndexRebuildPeriod == 0 {
+				err = r.Reindex(ctx)
+			} else {
+				err = r.Update(ctx)
+			}
+			if err != nil {
+				logger.Errorf("Error while updating search index: %v", err)
+			}
+		}
+	}
+}
+
+// searchConfig overrides default search parameters by environment ones
+func searchConfig(logger logger.Logger) search.Config {
+	cfg := search.DefaultConfig()
+
+	if param := os.Getenv(config.SearchFuzzyThresholdParam); param != "" {
+		threshold, err := strconv.ParseFloat(param, 64)
+		if err != nil || threshold < 0 || threshold > 1 {
+			logger.Errorf("Invalid fuzzy search threshold %q, default is used", param)
+		} else {
+			cfg.FuzzyThreshold = threshold
+		}
+	}
+
+	if param := os.Getenv(config.SearchFuzzyFallbackMinParam); param != "" {
+		fallbackMin, err := strconv.ParseUint(param, 10, 32)
+		if err != nil {
+			logger.Errorf("Invalid fuzzy search fallback minimum %q, default is used", param)
+		} else {
+			cfg.FuzzyFallbackMin = uint32(fallbackMin)
+		}
+	}
+
+	parseWeight(logger, config.SearchListensWeightParam, &cfg.Rank.Listens)
+	parseWeight(logger, config.SearchLikesWeightParam, &cfg.Rank.Likes)
+	parseWeight(logger, config.SearchPersonalWeightParam, &cfg.Rank.Personal)
+
+	return cfg
+}
+
+// parseWeight overrides weight of search ranking if environment param is set
+func parseWeight(logger logger.Logger, param string, weight *float64) {
+	value := os.Getenv(param)
+	if value == "" {
+		return
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil || parsed < 0 {
+		logger.Errorf("Invalid search rank weight %s=%q, default is used", param, value)
+		return
+	}
+	*weight = parsed
+}
+
 func init() {
 	_ = godotenv.Load()
 }
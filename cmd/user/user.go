@@ -18,12 +18,15 @@ import (
 
 	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/config"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/db/postgresql"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/notification"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/cmd/internal/s3"
 	commonHttp "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
 	userGRPC "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/microservices/user/delivery/grpc"
 	userProto "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/microservices/user/proto/generated"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
 
+	notificationRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/repository/postgresql"
+	notificationUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/usecase"
 	userS3 "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/client/s3"
 	userRepository "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/repository/postgresql"
 	userUsecase "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/usecase"
@@ -58,6 +61,13 @@ func main() {
 	}()
 
 	userRepo := userRepository.NewPostgreSQL(db, tables)
+	notificationRepo := notificationRepository.NewPostgreSQL(db, tables)
+
+	notificationChannels, err := notification.MakeChannels(notificationRepo, userRepo, logger)
+	if err != nil {
+		logger.Errorf("Error while creating notification channels: %v", err)
+		return
+	}
 
 	s3Client, err := s3.MakeS3MinioClient(os.Getenv(config.S3HostParam), os.Getenv(config.S3AccessKeyParam), os.Getenv(config.S3SecretKeyParam))
 	if err != nil {
@@ -66,7 +76,8 @@ func main() {
 	}
 	userS3 := userS3.NewS3AvatarSaver(os.Getenv(config.S3BucketParam), os.Getenv(config.S3AvatarFolderParam), s3Client)
 
-	userUsecase := userUsecase.NewUsecase(userRepo, userS3)
+	notificationUsecase := notificationUsecase.NewUsecase(notificationRepo, logger, notificationChannels...)
+	userUsecase := userUsecase.NewUsecase(userRepo, userS3, notificationUsecase)
 
 	listener, err := net.Listen("tcp", os.Getenv(config.UserListenParam))
 	defer func() {
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// purgeThreshold is amount of entries after which expired ones are removed on Set
+const purgeThreshold = 1024
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTL is concurrency-safe in-memory cache which entries expire after fixed duration
+type TTL[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[K]entry[V]
+}
+
+func NewTTL[K comparable, V any](ttl time.Duration) *TTL[K, V] {
+	return &TTL[K, V]{
+		ttl:     ttl,
+		entries: make(map[K]entry[V]),
+	}
+}
+
+// Get returns value by key if it exists and isn't expired
+func (c *TTL[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	if time.Now().After(e.expiresAt) {
+		delete(c.entries, key)
+		var zero V
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+func (c *TTL[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= purgeThreshold {
+		c.purgeExpired()
+	}
+
+	c.entries[key] = entry[V]{
+		value:     value,
+		expiresAt: time.Now().Add(c.ttl),
+	}
+}
+
+func (c *TTL[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+func (c *TTL[K, V]) purgeExpired() {
+	now := time.Now()
+	for k, e := range c.entries {
+		if now.After(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+}
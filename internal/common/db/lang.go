@@ -0,0 +1,28 @@
+package db
+
+import "unicode"
+
+// Text search configurations which names of entities are indexed with
+const (
+	LangEnglish = "english"
+	LangRussian = "russian"
+)
+
+// DetectLang chooses text search configuration for text by its alphabet:
+// russian if it has more Cyrillic letters than Latin ones, english otherwise
+func DetectLang(text string) string {
+	var cyrillic, latin int
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		}
+	}
+
+	if cyrillic > latin {
+		return LangRussian
+	}
+	return LangEnglish
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLang(t *testing.T) {
+	testTable := []struct {
+		name         string
+		text         string
+		expectedLang string
+	}{
+		{
+			name:         "Latin",
+			text:         "Master of Puppets",
+			expectedLang: LangEnglish,
+		},
+		{
+			name:         "Cyrillic",
+			text:         "Где нас нет",
+			expectedLang: LangRussian,
+		},
+		{
+			name:         "Mostly Cyrillic",
+			text:         "Oxxxymiron — Город под подошвой",
+			expectedLang: LangRussian,
+		},
+		{
+			name:         "Mostly Latin",
+			text:         "Lost in Москва",
+			expectedLang: LangEnglish,
+		},
+		{
+			name:         "No Letters",
+			text:         "1 2 3",
+			expectedLang: LangEnglish,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedLang, DetectLang(tc.text))
+		})
+	}
+}
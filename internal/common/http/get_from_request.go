@@ -17,6 +17,23 @@ const (
 	AlbumIdUrlParam    = "albumID"
 	PlaylistIdUrlParam = "playlistID"
 	UserIdUrlParam     = "userID"
+	RevisionIdUrlParam = "revisionID"
+	CommentIdUrlParam  = "commentID"
+
+	NotificationIdUrlParam = "notificationID"
+
+	RecentSearchIdUrlParam = "recentSearchID"
+
+	ShareCodeUrlParam = "shareCode"
+)
+
+const (
+	SortQueryParam   = "sort"
+	OrderQueryParam  = "order"
+	FilterQueryParam = "q"
+	LimitQueryParam  = "limit"
+	OffsetQueryParam = "offset"
+	CursorQueryParam = "cursor"
 )
 
 var ErrUnauthorized = &models.UnathorizedError{}
@@ -63,6 +80,27 @@ func GetPlaylistIDFromRequest(r *http.Request) (uint32, error) {
 	return convertID(chi.URLParam(r, PlaylistIdUrlParam))
 }
 
+func GetRevisionIDFromRequest(r *http.Request) (uint32, error) {
+	return convertID(chi.URLParam(r, RevisionIdUrlParam))
+}
+
+func GetCommentIDFromRequest(r *http.Request) (uint32, error) {
+	return convertID(chi.URLParam(r, CommentIdUrlParam))
+}
+
+func GetNotificationIDFromRequest(r *http.Request) (uint32, error) {
+	return convertID(chi.URLParam(r, NotificationIdUrlParam))
+}
+
+func GetRecentSearchIDFromRequest(r *http.Request) (uint32, error) {
+	return convertID(chi.URLParam(r, RecentSearchIdUrlParam))
+}
+
+// GetShareCodeFromRequest returns code as is: it's validated on usecase layer
+func GetShareCodeFromRequest(r *http.Request) string {
+	return chi.URLParam(r, ShareCodeUrlParam)
+}
+
 func convertID(idUrl string) (uint32, error) {
 	id, err := strconv.ParseUint(idUrl, 10, 32)
 	if err != nil || id == 0 {
@@ -71,3 +109,64 @@ func convertID(idUrl string) (uint32, error) {
 
 	return uint32(id), nil
 }
+
+// GetFavoritesQueryFromRequest returns error if pagination query params aren't numbers.
+// Sorting is validated on usecase layer.
+func GetFavoritesQueryFromRequest(r *http.Request) (models.FavoritesQuery, error) {
+	values := r.URL.Query()
+
+	q := models.FavoritesQuery{
+		SortBy: values.Get(SortQueryParam),
+		Order:  values.Get(OrderQueryParam),
+		Filter: values.Get(FilterQueryParam),
+	}
+
+	var err error
+	if q.Limit, err = convertQueryUint(values.Get(LimitQueryParam)); err != nil {
+		return models.FavoritesQuery{}, err
+	}
+	if q.Offset, err = convertQueryUint(values.Get(OffsetQueryParam)); err != nil {
+		return models.FavoritesQuery{}, err
+	}
+
+	return q, nil
+}
+
+// GetPaginationFromRequest returns limit and offset query params, zero if they aren't set
+func GetPaginationFromRequest(r *http.Request) (limit, offset uint32, err error) {
+	values := r.URL.Query()
+
+	if limit, err = convertQueryUint(values.Get(LimitQueryParam)); err != nil {
+		return 0, 0, err
+	}
+	if offset, err = convertQueryUint(values.Get(OffsetQueryParam)); err != nil {
+		return 0, 0, err
+	}
+
+	return limit, offset, nil
+}
+
+// GetCursorPaginationFromRequest returns cursor and limit query params, limit is zero if it isn't set.
+// Cursor is opaque here and is validated on usecase layer.
+func GetCursorPaginationFromRequest(r *http.Request) (cursor string, limit uint32, err error) {
+	values := r.URL.Query()
+
+	if limit, err = convertQueryUint(values.Get(LimitQueryParam)); err != nil {
+		return "", 0, err
+	}
+
+	return values.Get(CursorQueryParam), limit, nil
+}
+
+func convertQueryUint(param string) (uint32, error) {
+	if param == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		return 0, errors.New("invalid numeric query param")
+	}
+
+	return uint32(v), nil
+}
@@ -1,6 +1,9 @@
 package middleware
 
 import (
+	"bufio"
+	"errors"
+	"net"
 	"net/http"
 	"strconv"
 	"time"
@@ -20,6 +23,21 @@ func (w *ResponseWriterStatusCodeSaver) WriteHeader(code int) {
 	w.ResponseWriter.WriteHeader(code)
 }
 
+// Hijack lets handlers take over connection, e.g. to upgrade it to WebSocket
+func (w *ResponseWriterStatusCodeSaver) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer doesn't support hijacking")
+	}
+
+	conn, rw, err := hijacker.Hijack()
+	if err == nil {
+		w.statusCode = http.StatusSwitchingProtocols
+	}
+
+	return conn, rw, err
+}
+
 func (w *ResponseWriterStatusCodeSaver) StatusCode() int {
 	if w.statusCode == 0 {
 		return 200
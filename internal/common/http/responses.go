@@ -15,6 +15,7 @@ const (
 
 	IncorrectRequestBody = "incorrect input body"
 	InvalidURLParameter  = "invalid url parameter"
+	InvalidQueryParam    = "invalid query parameter"
 	UnathorizedUser      = "unathorized"
 	ForbiddenUser        = "user has no rights"
 
@@ -52,6 +53,9 @@ func ErrorResponse(w http.ResponseWriter, r *http.Request, msg string, code int,
 
 const minErrorToLogCode = 500
 
+// TotalCountHeader holds total amount of entries for paginated responses
+const TotalCountHeader = "X-Total-Count"
+
 func ErrorResponseWithErrLogging(w http.ResponseWriter, r *http.Request,
 	msg string, code int, logger logger.Logger, err error) {
 
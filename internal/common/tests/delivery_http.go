@@ -21,6 +21,14 @@ func DeliveryTestPost(t *testing.T, r *chi.Mux, target string, requestBody strin
 	return deliveryTest(t, r, req, expectedStatus, expectedJSONResponse, wrapper)
 }
 
+func DeliveryTestPut(t *testing.T, r *chi.Mux, target string, requestBody string,
+	expectedStatus int, expectedJSONResponse string, wrapper Wrapper) *httptest.ResponseRecorder {
+
+	t.Helper()
+	req := httptest.NewRequest("PUT", target, bytes.NewBufferString(requestBody))
+	return deliveryTest(t, r, req, expectedStatus, expectedJSONResponse, wrapper)
+}
+
 func DeliveryTestGet(t *testing.T, r *chi.Mux, target string,
 	expectedStatus int, expectedJSONResponse string, wrapper Wrapper) *httptest.ResponseRecorder {
 
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+)
+
+//go:generate easyjson -no_std_marshalers activity.go
+
+// Types of activities in users' timelines
+const (
+	// ActivityTypeRelease is new album or single of artist liked by user
+	ActivityTypeRelease = "release"
+	// ActivityTypePlaylistCreated is playlist created by followed user
+	ActivityTypePlaylistCreated = "playlistCreated"
+	// ActivityTypePlaylistUpdated is playlist updated by followed user
+	ActivityTypePlaylistUpdated = "playlistUpdated"
+	// ActivityTypeTrackLiked is track liked by followed user
+	ActivityTypeTrackLiked = "trackLiked"
+)
+
+// Page size of timeline: default one is used if limit isn't set
+const (
+	ActivityDefaultLimit uint32 = 20
+	ActivityMaxLimit     uint32 = 100
+)
+
+// Activity is event which is fanned out to timelines of interested users when it happens.
+// Only IDs of entities relevant to its type are set.
+type Activity struct {
+	ID         uint32    `db:"id"`
+	Type       string    `db:"type"`
+	UserID     *uint32   `db:"user_id"`
+	AlbumID    *uint32   `db:"album_id"`
+	TrackID    *uint32   `db:"track_id"`
+	PlaylistID *uint32   `db:"playlist_id"`
+	CreatedAt  time.Time `db:"created_at"`
+
+	// Entities activity refers to, set when timeline is got
+	User     *User     `db:"-"`
+	Album    *Album    `db:"-"`
+	Track    *Track    `db:"-"`
+	Playlist *Playlist `db:"-"`
+}
+
+//easyjson:json
+type ActivityTransfer struct {
+	ID        uint32              `json:"id"`
+	Type      string              `json:"type"`
+	User      *UserPublicTransfer `json:"user,omitempty"`
+	Album     *AlbumTransfer      `json:"album,omitempty"`
+	Track     *TrackTransfer      `json:"track,omitempty"`
+	Playlist  *PlaylistTransfer   `json:"playlist,omitempty"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+//easyjson:json
+type ActivityTransfers []ActivityTransfer
@@ -1,6 +1,9 @@
 package models
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 //go:generate easyjson -no_std_marshalers album.go
 
@@ -9,6 +12,11 @@ type Album struct {
 	Name        string  `db:"name"`
 	Description *string `db:"description"`
 	CoverSrc    string  `db:"cover_src"`
+
+	// LikedAt is set only for user's favorites
+	LikedAt *time.Time `db:"liked_at"`
+	// Highlight is set only for search results, see HighlightToHTML
+	Highlight string `db:"highlight"`
 }
 
 //easyjson:json
@@ -19,6 +27,8 @@ type AlbumTransfer struct {
 	Description *string         `json:"description,omitempty"`
 	IsLiked     bool            `json:"isLiked"`
 	CoverSrc    string          `json:"cover"`
+	LikedAt     *time.Time      `json:"likedAt,omitempty"`
+	Highlight   string          `json:"highlight,omitempty"`
 }
 
 //easyjson:json
@@ -56,6 +66,8 @@ func AlbumTransferFromEntry(ctx context.Context, a Album, user *User, likeChecke
 		Description: a.Description,
 		IsLiked:     isLiked,
 		CoverSrc:    a.CoverSrc,
+		LikedAt:     a.LikedAt,
+		Highlight:   HighlightToHTML(a.Highlight),
 	}, nil
 }
 
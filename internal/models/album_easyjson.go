@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -108,6 +109,20 @@ func easyjsonD775dbc7DecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(i
 			out.IsLiked = bool(in.Bool())
 		case "cover":
 			out.CoverSrc = string(in.String())
+		case "likedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.LikedAt = nil
+			} else {
+				if out.LikedAt == nil {
+					out.LikedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LikedAt).UnmarshalJSON(data))
+				}
+			}
+		case "highlight":
+			out.Highlight = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -152,6 +167,16 @@ func easyjsonD775dbc7EncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(o
 		out.RawString(prefix)
 		out.String(string(in.CoverSrc))
 	}
+	if in.LikedAt != nil {
+		const prefix string = ",\"likedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.LikedAt).MarshalJSON())
+	}
+	if in.Highlight != "" {
+		const prefix string = ",\"highlight\":"
+		out.RawString(prefix)
+		out.String(string(in.Highlight))
+	}
 	out.RawByte('}')
 }
 
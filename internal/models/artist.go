@@ -1,6 +1,9 @@
 package models
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 //go:generate easyjson -no_std_marshalers artist.go
 
@@ -9,14 +12,23 @@ type Artist struct {
 	UserID    *uint32 `db:"user_id"`
 	Name      string  `db:"name"`
 	AvatarSrc string  `db:"avatar_src"`
+
+	// LikedAt is set only for user's favorites
+	LikedAt *time.Time `db:"liked_at"`
+	// Highlight is set only for search results, see HighlightToHTML
+	Highlight string `db:"highlight"`
 }
 
 //easyjson:json
 type ArtistTransfer struct {
-	ID        uint32 `json:"id"`
-	Name      string `json:"name"`
-	IsLiked   bool   `json:"isLiked"`
-	AvatarSrc string `json:"cover"`
+	ID        uint32     `json:"id"`
+	Name      string     `json:"name"`
+	IsLiked   bool       `json:"isLiked"`
+	AvatarSrc string     `json:"cover"`
+	LikedAt   *time.Time `json:"likedAt,omitempty"`
+	Highlight string     `json:"highlight,omitempty"`
+	// Related is set only for artist's page
+	Related ArtistTransfers `json:"related,omitempty"`
 }
 
 //easyjson:json
@@ -43,6 +55,8 @@ func ArtistTransferFromEntry(ctx context.Context, a Artist, user *User,
 		Name:      a.Name,
 		IsLiked:   isLiked,
 		AvatarSrc: a.AvatarSrc,
+		LikedAt:   a.LikedAt,
+		Highlight: HighlightToHTML(a.Highlight),
 	}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -26,7 +27,7 @@ func easyjson75485a89DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(in
 		in.Delim('[')
 		if *out == nil {
 			if !in.IsDelim(']') {
-				*out = make(ArtistTransfers, 0, 1)
+				*out = make(ArtistTransfers, 0, 0)
 			} else {
 				*out = ArtistTransfers{}
 			}
@@ -96,6 +97,22 @@ func easyjson75485a89DecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(i
 			out.IsLiked = bool(in.Bool())
 		case "cover":
 			out.AvatarSrc = string(in.String())
+		case "likedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.LikedAt = nil
+			} else {
+				if out.LikedAt == nil {
+					out.LikedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LikedAt).UnmarshalJSON(data))
+				}
+			}
+		case "highlight":
+			out.Highlight = string(in.String())
+		case "related":
+			(out.Related).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -130,6 +147,21 @@ func easyjson75485a89EncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(o
 		out.RawString(prefix)
 		out.String(string(in.AvatarSrc))
 	}
+	if in.LikedAt != nil {
+		const prefix string = ",\"likedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.LikedAt).MarshalJSON())
+	}
+	if in.Highlight != "" {
+		const prefix string = ",\"highlight\":"
+		out.RawString(prefix)
+		out.String(string(in.Highlight))
+	}
+	if len(in.Related) != 0 {
+		const prefix string = ",\"related\":"
+		out.RawString(prefix)
+		(in.Related).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,158 @@
+package models
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"time"
+)
+
+//go:generate easyjson -no_std_marshalers comment.go
+
+// Types of entities which can be commented
+const (
+	CommentEntityTrack    = "track"
+	CommentEntityAlbum    = "album"
+	CommentEntityPlaylist = "playlist"
+)
+
+// Page size of comments: default one is used if limit isn't set
+const (
+	CommentDefaultLimit uint32 = 20
+	CommentMaxLimit     uint32 = 100
+)
+
+// CommentEntity identifies commented track, album or playlist
+type CommentEntity struct {
+	Type string
+	ID   uint32
+}
+
+// Comment is user's message about track, album or playlist, or reply to another comment.
+// Deleted comments are kept, so threads of their replies aren't broken.
+type Comment struct {
+	ID         uint32  `db:"id"`
+	EntityType string  `db:"entity_type"`
+	EntityID   uint32  `db:"entity_id"`
+	ParentID   *uint32 `db:"parent_id"`
+	// UserID is nil if author was deleted
+	UserID    *uint32    `db:"user_id"`
+	Text      string     `db:"text"`
+	CreatedAt time.Time  `db:"created_at"`
+	EditedAt  *time.Time `db:"edited_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+
+	Likes   uint32 `db:"likes"`
+	Replies uint32 `db:"replies"`
+}
+
+func (c *Comment) Entity() CommentEntity {
+	return CommentEntity{Type: c.EntityType, ID: c.EntityID}
+}
+
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+// EncodeCommentsCursor returns opaque cursor pointing to page of comments after given one
+func EncodeCommentsCursor(commentID uint32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(commentID), 10)))
+}
+
+// ParseCommentsCursor returns ID of comment cursor points after, empty cursor is the first page
+func ParseCommentsCursor(cursor string) (uint32, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, &InvalidCommentQueryError{Reason: "invalid cursor"}
+	}
+
+	commentID, err := strconv.ParseUint(string(raw), 10, 32)
+	if err != nil || commentID == 0 {
+		return 0, &InvalidCommentQueryError{Reason: "invalid cursor"}
+	}
+
+	return uint32(commentID), nil
+}
+
+// CommentTransfer hides text and author of deleted comment
+//
+//easyjson:json
+type CommentTransfer struct {
+	ID        uint32              `json:"id"`
+	ParentID  *uint32             `json:"parentID,omitempty"`
+	Author    *UserPublicTransfer `json:"author,omitempty"`
+	Text      string              `json:"text"`
+	CreatedAt time.Time           `json:"createdAt"`
+	EditedAt  *time.Time          `json:"editedAt,omitempty"`
+	IsDeleted bool                `json:"isDeleted"`
+	Likes     uint32              `json:"likes"`
+	IsLiked   bool                `json:"isLiked"`
+	Replies   uint32              `json:"replies"`
+}
+
+//easyjson:json
+type CommentTransfers []CommentTransfer
+
+type CommentLikeChecker func(ctx context.Context, commentID, userID uint32) (bool, error)
+
+type CommentAuthorGetter func(ctx context.Context, userID uint32) (*User, error)
+
+// CommentTransferFromEntry converts Comment to CommentTransfer
+func CommentTransferFromEntry(ctx context.Context, c Comment, user *User,
+	likeChecker CommentLikeChecker, authorGetter CommentAuthorGetter) (CommentTransfer, error) {
+
+	ct := CommentTransfer{
+		ID:        c.ID,
+		ParentID:  c.ParentID,
+		CreatedAt: c.CreatedAt,
+		IsDeleted: c.IsDeleted(),
+		Likes:     c.Likes,
+		Replies:   c.Replies,
+	}
+	if c.IsDeleted() {
+		return ct, nil
+	}
+
+	ct.Text = c.Text
+	ct.EditedAt = c.EditedAt
+
+	if c.UserID != nil {
+		author, err := authorGetter(ctx, *c.UserID)
+		if err != nil {
+			return CommentTransfer{}, err
+		}
+		at := UserPublicTransferFromEntry(*author)
+		ct.Author = &at
+	}
+
+	if user != nil {
+		isLiked, err := likeChecker(ctx, c.ID, user.ID)
+		if err != nil {
+			return CommentTransfer{}, err
+		}
+		ct.IsLiked = isLiked
+	}
+
+	return ct, nil
+}
+
+// CommentTransferFromList converts []Comment to []CommentTransfer
+func CommentTransferFromList(ctx context.Context, comments []Comment, user *User,
+	likeChecker CommentLikeChecker, authorGetter CommentAuthorGetter) (CommentTransfers, error) {
+
+	commentTransfers := make([]CommentTransfer, 0, len(comments))
+	for _, c := range comments {
+		ct, err := CommentTransferFromEntry(ctx, c, user, likeChecker, authorGetter)
+		if err != nil {
+			return nil, err
+		}
+
+		commentTransfers = append(commentTransfers, ct)
+	}
+
+	return commentTransfers, nil
+}
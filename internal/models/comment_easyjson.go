@@ -0,0 +1,285 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonE9abebc9DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(in *jlexer.Lexer, out *CommentTransfers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(CommentTransfers, 0, 0)
+			} else {
+				*out = CommentTransfers{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 CommentTransfer
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE9abebc9EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(out *jwriter.Writer, in CommentTransfers) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommentTransfers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE9abebc9EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommentTransfers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE9abebc9DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(l, v)
+}
+func easyjsonE9abebc9DecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(in *jlexer.Lexer, out *CommentTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "parentID":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentID = nil
+			} else {
+				if out.ParentID == nil {
+					out.ParentID = new(uint32)
+				}
+				*out.ParentID = uint32(in.Uint32())
+			}
+		case "author":
+			if in.IsNull() {
+				in.Skip()
+				out.Author = nil
+			} else {
+				if out.Author == nil {
+					out.Author = new(UserPublicTransfer)
+				}
+				easyjsonE9abebc9DecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(in, out.Author)
+			}
+		case "text":
+			out.Text = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "editedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.EditedAt = nil
+			} else {
+				if out.EditedAt == nil {
+					out.EditedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.EditedAt).UnmarshalJSON(data))
+				}
+			}
+		case "isDeleted":
+			out.IsDeleted = bool(in.Bool())
+		case "likes":
+			out.Likes = uint32(in.Uint32())
+		case "isLiked":
+			out.IsLiked = bool(in.Bool())
+		case "replies":
+			out.Replies = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE9abebc9EncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(out *jwriter.Writer, in CommentTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	if in.ParentID != nil {
+		const prefix string = ",\"parentID\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(*in.ParentID))
+	}
+	if in.Author != nil {
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		easyjsonE9abebc9EncodeGithubComGoParkMailRu20231TechnokaifInternalModels2(out, *in.Author)
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	if in.EditedAt != nil {
+		const prefix string = ",\"editedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.EditedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"isDeleted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDeleted))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Likes))
+	}
+	{
+		const prefix string = ",\"isLiked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLiked))
+	}
+	{
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Replies))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CommentTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE9abebc9EncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CommentTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE9abebc9DecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(l, v)
+}
+func easyjsonE9abebc9DecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(in *jlexer.Lexer, out *UserPublicTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "username":
+			out.Username = string(in.String())
+		case "firstName":
+			out.FirstName = string(in.String())
+		case "lastName":
+			out.LastName = string(in.String())
+		case "avatarSrc":
+			out.AvatarSrc = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE9abebc9EncodeGithubComGoParkMailRu20231TechnokaifInternalModels2(out *jwriter.Writer, in UserPublicTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"firstName\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"lastName\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	if in.AvatarSrc != "" {
+		const prefix string = ",\"avatarSrc\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarSrc))
+	}
+	out.RawByte('}')
+}
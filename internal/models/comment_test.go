@@ -0,0 +1,57 @@
+package models
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommentsCursor(t *testing.T) {
+	testTable := []struct {
+		name        string
+		cursor      string
+		expectedID  uint32
+		expectError bool
+	}{
+		{
+			name:       "Encoded",
+			cursor:     EncodeCommentsCursor(42),
+			expectedID: 42,
+		},
+		{
+			name:       "First Page",
+			cursor:     "",
+			expectedID: 0,
+		},
+		{
+			name:        "Not Base64",
+			cursor:      "!!!",
+			expectError: true,
+		},
+		{
+			name:        "Not ID",
+			cursor:      base64.RawURLEncoding.EncodeToString([]byte("abc")),
+			expectError: true,
+		},
+		{
+			name:        "Zero ID",
+			cursor:      base64.RawURLEncoding.EncodeToString([]byte("0")),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			commentID, err := ParseCommentsCursor(tc.cursor)
+
+			if tc.expectError {
+				var errInvalidQuery *InvalidCommentQueryError
+				assert.ErrorAs(t, err, &errInvalidQuery)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedID, commentID)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // Track errors
 
@@ -12,6 +15,22 @@ func (e *NoSuchTrackError) Error() string {
 	return fmt.Sprintf("track #%d doesn't exist", e.TrackID)
 }
 
+type NoSuchLyricsError struct {
+	TrackID uint32
+}
+
+func (e *NoSuchLyricsError) Error() string {
+	return fmt.Sprintf("track #%d has no lyrics", e.TrackID)
+}
+
+type InvalidLyricsError struct {
+	Reason string
+}
+
+func (e *InvalidLyricsError) Error() string {
+	return fmt.Sprintf("invalid lyrics: %s", e.Reason)
+}
+
 // Album errors
 
 type NoSuchAlbumError struct {
@@ -32,6 +51,47 @@ func (e *NoSuchPlaylistError) Error() string {
 	return fmt.Sprintf("playlist #%d doesn't exist", e.PlaylistID)
 }
 
+type NotSmartPlaylistError struct {
+	PlaylistID uint32
+}
+
+func (e *NotSmartPlaylistError) Error() string {
+	return fmt.Sprintf("playlist #%d isn't smart", e.PlaylistID)
+}
+
+type SmartPlaylistModifyError struct {
+	PlaylistID uint32
+}
+
+func (e *SmartPlaylistModifyError) Error() string {
+	return fmt.Sprintf("tracks of smart playlist #%d can't be changed manually", e.PlaylistID)
+}
+
+type InvalidSmartRulesError struct {
+	Reason string
+}
+
+func (e *InvalidSmartRulesError) Error() string {
+	return fmt.Sprintf("invalid smart playlist rules: %s", e.Reason)
+}
+
+type TooBigTracksBatchError struct {
+	Size uint32
+}
+
+func (e *TooBigTracksBatchError) Error() string {
+	return fmt.Sprintf("too many tracks in batch: %d > %d", e.Size, PlaylistTracksBatchMaxSize)
+}
+
+type NoSuchPlaylistRevisionError struct {
+	PlaylistID uint32
+	RevisionID uint32
+}
+
+func (e *NoSuchPlaylistRevisionError) Error() string {
+	return fmt.Sprintf("playlist #%d has no revision #%d", e.PlaylistID, e.RevisionID)
+}
+
 // Artist errors
 
 type NoSuchArtistError struct {
@@ -42,6 +102,81 @@ func (e *NoSuchArtistError) Error() string {
 	return fmt.Sprintf("artist #%d doesn't exist", e.ArtistID)
 }
 
+// Favorites errors
+
+type InvalidFavoritesQueryError struct {
+	Reason string
+}
+
+func (e *InvalidFavoritesQueryError) Error() string {
+	return fmt.Sprintf("invalid favorites query: %s", e.Reason)
+}
+
+// Activity errors
+
+type InvalidActivityQueryError struct {
+	Reason string
+}
+
+func (e *InvalidActivityQueryError) Error() string {
+	return fmt.Sprintf("invalid activity query: %s", e.Reason)
+}
+
+// Radio errors
+
+type InvalidRadioQueryError struct {
+	Reason string
+}
+
+func (e *InvalidRadioQueryError) Error() string {
+	return fmt.Sprintf("invalid radio query: %s", e.Reason)
+}
+
+// Comment errors
+
+type NoSuchCommentError struct {
+	CommentID uint32
+}
+
+func (e *NoSuchCommentError) Error() string {
+	return fmt.Sprintf("comment #%d doesn't exist", e.CommentID)
+}
+
+type InvalidCommentQueryError struct {
+	Reason string
+}
+
+func (e *InvalidCommentQueryError) Error() string {
+	return fmt.Sprintf("invalid comments query: %s", e.Reason)
+}
+
+type CommentRateLimitError struct {
+	Limit  uint32
+	Window time.Duration
+}
+
+func (e *CommentRateLimitError) Error() string {
+	return fmt.Sprintf("more than %d comments per %s", e.Limit, e.Window)
+}
+
+// Search errors
+
+type InvalidSearchFiltersError struct {
+	Reason string
+}
+
+func (e *InvalidSearchFiltersError) Error() string {
+	return fmt.Sprintf("invalid search filters: %s", e.Reason)
+}
+
+type NoSuchRecentSearchError struct {
+	RecentSearchID uint32
+}
+
+func (e *NoSuchRecentSearchError) Error() string {
+	return fmt.Sprintf("recent search #%d doesn't exist", e.RecentSearchID)
+}
+
 // Auth errors
 type ForbiddenUserError struct{}
 
@@ -66,6 +201,12 @@ func (e *NoSuchUserError) Error() string {
 	return fmt.Sprintf("user #%d doesn't exist", e.UserID)
 }
 
+type FollowSelfError struct{}
+
+func (e *FollowSelfError) Error() string {
+	return "user can't follow themselves"
+}
+
 type IncorrectPasswordError struct {
 	UserID uint32
 }
@@ -98,3 +239,67 @@ type CoverWrongFormatError struct {
 func (e *CoverWrongFormatError) Error() string {
 	return fmt.Sprintf("acover wrong format: %s", e.FileType)
 }
+
+type NoSuchNotificationError struct {
+	NotificationID uint32
+}
+
+func (e *NoSuchNotificationError) Error() string {
+	return fmt.Sprintf("notification #%d doesn't exist", e.NotificationID)
+}
+
+type InvalidNotificationQueryError struct {
+	Reason string
+}
+
+func (e *InvalidNotificationQueryError) Error() string {
+	return fmt.Sprintf("invalid notifications query: %s", e.Reason)
+}
+
+type InvalidRealtimeTopicError struct {
+	Topic string
+}
+
+func (e *InvalidRealtimeTopicError) Error() string {
+	return fmt.Sprintf("invalid topic: %q", e.Topic)
+}
+
+type NoSuchPlayerStateError struct {
+	UserID uint32
+}
+
+func (e *NoSuchPlayerStateError) Error() string {
+	return fmt.Sprintf("user #%d has no player state", e.UserID)
+}
+
+type InvalidPlayerStateError struct {
+	Reason string
+}
+
+func (e *InvalidPlayerStateError) Error() string {
+	return fmt.Sprintf("invalid player state: %s", e.Reason)
+}
+
+type PlayerStateConflictError struct {
+	Version uint32
+}
+
+func (e *PlayerStateConflictError) Error() string {
+	return fmt.Sprintf("player state was changed since version %d", e.Version)
+}
+
+type NoSuchShareLinkError struct {
+	Code string
+}
+
+func (e *NoSuchShareLinkError) Error() string {
+	return fmt.Sprintf("share link %q doesn't exist", e.Code)
+}
+
+type InvalidShareLinkError struct {
+	Reason string
+}
+
+func (e *InvalidShareLinkError) Error() string {
+	return fmt.Sprintf("invalid share link: %s", e.Reason)
+}
@@ -0,0 +1,80 @@
+package models
+
+import "fmt"
+
+// Favorites sortings
+const (
+	FavoritesSortLikedAt    = "likedAt"
+	FavoritesSortFollowedAt = "followedAt"
+	FavoritesSortName       = "name"
+	FavoritesSortArtist     = "artist"
+	FavoritesSortDuration   = "duration"
+)
+
+// Favorites sorting directions
+const (
+	FavoritesOrderAsc  = "asc"
+	FavoritesOrderDesc = "desc"
+)
+
+const FavoritesMaxLimit uint32 = 500
+
+// FavoritesQuery describes which part of user's favorites to get and in which order
+type FavoritesQuery struct {
+	SortBy string
+	Order  string
+	Filter string // substring of name (or artist's name), case insensitive
+	Limit  uint32 // 0 means no limit
+	Offset uint32
+}
+
+// Validate checks if query can be applied to favorites supporting given sortings and sets defaults.
+// By default entries are sorted by the first of sortings, the latest liked or followed go first.
+func (q *FavoritesQuery) Validate(sortings ...string) error {
+	if q.SortBy == "" && len(sortings) != 0 {
+		q.SortBy = sortings[0]
+	}
+
+	sortAllowed := false
+	for _, s := range sortings {
+		if s == q.SortBy {
+			sortAllowed = true
+			break
+		}
+	}
+	if !sortAllowed {
+		return &InvalidFavoritesQueryError{Reason: fmt.Sprintf("sorting by %q isn't supported", q.SortBy)}
+	}
+
+	if q.Order == "" {
+		q.Order = FavoritesOrderAsc
+		if q.SortBy == FavoritesSortLikedAt || q.SortBy == FavoritesSortFollowedAt {
+			q.Order = FavoritesOrderDesc
+		}
+	}
+	if q.Order != FavoritesOrderAsc && q.Order != FavoritesOrderDesc {
+		return &InvalidFavoritesQueryError{Reason: fmt.Sprintf("unknown order %q", q.Order)}
+	}
+
+	if q.Limit > FavoritesMaxLimit {
+		return &InvalidFavoritesQueryError{Reason: "limit is too big"}
+	}
+
+	return nil
+}
+
+// LimitValue returns limit as query argument: NULL is treated as no limit
+func (q FavoritesQuery) LimitValue() *uint32 {
+	if q.Limit == 0 {
+		return nil
+	}
+	return &q.Limit
+}
+
+// OrderSQL returns sorting direction as SQL keyword
+func (q FavoritesQuery) OrderSQL() string {
+	if q.Order == FavoritesOrderDesc {
+		return "DESC"
+	}
+	return "ASC"
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LyricsMaxLen is max length of lyrics text in bytes as typed by user, before html-escaping
+const LyricsMaxLen = 20000
+
+// Lyrics of track. Synced lyrics are in LRC format, Text is plain lyrics without timestamps.
+type Lyrics struct {
+	TrackID   uint32    `db:"track_id"`
+	Text      string    `db:"text"`
+	Synced    *string   `db:"synced"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// Validate checks lyrics and fills plain text from synced lyrics if it's empty
+func (l *Lyrics) Validate() error {
+	if l.Synced != nil {
+		lines, err := ParseLRC(*l.Synced)
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(l.Text) == "" {
+			l.Text = LyricsTextFromLines(lines)
+		}
+	}
+
+	if strings.TrimSpace(l.Text) == "" {
+		return &InvalidLyricsError{Reason: "no text"}
+	}
+
+	return nil
+}
+
+// LyricsLine is line of synced lyrics which is sung since Time
+type LyricsLine struct {
+	Time time.Duration
+	Text string
+}
+
+var (
+	lrcTimestamp = regexp.MustCompile(`^\[(\d+):(\d{1,2})(?:[.:](\d{1,3}))?\]`)
+	lrcTag       = regexp.MustCompile(`^\[([a-z#]+):(.*)\]$`)
+)
+
+// ParseLRC parses lines of LRC lyrics like "[01:02.50]Text". Line with several timestamps
+// is repeated for each of them. Offset tag shifts all lines, other tags are skipped.
+// Lines are sorted by time.
+func ParseLRC(lrc string) ([]LyricsLine, error) {
+	var lines []LyricsLine
+	var offset time.Duration
+	for n, raw := range strings.Split(lrc, "\n") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		if tag := lrcTag.FindStringSubmatch(raw); tag != nil {
+			if tag[1] == "offset" {
+				ms, err := strconv.Atoi(strings.TrimSpace(tag[2]))
+				if err != nil {
+					return nil, &InvalidLyricsError{Reason: fmt.Sprintf("invalid offset on line %d", n+1)}
+				}
+				// positive offset means lines are shown earlier
+				offset = -time.Duration(ms) * time.Millisecond
+			}
+			continue
+		}
+
+		var times []time.Duration
+		for {
+			ts := lrcTimestamp.FindStringSubmatch(raw)
+			if ts == nil {
+				break
+			}
+			times = append(times, lrcTime(ts[1], ts[2], ts[3]))
+			raw = raw[len(ts[0]):]
+		}
+		if len(times) == 0 {
+			return nil, &InvalidLyricsError{Reason: fmt.Sprintf("no timestamp on line %d", n+1)}
+		}
+
+		for _, t := range times {
+			lines = append(lines, LyricsLine{Time: t, Text: strings.TrimSpace(raw)})
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil, &InvalidLyricsError{Reason: "no lines"}
+	}
+
+	for i := range lines {
+		lines[i].Time += offset
+		if lines[i].Time < 0 {
+			lines[i].Time = 0
+		}
+	}
+	sort.SliceStable(lines, func(a, b int) bool {
+		return lines[a].Time < lines[b].Time
+	})
+
+	return lines, nil
+}
+
+// lrcTime converts parts of timestamp matched by lrcTimestamp to duration
+func lrcTime(minutes, seconds, fraction string) time.Duration {
+	m, _ := strconv.Atoi(minutes)
+	s, _ := strconv.Atoi(seconds)
+
+	t := time.Duration(m)*time.Minute + time.Duration(s)*time.Second
+	if fraction != "" {
+		// fraction is hundredths in most of files, but can be tenths or milliseconds
+		f, _ := strconv.Atoi(fraction + strings.Repeat("0", 3-len(fraction)))
+		t += time.Duration(f) * time.Millisecond
+	}
+
+	return t
+}
+
+// LyricsTextFromLines joins texts of lines skipping empty ones, which are instrumental breaks
+func LyricsTextFromLines(lines []LyricsLine) string {
+	texts := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line.Text != "" {
+			texts = append(texts, line.Text)
+		}
+	}
+
+	return strings.Join(texts, "\n")
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLRC(t *testing.T) {
+	testTable := []struct {
+		name          string
+		lrc           string
+		expectedLines []LyricsLine
+		expectError   bool
+	}{
+		{
+			name: "Common",
+			lrc: "[ar:Metallica]\n" +
+				"[ti:Master of Puppets]\n" +
+				"[00:21.50]End of passion play\n" +
+				"\n" +
+				"[00:25.05]Crumbling away\n",
+			expectedLines: []LyricsLine{
+				{Time: 21500 * time.Millisecond, Text: "End of passion play"},
+				{Time: 25050 * time.Millisecond, Text: "Crumbling away"},
+			},
+		},
+		{
+			name: "Repeated Line And Offset",
+			lrc: "[offset:+500]\n" +
+				"[01:10][00:10.1]Master! Master!\n" +
+				"[00:40.123]",
+			expectedLines: []LyricsLine{
+				{Time: 9600 * time.Millisecond, Text: "Master! Master!"},
+				{Time: 39623 * time.Millisecond, Text: ""},
+				{Time: 69500 * time.Millisecond, Text: "Master! Master!"},
+			},
+		},
+		{
+			name:        "No Timestamp",
+			lrc:         "[00:01.00]First\nSecond",
+			expectError: true,
+		},
+		{
+			name:        "No Lines",
+			lrc:         "[ar:Metallica]",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			lines, err := ParseLRC(tc.lrc)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedLines, lines)
+		})
+	}
+}
+
+func TestLyrics_Validate(t *testing.T) {
+	synced := "[00:01.00]Master of puppets\n[00:02.00]\n[00:03.00]I'm pulling your strings"
+	lyrics := Lyrics{Synced: &synced}
+
+	assert.NoError(t, lyrics.Validate())
+	assert.Equal(t, "Master of puppets\nI'm pulling your strings", lyrics.Text)
+
+	empty := Lyrics{Text: "  "}
+	assert.Error(t, empty.Validate())
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+)
+
+//go:generate easyjson -no_std_marshalers notification.go
+
+// Types of notifications
+const (
+	// NotificationTypePlaylistMember is sent to user added to authors of playlist
+	NotificationTypePlaylistMember = "addedToPlaylist"
+	// NotificationTypeRelease is new album or single of artist liked by user
+	NotificationTypeRelease = "release"
+	// NotificationTypeFollower is sent to user who got new follower
+	NotificationTypeFollower = "newFollower"
+)
+
+// Page size of inbox: default one is used if limit isn't set
+const (
+	NotificationDefaultLimit uint32 = 20
+	NotificationMaxLimit     uint32 = 100
+)
+
+// Notification is addressed to single user. Only IDs of entities relevant to its type are set:
+// ActorID is user who caused notification.
+type Notification struct {
+	ID         uint32    `db:"id"`
+	UserID     uint32    `db:"user_id"`
+	Type       string    `db:"type"`
+	ActorID    *uint32   `db:"actor_id"`
+	AlbumID    *uint32   `db:"album_id"`
+	PlaylistID *uint32   `db:"playlist_id"`
+	IsRead     bool      `db:"is_read"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+//easyjson:json
+type NotificationTransfer struct {
+	ID        uint32              `json:"id"`
+	Type      string              `json:"type"`
+	Actor     *UserPublicTransfer `json:"actor,omitempty"`
+	Album     *AlbumTransfer      `json:"album,omitempty"`
+	Playlist  *PlaylistTransfer   `json:"playlist,omitempty"`
+	IsRead    bool                `json:"isRead"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+//easyjson:json
+type NotificationTransfers []NotificationTransfer
@@ -0,0 +1,440 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson9806e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(in *jlexer.Lexer, out *NotificationTransfers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(NotificationTransfers, 0, 0)
+			} else {
+				*out = NotificationTransfers{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 NotificationTransfer
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(out *jwriter.Writer, in NotificationTransfers) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationTransfers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationTransfers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(l, v)
+}
+func easyjson9806e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(in *jlexer.Lexer, out *NotificationTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "type":
+			out.Type = string(in.String())
+		case "actor":
+			if in.IsNull() {
+				in.Skip()
+				out.Actor = nil
+			} else {
+				if out.Actor == nil {
+					out.Actor = new(UserPublicTransfer)
+				}
+				easyjson9806e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(in, out.Actor)
+			}
+		case "album":
+			if in.IsNull() {
+				in.Skip()
+				out.Album = nil
+			} else {
+				if out.Album == nil {
+					out.Album = new(AlbumTransfer)
+				}
+				(*out.Album).UnmarshalEasyJSON(in)
+			}
+		case "playlist":
+			if in.IsNull() {
+				in.Skip()
+				out.Playlist = nil
+			} else {
+				if out.Playlist == nil {
+					out.Playlist = new(PlaylistTransfer)
+				}
+				easyjson9806e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels3(in, out.Playlist)
+			}
+		case "isRead":
+			out.IsRead = bool(in.Bool())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(out *jwriter.Writer, in NotificationTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.Actor != nil {
+		const prefix string = ",\"actor\":"
+		out.RawString(prefix)
+		easyjson9806e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels2(out, *in.Actor)
+	}
+	if in.Album != nil {
+		const prefix string = ",\"album\":"
+		out.RawString(prefix)
+		(*in.Album).MarshalEasyJSON(out)
+	}
+	if in.Playlist != nil {
+		const prefix string = ",\"playlist\":"
+		out.RawString(prefix)
+		easyjson9806e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels3(out, *in.Playlist)
+	}
+	{
+		const prefix string = ",\"isRead\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsRead))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9806e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9806e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(l, v)
+}
+func easyjson9806e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels3(in *jlexer.Lexer, out *PlaylistTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "name":
+			out.Name = string(in.String())
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make(UserPublicTransfers, 0, 0)
+					} else {
+						out.Users = UserPublicTransfers{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 UserPublicTransfer
+					easyjson9806e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(in, &v4)
+					out.Users = append(out.Users, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "description":
+			if in.IsNull() {
+				in.Skip()
+				out.Description = nil
+			} else {
+				if out.Description == nil {
+					out.Description = new(string)
+				}
+				*out.Description = string(in.String())
+			}
+		case "isLiked":
+			out.IsLiked = bool(in.Bool())
+		case "cover":
+			out.CoverSrc = string(in.String())
+		case "isSmart":
+			out.IsSmart = bool(in.Bool())
+		case "rules":
+			if in.IsNull() {
+				in.Skip()
+				out.Rules = nil
+			} else {
+				if out.Rules == nil {
+					out.Rules = new(SmartPlaylistRules)
+				}
+				(*out.Rules).UnmarshalEasyJSON(in)
+			}
+		case "forkedFrom":
+			if in.IsNull() {
+				in.Skip()
+				out.ForkedFrom = nil
+			} else {
+				if out.ForkedFrom == nil {
+					out.ForkedFrom = new(uint32)
+				}
+				*out.ForkedFrom = uint32(in.Uint32())
+			}
+		case "likedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.LikedAt = nil
+			} else {
+				if out.LikedAt == nil {
+					out.LikedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LikedAt).UnmarshalJSON(data))
+				}
+			}
+		case "highlight":
+			out.Highlight = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels3(out *jwriter.Writer, in PlaylistTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"users\":"
+		out.RawString(prefix)
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Users {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				easyjson9806e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels2(out, v6)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Description != nil {
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(*in.Description))
+	}
+	{
+		const prefix string = ",\"isLiked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLiked))
+	}
+	if in.CoverSrc != "" {
+		const prefix string = ",\"cover\":"
+		out.RawString(prefix)
+		out.String(string(in.CoverSrc))
+	}
+	if in.IsSmart {
+		const prefix string = ",\"isSmart\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSmart))
+	}
+	if in.Rules != nil {
+		const prefix string = ",\"rules\":"
+		out.RawString(prefix)
+		(*in.Rules).MarshalEasyJSON(out)
+	}
+	if in.ForkedFrom != nil {
+		const prefix string = ",\"forkedFrom\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(*in.ForkedFrom))
+	}
+	if in.LikedAt != nil {
+		const prefix string = ",\"likedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.LikedAt).MarshalJSON())
+	}
+	if in.Highlight != "" {
+		const prefix string = ",\"highlight\":"
+		out.RawString(prefix)
+		out.String(string(in.Highlight))
+	}
+	out.RawByte('}')
+}
+func easyjson9806e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(in *jlexer.Lexer, out *UserPublicTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "username":
+			out.Username = string(in.String())
+		case "firstName":
+			out.FirstName = string(in.String())
+		case "lastName":
+			out.LastName = string(in.String())
+		case "avatarSrc":
+			out.AvatarSrc = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9806e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels2(out *jwriter.Writer, in UserPublicTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"firstName\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"lastName\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	if in.AvatarSrc != "" {
+		const prefix string = ",\"avatarSrc\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarSrc))
+	}
+	out.RawByte('}')
+}
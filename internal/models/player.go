@@ -0,0 +1,90 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+//go:generate easyjson -no_std_marshalers player.go
+
+// Repeat modes of player
+const (
+	PlayerRepeatOff   = "off"
+	PlayerRepeatQueue = "queue"
+	PlayerRepeatTrack = "track"
+)
+
+const (
+	PlayerQueueMaxLen    = 500
+	PlayerDeviceIDMaxLen = 64
+)
+
+// PlayerState is what user is listening now: it's shared between all of user's devices.
+// Version is increased by each update, so device can't overwrite changes it hasn't seen.
+type PlayerState struct {
+	UserID     uint32
+	TrackID    *uint32
+	PositionMs uint32
+	Queue      []uint32
+	Shuffle    bool
+	RepeatMode string
+	// DeviceID is device which is playing now, i.e. the last one which updated state
+	DeviceID   string
+	DeviceName string
+	Version    uint32
+	UpdatedAt  time.Time
+}
+
+// Validate checks state sent by device and sets defaults
+func (s *PlayerState) Validate() error {
+	if s.RepeatMode == "" {
+		s.RepeatMode = PlayerRepeatOff
+	}
+	if s.RepeatMode != PlayerRepeatOff && s.RepeatMode != PlayerRepeatQueue && s.RepeatMode != PlayerRepeatTrack {
+		return &InvalidPlayerStateError{Reason: fmt.Sprintf("unknown repeat mode %q", s.RepeatMode)}
+	}
+
+	if len(s.Queue) > PlayerQueueMaxLen {
+		return &InvalidPlayerStateError{Reason: "queue is too long"}
+	}
+	if s.Queue == nil {
+		s.Queue = []uint32{}
+	}
+
+	if s.DeviceID == "" {
+		return &InvalidPlayerStateError{Reason: "no device"}
+	}
+	if len(s.DeviceID) > PlayerDeviceIDMaxLen {
+		return &InvalidPlayerStateError{Reason: "device is too long"}
+	}
+
+	return nil
+}
+
+//easyjson:json
+type PlayerStateTransfer struct {
+	TrackID    *uint32   `json:"trackID"`
+	PositionMs uint32    `json:"positionMs"`
+	Queue      []uint32  `json:"queue"`
+	Shuffle    bool      `json:"shuffle"`
+	RepeatMode string    `json:"repeat"`
+	DeviceID   string    `json:"deviceID"`
+	DeviceName string    `json:"deviceName"`
+	Version    uint32    `json:"version"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// PlayerStateTransferFromEntry converts PlayerState to PlayerStateTransfer
+func PlayerStateTransferFromEntry(s PlayerState) PlayerStateTransfer {
+	return PlayerStateTransfer{
+		TrackID:    s.TrackID,
+		PositionMs: s.PositionMs,
+		Queue:      s.Queue,
+		Shuffle:    s.Shuffle,
+		RepeatMode: s.RepeatMode,
+		DeviceID:   s.DeviceID,
+		DeviceName: s.DeviceName,
+		Version:    s.Version,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 //go:generate easyjson -no_std_marshalers playlist.go
 
@@ -9,21 +12,56 @@ type Playlist struct {
 	Name        string  `db:"name"`
 	Description *string `db:"description"`
 	CoverSrc    string  `db:"cover_src"`
+	ForkedFrom  *uint32 `db:"forked_from"`
+
+	// Rules are set only for smart playlists
+	Rules *SmartPlaylistRules `db:"rules"`
+
+	// LikedAt is set only for user's favorites
+	LikedAt *time.Time `db:"liked_at"`
+	// Highlight is set only for search results, see HighlightToHTML
+	Highlight string `db:"highlight"`
 }
 
 //easyjson:json
 type PlaylistTransfer struct {
-	ID          uint32        `json:"id"`
-	Name        string        `json:"name"`
-	Users       UserTransfers `json:"users"`
-	Description *string       `json:"description,omitempty"`
-	IsLiked     bool          `json:"isLiked"`
-	CoverSrc    string        `json:"cover,omitempty"`
+	ID          uint32              `json:"id"`
+	Name        string              `json:"name"`
+	Users       UserPublicTransfers `json:"users"`
+	Description *string             `json:"description,omitempty"`
+	IsLiked     bool                `json:"isLiked"`
+	CoverSrc    string              `json:"cover,omitempty"`
+	IsSmart     bool                `json:"isSmart,omitempty"`
+	Rules       *SmartPlaylistRules `json:"rules,omitempty"`
+	ForkedFrom  *uint32             `json:"forkedFrom,omitempty"`
+	LikedAt     *time.Time          `json:"likedAt,omitempty"`
+	Highlight   string              `json:"highlight,omitempty"`
 }
 
 //easyjson:json
 type PlaylistTransfers []PlaylistTransfer
 
+// Statuses of tracks in batch modification of playlist
+const (
+	PlaylistTrackAdded         = "added"
+	PlaylistTrackDeleted       = "deleted"
+	PlaylistTrackAlreadyAdded  = "alreadyAdded"
+	PlaylistTrackNotInPlaylist = "notInPlaylist"
+	PlaylistTrackNotFound      = "notFound"
+)
+
+// PlaylistTracksBatchMaxSize is max amount of tracks in batch modification of playlist,
+// including tracks of album
+const PlaylistTracksBatchMaxSize = 500
+
+// PlaylistTrackResult is result of batch modification of playlist for one track
+//
+//easyjson:json
+type PlaylistTrackResult struct {
+	TrackID uint32 `json:"trackID"`
+	Status  string `json:"status"`
+}
+
 type usersByPlaylistsGetter func(ctx context.Context, playlistID uint32) ([]User, error)
 type playlistLikeChecker func(ctx context.Context, playlistID, userID uint32) (bool, error)
 
@@ -47,10 +85,15 @@ func PlaylistTransferFromEntry(ctx context.Context, p Playlist, user *User,
 	return PlaylistTransfer{
 		ID:          p.ID,
 		Name:        p.Name,
-		Users:       UserTransferFromList(users),
+		Users:       UserPublicTransferFromList(users),
 		Description: p.Description,
 		IsLiked:     isLiked,
 		CoverSrc:    p.CoverSrc,
+		IsSmart:     p.Rules != nil,
+		Rules:       p.Rules,
+		ForkedFrom:  p.ForkedFrom,
+		LikedAt:     p.LikedAt,
+		Highlight:   HighlightToHTML(p.Highlight),
 	}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -93,28 +94,7 @@ func easyjson3b1bf41aDecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(i
 		case "name":
 			out.Name = string(in.String())
 		case "users":
-			if in.IsNull() {
-				in.Skip()
-				out.Users = nil
-			} else {
-				in.Delim('[')
-				if out.Users == nil {
-					if !in.IsDelim(']') {
-						out.Users = make(UserTransfers, 0, 0)
-					} else {
-						out.Users = UserTransfers{}
-					}
-				} else {
-					out.Users = (out.Users)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v4 UserTransfer
-					easyjson3b1bf41aDecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(in, &v4)
-					out.Users = append(out.Users, v4)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+			(out.Users).UnmarshalEasyJSON(in)
 		case "description":
 			if in.IsNull() {
 				in.Skip()
@@ -129,6 +109,42 @@ func easyjson3b1bf41aDecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(i
 			out.IsLiked = bool(in.Bool())
 		case "cover":
 			out.CoverSrc = string(in.String())
+		case "isSmart":
+			out.IsSmart = bool(in.Bool())
+		case "rules":
+			if in.IsNull() {
+				in.Skip()
+				out.Rules = nil
+			} else {
+				if out.Rules == nil {
+					out.Rules = new(SmartPlaylistRules)
+				}
+				(*out.Rules).UnmarshalEasyJSON(in)
+			}
+		case "forkedFrom":
+			if in.IsNull() {
+				in.Skip()
+				out.ForkedFrom = nil
+			} else {
+				if out.ForkedFrom == nil {
+					out.ForkedFrom = new(uint32)
+				}
+				*out.ForkedFrom = uint32(in.Uint32())
+			}
+		case "likedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.LikedAt = nil
+			} else {
+				if out.LikedAt == nil {
+					out.LikedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LikedAt).UnmarshalJSON(data))
+				}
+			}
+		case "highlight":
+			out.Highlight = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -156,18 +172,7 @@ func easyjson3b1bf41aEncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(o
 	{
 		const prefix string = ",\"users\":"
 		out.RawString(prefix)
-		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v5, v6 := range in.Users {
-				if v5 > 0 {
-					out.RawByte(',')
-				}
-				easyjson3b1bf41aEncodeGithubComGoParkMailRu20231TechnokaifInternalModels2(out, v6)
-			}
-			out.RawByte(']')
-		}
+		(in.Users).MarshalEasyJSON(out)
 	}
 	if in.Description != nil {
 		const prefix string = ",\"description\":"
@@ -184,6 +189,31 @@ func easyjson3b1bf41aEncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(o
 		out.RawString(prefix)
 		out.String(string(in.CoverSrc))
 	}
+	if in.IsSmart {
+		const prefix string = ",\"isSmart\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSmart))
+	}
+	if in.Rules != nil {
+		const prefix string = ",\"rules\":"
+		out.RawString(prefix)
+		(*in.Rules).MarshalEasyJSON(out)
+	}
+	if in.ForkedFrom != nil {
+		const prefix string = ",\"forkedFrom\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(*in.ForkedFrom))
+	}
+	if in.LikedAt != nil {
+		const prefix string = ",\"likedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.LikedAt).MarshalJSON())
+	}
+	if in.Highlight != "" {
+		const prefix string = ",\"highlight\":"
+		out.RawString(prefix)
+		out.String(string(in.Highlight))
+	}
 	out.RawByte('}')
 }
 
@@ -196,7 +226,7 @@ func (v PlaylistTransfer) MarshalEasyJSON(w *jwriter.Writer) {
 func (v *PlaylistTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson3b1bf41aDecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(l, v)
 }
-func easyjson3b1bf41aDecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(in *jlexer.Lexer, out *UserTransfer) {
+func easyjson3b1bf41aDecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(in *jlexer.Lexer, out *PlaylistTrackResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -215,22 +245,10 @@ func easyjson3b1bf41aDecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(i
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = uint32(in.Uint32())
-		case "username":
-			out.Username = string(in.String())
-		case "email":
-			out.Email = string(in.String())
-		case "firstName":
-			out.FirstName = string(in.String())
-		case "lastName":
-			out.LastName = string(in.String())
-		case "birthDate":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.BirthDate).UnmarshalJSON(data))
-			}
-		case "avatarSrc":
-			out.AvatarSrc = string(in.String())
+		case "trackID":
+			out.TrackID = uint32(in.Uint32())
+		case "status":
+			out.Status = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -241,44 +259,29 @@ func easyjson3b1bf41aDecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(i
 		in.Consumed()
 	}
 }
-func easyjson3b1bf41aEncodeGithubComGoParkMailRu20231TechnokaifInternalModels2(out *jwriter.Writer, in UserTransfer) {
+func easyjson3b1bf41aEncodeGithubComGoParkMailRu20231TechnokaifInternalModels2(out *jwriter.Writer, in PlaylistTrackResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"trackID\":"
 		out.RawString(prefix[1:])
-		out.Uint32(uint32(in.ID))
-	}
-	{
-		const prefix string = ",\"username\":"
-		out.RawString(prefix)
-		out.String(string(in.Username))
-	}
-	{
-		const prefix string = ",\"email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"firstName\":"
-		out.RawString(prefix)
-		out.String(string(in.FirstName))
+		out.Uint32(uint32(in.TrackID))
 	}
 	{
-		const prefix string = ",\"lastName\":"
-		out.RawString(prefix)
-		out.String(string(in.LastName))
-	}
-	if true {
-		const prefix string = ",\"birthDate\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.Raw((in.BirthDate).MarshalJSON())
-	}
-	if in.AvatarSrc != "" {
-		const prefix string = ",\"avatarSrc\":"
-		out.RawString(prefix)
-		out.String(string(in.AvatarSrc))
+		out.String(string(in.Status))
 	}
 	out.RawByte('}')
 }
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistTrackResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3b1bf41aEncodeGithubComGoParkMailRu20231TechnokaifInternalModels2(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistTrackResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3b1bf41aDecodeGithubComGoParkMailRu20231TechnokaifInternalModels2(l, v)
+}
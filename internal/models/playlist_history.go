@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+//go:generate easyjson -no_std_marshalers playlist_history.go
+
+// Actions recorded in playlist history
+const (
+	PlaylistActionAddTrack    = "addTrack"
+	PlaylistActionDeleteTrack = "deleteTrack"
+	PlaylistActionUpdateInfo  = "updateInfo"
+	PlaylistActionUpdateCover = "updateCover"
+)
+
+// PlaylistHistoryEntry is one mutation of playlist made by user.
+// Prev* fields and TrackAddedAt keep state which is needed to revert it.
+type PlaylistHistoryEntry struct {
+	ID              uint32     `db:"id"`
+	PlaylistID      uint32     `db:"playlist_id"`
+	UserID          *uint32    `db:"user_id"`
+	Action          string     `db:"action"`
+	TrackID         *uint32    `db:"track_id"`
+	TrackAddedAt    *time.Time `db:"track_added_at"`
+	PrevName        *string    `db:"prev_name"`
+	PrevDescription *string    `db:"prev_description"`
+	PrevCoverSrc    *string    `db:"prev_cover_src"`
+	CreatedAt       time.Time  `db:"created_at"`
+}
+
+//easyjson:json
+type PlaylistHistoryEntryTransfer struct {
+	ID        uint32    `json:"id"`
+	UserID    *uint32   `json:"userID,omitempty"`
+	Action    string    `json:"action"`
+	TrackID   *uint32   `json:"trackID,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+//easyjson:json
+type PlaylistHistoryTransfers []PlaylistHistoryEntryTransfer
+
+// PlaylistHistoryTransferFromList converts []PlaylistHistoryEntry to PlaylistHistoryTransfers
+func PlaylistHistoryTransferFromList(entries []PlaylistHistoryEntry) PlaylistHistoryTransfers {
+	transfers := make([]PlaylistHistoryEntryTransfer, 0, len(entries))
+
+	for _, e := range entries {
+		transfers = append(transfers, PlaylistHistoryEntryTransfer{
+			ID:        e.ID,
+			UserID:    e.UserID,
+			Action:    e.Action,
+			TrackID:   e.TrackID,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return transfers
+}
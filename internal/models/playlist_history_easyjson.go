@@ -0,0 +1,169 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonEf374e83DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(in *jlexer.Lexer, out *PlaylistHistoryTransfers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(PlaylistHistoryTransfers, 0, 1)
+			} else {
+				*out = PlaylistHistoryTransfers{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 PlaylistHistoryEntryTransfer
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEf374e83EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(out *jwriter.Writer, in PlaylistHistoryTransfers) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistHistoryTransfers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEf374e83EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistHistoryTransfers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEf374e83DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(l, v)
+}
+func easyjsonEf374e83DecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(in *jlexer.Lexer, out *PlaylistHistoryEntryTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "userID":
+			if in.IsNull() {
+				in.Skip()
+				out.UserID = nil
+			} else {
+				if out.UserID == nil {
+					out.UserID = new(uint32)
+				}
+				*out.UserID = uint32(in.Uint32())
+			}
+		case "action":
+			out.Action = string(in.String())
+		case "trackID":
+			if in.IsNull() {
+				in.Skip()
+				out.TrackID = nil
+			} else {
+				if out.TrackID == nil {
+					out.TrackID = new(uint32)
+				}
+				*out.TrackID = uint32(in.Uint32())
+			}
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonEf374e83EncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(out *jwriter.Writer, in PlaylistHistoryEntryTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	if in.UserID != nil {
+		const prefix string = ",\"userID\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(*in.UserID))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	if in.TrackID != nil {
+		const prefix string = ",\"trackID\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(*in.TrackID))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistHistoryEntryTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonEf374e83EncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistHistoryEntryTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonEf374e83DecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(l, v)
+}
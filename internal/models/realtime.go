@@ -0,0 +1,98 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:generate easyjson -no_std_marshalers realtime.go
+
+// Types of real-time events
+const (
+	// RealtimeEventPlaylistUpdated is change of playlist info, members, rules or cover
+	RealtimeEventPlaylistUpdated = "playlistUpdated"
+	// RealtimeEventPlaylistTracksChanged is addition or removal of playlist's tracks
+	RealtimeEventPlaylistTracksChanged = "playlistTracksChanged"
+	// RealtimeEventPlaylistDeleted is deletion of playlist
+	RealtimeEventPlaylistDeleted = "playlistDeleted"
+	// RealtimeEventNotification is new notification in user's inbox
+	RealtimeEventNotification = "notification"
+	// RealtimeEventPlayerStateChanged is update of user's player state made on one of devices
+	RealtimeEventPlayerStateChanged = "playerStateChanged"
+)
+
+// Kinds of real-time topics
+const (
+	RealtimeTopicPlaylist      = "playlist"
+	RealtimeTopicNotifications = "notifications"
+	RealtimeTopicPlayer        = "player"
+)
+
+// RealtimeEvent is pushed to all subscribers of its topic.
+// It only tells what has changed, so clients fetch new state by themselves.
+//
+//easyjson:json
+type RealtimeEvent struct {
+	Topic string `json:"topic"`
+	Type  string `json:"type"`
+	// EntityID is ID of entity changed within topic, e.g. track added to playlist
+	EntityID uint32 `json:"entityID,omitempty"`
+	// Version is version of changed state, so clients which already have it don't fetch it again
+	Version uint32 `json:"version,omitempty"`
+}
+
+// RealtimeTopic is parsed name of topic
+type RealtimeTopic struct {
+	Kind string
+	ID   uint32
+}
+
+// PlaylistTopic returns name of topic with changes of playlist: playlist:{id}
+func PlaylistTopic(playlistID uint32) string {
+	return fmt.Sprintf("%s:%d", RealtimeTopicPlaylist, playlistID)
+}
+
+// NotificationsTopic returns name of topic with user's notifications: user:{id}:notifications
+func NotificationsTopic(userID uint32) string {
+	return fmt.Sprintf("user:%d:%s", userID, RealtimeTopicNotifications)
+}
+
+// PlayerTopic returns name of topic with changes of user's player state: user:{id}:player
+func PlayerTopic(userID uint32) string {
+	return fmt.Sprintf("user:%d:%s", userID, RealtimeTopicPlayer)
+}
+
+// ParseRealtimeTopic returns InvalidRealtimeTopicError if topic has unknown format
+func ParseRealtimeTopic(topic string) (RealtimeTopic, error) {
+	parts := strings.Split(topic, ":")
+
+	switch {
+	case len(parts) == 2 && parts[0] == RealtimeTopicPlaylist:
+		id, err := parseTopicID(parts[1])
+		if err != nil {
+			return RealtimeTopic{}, &InvalidRealtimeTopicError{Topic: topic}
+		}
+		return RealtimeTopic{Kind: RealtimeTopicPlaylist, ID: id}, nil
+
+	case len(parts) == 3 && parts[0] == "user" &&
+		(parts[2] == RealtimeTopicNotifications || parts[2] == RealtimeTopicPlayer):
+
+		id, err := parseTopicID(parts[1])
+		if err != nil {
+			return RealtimeTopic{}, &InvalidRealtimeTopicError{Topic: topic}
+		}
+		return RealtimeTopic{Kind: parts[2], ID: id}, nil
+	}
+
+	return RealtimeTopic{}, &InvalidRealtimeTopicError{Topic: topic}
+}
+
+func parseTopicID(s string) (uint32, error) {
+	id, err := strconv.ParseUint(s, 10, 32)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("invalid ID in topic: %s", s)
+	}
+
+	return uint32(id), nil
+}
@@ -0,0 +1,92 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson54f25af3DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(in *jlexer.Lexer, out *RealtimeEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "topic":
+			out.Topic = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "entityID":
+			out.EntityID = uint32(in.Uint32())
+		case "version":
+			out.Version = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson54f25af3EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(out *jwriter.Writer, in RealtimeEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"topic\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Topic))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.EntityID != 0 {
+		const prefix string = ",\"entityID\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.EntityID))
+	}
+	if in.Version != 0 {
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RealtimeEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson54f25af3EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RealtimeEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson54f25af3DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(l, v)
+}
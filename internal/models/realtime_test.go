@@ -0,0 +1,71 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRealtimeTopic(t *testing.T) {
+	testTable := []struct {
+		name          string
+		topic         string
+		expectedTopic RealtimeTopic
+		expectError   bool
+	}{
+		{
+			name:          "Playlist",
+			topic:         PlaylistTopic(5),
+			expectedTopic: RealtimeTopic{Kind: RealtimeTopicPlaylist, ID: 5},
+		},
+		{
+			name:          "Notifications",
+			topic:         NotificationsTopic(7),
+			expectedTopic: RealtimeTopic{Kind: RealtimeTopicNotifications, ID: 7},
+		},
+		{
+			name:          "Player",
+			topic:         PlayerTopic(7),
+			expectedTopic: RealtimeTopic{Kind: RealtimeTopicPlayer, ID: 7},
+		},
+		{
+			name:        "Unknown Kind",
+			topic:       "album:5",
+			expectError: true,
+		},
+		{
+			name:        "Unknown User Topic",
+			topic:       "user:5:history",
+			expectError: true,
+		},
+		{
+			name:        "Zero ID",
+			topic:       "playlist:0",
+			expectError: true,
+		},
+		{
+			name:        "Not ID",
+			topic:       "user:me:notifications",
+			expectError: true,
+		},
+		{
+			name:        "Extra Parts",
+			topic:       "playlist:5:tracks",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			topic, err := ParseRealtimeTopic(tc.topic)
+
+			if tc.expectError {
+				var errInvalidTopic *InvalidRealtimeTopicError
+				assert.ErrorAs(t, err, &errInvalidTopic)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTopic, topic)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+//go:generate easyjson -no_std_marshalers recommendation.go
+
+// Sources of personal recommendations
+const (
+	RecommendationSourcePersonal = "personal"
+	RecommendationSourceCharts   = "charts"
+)
+
+// BecauseYouLiked is group of recommendations based on one liked track
+type BecauseYouLiked struct {
+	Track           Track
+	Recommendations []Track
+}
+
+//easyjson:json
+type BecauseYouLikedTransfer struct {
+	Track           TrackTransfer  `json:"track"`
+	Recommendations TrackTransfers `json:"recommendations"`
+}
+
+//easyjson:json
+type BecauseYouLikedTransfers []BecauseYouLikedTransfer
+
+// BecauseYouLikedTransferFromList converts []BecauseYouLiked to []BecauseYouLikedTransfer
+func BecauseYouLikedTransferFromList(ctx context.Context, groups []BecauseYouLiked, user *User,
+	likeChecker trackLikeChecker, artistLikeChecker ArtistLikeChecker,
+	artistsGetter artistsByTrackGetter) (BecauseYouLikedTransfers, error) {
+
+	transfers := make(BecauseYouLikedTransfers, 0, len(groups))
+	for _, g := range groups {
+		tt, err := TrackTransferFromEntry(ctx, g.Track, user, likeChecker, artistLikeChecker, artistsGetter)
+		if err != nil {
+			return nil, err
+		}
+
+		rt, err := TrackTransferFromList(ctx, g.Recommendations, user, likeChecker, artistLikeChecker, artistsGetter)
+		if err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, BecauseYouLikedTransfer{
+			Track:           tt,
+			Recommendations: rt,
+		})
+	}
+
+	return transfers, nil
+}
+
+// Types of radio seeds
+const (
+	RadioSeedTrack  = "track"
+	RadioSeedArtist = "artist"
+)
+
+// Page size of radio queue: default one is used if limit isn't set
+const (
+	RadioDefaultLimit uint32 = 20
+	RadioMaxLimit     uint32 = 100
+)
+
+// RadioSeed is track or artist which radio queue is built around
+type RadioSeed struct {
+	Type string
+	ID   uint32
+}
+
+// ParseRadioSeed parses seed in form of "track:123" or "artist:45"
+func ParseRadioSeed(seed string) (RadioSeed, error) {
+	seedType, idStr, found := strings.Cut(seed, ":")
+	if !found {
+		return RadioSeed{}, &InvalidRadioQueryError{Reason: "seed must be in form of type:id"}
+	}
+	if seedType != RadioSeedTrack && seedType != RadioSeedArtist {
+		return RadioSeed{}, &InvalidRadioQueryError{Reason: "unknown seed type " + strconv.Quote(seedType)}
+	}
+
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil || id == 0 {
+		return RadioSeed{}, &InvalidRadioQueryError{Reason: "invalid seed ID " + strconv.Quote(idStr)}
+	}
+
+	return RadioSeed{Type: seedType, ID: uint32(id)}, nil
+}
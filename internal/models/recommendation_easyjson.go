@@ -0,0 +1,130 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson5c54f0e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(in *jlexer.Lexer, out *BecauseYouLikedTransfers) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(BecauseYouLikedTransfers, 0, 0)
+			} else {
+				*out = BecauseYouLikedTransfers{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 BecauseYouLikedTransfer
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5c54f0e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(out *jwriter.Writer, in BecauseYouLikedTransfers) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BecauseYouLikedTransfers) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5c54f0e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BecauseYouLikedTransfers) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5c54f0e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(l, v)
+}
+func easyjson5c54f0e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(in *jlexer.Lexer, out *BecauseYouLikedTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "track":
+			(out.Track).UnmarshalEasyJSON(in)
+		case "recommendations":
+			(out.Recommendations).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson5c54f0e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(out *jwriter.Writer, in BecauseYouLikedTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track\":"
+		out.RawString(prefix[1:])
+		(in.Track).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"recommendations\":"
+		out.RawString(prefix)
+		(in.Recommendations).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BecauseYouLikedTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson5c54f0e1EncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BecauseYouLikedTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson5c54f0e1DecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(l, v)
+}
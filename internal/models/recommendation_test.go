@@ -0,0 +1,61 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRadioSeed(t *testing.T) {
+	testTable := []struct {
+		name         string
+		seed         string
+		expectedSeed RadioSeed
+		expectError  bool
+	}{
+		{
+			name:         "Track",
+			seed:         "track:123",
+			expectedSeed: RadioSeed{Type: RadioSeedTrack, ID: 123},
+		},
+		{
+			name:         "Artist",
+			seed:         "artist:45",
+			expectedSeed: RadioSeed{Type: RadioSeedArtist, ID: 45},
+		},
+		{
+			name:        "Empty",
+			seed:        "",
+			expectError: true,
+		},
+		{
+			name:        "Unknown Type",
+			seed:        "album:1",
+			expectError: true,
+		},
+		{
+			name:        "Invalid ID",
+			seed:        "track:-1",
+			expectError: true,
+		},
+		{
+			name:        "Zero ID",
+			seed:        "artist:0",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			seed, err := ParseRadioSeed(tc.seed)
+
+			if tc.expectError {
+				var errInvalidQuery *InvalidRadioQueryError
+				assert.ErrorAs(t, err, &errInvalidQuery)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSeed, seed)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package models
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Types of search top result
+const (
+	SearchTypeAlbum    = "album"
+	SearchTypeArtist   = "artist"
+	SearchTypeTrack    = "track"
+	SearchTypePlaylist = "playlist"
+)
+
+// SearchTopResult points to the most relevant entity among all groups of search result
+type SearchTopResult struct {
+	Type string
+	ID   uint32
+}
+
+// SearchResult is result of search over all entities grouped by their type
+type SearchResult struct {
+	Albums    []Album
+	Artists   []Artist
+	Tracks    []Track
+	Playlists []Playlist
+
+	// Top is nil if nothing was found
+	Top *SearchTopResult
+
+	Facets *SearchFacets
+}
+
+// Kinds of tracks by release
+const (
+	SearchTrackKindAlbum  = "album"
+	SearchTrackKindSingle = "single"
+)
+
+// SearchFilters narrow down search results. Zero value of field means it isn't applied.
+// Entities of types which don't support some of set filters aren't found at all.
+type SearchFilters struct {
+	// Duration bounds in seconds, tracks only
+	MinDuration uint32
+	MaxDuration uint32
+	// TrackKind tells if track is from album or is single, tracks only
+	TrackKind string
+	// ArtistID is one of entity's artists, tracks and albums only
+	ArtistID uint32
+	// OnlyLiked requires user
+	OnlyLiked  bool
+	AddedAfter *time.Time
+}
+
+func (f SearchFilters) Validate() error {
+	if f.MaxDuration != 0 && f.MinDuration > f.MaxDuration {
+		return &InvalidSearchFiltersError{Reason: "min duration is greater than max one"}
+	}
+
+	if f.TrackKind != "" && f.TrackKind != SearchTrackKindAlbum && f.TrackKind != SearchTrackKindSingle {
+		return &InvalidSearchFiltersError{Reason: "unknown kind of track " + f.TrackKind}
+	}
+
+	return nil
+}
+
+// Supports reports if entities of given type can be filtered by all of set filters
+func (f SearchFilters) Supports(entityType string) bool {
+	if f.MinDuration != 0 || f.MaxDuration != 0 || f.TrackKind != "" {
+		return entityType == SearchTypeTrack
+	}
+	if f.ArtistID != 0 {
+		return entityType == SearchTypeTrack || entityType == SearchTypeAlbum
+	}
+
+	return true
+}
+
+// SearchCounts are amounts of all entities matching search query
+type SearchCounts struct {
+	Albums    uint32 `db:"albums"`
+	Artists   uint32 `db:"artists"`
+	Tracks    uint32 `db:"tracks"`
+	Playlists uint32 `db:"playlists"`
+}
+
+// SearchArtistFacet is amount of tracks and albums of artist among search results
+type SearchArtistFacet struct {
+	ArtistID uint32 `db:"artist_id"`
+	Name     string `db:"name"`
+	Count    uint32 `db:"count"`
+}
+
+// SearchFacets describe all search results, not only returned ones
+type SearchFacets struct {
+	Counts SearchCounts
+	// Artists are sorted by count
+	Artists []SearchArtistFacet
+}
+
+// Markers of matched parts in highlighted names. They are control characters,
+// so they can't be confused with HTML or typed by user.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// HighlightToHTML wraps matched parts of highlighted name into <b> tags.
+// Names are stored HTML-escaped, so highlight is unescaped before escaping to not escape it twice.
+// Markers inside HTML entities and unpaired markers are dropped, so result is always well-formed.
+func HighlightToHTML(highlight string) string {
+	if highlight == "" {
+		return ""
+	}
+
+	highlight = entityWithMarkers.ReplaceAllStringFunc(highlight, markersRemover.Replace)
+
+	var b strings.Builder
+	opened := false
+	for {
+		i := strings.IndexAny(highlight, HighlightStart+HighlightStop)
+		if i < 0 {
+			b.WriteString(html.EscapeString(html.UnescapeString(highlight)))
+			break
+		}
+		b.WriteString(html.EscapeString(html.UnescapeString(highlight[:i])))
+
+		switch marker := highlight[i : i+1]; {
+		case marker == HighlightStart && !opened:
+			b.WriteString("<b>")
+			opened = true
+		case marker == HighlightStop && opened:
+			b.WriteString("</b>")
+			opened = false
+		}
+		highlight = highlight[i+1:]
+	}
+	if opened {
+		b.WriteString("</b>")
+	}
+
+	return b.String()
+}
+
+// entityWithMarkers matches HTML entity which may be split by markers, e.g. "&\x02amp\x03;"
+var entityWithMarkers = regexp.MustCompile("&[#0-9A-Za-z" + HighlightStart + HighlightStop + "]+;")
+
+var markersRemover = strings.NewReplacer(HighlightStart, "", HighlightStop, "")
+
+// SearchSuggestion is name of entity which is suggested while user types search query
+type SearchSuggestion struct {
+	Type string `db:"type"`
+	ID   uint32 `db:"id"`
+	Name string `db:"name"`
+}
+
+// SearchEntity identifies entity of any searchable type
+type SearchEntity struct {
+	Type string `db:"entity_type"`
+	ID   uint32 `db:"entity_id"`
+}
+
+// RecentSearch is query searched by user or search result clicked by user
+type RecentSearch struct {
+	ID    uint32 `db:"id"`
+	Query string `db:"query"`
+
+	// Clicked result, nil if user only searched
+	EntityType *string `db:"entity_type"`
+	EntityID   *uint32 `db:"entity_id"`
+	// EntityName is nil if clicked entity was deleted
+	EntityName *string `db:"entity_name"`
+
+	SearchedAt time.Time `db:"searched_at"`
+}
+
+// SearchDocument is searchable entity with everything needed to find, filter and rank it
+// outside of database. Only entity of document's type is set.
+type SearchDocument struct {
+	Type string
+	ID   uint32
+	Name string
+
+	Album    *Album
+	Artist   *Artist
+	Track    *Track
+	Playlist *Playlist
+
+	// Lyrics are set for tracks having them
+	Lyrics string
+
+	// ArtistIDs are set for albums and tracks
+	ArtistIDs []uint32
+	// Listens of albums, artists and playlists are sums of their tracks' ones
+	Listens   uint32
+	Likes     uint32
+	CreatedAt time.Time
+}
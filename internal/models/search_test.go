@@ -0,0 +1,57 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlightToHTML(t *testing.T) {
+	testTable := []struct {
+		name         string
+		highlight    string
+		expectedHTML string
+	}{
+		{
+			name:         "Words",
+			highlight:    "\x02Master\x03 of \x02Puppets\x03",
+			expectedHTML: "<b>Master</b> of <b>Puppets</b>",
+		},
+		{
+			name:         "Escaped",
+			highlight:    "<script>\x02alert\x03</script> & co",
+			expectedHTML: "&lt;script&gt;<b>alert</b>&lt;/script&gt; &amp; co",
+		},
+		{
+			name:         "Stored Escaped",
+			highlight:    "Rock &amp; \x02Roll\x03 &#39;n&#39; Blues",
+			expectedHTML: "Rock &amp; <b>Roll</b> &#39;n&#39; Blues",
+		},
+		{
+			name:         "Markers In Entity",
+			highlight:    "Rock &\x02amp\x03; Roll",
+			expectedHTML: "Rock &amp; Roll",
+		},
+		{
+			name:         "Unpaired Markers",
+			highlight:    "\x03Где \x02нас\x02 нет",
+			expectedHTML: "Где <b>нас нет</b>",
+		},
+		{
+			name:         "Nothing Matched",
+			highlight:    "Горгород",
+			expectedHTML: "Горгород",
+		},
+		{
+			name:         "Empty",
+			highlight:    "",
+			expectedHTML: "",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedHTML, HighlightToHTML(tc.highlight))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+//go:generate easyjson -no_std_marshalers share.go
+
+// Types of shared entities
+const (
+	ShareTypeTrack    = "track"
+	ShareTypeAlbum    = "album"
+	ShareTypeArtist   = "artist"
+	ShareTypePlaylist = "playlist"
+)
+
+// Share codes consist of ShareCodeLen symbols of ShareCodeAlphabet
+const (
+	ShareCodeLen      = 8
+	ShareCodeAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// ShareLink is short code of entity: it's the same for all users who share the entity.
+// Clicks is amount of times link was opened.
+type ShareLink struct {
+	Code       string    `db:"code"`
+	EntityType string    `db:"entity_type"`
+	EntityID   uint32    `db:"entity_id"`
+	Clicks     uint32    `db:"clicks"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// ValidateShareType returns InvalidShareLinkError if entity of such type can't be shared
+func ValidateShareType(entityType string) error {
+	switch entityType {
+	case ShareTypeTrack, ShareTypeAlbum, ShareTypeArtist, ShareTypePlaylist:
+		return nil
+	}
+
+	return &InvalidShareLinkError{Reason: fmt.Sprintf("unknown type %q", entityType)}
+}
+
+// ValidateShareCode returns InvalidShareLinkError if code has wrong format
+func ValidateShareCode(code string) error {
+	if len(code) != ShareCodeLen {
+		return &InvalidShareLinkError{Reason: "wrong code length"}
+	}
+
+	for _, c := range code {
+		if !strings.ContainsRune(ShareCodeAlphabet, c) {
+			return &InvalidShareLinkError{Reason: fmt.Sprintf("wrong code symbol %q", c)}
+		}
+	}
+
+	return nil
+}
+
+//easyjson:json
+type ShareLinkTransfer struct {
+	Code   string `json:"code"`
+	URL    string `json:"url"`
+	Type   string `json:"type"`
+	ID     uint32 `json:"id"`
+	Clicks uint32 `json:"clicks"`
+}
+
+// ShareLinkTransferFromEntry converts ShareLink to ShareLinkTransfer, URL of link is on site with siteURL
+func ShareLinkTransferFromEntry(l ShareLink, siteURL string) ShareLinkTransfer {
+	return ShareLinkTransfer{
+		Code:   l.Code,
+		URL:    fmt.Sprintf("%s/share/%s", siteURL, l.Code),
+		Type:   l.EntityType,
+		ID:     l.EntityID,
+		Clicks: l.Clicks,
+	}
+}
@@ -0,0 +1,99 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson4f304ef7DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(in *jlexer.Lexer, out *ShareLinkTransfer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "clicks":
+			out.Clicks = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4f304ef7EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(out *jwriter.Writer, in ShareLinkTransfer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.ID))
+	}
+	{
+		const prefix string = ",\"clicks\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Clicks))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ShareLinkTransfer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4f304ef7EncodeGithubComGoParkMailRu20231TechnokaifInternalModels(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ShareLinkTransfer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4f304ef7DecodeGithubComGoParkMailRu20231TechnokaifInternalModels(l, v)
+}
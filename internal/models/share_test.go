@@ -0,0 +1,53 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateShareCode(t *testing.T) {
+	testTable := []struct {
+		name        string
+		code        string
+		expectError bool
+	}{
+		{
+			name: "Common",
+			code: "aZ09bY18",
+		},
+		{
+			name:        "Too Short",
+			code:        "aZ09",
+			expectError: true,
+		},
+		{
+			name:        "Too Long",
+			code:        "aZ09bY18c",
+			expectError: true,
+		},
+		{
+			name:        "Wrong Symbol",
+			code:        "aZ09bY1-",
+			expectError: true,
+		},
+		{
+			name:        "Not ASCII",
+			code:        "aZ09bYЯ",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateShareCode(tc.code)
+
+			if tc.expectError {
+				var errInvalidLink *InvalidShareLinkError
+				assert.ErrorAs(t, err, &errInvalidLink)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+//go:generate easyjson -no_std_marshalers smart_playlist.go
+
+// Smart playlist rule fields
+const (
+	SmartFieldName        = "name"
+	SmartFieldDuration    = "duration"
+	SmartFieldListens     = "listens"
+	SmartFieldArtist      = "artist"
+	SmartFieldLiked       = "liked"
+	SmartFieldLikedArtist = "likedArtist"
+	SmartFieldLikedAt     = "likedAt"
+	SmartFieldListenedAt  = "listenedAt"
+)
+
+// Smart playlist rule operators
+const (
+	SmartOpEq       = "eq"
+	SmartOpNeq      = "neq"
+	SmartOpLt       = "lt"
+	SmartOpLte      = "lte"
+	SmartOpGt       = "gt"
+	SmartOpGte      = "gte"
+	SmartOpContains = "contains"
+	SmartOpInLast   = "inLast" // value is amount of days
+)
+
+// Smart playlist rules combining modes
+const (
+	SmartMatchAll = "all"
+	SmartMatchAny = "any"
+)
+
+// Smart playlist orderings
+const (
+	SmartOrderListens     = "listens"
+	SmartOrderUserListens = "userListens"
+	SmartOrderLikedAt     = "likedAt"
+	SmartOrderName        = "name"
+	SmartOrderDuration    = "duration"
+)
+
+const (
+	SmartPlaylistDefaultLimit uint32 = 100
+	SmartPlaylistMaxLimit     uint32 = 500
+	smartPlaylistMaxRules            = 20
+)
+
+var smartFieldsOps = map[string][]string{
+	SmartFieldName:        {SmartOpEq, SmartOpNeq, SmartOpContains},
+	SmartFieldDuration:    {SmartOpEq, SmartOpNeq, SmartOpLt, SmartOpLte, SmartOpGt, SmartOpGte},
+	SmartFieldListens:     {SmartOpEq, SmartOpNeq, SmartOpLt, SmartOpLte, SmartOpGt, SmartOpGte},
+	SmartFieldArtist:      {SmartOpEq, SmartOpNeq},
+	SmartFieldLiked:       {SmartOpEq},
+	SmartFieldLikedArtist: {SmartOpEq},
+	SmartFieldLikedAt:     {SmartOpInLast},
+	SmartFieldListenedAt:  {SmartOpInLast},
+}
+
+var smartOrders = map[string]struct{}{
+	SmartOrderListens:     {},
+	SmartOrderUserListens: {},
+	SmartOrderLikedAt:     {},
+	SmartOrderName:        {},
+	SmartOrderDuration:    {},
+}
+
+// SmartPlaylistRule is one condition over tracks' fields, likes, listens and artists.
+// Likes and listens are taken of the smart playlist's owner.
+//
+//easyjson:json
+type SmartPlaylistRule struct {
+	Field string `json:"field"`
+	Op    string `json:"op"`
+	Value string `json:"value"`
+}
+
+// SmartPlaylistRules is rule expression which defines tracks of smart playlist
+//
+//easyjson:json
+type SmartPlaylistRules struct {
+	Match           string              `json:"match"`
+	Rules           []SmartPlaylistRule `json:"rules"`
+	OrderBy         string              `json:"orderBy,omitempty"`
+	OrderPeriodDays uint32              `json:"orderPeriodDays,omitempty"`
+	Limit           uint32              `json:"limit,omitempty"`
+}
+
+// SmartPlaylist implements rules of auto-updating playlist
+type SmartPlaylist struct {
+	PlaylistID uint32             `db:"playlist_id"`
+	OwnerID    uint32             `db:"owner_id"`
+	Rules      SmartPlaylistRules `db:"rules"`
+	UpdatedAt  time.Time          `db:"updated_at"`
+}
+
+// Validate checks if rules can be compiled and sets defaults
+func (r *SmartPlaylistRules) Validate() error {
+	if r.Match == "" {
+		r.Match = SmartMatchAll
+	}
+	if r.Match != SmartMatchAll && r.Match != SmartMatchAny {
+		return &InvalidSmartRulesError{Reason: fmt.Sprintf("unknown match mode %q", r.Match)}
+	}
+
+	if len(r.Rules) == 0 {
+		return &InvalidSmartRulesError{Reason: "no rules"}
+	}
+	if len(r.Rules) > smartPlaylistMaxRules {
+		return &InvalidSmartRulesError{Reason: "too many rules"}
+	}
+
+	for _, rule := range r.Rules {
+		if err := rule.validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.OrderBy != "" {
+		if _, ok := smartOrders[r.OrderBy]; !ok {
+			return &InvalidSmartRulesError{Reason: fmt.Sprintf("unknown ordering %q", r.OrderBy)}
+		}
+	}
+
+	if r.Limit == 0 {
+		r.Limit = SmartPlaylistDefaultLimit
+	}
+	if r.Limit > SmartPlaylistMaxLimit {
+		return &InvalidSmartRulesError{Reason: "limit is too big"}
+	}
+
+	return nil
+}
+
+func (r SmartPlaylistRule) validate() error {
+	ops, ok := smartFieldsOps[r.Field]
+	if !ok {
+		return &InvalidSmartRulesError{Reason: fmt.Sprintf("unknown field %q", r.Field)}
+	}
+
+	opAllowed := false
+	for _, op := range ops {
+		if op == r.Op {
+			opAllowed = true
+			break
+		}
+	}
+	if !opAllowed {
+		return &InvalidSmartRulesError{Reason: fmt.Sprintf("operator %q isn't allowed for field %q", r.Op, r.Field)}
+	}
+
+	switch r.Field {
+	case SmartFieldName:
+		if r.Value == "" {
+			return &InvalidSmartRulesError{Reason: "empty name value"}
+		}
+	case SmartFieldLiked, SmartFieldLikedArtist:
+		if _, err := r.BoolValue(); err != nil {
+			return &InvalidSmartRulesError{Reason: fmt.Sprintf("field %q needs bool value", r.Field)}
+		}
+	default:
+		if _, err := r.UintValue(); err != nil {
+			return &InvalidSmartRulesError{Reason: fmt.Sprintf("field %q needs unsigned integer value", r.Field)}
+		}
+	}
+
+	return nil
+}
+
+// UintValue parses rule value as unsigned integer
+func (r SmartPlaylistRule) UintValue() (uint32, error) {
+	v, err := strconv.ParseUint(r.Value, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(v), nil
+}
+
+// BoolValue parses rule value as bool
+func (r SmartPlaylistRule) BoolValue() (bool, error) {
+	return strconv.ParseBool(r.Value)
+}
+
+// Value implements driver.Valuer to store rules as JSONB
+func (r SmartPlaylistRules) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+// Scan implements sql.Scanner to read rules from JSONB
+func (r *SmartPlaylistRules) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	default:
+		return errors.New("incompatible type for smart playlist rules")
+	}
+}
@@ -0,0 +1,197 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package models
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonD541eeecDecodeGithubComGoParkMailRu20231TechnokaifInternalModels(in *jlexer.Lexer, out *SmartPlaylistRules) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "match":
+			out.Match = string(in.String())
+		case "rules":
+			if in.IsNull() {
+				in.Skip()
+				out.Rules = nil
+			} else {
+				in.Delim('[')
+				if out.Rules == nil {
+					if !in.IsDelim(']') {
+						out.Rules = make([]SmartPlaylistRule, 0, 1)
+					} else {
+						out.Rules = []SmartPlaylistRule{}
+					}
+				} else {
+					out.Rules = (out.Rules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 SmartPlaylistRule
+					(v1).UnmarshalEasyJSON(in)
+					out.Rules = append(out.Rules, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "orderBy":
+			out.OrderBy = string(in.String())
+		case "orderPeriodDays":
+			out.OrderPeriodDays = uint32(in.Uint32())
+		case "limit":
+			out.Limit = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD541eeecEncodeGithubComGoParkMailRu20231TechnokaifInternalModels(out *jwriter.Writer, in SmartPlaylistRules) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"match\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Match))
+	}
+	{
+		const prefix string = ",\"rules\":"
+		out.RawString(prefix)
+		if in.Rules == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Rules {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.OrderBy != "" {
+		const prefix string = ",\"orderBy\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderBy))
+	}
+	if in.OrderPeriodDays != 0 {
+		const prefix string = ",\"orderPeriodDays\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.OrderPeriodDays))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SmartPlaylistRules) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD541eeecEncodeGithubComGoParkMailRu20231TechnokaifInternalModels(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SmartPlaylistRules) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD541eeecDecodeGithubComGoParkMailRu20231TechnokaifInternalModels(l, v)
+}
+func easyjsonD541eeecDecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(in *jlexer.Lexer, out *SmartPlaylistRule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "field":
+			out.Field = string(in.String())
+		case "op":
+			out.Op = string(in.String())
+		case "value":
+			out.Value = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD541eeecEncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(out *jwriter.Writer, in SmartPlaylistRule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"field\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Field))
+	}
+	{
+		const prefix string = ",\"op\":"
+		out.RawString(prefix)
+		out.String(string(in.Op))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SmartPlaylistRule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD541eeecEncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SmartPlaylistRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD541eeecDecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(l, v)
+}
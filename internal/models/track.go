@@ -1,6 +1,9 @@
 package models
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 //go:generate easyjson -no_std_marshalers track.go
 
@@ -13,6 +16,14 @@ type Track struct {
 	RecordSrc     string  `db:"record_src"`
 	Duration      uint32  `db:"duration"`
 	Listens       uint32  `db:"listens"`
+
+	// LikedAt is set only for user's favorites
+	LikedAt *time.Time `db:"liked_at"`
+	// Highlight is set only for search results, see HighlightToHTML
+	Highlight string `db:"highlight"`
+	// LyricsSnippet is set only for search results matched by lyrics,
+	// it's fragment of lyrics with matched words highlighted like Highlight
+	LyricsSnippet string `db:"lyrics_snippet"`
 }
 
 //easyjson:json
@@ -27,6 +38,9 @@ type TrackTransfer struct {
 	Listens       uint32          `json:"listens"`
 	IsLiked       bool            `json:"isLiked"`
 	RecordSrc     string          `json:"recordSrc"`
+	LikedAt       *time.Time      `json:"likedAt,omitempty"`
+	Highlight     string          `json:"highlight,omitempty"`
+	LyricsSnippet string          `json:"lyricsSnippet,omitempty"`
 }
 
 //easyjson:json
@@ -68,6 +82,9 @@ func TrackTransferFromEntry(ctx context.Context, t Track, user *User, likeChecke
 		Listens:       t.Listens,
 		IsLiked:       isLiked,
 		RecordSrc:     t.RecordSrc,
+		LikedAt:       t.LikedAt,
+		Highlight:     HighlightToHTML(t.Highlight),
+		LyricsSnippet: HighlightToHTML(t.LyricsSnippet),
 	}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -124,6 +125,22 @@ func easyjsonAe118d8fDecodeGithubComGoParkMailRu20231TechnokaifInternalModels1(i
 			out.IsLiked = bool(in.Bool())
 		case "recordSrc":
 			out.RecordSrc = string(in.String())
+		case "likedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.LikedAt = nil
+			} else {
+				if out.LikedAt == nil {
+					out.LikedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.LikedAt).UnmarshalJSON(data))
+				}
+			}
+		case "highlight":
+			out.Highlight = string(in.String())
+		case "lyricsSnippet":
+			out.LyricsSnippet = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -188,6 +205,21 @@ func easyjsonAe118d8fEncodeGithubComGoParkMailRu20231TechnokaifInternalModels1(o
 		out.RawString(prefix)
 		out.String(string(in.RecordSrc))
 	}
+	if in.LikedAt != nil {
+		const prefix string = ",\"likedAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.LikedAt).MarshalJSON())
+	}
+	if in.Highlight != "" {
+		const prefix string = ",\"highlight\":"
+		out.RawString(prefix)
+		out.String(string(in.Highlight))
+	}
+	if in.LyricsSnippet != "" {
+		const prefix string = ",\"lyricsSnippet\":"
+		out.RawString(prefix)
+		out.String(string(in.LyricsSnippet))
+	}
 	out.RawByte('}')
 }
 
@@ -60,6 +60,75 @@ func UserTransferFromList(users []User) []UserTransfer {
 	return userTransfers
 }
 
+// UserPublicTransfer is user info which is visible to other users,
+// so it has no email and birth date
+//
+//easyjson:json
+type UserPublicTransfer struct {
+	ID        uint32 `json:"id"`
+	Username  string `json:"username"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	AvatarSrc string `json:"avatarSrc,omitempty"`
+}
+
+//easyjson:json
+type UserPublicTransfers []UserPublicTransfer
+
+func UserPublicTransferFromEntry(user User) UserPublicTransfer {
+	return UserPublicTransfer{
+		ID:        user.ID,
+		Username:  user.Username,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		AvatarSrc: user.AvatarSrc,
+	}
+}
+
+func UserPublicTransferFromList(users []User) []UserPublicTransfer {
+	userTransfers := make([]UserPublicTransfer, 0, len(users))
+	for _, u := range users {
+		userTransfers = append(userTransfers, UserPublicTransferFromEntry(u))
+	}
+
+	return userTransfers
+}
+
+// UserFollowCounts are amounts of user's followers and of users they follow
+type UserFollowCounts struct {
+	Followers uint32 `db:"followers"`
+	Following uint32 `db:"following"`
+}
+
+// UserProfileTransfer is public profile of user
+//
+//easyjson:json
+type UserProfileTransfer struct {
+	ID         uint32 `json:"id"`
+	Username   string `json:"username"`
+	FirstName  string `json:"firstName"`
+	LastName   string `json:"lastName"`
+	AvatarSrc  string `json:"avatarSrc,omitempty"`
+	Followers  uint32 `json:"followers"`
+	Following  uint32 `json:"following"`
+	IsFollowed bool   `json:"isFollowed"`
+}
+
+// UserProfileTransferFromEntry converts User to UserProfileTransfer,
+// isFollowed tells if user is followed by one who views profile
+func UserProfileTransferFromEntry(user User, counts UserFollowCounts, isFollowed bool) UserProfileTransfer {
+	return UserProfileTransfer{
+		ID:         user.ID,
+		Username:   user.Username,
+		FirstName:  user.FirstName,
+		LastName:   user.LastName,
+		AvatarSrc:  user.AvatarSrc,
+		Followers:  counts.Followers,
+		Following:  counts.Following,
+		IsFollowed: isFollowed,
+	}
+}
+
 func (d *Date) UnmarshalJSON(b []byte) error {
 	s := strings.Trim(string(b), "\"")
 
@@ -0,0 +1,51 @@
+package activity
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+//go:generate mockgen -source=activity.go -destination=mocks/mock.go
+
+// Usecase includes bussiness logics methods to work with activity feed
+type Usecase interface {
+	// GetTimeline returns page of activities in user's timeline, the latest first,
+	// and total amount of them
+	GetTimeline(ctx context.Context, userID uint32, limit, offset uint32) ([]models.Activity, uint32, error)
+}
+
+// Repository includes DBMS-relatable methods to work with activities.
+// Activities are fanned out on write: each of them is added to timelines
+// of all interested users at once, so reading timeline is cheap.
+// Timelines are secondary to entities themselves, so callers only log failures of adding activities.
+type Repository interface {
+	// AddForArtistsFans adds activity to timelines of users who like any of artists
+	AddForArtistsFans(ctx context.Context, activity models.Activity, artistsID []uint32) error
+
+	// AddForFollowers adds activity to timelines of followers of activity's user.
+	// The same activity added before is replaced, so repeated updates don't flood timelines.
+	AddForFollowers(ctx context.Context, activity models.Activity) error
+
+	// Delete removes activity with the same type, user and entities from all timelines
+	Delete(ctx context.Context, activity models.Activity) error
+
+	// GetTimeline returns page of activities in user's timeline, the latest first,
+	// and total amount of them. Entities activities refer to are set too.
+	GetTimeline(ctx context.Context, userID uint32, limit, offset uint32) ([]models.Activity, uint32, error)
+}
+
+// Tables includes methods which return needed tables
+// to work with activities on repository layer
+type Tables interface {
+	Activities() string
+	ActivityTimeline() string
+	LikedArtists() string
+	Followers() string
+
+	Users() string
+	Albums() string
+	Tracks() string
+	Playlists() string
+	SmartPlaylists() string
+}
@@ -0,0 +1,152 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+)
+
+type Handler struct {
+	activityServices activity.Usecase
+	albumServices    album.Usecase
+	artistServices   artist.Usecase
+	trackServices    track.Usecase
+	playlistServices playlist.Usecase
+	userServices     user.Usecase
+	logger           logger.Logger
+}
+
+func NewHandler(acu activity.Usecase, alu album.Usecase, aru artist.Usecase,
+	tu track.Usecase, pu playlist.Usecase, uu user.Usecase, l logger.Logger) *Handler {
+
+	return &Handler{
+		activityServices: acu,
+		albumServices:    alu,
+		artistServices:   aru,
+		trackServices:    tu,
+		playlistServices: pu,
+		userServices:     uu,
+
+		logger: l,
+	}
+}
+
+// @Summary      Activity Feed
+// @Tags         Feed
+// @Description  Get personal activity feed of user: new releases of liked artists,
+// @Description  playlists created or updated and tracks liked by followed users. The latest go first.
+// @Produce      json
+// @Param		 limit	query		int		false	"Page size"
+// @Param		 offset	query		int		false	"Page offset"
+// @Success      200    {object}  	models.ActivityTransfers	"Activities got"
+// @Header		 200	{integer}	X-Total-Count				"Total amount of activities"
+// @Failure		 400	{object}	http.Error					"Incorrect input"
+// @Failure      401    {object}  	http.Error  				"User unathorized"
+// @Failure      500    {object}  	http.Error  				"Server error"
+// @Router       /api/feed/activity [get]
+func (h *Handler) GetActivity(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		if errors.Is(err, commonHTTP.ErrUnauthorized) {
+			commonHTTP.ErrorResponse(w, r, commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger)
+			return
+		}
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			activityGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	limit, offset, err := commonHTTP.GetPaginationFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	activities, total, err := h.activityServices.GetTimeline(r.Context(), user.ID, limit, offset)
+	if err != nil {
+		var errInvalidQuery *models.InvalidActivityQueryError
+		if errors.As(err, &errInvalidQuery) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			activityGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	at := make(models.ActivityTransfers, 0, len(activities))
+	for _, a := range activities {
+		t, err := h.activityTransferFromEntry(r.Context(), a, user)
+		if err != nil {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				activityGetServerError, http.StatusInternalServerError, h.logger, err)
+			return
+		}
+
+		at = append(at, t)
+	}
+
+	w.Header().Set(commonHTTP.TotalCountHeader, strconv.FormatUint(uint64(total), 10))
+	commonHTTP.SuccessResponse(w, r, at, h.logger)
+}
+
+// activityTransferFromEntry fills ActivityTransfer with entities activity refers to,
+// which are got by repository with the whole page of activities
+func (h *Handler) activityTransferFromEntry(ctx context.Context,
+	a models.Activity, user *models.User) (models.ActivityTransfer, error) {
+
+	at := models.ActivityTransfer{
+		ID:        a.ID,
+		Type:      a.Type,
+		CreatedAt: a.CreatedAt,
+	}
+
+	if a.User != nil {
+		ut := models.UserPublicTransferFromEntry(*a.User)
+		at.User = &ut
+	}
+
+	if a.Album != nil {
+		alt, err := models.AlbumTransferFromEntry(ctx, *a.Album, user,
+			h.albumServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByAlbum)
+		if err != nil {
+			return models.ActivityTransfer{}, err
+		}
+		at.Album = &alt
+	}
+
+	if a.Track != nil {
+		tt, err := models.TrackTransferFromEntry(ctx, *a.Track, user,
+			h.trackServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByTrack)
+		if err != nil {
+			return models.ActivityTransfer{}, err
+		}
+		at.Track = &tt
+	}
+
+	if a.Playlist != nil {
+		pt, err := models.PlaylistTransferFromEntry(ctx, *a.Playlist, user,
+			h.playlistServices.IsLiked, h.userServices.GetByPlaylist)
+		if err != nil {
+			return models.ActivityTransfer{}, err
+		}
+		at.Playlist = &pt
+	}
+
+	return at, nil
+}
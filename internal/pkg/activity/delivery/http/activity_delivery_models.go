@@ -0,0 +1,5 @@
+package http
+
+const (
+	activityGetServerError = "can't get activity feed"
+)
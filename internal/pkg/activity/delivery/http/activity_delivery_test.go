@@ -0,0 +1,202 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	activityMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity/mocks"
+	albumMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/mocks"
+	artistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/mocks"
+	playlistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/mocks"
+	trackMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/mocks"
+	userMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/mocks"
+)
+
+func TestActivityDeliveryHTTP_GetActivity(t *testing.T) {
+	// Init
+	type mockBehavior func(acu *activityMocks.MockUsecase, aru *artistMocks.MockUsecase,
+		tu *trackMocks.MockUsecase, uu *userMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	acu := activityMocks.NewMockUsecase(c)
+	alu := albumMocks.NewMockUsecase(c)
+	aru := artistMocks.NewMockUsecase(c)
+	tu := trackMocks.NewMockUsecase(c)
+	pu := playlistMocks.NewMockUsecase(c)
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(acu, alu, aru, tu, pu, uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/feed/activity", h.GetActivity)
+
+	// Test filling
+	user := &models.User{ID: 1}
+
+	var followedUserID uint32 = 2
+	var trackID uint32 = 3
+	createdAt := time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	followedUser := &models.User{
+		ID:        followedUserID,
+		Username:  "yarik_tri",
+		FirstName: "Yaroslav",
+		LastName:  "Kuzmin",
+	}
+	track := &models.Track{
+		ID:        trackID,
+		Name:      "Где нас нет",
+		CoverSrc:  "/tracks/covers/where_we_are_not.png",
+		RecordSrc: "/tracks/records/where_we_are_not.wav",
+	}
+	activities := []models.Activity{
+		{
+			ID:        1,
+			Type:      models.ActivityTypeTrackLiked,
+			UserID:    &followedUserID,
+			TrackID:   &trackID,
+			CreatedAt: createdAt,
+			User:      followedUser,
+			Track:     track,
+		},
+	}
+	artists := []models.Artist{
+		{
+			ID:        1,
+			Name:      "Oxxxymiron",
+			AvatarSrc: "/artists/avatars/oxxxymiron.png",
+		},
+	}
+
+	correctResponse := `[
+		{
+			"id": 1,
+			"type": "trackLiked",
+			"user": {
+				"id": 2,
+				"username": "yarik_tri",
+				"firstName": "Yaroslav",
+				"lastName": "Kuzmin"
+			},
+			"track": {
+				"id": 3,
+				"name": "Где нас нет",
+				"artists": [
+					{
+						"id": 1,
+						"name": "Oxxxymiron",
+						"isLiked": true,
+						"cover": "/artists/avatars/oxxxymiron.png"
+					}
+				],
+				"cover": "/tracks/covers/where_we_are_not.png",
+				"duration": 0,
+				"listens": 0,
+				"isLiked": false,
+				"recordSrc": "/tracks/records/where_we_are_not.wav"
+			},
+			"createdAt": "2023-05-01T12:00:00Z"
+		}
+	]`
+
+	testTable := []struct {
+		name             string
+		query            string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:  "Common",
+			query: "?limit=10&offset=0",
+			user:  user,
+			mockBehavior: func(acu *activityMocks.MockUsecase, aru *artistMocks.MockUsecase,
+				tu *trackMocks.MockUsecase, uu *userMocks.MockUsecase) {
+
+				acu.EXPECT().GetTimeline(gomock.Any(), user.ID, uint32(10), uint32(0)).Return(activities, uint32(1), nil)
+				aru.EXPECT().GetByTrack(gomock.Any(), trackID).Return(artists, nil)
+				tu.EXPECT().IsLiked(gomock.Any(), trackID, user.ID).Return(false, nil)
+				aru.EXPECT().IsLiked(gomock.Any(), artists[0].ID, user.ID).Return(true, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name: "Empty Feed",
+			user: user,
+			mockBehavior: func(acu *activityMocks.MockUsecase, aru *artistMocks.MockUsecase,
+				tu *trackMocks.MockUsecase, uu *userMocks.MockUsecase) {
+
+				acu.EXPECT().GetTimeline(gomock.Any(), user.ID, uint32(0), uint32(0)).Return([]models.Activity{}, uint32(0), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `[]`,
+		},
+		{
+			name: "Unauthorized",
+			user: nil,
+			mockBehavior: func(acu *activityMocks.MockUsecase, aru *artistMocks.MockUsecase,
+				tu *trackMocks.MockUsecase, uu *userMocks.MockUsecase) {
+			},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:  "Incorrect Query",
+			query: "?limit=-1",
+			user:  user,
+			mockBehavior: func(acu *activityMocks.MockUsecase, aru *artistMocks.MockUsecase,
+				tu *trackMocks.MockUsecase, uu *userMocks.MockUsecase) {
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
+		},
+		{
+			name:  "Too Big Limit",
+			query: "?limit=1000",
+			user:  user,
+			mockBehavior: func(acu *activityMocks.MockUsecase, aru *artistMocks.MockUsecase,
+				tu *trackMocks.MockUsecase, uu *userMocks.MockUsecase) {
+
+				acu.EXPECT().GetTimeline(gomock.Any(), user.ID, uint32(1000), uint32(0)).
+					Return(nil, uint32(0), &models.InvalidActivityQueryError{Reason: "limit is too big"})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
+		},
+		{
+			name: "Server Error",
+			user: user,
+			mockBehavior: func(acu *activityMocks.MockUsecase, aru *artistMocks.MockUsecase,
+				tu *trackMocks.MockUsecase, uu *userMocks.MockUsecase) {
+
+				acu.EXPECT().GetTimeline(gomock.Any(), user.ID, uint32(0), uint32(0)).Return(nil, uint32(0), errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(activityGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(acu, aru, tu, uu)
+
+			commonTests.DeliveryTestGet(t, r, "/api/feed/activity"+tc.query, tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
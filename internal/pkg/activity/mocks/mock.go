@@ -0,0 +1,282 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: activity.go
+
+// Package mock_activity is a generated GoMock package.
+package mock_activity
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetTimeline mocks base method.
+func (m *MockUsecase) GetTimeline(ctx context.Context, userID, limit, offset uint32) ([]models.Activity, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeline", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]models.Activity)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTimeline indicates an expected call of GetTimeline.
+func (mr *MockUsecaseMockRecorder) GetTimeline(ctx, userID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockUsecase)(nil).GetTimeline), ctx, userID, limit, offset)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddForArtistsFans mocks base method.
+func (m *MockRepository) AddForArtistsFans(ctx context.Context, activity models.Activity, artistsID []uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddForArtistsFans", ctx, activity, artistsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddForArtistsFans indicates an expected call of AddForArtistsFans.
+func (mr *MockRepositoryMockRecorder) AddForArtistsFans(ctx, activity, artistsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddForArtistsFans", reflect.TypeOf((*MockRepository)(nil).AddForArtistsFans), ctx, activity, artistsID)
+}
+
+// AddForFollowers mocks base method.
+func (m *MockRepository) AddForFollowers(ctx context.Context, activity models.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddForFollowers", ctx, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddForFollowers indicates an expected call of AddForFollowers.
+func (mr *MockRepositoryMockRecorder) AddForFollowers(ctx, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddForFollowers", reflect.TypeOf((*MockRepository)(nil).AddForFollowers), ctx, activity)
+}
+
+// Delete mocks base method.
+func (m *MockRepository) Delete(ctx context.Context, activity models.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder) Delete(ctx, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, activity)
+}
+
+// GetTimeline mocks base method.
+func (m *MockRepository) GetTimeline(ctx context.Context, userID, limit, offset uint32) ([]models.Activity, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeline", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]models.Activity)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTimeline indicates an expected call of GetTimeline.
+func (mr *MockRepositoryMockRecorder) GetTimeline(ctx, userID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeline", reflect.TypeOf((*MockRepository)(nil).GetTimeline), ctx, userID, limit, offset)
+}
+
+// MockTables is a mock of Tables interface.
+type MockTables struct {
+	ctrl     *gomock.Controller
+	recorder *MockTablesMockRecorder
+}
+
+// MockTablesMockRecorder is the mock recorder for MockTables.
+type MockTablesMockRecorder struct {
+	mock *MockTables
+}
+
+// NewMockTables creates a new mock instance.
+func NewMockTables(ctrl *gomock.Controller) *MockTables {
+	mock := &MockTables{ctrl: ctrl}
+	mock.recorder = &MockTablesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTables) EXPECT() *MockTablesMockRecorder {
+	return m.recorder
+}
+
+// Activities mocks base method.
+func (m *MockTables) Activities() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activities")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Activities indicates an expected call of Activities.
+func (mr *MockTablesMockRecorder) Activities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activities", reflect.TypeOf((*MockTables)(nil).Activities))
+}
+
+// ActivityTimeline mocks base method.
+func (m *MockTables) ActivityTimeline() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivityTimeline")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ActivityTimeline indicates an expected call of ActivityTimeline.
+func (mr *MockTablesMockRecorder) ActivityTimeline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivityTimeline", reflect.TypeOf((*MockTables)(nil).ActivityTimeline))
+}
+
+// Albums mocks base method.
+func (m *MockTables) Albums() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Albums")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Albums indicates an expected call of Albums.
+func (mr *MockTablesMockRecorder) Albums() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Albums", reflect.TypeOf((*MockTables)(nil).Albums))
+}
+
+// Followers mocks base method.
+func (m *MockTables) Followers() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Followers")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Followers indicates an expected call of Followers.
+func (mr *MockTablesMockRecorder) Followers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Followers", reflect.TypeOf((*MockTables)(nil).Followers))
+}
+
+// LikedArtists mocks base method.
+func (m *MockTables) LikedArtists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedArtists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedArtists indicates an expected call of LikedArtists.
+func (mr *MockTablesMockRecorder) LikedArtists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedArtists", reflect.TypeOf((*MockTables)(nil).LikedArtists))
+}
+
+// Playlists mocks base method.
+func (m *MockTables) Playlists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Playlists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Playlists indicates an expected call of Playlists.
+func (mr *MockTablesMockRecorder) Playlists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Playlists", reflect.TypeOf((*MockTables)(nil).Playlists))
+}
+
+// SmartPlaylists mocks base method.
+func (m *MockTables) SmartPlaylists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SmartPlaylists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SmartPlaylists indicates an expected call of SmartPlaylists.
+func (mr *MockTablesMockRecorder) SmartPlaylists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SmartPlaylists", reflect.TypeOf((*MockTables)(nil).SmartPlaylists))
+}
+
+// Tracks mocks base method.
+func (m *MockTables) Tracks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tracks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Tracks indicates an expected call of Tracks.
+func (mr *MockTablesMockRecorder) Tracks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tracks", reflect.TypeOf((*MockTables)(nil).Tracks))
+}
+
+// Users mocks base method.
+func (m *MockTables) Users() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Users")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Users indicates an expected call of Users.
+func (mr *MockTablesMockRecorder) Users() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Users", reflect.TypeOf((*MockTables)(nil).Users))
+}
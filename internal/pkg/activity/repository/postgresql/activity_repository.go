@@ -0,0 +1,274 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity"
+
+	commonSQL "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/db"
+)
+
+// PostgreSQL implements activity.Repository
+type PostgreSQL struct {
+	db     *sqlx.DB
+	tables activity.Tables
+}
+
+func NewPostgreSQL(db *sqlx.DB, t activity.Tables) *PostgreSQL {
+	return &PostgreSQL{
+		db:     db,
+		tables: t,
+	}
+}
+
+// sameActivityCondition matches activities with type, user and entities in $1-$5 arguments
+const sameActivityCondition = `type = $1
+	AND user_id IS NOT DISTINCT FROM $2
+	AND album_id IS NOT DISTINCT FROM $3
+	AND track_id IS NOT DISTINCT FROM $4
+	AND playlist_id IS NOT DISTINCT FROM $5`
+
+func activityArgs(a models.Activity) []interface{} {
+	return []interface{}{a.Type, a.UserID, a.AlbumID, a.TrackID, a.PlaylistID}
+}
+
+// insert adds activity and returns its ID and creation time
+func (p *PostgreSQL) insert(ctx context.Context, tx *sql.Tx, a models.Activity) (uint32, time.Time, error) {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (type, user_id, album_id, track_id, playlist_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at;`,
+		p.tables.Activities())
+
+	var id uint32
+	var createdAt time.Time
+	if err := tx.QueryRowContext(ctx, query, activityArgs(a)...).Scan(&id, &createdAt); err != nil {
+		return 0, time.Time{}, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return id, createdAt, nil
+}
+
+func (p *PostgreSQL) AddForArtistsFans(ctx context.Context,
+	a models.Activity, artistsID []uint32) (repoErr error) {
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	activityID, createdAt, err := p.insert(ctx, tx, a)
+	if err != nil {
+		return err
+	}
+
+	fanOutQuery := fmt.Sprintf(
+		`INSERT INTO %s (user_id, activity_id, created_at)
+		SELECT DISTINCT la.user_id, $1::INT, $2::TIMESTAMPTZ
+		FROM %s la
+		WHERE la.artist_id = ANY($3);`,
+		p.tables.ActivityTimeline(), p.tables.LikedArtists())
+	if _, err := tx.ExecContext(ctx, fanOutQuery, activityID, createdAt, pq.Array(artistsID)); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) AddForFollowers(ctx context.Context, a models.Activity) (repoErr error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	// Timeline entries of replaced activity are deleted by cascade
+	deleteQuery := fmt.Sprintf(
+		`DELETE FROM %s
+		WHERE %s;`,
+		p.tables.Activities(), sameActivityCondition)
+	if _, err := tx.ExecContext(ctx, deleteQuery, activityArgs(a)...); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	activityID, createdAt, err := p.insert(ctx, tx, a)
+	if err != nil {
+		return err
+	}
+
+	fanOutQuery := fmt.Sprintf(
+		`INSERT INTO %s (user_id, activity_id, created_at)
+		SELECT f.follower_id, $1::INT, $2::TIMESTAMPTZ
+		FROM %s f
+		WHERE f.user_id = $3;`,
+		p.tables.ActivityTimeline(), p.tables.Followers())
+	if _, err := tx.ExecContext(ctx, fanOutQuery, activityID, createdAt, a.UserID); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) Delete(ctx context.Context, a models.Activity) error {
+	query := fmt.Sprintf(
+		`DELETE FROM %s
+		WHERE %s;`,
+		p.tables.Activities(), sameActivityCondition)
+
+	if _, err := p.db.ExecContext(ctx, query, activityArgs(a)...); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) GetTimeline(ctx context.Context,
+	userID uint32, limit, offset uint32) ([]models.Activity, uint32, error) {
+
+	countQuery := fmt.Sprintf(
+		`SELECT COUNT(*)
+		FROM %s
+		WHERE user_id = $1;`,
+		p.tables.ActivityTimeline())
+
+	var total uint32
+	if err := p.db.GetContext(ctx, &total, countQuery, userID); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	query := fmt.Sprintf(
+		`SELECT a.id, a.type, a.user_id, a.album_id, a.track_id, a.playlist_id, a.created_at
+		FROM %s t
+			INNER JOIN %s a ON a.id = t.activity_id
+		WHERE t.user_id = $1
+		ORDER BY t.created_at DESC, a.id DESC
+		LIMIT $2 OFFSET $3;`,
+		p.tables.ActivityTimeline(), p.tables.Activities())
+
+	activities := []models.Activity{}
+	if err := p.db.SelectContext(ctx, &activities, query, userID, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	if err := p.setEntities(ctx, activities); err != nil {
+		return nil, 0, err
+	}
+
+	return activities, total, nil
+}
+
+// setEntities sets entities activities refer to. Entities of each type are selected in one query.
+func (p *PostgreSQL) setEntities(ctx context.Context, activities []models.Activity) error {
+	var usersID, albumsID, tracksID, playlistsID []uint32
+	for _, a := range activities {
+		if a.UserID != nil {
+			usersID = append(usersID, *a.UserID)
+		}
+		if a.AlbumID != nil {
+			albumsID = append(albumsID, *a.AlbumID)
+		}
+		if a.TrackID != nil {
+			tracksID = append(tracksID, *a.TrackID)
+		}
+		if a.PlaylistID != nil {
+			playlistsID = append(playlistsID, *a.PlaylistID)
+		}
+	}
+
+	var users []models.User
+	usersQuery := fmt.Sprintf(
+		`SELECT id, username, first_name, last_name, avatar_src
+		FROM %s
+		WHERE id = ANY($1);`,
+		p.tables.Users())
+	if err := p.selectByIDs(ctx, &users, usersQuery, usersID); err != nil {
+		return err
+	}
+
+	var albums []models.Album
+	albumsQuery := fmt.Sprintf(
+		`SELECT id, name, description, cover_src
+		FROM %s
+		WHERE id = ANY($1);`,
+		p.tables.Albums())
+	if err := p.selectByIDs(ctx, &albums, albumsQuery, albumsID); err != nil {
+		return err
+	}
+
+	var tracks []models.Track
+	tracksQuery := fmt.Sprintf(
+		`SELECT id, name, album_id, cover_src, record_src, listens, duration
+		FROM %s
+		WHERE id = ANY($1);`,
+		p.tables.Tracks())
+	if err := p.selectByIDs(ctx, &tracks, tracksQuery, tracksID); err != nil {
+		return err
+	}
+
+	var playlists []models.Playlist
+	playlistsQuery := fmt.Sprintf(
+		`SELECT p.id, p.name, p.description, p.cover_src, p.forked_from, sp.rules
+		FROM %s p
+			LEFT JOIN %s sp ON p.id = sp.playlist_id
+		WHERE p.id = ANY($1);`,
+		p.tables.Playlists(), p.tables.SmartPlaylists())
+	if err := p.selectByIDs(ctx, &playlists, playlistsQuery, playlistsID); err != nil {
+		return err
+	}
+
+	usersByID := make(map[uint32]*models.User, len(users))
+	for i := range users {
+		usersByID[users[i].ID] = &users[i]
+	}
+	albumsByID := make(map[uint32]*models.Album, len(albums))
+	for i := range albums {
+		albumsByID[albums[i].ID] = &albums[i]
+	}
+	tracksByID := make(map[uint32]*models.Track, len(tracks))
+	for i := range tracks {
+		tracksByID[tracks[i].ID] = &tracks[i]
+	}
+	playlistsByID := make(map[uint32]*models.Playlist, len(playlists))
+	for i := range playlists {
+		playlistsByID[playlists[i].ID] = &playlists[i]
+	}
+
+	for i := range activities {
+		a := &activities[i]
+		if a.UserID != nil {
+			a.User = usersByID[*a.UserID]
+		}
+		if a.AlbumID != nil {
+			a.Album = albumsByID[*a.AlbumID]
+		}
+		if a.TrackID != nil {
+			a.Track = tracksByID[*a.TrackID]
+		}
+		if a.PlaylistID != nil {
+			a.Playlist = playlistsByID[*a.PlaylistID]
+		}
+	}
+
+	return nil
+}
+
+// selectByIDs selects entities with given IDs into dest, query isn't executed if there are no IDs
+func (p *PostgreSQL) selectByIDs(ctx context.Context, dest interface{}, query string, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := p.db.SelectContext(ctx, dest, query, pq.Array(ids)); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
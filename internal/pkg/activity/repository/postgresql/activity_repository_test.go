@@ -0,0 +1,468 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	activityMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity/mocks"
+)
+
+var ctx = context.Background()
+
+const activitiesTable = "Activities"
+const activityTimelineTable = "Activity_timeline"
+const likedArtistsTable = "Liked_artists"
+const followersTable = "Followers"
+const usersTable = "Users"
+const albumsTable = "Albums"
+const tracksTable = "Tracks"
+const playlistsTable = "Playlists"
+const smartPlaylistsTable = "Smart_playlists"
+
+var errPqInternal = errors.New("postgres is dead")
+
+func TestActivityRepositoryPostgreSQL_AddForArtistsFans(t *testing.T) {
+	// Init
+	type mockBehavior func(a models.Activity, artistsID []uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := activityMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	var albumID uint32 = 1
+	release := models.Activity{
+		Type:    models.ActivityTypeRelease,
+		AlbumID: &albumID,
+	}
+	artistsID := []uint32{1, 2}
+
+	const activityID uint32 = 5
+	createdAt := time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(a models.Activity, artistsID []uint32) {
+				tablesMock.EXPECT().Activities().Return(activitiesTable)
+				tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+
+				sqlxMock.ExpectBegin()
+
+				row := sqlxMock.NewRows([]string{"id", "created_at"}).AddRow(activityID, createdAt)
+				sqlxMock.ExpectQuery("INSERT INTO "+activitiesTable).
+					WithArgs(a.Type, a.UserID, a.AlbumID, a.TrackID, a.PlaylistID).
+					WillReturnRows(row)
+
+				sqlxMock.ExpectExec("INSERT INTO "+activityTimelineTable+"(.+)FROM "+likedArtistsTable).
+					WithArgs(activityID, createdAt, pq.Array(artistsID)).
+					WillReturnResult(driver.RowsAffected(3))
+
+				sqlxMock.ExpectCommit()
+			},
+		},
+		{
+			name: "Fan Out Issue",
+			mockBehavior: func(a models.Activity, artistsID []uint32) {
+				tablesMock.EXPECT().Activities().Return(activitiesTable)
+				tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+
+				sqlxMock.ExpectBegin()
+
+				row := sqlxMock.NewRows([]string{"id", "created_at"}).AddRow(activityID, createdAt)
+				sqlxMock.ExpectQuery("INSERT INTO "+activitiesTable).
+					WithArgs(a.Type, a.UserID, a.AlbumID, a.TrackID, a.PlaylistID).
+					WillReturnRows(row)
+
+				sqlxMock.ExpectExec("INSERT INTO "+activityTimelineTable).
+					WithArgs(activityID, createdAt, pq.Array(artistsID)).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+		{
+			name: "Insert Issue",
+			mockBehavior: func(a models.Activity, artistsID []uint32) {
+				tablesMock.EXPECT().Activities().Return(activitiesTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectQuery("INSERT INTO "+activitiesTable).
+					WithArgs(a.Type, a.UserID, a.AlbumID, a.TrackID, a.PlaylistID).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(release, artistsID)
+
+			err := repo.AddForArtistsFans(ctx, release, artistsID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestActivityRepositoryPostgreSQL_AddForFollowers(t *testing.T) {
+	// Init
+	type mockBehavior func(a models.Activity)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := activityMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	var userID uint32 = 1
+	var playlistID uint32 = 2
+	updated := models.Activity{
+		Type:       models.ActivityTypePlaylistUpdated,
+		UserID:     &userID,
+		PlaylistID: &playlistID,
+	}
+
+	const activityID uint32 = 5
+	createdAt := time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common (the same activity is replaced)",
+			mockBehavior: func(a models.Activity) {
+				tablesMock.EXPECT().Activities().Return(activitiesTable).Times(2)
+				tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+				tablesMock.EXPECT().Followers().Return(followersTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectExec("DELETE FROM "+activitiesTable+"(.+)type = \\$1(.+)user_id IS NOT DISTINCT FROM \\$2").
+					WithArgs(a.Type, a.UserID, a.AlbumID, a.TrackID, a.PlaylistID).
+					WillReturnResult(driver.RowsAffected(1))
+
+				row := sqlxMock.NewRows([]string{"id", "created_at"}).AddRow(activityID, createdAt)
+				sqlxMock.ExpectQuery("INSERT INTO "+activitiesTable).
+					WithArgs(a.Type, a.UserID, a.AlbumID, a.TrackID, a.PlaylistID).
+					WillReturnRows(row)
+
+				sqlxMock.ExpectExec("INSERT INTO "+activityTimelineTable+"(.+)FROM "+followersTable).
+					WithArgs(activityID, createdAt, a.UserID).
+					WillReturnResult(driver.RowsAffected(2))
+
+				sqlxMock.ExpectCommit()
+			},
+		},
+		{
+			name: "Delete Issue",
+			mockBehavior: func(a models.Activity) {
+				tablesMock.EXPECT().Activities().Return(activitiesTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectExec("DELETE FROM "+activitiesTable).
+					WithArgs(a.Type, a.UserID, a.AlbumID, a.TrackID, a.PlaylistID).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+		{
+			name: "Fan Out Issue",
+			mockBehavior: func(a models.Activity) {
+				tablesMock.EXPECT().Activities().Return(activitiesTable).Times(2)
+				tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+				tablesMock.EXPECT().Followers().Return(followersTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectExec("DELETE FROM "+activitiesTable).
+					WithArgs(a.Type, a.UserID, a.AlbumID, a.TrackID, a.PlaylistID).
+					WillReturnResult(driver.RowsAffected(0))
+
+				row := sqlxMock.NewRows([]string{"id", "created_at"}).AddRow(activityID, createdAt)
+				sqlxMock.ExpectQuery("INSERT INTO "+activitiesTable).
+					WithArgs(a.Type, a.UserID, a.AlbumID, a.TrackID, a.PlaylistID).
+					WillReturnRows(row)
+
+				sqlxMock.ExpectExec("INSERT INTO "+activityTimelineTable).
+					WithArgs(activityID, createdAt, a.UserID).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(updated)
+
+			err := repo.AddForFollowers(ctx, updated)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestActivityRepositoryPostgreSQL_GetTimeline(t *testing.T) {
+	// Init
+	type mockBehavior func(userID uint32, limit, offset uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := activityMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const userID uint32 = 1
+	const limit uint32 = 10
+	const offset uint32 = 0
+
+	var followedUserID uint32 = 2
+	var trackID uint32 = 3
+	var albumID uint32 = 4
+	createdAt := time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	followedUser := models.User{
+		ID:        followedUserID,
+		Username:  "yarik_tri",
+		FirstName: "Yaroslav",
+		LastName:  "Kuzmin",
+		AvatarSrc: "/users/avatars/yarik_tri.png",
+	}
+	track := models.Track{
+		ID:        trackID,
+		Name:      "Где нас нет",
+		CoverSrc:  "/tracks/covers/where_we_are_not.png",
+		RecordSrc: "/tracks/records/where_we_are_not.wav",
+		Listens:   100,
+		Duration:  180,
+	}
+	album := models.Album{
+		ID:       albumID,
+		Name:     "Горгород",
+		CoverSrc: "/albums/covers/gorgorod.png",
+	}
+
+	expectTables := func() {
+		tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable).Times(2)
+		tablesMock.EXPECT().Activities().Return(activitiesTable)
+		tablesMock.EXPECT().Users().Return(usersTable)
+		tablesMock.EXPECT().Albums().Return(albumsTable)
+		tablesMock.EXPECT().Tracks().Return(tracksTable)
+		tablesMock.EXPECT().Playlists().Return(playlistsTable)
+		tablesMock.EXPECT().SmartPlaylists().Return(smartPlaylistsTable)
+	}
+	activityColumns := []string{"id", "type", "user_id", "album_id", "track_id", "playlist_id", "created_at"}
+
+	testTable := []struct {
+		name               string
+		mockBehavior       mockBehavior
+		expectedActivities []models.Activity
+		expectedTotal      uint32
+		expectError        bool
+		expectedError      error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID uint32, limit, offset uint32) {
+				expectTables()
+
+				countRow := sqlxMock.NewRows([]string{"count"}).AddRow(2)
+				sqlxMock.ExpectQuery("SELECT COUNT(.+) FROM " + activityTimelineTable).
+					WithArgs(userID).
+					WillReturnRows(countRow)
+
+				rows := sqlxMock.NewRows(activityColumns).
+					AddRow(2, models.ActivityTypeTrackLiked, followedUserID, nil, trackID, nil, createdAt).
+					AddRow(1, models.ActivityTypeRelease, nil, albumID, nil, nil, createdAt)
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+activityTimelineTable+" t(.+)ORDER BY t.created_at DESC").
+					WithArgs(userID, limit, offset).
+					WillReturnRows(rows)
+
+				userRows := sqlxMock.NewRows([]string{"id", "username", "first_name", "last_name", "avatar_src"}).
+					AddRow(followedUser.ID, followedUser.Username, followedUser.FirstName,
+						followedUser.LastName, followedUser.AvatarSrc)
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + usersTable).
+					WithArgs(pq.Array([]uint32{followedUserID})).
+					WillReturnRows(userRows)
+
+				albumRows := sqlxMock.NewRows([]string{"id", "name", "description", "cover_src"}).
+					AddRow(album.ID, album.Name, album.Description, album.CoverSrc)
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + albumsTable).
+					WithArgs(pq.Array([]uint32{albumID})).
+					WillReturnRows(albumRows)
+
+				trackRows := sqlxMock.NewRows(
+					[]string{"id", "name", "album_id", "cover_src", "record_src", "listens", "duration"}).
+					AddRow(track.ID, track.Name, track.AlbumID, track.CoverSrc, track.RecordSrc,
+						track.Listens, track.Duration)
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + tracksTable).
+					WithArgs(pq.Array([]uint32{trackID})).
+					WillReturnRows(trackRows)
+			},
+			expectedActivities: []models.Activity{
+				{
+					ID:        2,
+					Type:      models.ActivityTypeTrackLiked,
+					UserID:    &followedUserID,
+					TrackID:   &trackID,
+					CreatedAt: createdAt,
+					User:      &followedUser,
+					Track:     &track,
+				},
+				{
+					ID:        1,
+					Type:      models.ActivityTypeRelease,
+					AlbumID:   &albumID,
+					CreatedAt: createdAt,
+					Album:     &album,
+				},
+			},
+			expectedTotal: 2,
+		},
+		{
+			name: "Empty Timeline",
+			mockBehavior: func(userID uint32, limit, offset uint32) {
+				expectTables()
+
+				countRow := sqlxMock.NewRows([]string{"count"}).AddRow(0)
+				sqlxMock.ExpectQuery("SELECT COUNT(.+) FROM " + activityTimelineTable).
+					WithArgs(userID).
+					WillReturnRows(countRow)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+activityTimelineTable).
+					WithArgs(userID, limit, offset).
+					WillReturnRows(sqlxMock.NewRows(activityColumns))
+			},
+			expectedActivities: []models.Activity{},
+			expectedTotal:      0,
+		},
+		{
+			name: "Entities Issue",
+			mockBehavior: func(userID uint32, limit, offset uint32) {
+				tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable).Times(2)
+				tablesMock.EXPECT().Activities().Return(activitiesTable)
+				tablesMock.EXPECT().Users().Return(usersTable)
+
+				countRow := sqlxMock.NewRows([]string{"count"}).AddRow(1)
+				sqlxMock.ExpectQuery("SELECT COUNT(.+) FROM " + activityTimelineTable).
+					WithArgs(userID).
+					WillReturnRows(countRow)
+
+				rows := sqlxMock.NewRows(activityColumns).
+					AddRow(2, models.ActivityTypeTrackLiked, followedUserID, nil, trackID, nil, createdAt)
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+activityTimelineTable).
+					WithArgs(userID, limit, offset).
+					WillReturnRows(rows)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + usersTable).
+					WithArgs(pq.Array([]uint32{followedUserID})).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID uint32, limit, offset uint32) {
+				tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+
+				sqlxMock.ExpectQuery("SELECT COUNT(.+) FROM " + activityTimelineTable).
+					WithArgs(userID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(userID, limit, offset)
+
+			activities, total, err := repo.GetTimeline(ctx, userID, limit, offset)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedActivities, activities)
+				assert.Equal(t, tc.expectedTotal, total)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
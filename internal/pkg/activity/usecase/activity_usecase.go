@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity"
+)
+
+// Usecase implements activity.Usecase
+type Usecase struct {
+	repo activity.Repository
+}
+
+func NewUsecase(r activity.Repository) *Usecase {
+	return &Usecase{
+		repo: r,
+	}
+}
+
+func (u *Usecase) GetTimeline(ctx context.Context,
+	userID uint32, limit, offset uint32) ([]models.Activity, uint32, error) {
+
+	if limit == 0 {
+		limit = models.ActivityDefaultLimit
+	}
+	if limit > models.ActivityMaxLimit {
+		return nil, 0, fmt.Errorf("(usecase) %w", &models.InvalidActivityQueryError{Reason: "limit is too big"})
+	}
+
+	activities, total, err := u.repo.GetTimeline(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("(usecase) can't get timeline from repository: %w", err)
+	}
+
+	return activities, total, nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	activityMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity/mocks"
+)
+
+var ctx = context.Background()
+
+func TestActivityUsecase_GetTimeline(t *testing.T) {
+	type mockBehavior func(r *activityMocks.MockRepository, userID, limit, offset uint32)
+
+	c := gomock.NewController(t)
+
+	r := activityMocks.NewMockRepository(c)
+
+	u := NewUsecase(r)
+
+	const userID uint32 = 1
+	var trackID uint32 = 2
+
+	activities := []models.Activity{
+		{ID: 1, Type: models.ActivityTypeTrackLiked, UserID: new(uint32), TrackID: &trackID},
+	}
+	const total uint32 = 3
+
+	testTable := []struct {
+		name             string
+		limit            uint32
+		offset           uint32
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:   "Common",
+			limit:  10,
+			offset: 2,
+			mockBehavior: func(r *activityMocks.MockRepository, userID, limit, offset uint32) {
+				r.EXPECT().GetTimeline(ctx, userID, limit, offset).Return(activities, total, nil)
+			},
+		},
+		{
+			name: "Default Limit",
+			mockBehavior: func(r *activityMocks.MockRepository, userID, limit, offset uint32) {
+				r.EXPECT().GetTimeline(ctx, userID, models.ActivityDefaultLimit, offset).Return(activities, total, nil)
+			},
+		},
+		{
+			name:             "Too Big Limit",
+			limit:            models.ActivityMaxLimit + 1,
+			mockBehavior:     func(r *activityMocks.MockRepository, userID, limit, offset uint32) {},
+			expectError:      true,
+			expectedErrorMsg: "limit is too big",
+		},
+		{
+			name:  "Repository Issue",
+			limit: 10,
+			mockBehavior: func(r *activityMocks.MockRepository, userID, limit, offset uint32) {
+				r.EXPECT().GetTimeline(ctx, userID, limit, offset).Return(nil, uint32(0), errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get timeline",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(r, userID, tc.limit, tc.offset)
+
+			got, gotTotal, err := u.GetTimeline(ctx, userID, tc.limit, tc.offset)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, activities, got)
+				assert.Equal(t, total, gotTotal)
+			}
+		})
+	}
+}
@@ -14,9 +14,12 @@ type Usecase interface {
 	GetByID(ctx context.Context, albumID uint32) (*models.Album, error)
 	Delete(ctx context.Context, albumID uint32, userID uint32) error
 	GetFeed(ctx context.Context) ([]models.Album, error)
+	// GetPersonalFeed returns feed for logged in user: albums from user's activity timeline go first,
+	// the rest is filled with general feed
+	GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Album, error)
 	GetByArtist(ctx context.Context, artistID uint32) ([]models.Album, error)
 	GetByTrack(ctx context.Context, trackID uint32) (*models.Album, error)
-	GetLikedByUser(ctx context.Context, userID uint32) ([]models.Album, error)
+	GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Album, uint32, error)
 	SetLike(ctx context.Context, albumID, userID uint32) (bool, error)
 	UnLike(ctx context.Context, albumID, userID uint32) (bool, error)
 	IsLiked(ctx context.Context, albumID, userID uint32) (bool, error)
@@ -30,9 +33,12 @@ type Repository interface {
 	GetByID(ctx context.Context, albumID uint32) (*models.Album, error)
 	DeleteByID(ctx context.Context, albumID uint32) error
 	GetFeed(ctx context.Context, limit uint32) ([]models.Album, error)
+	// GetPersonalFeed returns albums from activity timeline of user: releases of liked artists, the latest first
+	GetPersonalFeed(ctx context.Context, userID uint32, limit uint32) ([]models.Album, error)
 	GetByArtist(ctx context.Context, artistID uint32) ([]models.Album, error)
 	GetByTrack(ctx context.Context, trackID uint32) (*models.Album, error)
-	GetLikedByUser(ctx context.Context, userID uint32) ([]models.Album, error)
+	// GetLikedByUser returns page of user's liked albums and their total amount
+	GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Album, uint32, error)
 	InsertLike(ctx context.Context, albumID, userID uint32) (bool, error)
 	DeleteLike(ctx context.Context, albumID, userID uint32) (bool, error)
 	IsLiked(ctx context.Context, albumID, userID uint32) (bool, error)
@@ -45,4 +51,7 @@ type Tables interface {
 	Tracks() string
 	ArtistsAlbums() string
 	LikedAlbums() string
+	Artists() string
+	Activities() string
+	ActivityTimeline() string
 }
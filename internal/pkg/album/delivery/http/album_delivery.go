@@ -3,6 +3,7 @@ package http
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
@@ -231,21 +232,26 @@ func (h *Handler) GetByArtist(w http.ResponseWriter, r *http.Request) {
 
 // @Summary		Album Feed
 // @Tags		Feed
-// @Description	Feed albums
+// @Description	Feed albums: personal ones for logged in user, general ones for anonymous
 // @Produce		json
 // @Success		200		{object}	models.AlbumTransfer	"Albums feed"
 // @Failure		500		{object}	http.Error 				"Server error"
 // @Router		/api/albums/feed [get]
 func (h *Handler) Feed(w http.ResponseWriter, r *http.Request) {
-	albums, err := h.albumServices.GetFeed(r.Context())
-	if err != nil {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			albumsGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
 	}
 
-	user, err := commonHTTP.GetUserFromRequest(r)
-	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+	var albums []models.Album
+	if user != nil {
+		albums, err = h.albumServices.GetPersonalFeed(r.Context(), user.ID)
+	} else {
+		albums, err = h.albumServices.GetFeed(r.Context())
+	}
+	if err != nil {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			albumsGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
@@ -266,7 +272,13 @@ func (h *Handler) Feed(w http.ResponseWriter, r *http.Request) {
 // @Tags         Favorite
 // @Description  Get user's favorite albums
 // @Produce      json
+// @Param		 sort	query		string	false	"Sorting: likedAt, name or artist"
+// @Param		 order	query		string	false	"Order: asc or desc"
+// @Param		 q		query		string	false	"Filter by name of album or artist"
+// @Param		 limit	query		int		false	"Page size"
+// @Param		 offset	query		int		false	"Page offset"
 // @Success      200    {object}  	models.AlbumTransfers 	"Albums got"
+// @Header		 200	{integer}	X-Total-Count			"Total amount of liked albums"
 // @Failure		 400	{object}	http.Error				"Incorrect input"
 // @Failure      401    {object}  	http.Error  			"Unauthorized user"
 // @Failure      403    {object}  	http.Error  			"Forbidden user"
@@ -280,8 +292,22 @@ func (h *Handler) GetFavorite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	favAlbums, err := h.albumServices.GetLikedByUser(r.Context(), user.ID)
+	q, err := commonHTTP.GetFavoritesQueryFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	favAlbums, total, err := h.albumServices.GetLikedByUser(r.Context(), user.ID, q)
 	if err != nil {
+		var errInvalidQuery *models.InvalidFavoritesQueryError
+		if errors.As(err, &errInvalidQuery) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			albumsGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
@@ -295,6 +321,7 @@ func (h *Handler) GetFavorite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set(commonHTTP.TotalCountHeader, strconv.FormatUint(uint64(total), 10))
 	commonHTTP.SuccessResponse(w, r, at, h.logger)
 }
 
@@ -470,6 +470,7 @@ func TestAlbumDeliveryHTTP_Feed(t *testing.T) {
 
 	testTable := []struct {
 		name             string
+		user             *models.User
 		mockBehavior     mockBehavior
 		expectedStatus   int
 		expectedResponse string
@@ -484,6 +485,28 @@ func TestAlbumDeliveryHTTP_Feed(t *testing.T) {
 			expectedStatus:   http.StatusOK,
 			expectedResponse: correctResponse,
 		},
+		{
+			name: "Personal",
+			user: &correctUser,
+			mockBehavior: func(alu *albumMocks.MockUsecase, aru *artistMocks.MockUsecase) {
+				alu.EXPECT().GetPersonalFeed(gomock.Any(), correctUser.ID).Return(expectedReturnAlbums, nil)
+				alu.EXPECT().IsLiked(gomock.Any(), gomock.Any(), correctUser.ID).Return(false, nil).Times(2)
+				aru.EXPECT().GetByAlbum(gomock.Any(), expectedReturnAlbums[0].ID).Return(expectedReturnArtists[0:1], nil)
+				aru.EXPECT().GetByAlbum(gomock.Any(), expectedReturnAlbums[1].ID).Return(expectedReturnArtists[1:3], nil)
+				aru.EXPECT().IsLiked(gomock.Any(), gomock.Any(), correctUser.ID).Return(false, nil).Times(3)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name: "Personal Albums Issues",
+			user: &correctUser,
+			mockBehavior: func(alu *albumMocks.MockUsecase, aru *artistMocks.MockUsecase) {
+				alu.EXPECT().GetPersonalFeed(gomock.Any(), correctUser.ID).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(albumsGetServerError),
+		},
 		{
 			name: "No Albums",
 			mockBehavior: func(alu *albumMocks.MockUsecase, aru *artistMocks.MockUsecase) {
@@ -518,7 +541,7 @@ func TestAlbumDeliveryHTTP_Feed(t *testing.T) {
 
 			commonTests.DeliveryTestGet(t, r, "/api/albums/feed",
 				tc.expectedStatus, tc.expectedResponse,
-				func(req *http.Request) *http.Request { return req })
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
 		})
 	}
 }
@@ -617,7 +640,7 @@ func TestAlbumDeliveryHTTP_GetFavorite(t *testing.T) {
 			name: "Common",
 			user: &correctUser,
 			mockBehavior: func(alu *albumMocks.MockUsecase, au *artistMocks.MockUsecase, userID uint32) {
-				alu.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(expectedReturnAlbums, nil)
+				alu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(expectedReturnAlbums, uint32(len(expectedReturnAlbums)), nil)
 				for ind, album := range expectedReturnAlbums {
 					alu.EXPECT().IsLiked(gomock.Any(), album.ID, correctUserID).Return(true, nil)
 					au.EXPECT().GetByAlbum(gomock.Any(), album.ID).Return(expectedReturnArtists[ind:ind+1], nil)
@@ -633,7 +656,7 @@ func TestAlbumDeliveryHTTP_GetFavorite(t *testing.T) {
 			name: "Albums Issue",
 			user: &correctUser,
 			mockBehavior: func(alu *albumMocks.MockUsecase, au *artistMocks.MockUsecase, userID uint32) {
-				alu.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(nil, errors.New(""))
+				alu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(nil, uint32(0), errors.New(""))
 			},
 			expectedStatus:   http.StatusInternalServerError,
 			expectedResponse: commonTests.ErrorResponse(albumsGetServerError),
@@ -642,7 +665,7 @@ func TestAlbumDeliveryHTTP_GetFavorite(t *testing.T) {
 			name: "Artists Issue",
 			user: &correctUser,
 			mockBehavior: func(alu *albumMocks.MockUsecase, au *artistMocks.MockUsecase, userID uint32) {
-				alu.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(expectedReturnAlbums, nil)
+				alu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(expectedReturnAlbums, uint32(len(expectedReturnAlbums)), nil)
 				au.EXPECT().GetByAlbum(gomock.Any(), expectedReturnAlbums[0].ID).Return(nil, errors.New(""))
 			},
 			expectedStatus:   http.StatusInternalServerError,
@@ -125,18 +125,34 @@ func (mr *MockUsecaseMockRecorder) GetFeed(ctx interface{}) *gomock.Call {
 }
 
 // GetLikedByUser mocks base method.
-func (m *MockUsecase) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Album, error) {
+func (m *MockUsecase) GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Album, uint32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID)
+	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID, q)
+	ret0, _ := ret[0].([]models.Album)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLikedByUser indicates an expected call of GetLikedByUser.
+func (mr *MockUsecaseMockRecorder) GetLikedByUser(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockUsecase)(nil).GetLikedByUser), ctx, userID, q)
+}
+
+// GetPersonalFeed mocks base method.
+func (m *MockUsecase) GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalFeed", ctx, userID)
 	ret0, _ := ret[0].([]models.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLikedByUser indicates an expected call of GetLikedByUser.
-func (mr *MockUsecaseMockRecorder) GetLikedByUser(ctx, userID interface{}) *gomock.Call {
+// GetPersonalFeed indicates an expected call of GetPersonalFeed.
+func (mr *MockUsecaseMockRecorder) GetPersonalFeed(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockUsecase)(nil).GetLikedByUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalFeed", reflect.TypeOf((*MockUsecase)(nil).GetPersonalFeed), ctx, userID)
 }
 
 // IsLiked mocks base method.
@@ -311,18 +327,34 @@ func (mr *MockRepositoryMockRecorder) GetFeed(ctx, limit interface{}) *gomock.Ca
 }
 
 // GetLikedByUser mocks base method.
-func (m *MockRepository) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Album, error) {
+func (m *MockRepository) GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Album, uint32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID)
+	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID, q)
+	ret0, _ := ret[0].([]models.Album)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLikedByUser indicates an expected call of GetLikedByUser.
+func (mr *MockRepositoryMockRecorder) GetLikedByUser(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockRepository)(nil).GetLikedByUser), ctx, userID, q)
+}
+
+// GetPersonalFeed mocks base method.
+func (m *MockRepository) GetPersonalFeed(ctx context.Context, userID, limit uint32) ([]models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalFeed", ctx, userID, limit)
 	ret0, _ := ret[0].([]models.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLikedByUser indicates an expected call of GetLikedByUser.
-func (mr *MockRepositoryMockRecorder) GetLikedByUser(ctx, userID interface{}) *gomock.Call {
+// GetPersonalFeed indicates an expected call of GetPersonalFeed.
+func (mr *MockRepositoryMockRecorder) GetPersonalFeed(ctx, userID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockRepository)(nil).GetLikedByUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalFeed", reflect.TypeOf((*MockRepository)(nil).GetPersonalFeed), ctx, userID, limit)
 }
 
 // Insert mocks base method.
@@ -393,6 +425,34 @@ func (m *MockTables) EXPECT() *MockTablesMockRecorder {
 	return m.recorder
 }
 
+// Activities mocks base method.
+func (m *MockTables) Activities() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activities")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Activities indicates an expected call of Activities.
+func (mr *MockTablesMockRecorder) Activities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activities", reflect.TypeOf((*MockTables)(nil).Activities))
+}
+
+// ActivityTimeline mocks base method.
+func (m *MockTables) ActivityTimeline() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivityTimeline")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ActivityTimeline indicates an expected call of ActivityTimeline.
+func (mr *MockTablesMockRecorder) ActivityTimeline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivityTimeline", reflect.TypeOf((*MockTables)(nil).ActivityTimeline))
+}
+
 // Albums mocks base method.
 func (m *MockTables) Albums() string {
 	m.ctrl.T.Helper()
@@ -407,6 +467,20 @@ func (mr *MockTablesMockRecorder) Albums() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Albums", reflect.TypeOf((*MockTables)(nil).Albums))
 }
 
+// Artists mocks base method.
+func (m *MockTables) Artists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Artists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Artists indicates an expected call of Artists.
+func (mr *MockTablesMockRecorder) Artists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Artists", reflect.TypeOf((*MockTables)(nil).Artists))
+}
+
 // ArtistsAlbums mocks base method.
 func (m *MockTables) ArtistsAlbums() string {
 	m.ctrl.T.Helper()
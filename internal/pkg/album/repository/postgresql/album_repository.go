@@ -58,12 +58,13 @@ func (p *PostgreSQL) Insert(ctx context.Context, album models.Album, artistsID [
 	defer commonSQL.CheckTransaction(tx, &repoErr)
 
 	insertAlbumQuery := fmt.Sprintf(
-		`INSERT INTO %s (name, description, cover_src)
-		VALUES ($1, $2, $3) RETURNING id;`,
+		`INSERT INTO %s (name, description, cover_src, lang)
+		VALUES ($1, $2, $3, $4) RETURNING id;`,
 		p.tables.Albums())
 
 	var albumID uint32
-	row := tx.QueryRowContext(ctx, insertAlbumQuery, album.Name, album.Description, album.CoverSrc)
+	row := tx.QueryRowContext(ctx, insertAlbumQuery,
+		album.Name, album.Description, album.CoverSrc, commonSQL.DetectLang(album.Name))
 	if err := row.Scan(&albumID); err != nil {
 		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
@@ -140,6 +141,29 @@ func (p *PostgreSQL) GetFeed(ctx context.Context, limit uint32) ([]models.Album,
 	return albums, nil
 }
 
+func (p *PostgreSQL) GetPersonalFeed(ctx context.Context, userID uint32, limit uint32) ([]models.Album, error) {
+	query := fmt.Sprintf(
+		`SELECT a.id, a.name, a.description, a.cover_src
+		FROM %s a
+			INNER JOIN (
+				SELECT ac.album_id, MAX(tl.created_at) AS active_at
+				FROM %s tl
+					INNER JOIN %s ac ON ac.id = tl.activity_id
+				WHERE tl.user_id = $1 AND ac.album_id IS NOT NULL
+				GROUP BY ac.album_id
+			) f ON f.album_id = a.id
+		ORDER BY f.active_at DESC, a.id DESC
+		LIMIT $2;`,
+		p.tables.Albums(), p.tables.ActivityTimeline(), p.tables.Activities())
+
+	albums := []models.Album{}
+	if err := p.db.SelectContext(ctx, &albums, query, userID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return albums, nil
+}
+
 func (p *PostgreSQL) GetByArtist(ctx context.Context, artistID uint32) ([]models.Album, error) {
 	query := fmt.Sprintf(
 		`SELECT a.id, a.name, a.description, a.cover_src 
@@ -180,25 +204,69 @@ func (p *PostgreSQL) GetByTrack(ctx context.Context, trackID uint32) (*models.Al
 	return &album, nil
 }
 
-func (p *PostgreSQL) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Album, error) {
+func (p *PostgreSQL) GetLikedByUser(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.Album, uint32, error) {
+
+	filter := fmt.Sprintf(
+		`ua.user_id = $1 AND ($2 = ''
+			OR STRPOS(LOWER(a.name), LOWER($2)) > 0
+			OR EXISTS (
+				SELECT 1
+				FROM %s aa
+					INNER JOIN %s ar ON aa.artist_id = ar.id
+				WHERE aa.album_id = a.id AND STRPOS(LOWER(ar.name), LOWER($2)) > 0
+			))`,
+		p.tables.ArtistsAlbums(), p.tables.Artists())
+
+	countQuery := fmt.Sprintf(
+		`SELECT COUNT(*)
+		FROM %s a
+			INNER JOIN %s ua ON a.id = ua.album_id
+		WHERE %s;`,
+		p.tables.Albums(), p.tables.LikedAlbums(), filter)
+
+	var total uint32
+	if err := p.db.GetContext(ctx, &total, countQuery, userID, q.Filter); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
 	query := fmt.Sprintf(
-		`SELECT a.id, a.name, a.description, a.cover_src
-		FROM %s a 
-			INNER JOIN %s ua ON a.id = ua.album_id 
-		WHERE ua.user_id = $1
-		ORDER BY liked_at DESC;`,
-		p.tables.Albums(), p.tables.LikedAlbums())
+		`SELECT a.id, a.name, a.description, a.cover_src, ua.liked_at
+		FROM %s a
+			INNER JOIN %s ua ON a.id = ua.album_id
+		WHERE %s
+		ORDER BY %s %s, a.id
+		LIMIT $3 OFFSET $4;`,
+		p.tables.Albums(), p.tables.LikedAlbums(), filter, p.favoritesSortColumn(q.SortBy), q.OrderSQL())
 
 	var albums []models.Album
-	if err := p.db.SelectContext(ctx, &albums, query, userID); err != nil {
+	if err := p.db.SelectContext(ctx, &albums, query, userID, q.Filter, q.LimitValue(), q.Offset); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchUserError{UserID: userID}, err)
+			return nil, 0, fmt.Errorf("(repo) %w: %w", &models.NoSuchUserError{UserID: userID}, err)
 		}
 
-		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
 
-	return albums, nil
+	return albums, total, nil
+}
+
+// favoritesSortColumn returns expression to order liked albums by.
+// Album with several artists is sorted by the first of them alphabetically.
+func (p *PostgreSQL) favoritesSortColumn(sortBy string) string {
+	switch sortBy {
+	case models.FavoritesSortName:
+		return "a.name"
+	case models.FavoritesSortArtist:
+		return fmt.Sprintf(
+			`(SELECT MIN(ar.name)
+			FROM %s aa
+				INNER JOIN %s ar ON aa.artist_id = ar.id
+			WHERE aa.album_id = a.id)`,
+			p.tables.ArtistsAlbums(), p.tables.Artists())
+	}
+
+	return "ua.liked_at"
 }
 
 const errorLikeExists = "unique_violation"
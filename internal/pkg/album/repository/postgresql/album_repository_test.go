@@ -23,6 +23,9 @@ const albumTable = "Albums"
 const trackTable = "Track"
 const artistsAlbumsTable = "Artists_Albums"
 const likedAlbumsTable = "Liked_albums"
+const artistTable = "Artists"
+const activitiesTable = "Activities"
+const activityTimelineTable = "Activity_timeline"
 
 var errPqInternal = errors.New("postgres is dead")
 
@@ -154,7 +157,7 @@ func TestAlbumRepositoryPostgreSQL_Insert(t *testing.T) {
 
 				row := sqlxMock.NewRows([]string{"id"}).AddRow(id)
 				sqlxMock.ExpectQuery("INSERT INTO "+albumTable).
-					WithArgs(a.Name, a.Description, a.CoverSrc).
+					WithArgs(a.Name, a.Description, a.CoverSrc, "russian").
 					WillReturnRows(row)
 
 				for _, artistID := range artistsID {
@@ -179,7 +182,7 @@ func TestAlbumRepositoryPostgreSQL_Insert(t *testing.T) {
 
 				row := sqlxMock.NewRows([]string{"id"}).AddRow(id)
 				sqlxMock.ExpectQuery("INSERT INTO "+albumTable).
-					WithArgs(a.Name, a.Description, a.CoverSrc).
+					WithArgs(a.Name, a.Description, a.CoverSrc, "russian").
 					WillReturnRows(row)
 
 				sqlxMock.ExpectExec("INSERT INTO "+artistsAlbumsTable).
@@ -202,7 +205,7 @@ func TestAlbumRepositoryPostgreSQL_Insert(t *testing.T) {
 				sqlxMock.ExpectBegin()
 
 				sqlxMock.ExpectQuery("INSERT INTO "+albumTable).
-					WithArgs(a.Name, a.Description, a.CoverSrc).
+					WithArgs(a.Name, a.Description, a.CoverSrc, "russian").
 					WillReturnError(errPqInternal)
 
 				sqlxMock.ExpectRollback()
@@ -494,6 +497,107 @@ func TestAlbumRepositoryPostgreSQL_GetFeed(t *testing.T) {
 	}
 }
 
+func TestAlbumRepositoryPostgreSQL_GetPersonalFeed(t *testing.T) {
+	// Init
+	type mockBehavior func(userID uint32, albums []models.Album)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := albumMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const userID uint32 = 1
+	var feedAmountLimit uint32 = 100
+
+	description := "Антиутопия"
+	defaultAlbums := []models.Album{
+		{
+			ID:          1,
+			Name:        "Горгород",
+			Description: &description,
+			CoverSrc:    "/albums/covers/gorgorod.png",
+		},
+	}
+
+	testTable := []struct {
+		name           string
+		mockBehavior   mockBehavior
+		expectedAlbums []models.Album
+		expectError    bool
+		expectedError  error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID uint32, a []models.Album) {
+				tablesMock.EXPECT().Albums().Return(albumTable)
+				tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+				tablesMock.EXPECT().Activities().Return(activitiesTable)
+
+				rows := sqlxMock.NewRows([]string{"id", "name", "description", "cover_src"})
+				for ind := range a {
+					rows.AddRow(a[ind].ID, a[ind].Name, a[ind].Description, a[ind].CoverSrc)
+				}
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+albumTable+"(.+)"+activityTimelineTable).
+					WithArgs(userID, feedAmountLimit).
+					WillReturnRows(rows)
+			},
+			expectedAlbums: defaultAlbums,
+		},
+		{
+			name: "No Activity",
+			mockBehavior: func(userID uint32, a []models.Album) {
+				tablesMock.EXPECT().Albums().Return(albumTable)
+				tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+				tablesMock.EXPECT().Activities().Return(activitiesTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+albumTable).
+					WithArgs(userID, feedAmountLimit).
+					WillReturnRows(sqlxMock.NewRows([]string{"id", "name", "description", "cover_src"}))
+			},
+			expectedAlbums: []models.Album{},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID uint32, a []models.Album) {
+				tablesMock.EXPECT().Albums().Return(albumTable)
+				tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+				tablesMock.EXPECT().Activities().Return(activitiesTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+albumTable).
+					WithArgs(userID, feedAmountLimit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(userID, tc.expectedAlbums)
+
+			a, err := repo.GetPersonalFeed(ctx, userID, feedAmountLimit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorAs(t, err, &tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedAlbums, a)
+			}
+		})
+	}
+}
+
 func TestAlbumRepositoryPostgreSQL_GetByArtist(t *testing.T) {
 	// Init
 	type mockBehavior func(artistID uint32, albums []models.Album)
@@ -755,16 +859,22 @@ func TestAlbumRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "Common",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, a []models.Album) {
-				tablesMock.EXPECT().Albums().Return(albumTable)
-				tablesMock.EXPECT().LikedAlbums().Return(likedAlbumsTable)
+				tablesMock.EXPECT().Albums().Return(albumTable).Times(2)
+				tablesMock.EXPECT().LikedAlbums().Return(likedAlbumsTable).Times(2)
+				tablesMock.EXPECT().ArtistsAlbums().Return(artistsAlbumsTable)
+				tablesMock.EXPECT().Artists().Return(artistTable)
 
 				rows := sqlxMock.NewRows([]string{"id", "name", "description", "cover_src"})
 				for ind := range a {
 					rows.AddRow(a[ind].ID, a[ind].Name, a[ind].Description, a[ind].CoverSrc)
 				}
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s a INNER JOIN %s",
+					albumTable, likedAlbumsTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(a)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s a INNER JOIN %s",
 					albumTable, likedAlbumsTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnRows(rows)
 			},
 			expectedAlbums: defaultAlbums,
@@ -773,12 +883,18 @@ func TestAlbumRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "No Such User",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, a []models.Album) {
-				tablesMock.EXPECT().Albums().Return(albumTable)
-				tablesMock.EXPECT().LikedAlbums().Return(likedAlbumsTable)
+				tablesMock.EXPECT().Albums().Return(albumTable).Times(2)
+				tablesMock.EXPECT().LikedAlbums().Return(likedAlbumsTable).Times(2)
+				tablesMock.EXPECT().ArtistsAlbums().Return(artistsAlbumsTable)
+				tablesMock.EXPECT().Artists().Return(artistTable)
 
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s a INNER JOIN %s",
+					albumTable, likedAlbumsTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(a)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s a INNER JOIN %s",
 					albumTable, likedAlbumsTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expectError:   true,
@@ -788,12 +904,18 @@ func TestAlbumRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "Internal PostgreSQL Error",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, a []models.Album) {
-				tablesMock.EXPECT().Albums().Return(albumTable)
-				tablesMock.EXPECT().LikedAlbums().Return(likedAlbumsTable)
+				tablesMock.EXPECT().Albums().Return(albumTable).Times(2)
+				tablesMock.EXPECT().LikedAlbums().Return(likedAlbumsTable).Times(2)
+				tablesMock.EXPECT().ArtistsAlbums().Return(artistsAlbumsTable)
+				tablesMock.EXPECT().Artists().Return(artistTable)
 
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s a INNER JOIN %s",
+					albumTable, likedAlbumsTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(a)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s a INNER JOIN %s",
 					albumTable, likedAlbumsTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnError(errPqInternal)
 			},
 			expectError:   true,
@@ -806,7 +928,7 @@ func TestAlbumRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			// Call mock
 			tc.mockBehavior(tc.userID, tc.expectedAlbums)
 
-			a, err := repo.GetLikedByUser(ctx, tc.userID)
+			a, total, err := repo.GetLikedByUser(ctx, tc.userID, models.FavoritesQuery{})
 
 			// Test
 			if tc.expectError {
@@ -814,6 +936,7 @@ func TestAlbumRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tc.expectedAlbums, a)
+				assert.Equal(t, uint32(len(tc.expectedAlbums)), total)
 			}
 		})
 	}
@@ -5,22 +5,34 @@ import (
 	"fmt"
 
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
 )
 
 const feedAlbumsAmountLimit uint32 = 100
 
 // Usecase implements album.Usecase
 type Usecase struct {
-	albumRepo  album.Repository
-	artistRepo artist.Repository
+	albumRepo    album.Repository
+	artistRepo   artist.Repository
+	activityRepo activity.Repository
+	notifier     notification.Notifier
+
+	logger logger.Logger
 }
 
-func NewUsecase(alr album.Repository, arr artist.Repository) *Usecase {
+func NewUsecase(alr album.Repository, arr artist.Repository,
+	acr activity.Repository, n notification.Notifier, l logger.Logger) *Usecase {
+
 	return &Usecase{
-		albumRepo:  alr,
-		artistRepo: arr,
+		albumRepo:    alr,
+		artistRepo:   arr,
+		activityRepo: acr,
+		notifier:     n,
+		logger:       l,
 	}
 }
 
@@ -45,6 +57,14 @@ func (u *Usecase) Create(ctx context.Context, album models.Album, artistsID []ui
 		return 0, fmt.Errorf("(usecase) can't insert album into repository: %w", err)
 	}
 
+	release := models.Activity{Type: models.ActivityTypeRelease, AlbumID: &albumID}
+	if err := u.activityRepo.AddForArtistsFans(ctx, release, artistsID); err != nil {
+		u.logger.ErrorfReqID(ctx, "can't add release of album #%d to activity feed: %v", albumID, err)
+	}
+
+	releaseNotification := models.Notification{Type: models.NotificationTypeRelease, AlbumID: &albumID}
+	u.notifier.NotifyArtistsFans(ctx, releaseNotification, artistsID)
+
 	return albumID, nil
 }
 
@@ -93,6 +113,36 @@ func (u *Usecase) GetFeed(ctx context.Context) ([]models.Album, error) {
 	return albums, nil
 }
 
+func (u *Usecase) GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Album, error) {
+	albums, err := u.albumRepo.GetPersonalFeed(ctx, userID, feedAlbumsAmountLimit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get personal feed albums from repository: %w", err)
+	}
+	if len(albums) >= int(feedAlbumsAmountLimit) {
+		return albums, nil
+	}
+
+	general, err := u.albumRepo.GetFeed(ctx, feedAlbumsAmountLimit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get feed albums from repository: %w", err)
+	}
+
+	inFeed := make(map[uint32]struct{}, len(albums))
+	for _, a := range albums {
+		inFeed[a.ID] = struct{}{}
+	}
+	for _, a := range general {
+		if len(albums) >= int(feedAlbumsAmountLimit) {
+			break
+		}
+		if _, ok := inFeed[a.ID]; !ok {
+			albums = append(albums, a)
+		}
+	}
+
+	return albums, nil
+}
+
 func (u *Usecase) GetByArtist(ctx context.Context, artistID uint32) ([]models.Album, error) {
 	if err := u.artistRepo.Check(ctx, artistID); err != nil {
 		return nil, fmt.Errorf("(usecase) can't find artist with id #%d: %w", artistID, err)
@@ -115,13 +165,21 @@ func (u *Usecase) GetByTrack(ctx context.Context, trackID uint32) (*models.Album
 	return album, nil
 }
 
-func (u *Usecase) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Album, error) {
-	albums, err := u.albumRepo.GetLikedByUser(ctx, userID)
+func (u *Usecase) GetLikedByUser(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.Album, uint32, error) {
+
+	if err := q.Validate(models.FavoritesSortLikedAt, models.FavoritesSortName,
+		models.FavoritesSortArtist); err != nil {
+
+		return nil, 0, fmt.Errorf("(usecase) %w", err)
+	}
+
+	albums, total, err := u.albumRepo.GetLikedByUser(ctx, userID, q)
 	if err != nil {
-		return nil, fmt.Errorf("(usecase) can't get albums from repository: %w", err)
+		return nil, 0, fmt.Errorf("(usecase) can't get albums from repository: %w", err)
 	}
 
-	return albums, nil
+	return albums, total, nil
 }
 
 func (u *Usecase) SetLike(ctx context.Context, albumID, userID uint32) (bool, error) {
@@ -5,9 +5,12 @@ import (
 	"errors"
 	"testing"
 
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	activityMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity/mocks"
 	albumMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/mocks"
 	artistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/mocks"
+	notificationMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -22,8 +25,10 @@ func TestAlbumUsecase_Create(t *testing.T) {
 
 	alr := albumMocks.NewMockRepository(c)
 	arr := artistMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
 
-	u := NewUsecase(alr, arr)
+	u := NewUsecase(alr, arr, acr, n, commonTests.MockLogger(c))
 
 	var correctUserID uint32 = 1
 	correctArtists := []models.Artist{
@@ -41,6 +46,15 @@ func TestAlbumUsecase_Create(t *testing.T) {
 		CoverSrc: "/albums/covers/1.png",
 	}
 
+	release := models.Activity{
+		Type:    models.ActivityTypeRelease,
+		AlbumID: &correctAlbum.ID,
+	}
+	releaseNotification := models.Notification{
+		Type:    models.NotificationTypeRelease,
+		AlbumID: &correctAlbum.ID,
+	}
+
 	testTable := []struct {
 		name             string
 		album            models.Album
@@ -62,6 +76,8 @@ func TestAlbumUsecase_Create(t *testing.T) {
 					arr.EXPECT().GetByID(ctx, id).Return(&correctArtists[ind], nil)
 				}
 				alr.EXPECT().Insert(ctx, album, artistsID).Return(correctAlbum.ID, nil)
+				acr.EXPECT().AddForArtistsFans(ctx, release, artistsID).Return(nil)
+				n.EXPECT().NotifyArtistsFans(ctx, releaseNotification, artistsID)
 			},
 		},
 		{
@@ -110,6 +126,22 @@ func TestAlbumUsecase_Create(t *testing.T) {
 			expectError:      true,
 			expectedErrorMsg: "can't insert album",
 		},
+		{
+			name:      "Activity Issue Doesn't Fail Creation",
+			album:     correctAlbum,
+			userID:    correctUserID,
+			artistsID: []uint32{1},
+			mockBehavior: func(alr *albumMocks.MockRepository, arr *artistMocks.MockRepository,
+				album models.Album, artistsID []uint32, userID uint32) {
+
+				for ind, id := range artistsID {
+					arr.EXPECT().GetByID(ctx, id).Return(&correctArtists[ind], nil)
+				}
+				alr.EXPECT().Insert(ctx, album, artistsID).Return(correctAlbum.ID, nil)
+				acr.EXPECT().AddForArtistsFans(ctx, release, artistsID).Return(errors.New(""))
+				n.EXPECT().NotifyArtistsFans(ctx, releaseNotification, artistsID)
+			},
+		},
 	}
 
 	for _, tc := range testTable {
@@ -136,8 +168,10 @@ func TestAlbumUsecase_Delete(t *testing.T) {
 
 	alr := albumMocks.NewMockRepository(c)
 	arr := artistMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
 
-	u := NewUsecase(alr, arr)
+	u := NewUsecase(alr, arr, acr, n, commonTests.MockLogger(c))
 
 	var correctUserID uint32 = 1
 	const correctAlbumID uint32 = 1
@@ -239,3 +273,87 @@ func TestAlbumUsecase_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestAlbumUsecase_GetPersonalFeed(t *testing.T) {
+	type mockBehavior func(alr *albumMocks.MockRepository, userID uint32)
+
+	c := gomock.NewController(t)
+
+	alr := albumMocks.NewMockRepository(c)
+	arr := artistMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+
+	u := NewUsecase(alr, arr, acr, notificationMocks.NewMockNotifier(c), commonTests.MockLogger(c))
+
+	const correctUserID uint32 = 1
+
+	personalAlbums := []models.Album{
+		{ID: 1, Name: "Горгород"},
+		{ID: 2, Name: "Стыд или Слава"},
+	}
+	generalAlbums := []models.Album{
+		{ID: 2, Name: "Стыд или Слава"},
+		{ID: 3, Name: "Властелин Калек"},
+	}
+
+	fullPersonalAlbums := make([]models.Album, feedAlbumsAmountLimit)
+	for i := range fullPersonalAlbums {
+		fullPersonalAlbums[i].ID = uint32(i + 1)
+	}
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectedAlbums   []models.Album
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Padded With General Feed",
+			mockBehavior: func(alr *albumMocks.MockRepository, userID uint32) {
+				alr.EXPECT().GetPersonalFeed(ctx, userID, feedAlbumsAmountLimit).Return(personalAlbums, nil)
+				alr.EXPECT().GetFeed(ctx, feedAlbumsAmountLimit).Return(generalAlbums, nil)
+			},
+			expectedAlbums: []models.Album{personalAlbums[0], personalAlbums[1], generalAlbums[1]},
+		},
+		{
+			name: "Full Personal Feed",
+			mockBehavior: func(alr *albumMocks.MockRepository, userID uint32) {
+				alr.EXPECT().GetPersonalFeed(ctx, userID, feedAlbumsAmountLimit).Return(fullPersonalAlbums, nil)
+			},
+			expectedAlbums: fullPersonalAlbums,
+		},
+		{
+			name: "Personal Feed Issue",
+			mockBehavior: func(alr *albumMocks.MockRepository, userID uint32) {
+				alr.EXPECT().GetPersonalFeed(ctx, userID, feedAlbumsAmountLimit).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get personal feed albums",
+		},
+		{
+			name: "General Feed Issue",
+			mockBehavior: func(alr *albumMocks.MockRepository, userID uint32) {
+				alr.EXPECT().GetPersonalFeed(ctx, userID, feedAlbumsAmountLimit).Return(personalAlbums, nil)
+				alr.EXPECT().GetFeed(ctx, feedAlbumsAmountLimit).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get feed albums",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(alr, correctUserID)
+
+			albums, err := u.GetPersonalFeed(ctx, correctUserID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedAlbums, albums)
+			}
+		})
+	}
+}
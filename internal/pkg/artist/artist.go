@@ -14,9 +14,13 @@ type Usecase interface {
 	GetByID(ctx context.Context, artistID uint32) (*models.Artist, error)
 	Delete(ctx context.Context, artistID uint32, userID uint32) error
 	GetFeed(ctx context.Context) ([]models.Artist, error)
+	// GetPersonalFeed returns feed for logged in user: artists from user's activity feed go first,
+	// the rest is filled with general feed
+	GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Artist, error)
 	GetByAlbum(ctx context.Context, albumID uint32) ([]models.Artist, error)
 	GetByTrack(ctx context.Context, trackID uint32) ([]models.Artist, error)
-	GetLikedByUser(ctx context.Context, userID uint32) ([]models.Artist, error)
+	GetRelated(ctx context.Context, artistID uint32, limit uint32) ([]models.Artist, error)
+	GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Artist, uint32, error)
 	SetLike(ctx context.Context, artistID, userID uint32) (bool, error)
 	UnLike(ctx context.Context, artistID, userID uint32) (bool, error)
 	IsLiked(ctx context.Context, artistID, userID uint32) (bool, error)
@@ -38,6 +42,9 @@ type Repository interface {
 
 	// GetFeed returns artist entries with biggest amount of likes per some duration
 	GetFeed(ctx context.Context, limit uint32) ([]models.Artist, error)
+	// GetPersonalFeed returns artists of albums and tracks from user's activity feed, which user doesn't like yet.
+	// The more recent activity is, the higher they are.
+	GetPersonalFeed(ctx context.Context, userID uint32, limit uint32) ([]models.Artist, error)
 
 	// GetByAlbum returns all artist entries related with album entry with given ID
 	GetByAlbum(ctx context.Context, albumID uint32) ([]models.Artist, error)
@@ -45,8 +52,13 @@ type Repository interface {
 	// GetByTrack returns all artist entries related with Track with given ID
 	GetByTrack(ctx context.Context, trackID uint32) ([]models.Artist, error)
 
+	// GetRelated returns the most related artists to artist with given ID,
+	// they are recomputed by offline batch job
+	GetRelated(ctx context.Context, artistID uint32, limit uint32) ([]models.Artist, error)
+
 	// GetByAlbum returns all Artist entries with like entry of user with given ID
-	GetLikedByUser(ctx context.Context, userID uint32) ([]models.Artist, error)
+	// GetLikedByUser returns page of user's liked artists and their total amount
+	GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Artist, uint32, error)
 
 	InsertLike(ctx context.Context, artistID, userID uint32) (bool, error)
 
@@ -62,4 +74,7 @@ type Tables interface {
 	ArtistsAlbums() string
 	ArtistsTracks() string
 	LikedArtists() string
+	Activities() string
+	ActivityTimeline() string
+	RelatedArtists() string
 }
@@ -3,6 +3,7 @@ package http
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
@@ -113,9 +114,69 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Related artists are secondary to artist itself, so page is served without them on failure
+	related, err := h.artistServices.GetRelated(r.Context(), artistID, artistPageRelatedLimit)
+	if err != nil {
+		h.logger.ErrorfReqID(r.Context(), "can't get artists related to artist #%d: %v", artistID, err)
+	} else {
+		ar.Related, err = models.ArtistTransferFromList(r.Context(), related, user, h.artistServices.IsLiked)
+		if err != nil {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				artistGetServerError, http.StatusInternalServerError, h.logger, err)
+			return
+		}
+	}
+
 	commonHTTP.SuccessResponse(w, r, ar, h.logger)
 }
 
+// @Summary		Related Artists
+// @Tags		Artist
+// @Description	Get artists related to chosen one by shared listeners, likes and collaborations
+// @Produce		json
+// @Success		200		{object}	models.ArtistTransfers	"Artists got"
+// @Failure		400		{object}	http.Error				"Client error"
+// @Failure		500		{object}	http.Error				"Server error"
+// @Router		/api/artists/{artistID}/related [get]
+func (h *Handler) GetRelated(w http.ResponseWriter, r *http.Request) {
+	artistID, err := commonHTTP.GetArtistIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			artistsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	artists, err := h.artistServices.GetRelated(r.Context(), artistID, relatedArtistsLimit)
+	if err != nil {
+		var errNoSuchArtist *models.NoSuchArtistError
+		if errors.As(err, &errNoSuchArtist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				artistNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			artistsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	at, err := models.ArtistTransferFromList(r.Context(), artists, user, h.artistServices.IsLiked)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			artistsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	commonHTTP.SuccessResponse(w, r, at, h.logger)
+}
+
 // @Summary		Delete Artist
 // @Tags		Artist
 // @Description	Delete artist with chosen ID
@@ -169,21 +230,26 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 
 // @Summary		Artist Feed
 // @Tags		Feed
-// @Description	Feed artists
+// @Description	Feed artists: personal ones for logged in user, general ones for anonymous
 // @Produce		json
 // @Success		200		{object}	models.ArtistTransfers	"Artists feed"
 // @Failure		500		{object}	http.Error				"Server error"
 // @Router		/api/artists/feed [get]
 func (h *Handler) Feed(w http.ResponseWriter, r *http.Request) {
-	artists, err := h.artistServices.GetFeed(r.Context())
-	if err != nil {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			artistsGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
 	}
 
-	user, err := commonHTTP.GetUserFromRequest(r)
-	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+	var artists []models.Artist
+	if user != nil {
+		artists, err = h.artistServices.GetPersonalFeed(r.Context(), user.ID)
+	} else {
+		artists, err = h.artistServices.GetFeed(r.Context())
+	}
+	if err != nil {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			artistsGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
@@ -203,7 +269,13 @@ func (h *Handler) Feed(w http.ResponseWriter, r *http.Request) {
 // @Tags         Favorite
 // @Description  Get user's favorite artists
 // @Produce      json
+// @Param		 sort	query		string	false	"Sorting: likedAt or name"
+// @Param		 order	query		string	false	"Order: asc or desc"
+// @Param		 q		query		string	false	"Filter by name of artist"
+// @Param		 limit	query		int		false	"Page size"
+// @Param		 offset	query		int		false	"Page offset"
 // @Success      200    {object}  	models.ArtistTransfers 	"Artists got"
+// @Header		 200	{integer}	X-Total-Count			"Total amount of liked artists"
 // @Failure		 400	{object}	http.Error				"Incorrect input"
 // @Failure      401    {object}  	http.Error  			"Unauthorized user"
 // @Failure      403    {object}  	http.Error  			"Forbidden user"
@@ -217,8 +289,22 @@ func (h *Handler) GetFavorite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	artists, err := h.artistServices.GetLikedByUser(r.Context(), user.ID)
+	q, err := commonHTTP.GetFavoritesQueryFromRequest(r)
 	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	artists, total, err := h.artistServices.GetLikedByUser(r.Context(), user.ID, q)
+	if err != nil {
+		var errInvalidQuery *models.InvalidFavoritesQueryError
+		if errors.As(err, &errInvalidQuery) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			artistsGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
@@ -231,6 +317,7 @@ func (h *Handler) GetFavorite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set(commonHTTP.TotalCountHeader, strconv.FormatUint(uint64(total), 10))
 	commonHTTP.SuccessResponse(w, r, at, h.logger)
 }
 
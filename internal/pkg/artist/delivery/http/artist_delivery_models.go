@@ -10,6 +10,12 @@ import (
 
 //go:generate easyjson -no_std_marshalers artist_delivery_models.go
 
+const (
+	// artistPageRelatedLimit is amount of related artists shown on artist's page
+	artistPageRelatedLimit uint32 = 5
+	relatedArtistsLimit    uint32 = 20
+)
+
 // Response messages
 const (
 	albumNotFound  = "no such album"
@@ -142,11 +142,27 @@ func TestArtistDeliveryHTTP_Get(t *testing.T) {
 		AvatarSrc: "/artists/avatars/oxxxymiron.png",
 	}
 
+	expectedRelatedArtists := []models.Artist{
+		{
+			ID:        2,
+			Name:      "SALUKI",
+			AvatarSrc: "/artists/avatars/saluki.png",
+		},
+	}
+
 	correctResponse := `{
 		"id": 1,
 		"name": "Oxxxymiron",
 		"isLiked": false,
-		"cover": "/artists/avatars/oxxxymiron.png"
+		"cover": "/artists/avatars/oxxxymiron.png",
+		"related": [
+			{
+				"id": 2,
+				"name": "SALUKI",
+				"isLiked": true,
+				"cover": "/artists/avatars/saluki.png"
+			}
+		]
 	}`
 
 	testTable := []struct {
@@ -164,10 +180,31 @@ func TestArtistDeliveryHTTP_Get(t *testing.T) {
 			mockBehavior: func(au *artistMocks.MockUsecase) {
 				au.EXPECT().GetByID(gomock.Any(), correctArtistID).Return(&expectedReturnArtist, nil)
 				au.EXPECT().IsLiked(gomock.Any(), correctArtistID, correctUser.ID).Return(false, nil)
+				au.EXPECT().GetRelated(gomock.Any(), correctArtistID, artistPageRelatedLimit).
+					Return(expectedRelatedArtists, nil)
+				au.EXPECT().IsLiked(gomock.Any(), expectedRelatedArtists[0].ID, correctUser.ID).Return(true, nil)
 			},
 			expectedStatus:   http.StatusOK,
 			expectedResponse: correctResponse,
 		},
+		{
+			name:         "Related Artists Issue (page without them)",
+			artistIDPath: correctArtistIDPath,
+			user:         &correctUser,
+			mockBehavior: func(au *artistMocks.MockUsecase) {
+				au.EXPECT().GetByID(gomock.Any(), correctArtistID).Return(&expectedReturnArtist, nil)
+				au.EXPECT().IsLiked(gomock.Any(), correctArtistID, correctUser.ID).Return(false, nil)
+				au.EXPECT().GetRelated(gomock.Any(), correctArtistID, artistPageRelatedLimit).
+					Return(nil, errors.New(""))
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: `{
+				"id": 1,
+				"name": "Oxxxymiron",
+				"isLiked": false,
+				"cover": "/artists/avatars/oxxxymiron.png"
+			}`,
+		},
 		{
 			name:             "Incorrect ID In Path",
 			artistIDPath:     "0",
@@ -209,6 +246,123 @@ func TestArtistDeliveryHTTP_Get(t *testing.T) {
 	}
 }
 
+func TestArtistDeliveryHTTP_GetRelated(t *testing.T) {
+	// Init
+	type mockBehavior func(au *artistMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	au := artistMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(au, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/artists/{artistID}/related", h.GetRelated)
+
+	// Test filling
+	const correctArtistID uint32 = 1
+	correctArtistIDPath := fmt.Sprint(correctArtistID)
+
+	expectedReturnArtists := []models.Artist{
+		{
+			ID:        2,
+			Name:      "SALUKI",
+			AvatarSrc: "/artists/avatars/saluki.png",
+		},
+		{
+			ID:        3,
+			Name:      "Markul",
+			AvatarSrc: "/artists/avatars/markul.png",
+		},
+	}
+
+	correctResponse := `[
+		{
+			"id": 2,
+			"name": "SALUKI",
+			"isLiked": false,
+			"cover": "/artists/avatars/saluki.png"
+		},
+		{
+			"id": 3,
+			"name": "Markul",
+			"isLiked": false,
+			"cover": "/artists/avatars/markul.png"
+		}
+	]`
+
+	testTable := []struct {
+		name             string
+		artistIDPath     string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:         "Common",
+			artistIDPath: correctArtistIDPath,
+			mockBehavior: func(au *artistMocks.MockUsecase) {
+				au.EXPECT().GetRelated(gomock.Any(), correctArtistID, relatedArtistsLimit).
+					Return(expectedReturnArtists, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:         "No Related Artists",
+			artistIDPath: correctArtistIDPath,
+			mockBehavior: func(au *artistMocks.MockUsecase) {
+				au.EXPECT().GetRelated(gomock.Any(), correctArtistID, relatedArtistsLimit).
+					Return([]models.Artist{}, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `[]`,
+		},
+		{
+			name:             "Incorrect ID In Path",
+			artistIDPath:     "0",
+			mockBehavior:     func(au *artistMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHttp.InvalidURLParameter),
+		},
+		{
+			name:         "No Such Artist",
+			artistIDPath: correctArtistIDPath,
+			mockBehavior: func(au *artistMocks.MockUsecase) {
+				au.EXPECT().GetRelated(gomock.Any(), correctArtistID, relatedArtistsLimit).
+					Return(nil, &models.NoSuchArtistError{})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(artistNotFound),
+		},
+		{
+			name:         "Server Error",
+			artistIDPath: correctArtistIDPath,
+			mockBehavior: func(au *artistMocks.MockUsecase) {
+				au.EXPECT().GetRelated(gomock.Any(), correctArtistID, relatedArtistsLimit).
+					Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(artistsGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(au)
+
+			commonTests.DeliveryTestGet(t, r, "/api/artists/"+tc.artistIDPath+"/related",
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
 func TestArtistDeliveryHTTP_Delete(t *testing.T) {
 	// Init
 	type mockBehavior func(au *artistMocks.MockUsecase)
@@ -378,6 +532,7 @@ func TestArtistDeliveryHTTP_Feed(t *testing.T) {
 
 	testTable := []struct {
 		name             string
+		user             *models.User
 		mockBehavior     mockBehavior
 		expectedStatus   int
 		expectedResponse string
@@ -390,6 +545,25 @@ func TestArtistDeliveryHTTP_Feed(t *testing.T) {
 			expectedStatus:   http.StatusOK,
 			expectedResponse: correctResponse,
 		},
+		{
+			name: "Personal",
+			user: &correctUser,
+			mockBehavior: func(au *artistMocks.MockUsecase) {
+				au.EXPECT().GetPersonalFeed(gomock.Any(), correctUser.ID).Return(expectedReturnArtists, nil)
+				au.EXPECT().IsLiked(gomock.Any(), gomock.Any(), correctUser.ID).Return(false, nil).Times(len(expectedReturnArtists))
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name: "Personal Server Error",
+			user: &correctUser,
+			mockBehavior: func(au *artistMocks.MockUsecase) {
+				au.EXPECT().GetPersonalFeed(gomock.Any(), correctUser.ID).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(artistsGetServerError),
+		},
 		{
 			name: "No Artists",
 			mockBehavior: func(au *artistMocks.MockUsecase) {
@@ -415,7 +589,7 @@ func TestArtistDeliveryHTTP_Feed(t *testing.T) {
 
 			commonTests.DeliveryTestGet(t, r, "/api/artists/feed",
 				tc.expectedStatus, tc.expectedResponse,
-				commonTests.NoWrapUserFunc())
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
 		})
 	}
 }
@@ -478,7 +652,7 @@ func TestArtistDeliveryHTTP_GetFavorite(t *testing.T) {
 			name: "Common",
 			user: &correctUser,
 			mockBehavior: func(au *artistMocks.MockUsecase, userID uint32) {
-				au.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(expectedReturnArtists, nil)
+				au.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(expectedReturnArtists, uint32(len(expectedReturnArtists)), nil)
 				for _, a := range expectedReturnArtists {
 					au.EXPECT().IsLiked(gomock.Any(), a.ID, userID).Return(true, nil)
 				}
@@ -490,7 +664,7 @@ func TestArtistDeliveryHTTP_GetFavorite(t *testing.T) {
 			name: "Artists Issue",
 			user: &correctUser,
 			mockBehavior: func(au *artistMocks.MockUsecase, userID uint32) {
-				au.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(nil, errors.New(""))
+				au.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(nil, uint32(0), errors.New(""))
 			},
 			expectedStatus:   http.StatusInternalServerError,
 			expectedResponse: commonTests.ErrorResponse(artistsGetServerError),
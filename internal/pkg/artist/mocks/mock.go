@@ -125,18 +125,49 @@ func (mr *MockUsecaseMockRecorder) GetFeed(ctx interface{}) *gomock.Call {
 }
 
 // GetLikedByUser mocks base method.
-func (m *MockUsecase) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Artist, error) {
+func (m *MockUsecase) GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Artist, uint32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID)
+	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID, q)
+	ret0, _ := ret[0].([]models.Artist)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLikedByUser indicates an expected call of GetLikedByUser.
+func (mr *MockUsecaseMockRecorder) GetLikedByUser(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockUsecase)(nil).GetLikedByUser), ctx, userID, q)
+}
+
+// GetPersonalFeed mocks base method.
+func (m *MockUsecase) GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalFeed", ctx, userID)
 	ret0, _ := ret[0].([]models.Artist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLikedByUser indicates an expected call of GetLikedByUser.
-func (mr *MockUsecaseMockRecorder) GetLikedByUser(ctx, userID interface{}) *gomock.Call {
+// GetPersonalFeed indicates an expected call of GetPersonalFeed.
+func (mr *MockUsecaseMockRecorder) GetPersonalFeed(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalFeed", reflect.TypeOf((*MockUsecase)(nil).GetPersonalFeed), ctx, userID)
+}
+
+// GetRelated mocks base method.
+func (m *MockUsecase) GetRelated(ctx context.Context, artistID, limit uint32) ([]models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelated", ctx, artistID, limit)
+	ret0, _ := ret[0].([]models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelated indicates an expected call of GetRelated.
+func (mr *MockUsecaseMockRecorder) GetRelated(ctx, artistID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockUsecase)(nil).GetLikedByUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelated", reflect.TypeOf((*MockUsecase)(nil).GetRelated), ctx, artistID, limit)
 }
 
 // IsLiked mocks base method.
@@ -311,18 +342,49 @@ func (mr *MockRepositoryMockRecorder) GetFeed(ctx, limit interface{}) *gomock.Ca
 }
 
 // GetLikedByUser mocks base method.
-func (m *MockRepository) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Artist, error) {
+func (m *MockRepository) GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Artist, uint32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID)
+	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID, q)
+	ret0, _ := ret[0].([]models.Artist)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLikedByUser indicates an expected call of GetLikedByUser.
+func (mr *MockRepositoryMockRecorder) GetLikedByUser(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockRepository)(nil).GetLikedByUser), ctx, userID, q)
+}
+
+// GetPersonalFeed mocks base method.
+func (m *MockRepository) GetPersonalFeed(ctx context.Context, userID, limit uint32) ([]models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalFeed", ctx, userID, limit)
 	ret0, _ := ret[0].([]models.Artist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLikedByUser indicates an expected call of GetLikedByUser.
-func (mr *MockRepositoryMockRecorder) GetLikedByUser(ctx, userID interface{}) *gomock.Call {
+// GetPersonalFeed indicates an expected call of GetPersonalFeed.
+func (mr *MockRepositoryMockRecorder) GetPersonalFeed(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalFeed", reflect.TypeOf((*MockRepository)(nil).GetPersonalFeed), ctx, userID, limit)
+}
+
+// GetRelated mocks base method.
+func (m *MockRepository) GetRelated(ctx context.Context, artistID, limit uint32) ([]models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelated", ctx, artistID, limit)
+	ret0, _ := ret[0].([]models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelated indicates an expected call of GetRelated.
+func (mr *MockRepositoryMockRecorder) GetRelated(ctx, artistID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockRepository)(nil).GetLikedByUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelated", reflect.TypeOf((*MockRepository)(nil).GetRelated), ctx, artistID, limit)
 }
 
 // Insert mocks base method.
@@ -393,6 +455,34 @@ func (m *MockTables) EXPECT() *MockTablesMockRecorder {
 	return m.recorder
 }
 
+// Activities mocks base method.
+func (m *MockTables) Activities() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activities")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Activities indicates an expected call of Activities.
+func (mr *MockTablesMockRecorder) Activities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activities", reflect.TypeOf((*MockTables)(nil).Activities))
+}
+
+// ActivityTimeline mocks base method.
+func (m *MockTables) ActivityTimeline() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivityTimeline")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ActivityTimeline indicates an expected call of ActivityTimeline.
+func (mr *MockTablesMockRecorder) ActivityTimeline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivityTimeline", reflect.TypeOf((*MockTables)(nil).ActivityTimeline))
+}
+
 // Artists mocks base method.
 func (m *MockTables) Artists() string {
 	m.ctrl.T.Helper()
@@ -448,3 +538,17 @@ func (mr *MockTablesMockRecorder) LikedArtists() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedArtists", reflect.TypeOf((*MockTables)(nil).LikedArtists))
 }
+
+// RelatedArtists mocks base method.
+func (m *MockTables) RelatedArtists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedArtists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// RelatedArtists indicates an expected call of RelatedArtists.
+func (mr *MockTablesMockRecorder) RelatedArtists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedArtists", reflect.TypeOf((*MockTables)(nil).RelatedArtists))
+}
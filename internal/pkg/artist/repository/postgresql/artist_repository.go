@@ -11,6 +11,8 @@ import (
 
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist"
+
+	commonSQL "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/db"
 )
 
 // PostgreSQL implements artist.Repository
@@ -50,12 +52,13 @@ func (p *PostgreSQL) Check(ctx context.Context, artistID uint32) error {
 
 func (p *PostgreSQL) Insert(ctx context.Context, artist models.Artist) (uint32, error) {
 	query := fmt.Sprintf(
-		`INSERT INTO %s (user_id, name, avatar_src) 
-		VALUES ($1, $2, $3) RETURNING id;`,
+		`INSERT INTO %s (user_id, name, avatar_src, lang) 
+		VALUES ($1, $2, $3, $4) RETURNING id;`,
 		p.tables.Artists())
 
 	var artistID uint32
-	row := p.db.QueryRowContext(ctx, query, artist.UserID, artist.Name, artist.AvatarSrc)
+	row := p.db.QueryRowContext(ctx, query,
+		artist.UserID, artist.Name, artist.AvatarSrc, commonSQL.DetectLang(artist.Name))
 	if err := row.Scan(&artistID); err != nil {
 		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
@@ -122,6 +125,37 @@ func (p *PostgreSQL) GetFeed(ctx context.Context, limit uint32) ([]models.Artist
 	return artists, nil
 }
 
+func (p *PostgreSQL) GetPersonalFeed(ctx context.Context, userID uint32, limit uint32) ([]models.Artist, error) {
+	query := fmt.Sprintf(
+		`SELECT a.id, a.name, a.avatar_src
+		FROM %[1]s a
+			INNER JOIN (
+				SELECT COALESCE(aa.artist_id, at.artist_id) AS artist_id, MAX(tl.created_at) AS active_at
+				FROM %[2]s tl
+					INNER JOIN %[3]s ac ON ac.id = tl.activity_id
+					LEFT JOIN %[4]s aa ON aa.album_id = ac.album_id
+					LEFT JOIN %[5]s at ON at.track_id = ac.track_id
+				WHERE tl.user_id = $1 AND COALESCE(aa.artist_id, at.artist_id) IS NOT NULL
+				GROUP BY COALESCE(aa.artist_id, at.artist_id)
+			) f ON f.artist_id = a.id
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM %[6]s la
+			WHERE la.user_id = $1 AND la.artist_id = a.id
+		)
+		ORDER BY f.active_at DESC, a.id DESC
+		LIMIT $2;`,
+		p.tables.Artists(), p.tables.ActivityTimeline(), p.tables.Activities(),
+		p.tables.ArtistsAlbums(), p.tables.ArtistsTracks(), p.tables.LikedArtists())
+
+	artists := []models.Artist{}
+	if err := p.db.SelectContext(ctx, &artists, query, userID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return artists, nil
+}
+
 func (p *PostgreSQL) GetByAlbum(ctx context.Context, albumID uint32) ([]models.Artist, error) {
 	query := fmt.Sprintf(
 		`SELECT a.id, a.user_id, a.name, a.avatar_src 
@@ -162,25 +196,65 @@ func (p *PostgreSQL) GetByTrack(ctx context.Context, trackID uint32) ([]models.A
 	return artists, nil
 }
 
-func (p *PostgreSQL) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Artist, error) {
+func (p *PostgreSQL) GetRelated(ctx context.Context, artistID uint32, limit uint32) ([]models.Artist, error) {
 	query := fmt.Sprintf(
-		`SELECT a.id, a.name, a.avatar_src
+		`SELECT a.id, a.user_id, a.name, a.avatar_src 
 		FROM %s a 
-			INNER JOIN %s ua ON a.id = ua.artist_id 
-		WHERE ua.user_id = $1
-		ORDER BY liked_at DESC;`,
-		p.tables.Artists(), p.tables.LikedArtists())
+			INNER JOIN %s ra ON a.id = ra.related_id 
+		WHERE ra.artist_id = $1 
+		ORDER BY ra.score DESC, a.id 
+		LIMIT $2;`,
+		p.tables.Artists(), p.tables.RelatedArtists())
+
+	artists := []models.Artist{}
+	if err := p.db.SelectContext(ctx, &artists, query, artistID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return artists, nil
+}
+
+func (p *PostgreSQL) GetLikedByUser(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.Artist, uint32, error) {
+
+	const filter = `ua.user_id = $1 AND ($2 = '' OR STRPOS(LOWER(a.name), LOWER($2)) > 0)`
+
+	countQuery := fmt.Sprintf(
+		`SELECT COUNT(*)
+		FROM %s a
+			INNER JOIN %s ua ON a.id = ua.artist_id
+		WHERE %s;`,
+		p.tables.Artists(), p.tables.LikedArtists(), filter)
+
+	var total uint32
+	if err := p.db.GetContext(ctx, &total, countQuery, userID, q.Filter); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	sortColumn := "ua.liked_at"
+	if q.SortBy == models.FavoritesSortName {
+		sortColumn = "a.name"
+	}
+
+	query := fmt.Sprintf(
+		`SELECT a.id, a.name, a.avatar_src, ua.liked_at
+		FROM %s a
+			INNER JOIN %s ua ON a.id = ua.artist_id
+		WHERE %s
+		ORDER BY %s %s, a.id
+		LIMIT $3 OFFSET $4;`,
+		p.tables.Artists(), p.tables.LikedArtists(), filter, sortColumn, q.OrderSQL())
 
 	var artists []models.Artist
-	if err := p.db.SelectContext(ctx, &artists, query, userID); err != nil {
+	if err := p.db.SelectContext(ctx, &artists, query, userID, q.Filter, q.LimitValue(), q.Offset); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchUserError{UserID: userID}, err)
+			return nil, 0, fmt.Errorf("(repo) %w: %w", &models.NoSuchUserError{UserID: userID}, err)
 		}
 
-		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
 
-	return artists, nil
+	return artists, total, nil
 }
 
 const errorLikeExists = "unique_violation"
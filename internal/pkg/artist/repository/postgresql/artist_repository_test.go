@@ -23,6 +23,9 @@ const artistTable = "Artists"
 const likedArtistsTable = "Liked_artists"
 const artistsAlbumsTable = "Artists_Albums"
 const artistsTracksTable = "Artists_Tracks"
+const relatedArtistsTable = "Related_artists"
+const activityTimelineTable = "Activity_timeline"
+const activitiesTable = "Activities"
 
 var errPqInternal = errors.New("postgres is dead")
 
@@ -151,7 +154,7 @@ func TestArtistRepositoryPostgreSQL_Insert(t *testing.T) {
 
 				row := sqlxMock.NewRows([]string{"id"}).AddRow(id)
 				sqlxMock.ExpectQuery("INSERT INTO "+artistTable).
-					WithArgs(a.UserID, a.Name, a.AvatarSrc).
+					WithArgs(a.UserID, a.Name, a.AvatarSrc, "english").
 					WillReturnRows(row)
 			},
 			expectedID: 1,
@@ -163,7 +166,7 @@ func TestArtistRepositoryPostgreSQL_Insert(t *testing.T) {
 				tablesMock.EXPECT().Artists().Return(artistTable)
 
 				sqlxMock.ExpectQuery("INSERT INTO "+artistTable).
-					WithArgs(a.UserID, a.Name, a.AvatarSrc).
+					WithArgs(a.UserID, a.Name, a.AvatarSrc, "english").
 					WillReturnError(errPqInternal)
 			},
 			expectedID:    1,
@@ -447,6 +450,115 @@ func TestArtistRepositoryPostgreSQL_GetFeed(t *testing.T) {
 	}
 }
 
+func TestArtistRepositoryPostgreSQL_GetPersonalFeed(t *testing.T) {
+	// Init
+	type mockBehavior func(userID uint32, artists []models.Artist)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := artistMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const userID uint32 = 1
+	const feedAmountLimit uint32 = 100
+
+	defaultArtists := []models.Artist{
+		{
+			ID:        1,
+			Name:      "Oxxxymiron",
+			AvatarSrc: "/artists/avatars/oxxxymiron.png",
+		},
+		{
+			ID:        2,
+			Name:      "SALUKI",
+			AvatarSrc: "/artists/avatars/saluki.png",
+		},
+	}
+
+	expectTables := func() {
+		tablesMock.EXPECT().Artists().Return(artistTable)
+		tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+		tablesMock.EXPECT().Activities().Return(activitiesTable)
+		tablesMock.EXPECT().ArtistsAlbums().Return(artistsAlbumsTable)
+		tablesMock.EXPECT().ArtistsTracks().Return(artistsTracksTable)
+		tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+	}
+
+	testTable := []struct {
+		name            string
+		mockBehavior    mockBehavior
+		expectedArtists []models.Artist
+		expectError     bool
+		expectedError   error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID uint32, artists []models.Artist) {
+				expectTables()
+
+				rows := sqlxMock.NewRows([]string{"id", "name", "avatar_src"})
+				for _, a := range artists {
+					rows.AddRow(a.ID, a.Name, a.AvatarSrc)
+				}
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+artistTable+"(.+)"+activityTimelineTable+
+					"(.+)NOT EXISTS(.+)"+likedArtistsTable).
+					WithArgs(userID, feedAmountLimit).
+					WillReturnRows(rows)
+			},
+			expectedArtists: defaultArtists,
+		},
+		{
+			name: "No Activity",
+			mockBehavior: func(userID uint32, artists []models.Artist) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+artistTable).
+					WithArgs(userID, feedAmountLimit).
+					WillReturnRows(sqlxMock.NewRows([]string{"id", "name", "avatar_src"}))
+			},
+			expectedArtists: []models.Artist{},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID uint32, artists []models.Artist) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+artistTable).
+					WithArgs(userID, feedAmountLimit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(userID, tc.expectedArtists)
+
+			a, err := repo.GetPersonalFeed(ctx, userID, feedAmountLimit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedArtists, a)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestArtistRepositoryPostgreSQL_GetByAlbum(t *testing.T) {
 	// Init
 	type mockBehavior func(albumID uint32, artists []models.Artist)
@@ -661,6 +773,114 @@ func TestArtistRepositoryPostgreSQL_GetByTrack(t *testing.T) {
 	}
 }
 
+func TestArtistRepositoryPostgreSQL_GetRelated(t *testing.T) {
+	// Init
+	type mockBehavior func(artistID, limit uint32, artists []models.Artist)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := artistMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultArtistID uint32 = 1
+	const defaultLimit uint32 = 5
+
+	defaultArtists := []models.Artist{
+		{
+			ID:        2,
+			Name:      "SALUKI",
+			AvatarSrc: "/artists/avatars/saluki.png",
+		},
+		{
+			ID:        3,
+			Name:      "Markul",
+			AvatarSrc: "/artists/avatars/markul.png",
+		},
+	}
+
+	testTable := []struct {
+		name            string
+		artistID        uint32
+		mockBehavior    mockBehavior
+		expectedArtists []models.Artist
+		expectError     bool
+		expectedError   error
+	}{
+		{
+			name:     "Common",
+			artistID: defaultArtistID,
+			mockBehavior: func(artistID, limit uint32, a []models.Artist) {
+				tablesMock.EXPECT().Artists().Return(artistTable)
+				tablesMock.EXPECT().RelatedArtists().Return(relatedArtistsTable)
+
+				row := sqlxMock.NewRows([]string{"id", "name", "avatar_src"}).
+					AddRow(a[0].ID, a[0].Name, a[0].AvatarSrc).
+					AddRow(a[1].ID, a[1].Name, a[1].AvatarSrc)
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s a INNER JOIN %s",
+					artistTable, relatedArtistsTable)).
+					WithArgs(artistID, limit).
+					WillReturnRows(row)
+			},
+			expectedArtists: defaultArtists,
+		},
+		{
+			name:     "No Related Artists",
+			artistID: defaultArtistID,
+			mockBehavior: func(artistID, limit uint32, a []models.Artist) {
+				tablesMock.EXPECT().Artists().Return(artistTable)
+				tablesMock.EXPECT().RelatedArtists().Return(relatedArtistsTable)
+
+				row := sqlxMock.NewRows([]string{"id", "name", "avatar_src"})
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s a INNER JOIN %s",
+					artistTable, relatedArtistsTable)).
+					WithArgs(artistID, limit).
+					WillReturnRows(row)
+			},
+			expectedArtists: []models.Artist{},
+		},
+		{
+			name:     "Internal PostgreSQL Error",
+			artistID: defaultArtistID,
+			mockBehavior: func(artistID, limit uint32, a []models.Artist) {
+				tablesMock.EXPECT().Artists().Return(artistTable)
+				tablesMock.EXPECT().RelatedArtists().Return(relatedArtistsTable)
+
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s a INNER JOIN %s",
+					artistTable, relatedArtistsTable)).
+					WithArgs(artistID, limit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tc.artistID, defaultLimit, tc.expectedArtists)
+
+			a, err := repo.GetRelated(ctx, tc.artistID, defaultLimit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedArtists, a)
+			}
+		})
+	}
+}
+
 func TestArtistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 	// Init
 	type mockBehavior func(userID uint32, artists []models.Artist)
@@ -705,15 +925,19 @@ func TestArtistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "Common",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, a []models.Artist) {
-				tablesMock.EXPECT().Artists().Return(artistTable)
-				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+				tablesMock.EXPECT().Artists().Return(artistTable).Times(2)
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable).Times(2)
 
 				rows := sqlxMock.NewRows([]string{"id", "name", "avatar_src"}).
 					AddRow(a[0].ID, a[0].Name, a[0].AvatarSrc).
 					AddRow(a[1].ID, a[1].Name, a[1].AvatarSrc)
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s a INNER JOIN %s",
+					artistTable, likedArtistsTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(a)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s a INNER JOIN %s",
 					artistTable, likedArtistsTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnRows(rows)
 			},
 			expectedArtists: defaultArtists,
@@ -722,12 +946,16 @@ func TestArtistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "No Such User",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, a []models.Artist) {
-				tablesMock.EXPECT().Artists().Return(artistTable)
-				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+				tablesMock.EXPECT().Artists().Return(artistTable).Times(2)
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable).Times(2)
 
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s a INNER JOIN %s",
+					artistTable, likedArtistsTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(a)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s a INNER JOIN %s",
 					artistTable, likedArtistsTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expectError:   true,
@@ -737,12 +965,16 @@ func TestArtistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "Internal PostgreSQL Error",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, a []models.Artist) {
-				tablesMock.EXPECT().Artists().Return(artistTable)
-				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+				tablesMock.EXPECT().Artists().Return(artistTable).Times(2)
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable).Times(2)
 
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s a INNER JOIN %s",
+					artistTable, likedArtistsTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(a)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s a INNER JOIN %s",
 					artistTable, likedArtistsTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnError(errPqInternal)
 			},
 			expectError:   true,
@@ -755,7 +987,7 @@ func TestArtistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			// Call mock
 			tc.mockBehavior(tc.userID, tc.expectedArtists)
 
-			a, err := repo.GetLikedByUser(ctx, tc.userID)
+			a, total, err := repo.GetLikedByUser(ctx, tc.userID, models.FavoritesQuery{})
 
 			// Test
 			if tc.expectError {
@@ -763,6 +995,7 @@ func TestArtistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tc.expectedArtists, a)
+				assert.Equal(t, uint32(len(tc.expectedArtists)), total)
 			}
 		})
 	}
@@ -67,6 +67,36 @@ func (u *Usecase) GetFeed(ctx context.Context) ([]models.Artist, error) {
 	return artists, nil
 }
 
+func (u *Usecase) GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Artist, error) {
+	artists, err := u.repo.GetPersonalFeed(ctx, userID, feedArtistsAmountLimit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get personal feed artists from repository: %w", err)
+	}
+	if len(artists) >= int(feedArtistsAmountLimit) {
+		return artists, nil
+	}
+
+	general, err := u.repo.GetFeed(ctx, feedArtistsAmountLimit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get feed artists from repository: %w", err)
+	}
+
+	inFeed := make(map[uint32]struct{}, len(artists))
+	for _, a := range artists {
+		inFeed[a.ID] = struct{}{}
+	}
+	for _, a := range general {
+		if len(artists) >= int(feedArtistsAmountLimit) {
+			break
+		}
+		if _, ok := inFeed[a.ID]; !ok {
+			artists = append(artists, a)
+		}
+	}
+
+	return artists, nil
+}
+
 func (u *Usecase) GetByAlbum(ctx context.Context, albumID uint32) ([]models.Artist, error) {
 	artists, err := u.repo.GetByAlbum(ctx, albumID)
 	if err != nil {
@@ -85,15 +115,34 @@ func (u *Usecase) GetByTrack(ctx context.Context, trackID uint32) ([]models.Arti
 	return artists, nil
 }
 
-func (u *Usecase) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Artist, error) {
-	artists, err := u.repo.GetLikedByUser(ctx, userID)
+func (u *Usecase) GetRelated(ctx context.Context, artistID uint32, limit uint32) ([]models.Artist, error) {
+	if err := u.repo.Check(ctx, artistID); err != nil {
+		return nil, fmt.Errorf("(usecase) can't find artist with id #%d: %w", artistID, err)
+	}
+
+	artists, err := u.repo.GetRelated(ctx, artistID, limit)
 	if err != nil {
-		return nil, fmt.Errorf("(usecase) can't get artists from repository: %w", err)
+		return nil, fmt.Errorf("(usecase) can't get related artists from repository: %w", err)
 	}
 
 	return artists, nil
 }
 
+func (u *Usecase) GetLikedByUser(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.Artist, uint32, error) {
+
+	if err := q.Validate(models.FavoritesSortLikedAt, models.FavoritesSortName); err != nil {
+		return nil, 0, fmt.Errorf("(usecase) %w", err)
+	}
+
+	artists, total, err := u.repo.GetLikedByUser(ctx, userID, q)
+	if err != nil {
+		return nil, 0, fmt.Errorf("(usecase) can't get artists from repository: %w", err)
+	}
+
+	return artists, total, nil
+}
+
 func (u *Usecase) SetLike(ctx context.Context, artistID, userID uint32) (bool, error) {
 	if err := u.repo.Check(ctx, artistID); err != nil {
 		return false, fmt.Errorf("(usecase) can't find artist with id #%d: %w", artistID, err)
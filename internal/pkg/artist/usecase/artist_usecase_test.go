@@ -161,3 +161,72 @@ func TestArtistUsecase_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestArtistUsecase_GetRelated(t *testing.T) {
+	type mockBehavior func(ar *artistMocks.MockRepository, artistID, limit uint32)
+
+	c := gomock.NewController(t)
+
+	ar := artistMocks.NewMockRepository(c)
+
+	u := NewUsecase(ar)
+
+	const correctArtistID uint32 = 1
+	const limit uint32 = 5
+
+	correctRelated := []models.Artist{
+		{
+			ID:        2,
+			Name:      "SALUKI",
+			AvatarSrc: "/artists/avatars/saluki.png",
+		},
+	}
+
+	testTable := []struct {
+		name         string
+		artistID     uint32
+		mockBehavior mockBehavior
+		expectError  bool
+	}{
+		{
+			name:     "Common",
+			artistID: correctArtistID,
+			mockBehavior: func(ar *artistMocks.MockRepository, artistID, limit uint32) {
+				ar.EXPECT().Check(ctx, artistID).Return(nil)
+				ar.EXPECT().GetRelated(ctx, artistID, limit).Return(correctRelated, nil)
+			},
+		},
+		{
+			name:     "No Such Artist",
+			artistID: correctArtistID,
+			mockBehavior: func(ar *artistMocks.MockRepository, artistID, limit uint32) {
+				ar.EXPECT().Check(ctx, artistID).Return(&models.NoSuchArtistError{ArtistID: artistID})
+			},
+			expectError: true,
+		},
+		{
+			name:     "GetRelated Issue",
+			artistID: correctArtistID,
+			mockBehavior: func(ar *artistMocks.MockRepository, artistID, limit uint32) {
+				ar.EXPECT().Check(ctx, artistID).Return(nil)
+				ar.EXPECT().GetRelated(ctx, artistID, limit).Return(nil, errors.New(""))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(ar, tc.artistID, limit)
+
+			artists, err := u.GetRelated(ctx, tc.artistID, limit)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, correctRelated, artists)
+			}
+		})
+	}
+}
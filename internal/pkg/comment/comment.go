@@ -0,0 +1,97 @@
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+//go:generate mockgen -source=comment.go -destination=mocks/mock.go
+
+// Usecase includes bussiness logics methods to work with comments
+type Usecase interface {
+	// Create adds comment of user to track, album or playlist
+	Create(ctx context.Context, entity models.CommentEntity, text string, userID uint32) (uint32, error)
+
+	// Reply adds reply of user to comment with given ID
+	Reply(ctx context.Context, parentID uint32, text string, userID uint32) (uint32, error)
+
+	// GetByEntity returns page of top-level comments of entity, the latest first,
+	// and cursor of next page, which is empty if there are no more comments
+	GetByEntity(ctx context.Context, entity models.CommentEntity,
+		cursor string, limit uint32) ([]models.Comment, string, error)
+
+	// GetReplies returns page of replies to comment, the earliest first,
+	// and cursor of next page, which is empty if there are no more replies
+	GetReplies(ctx context.Context, commentID uint32, cursor string, limit uint32) ([]models.Comment, string, error)
+
+	// Update changes text of comment, only author can do it
+	Update(ctx context.Context, commentID uint32, text string, userID uint32) error
+
+	// Delete marks comment as deleted, only author or moderator can do it
+	Delete(ctx context.Context, commentID uint32, userID uint32) error
+
+	SetLike(ctx context.Context, commentID, userID uint32) (bool, error)
+	UnLike(ctx context.Context, commentID, userID uint32) (bool, error)
+	IsLiked(ctx context.Context, commentID, userID uint32) (bool, error)
+}
+
+// Repository includes DBMS-relatable methods to work with comments.
+// Deleted comments are shown in lists only if they have replies.
+type Repository interface {
+	// Insert creates new entry of comment in DB with given model
+	Insert(ctx context.Context, comment models.Comment) (uint32, error)
+
+	// GetByID returns models.NoSuchCommentError if comment-entry with given ID doesn't exist in DB,
+	// deleted comments are returned too
+	GetByID(ctx context.Context, commentID uint32) (*models.Comment, error)
+
+	// GetByEntity returns top-level comments of entity with ID less than beforeID,
+	// the latest first. Zero beforeID means from the latest one.
+	GetByEntity(ctx context.Context, entity models.CommentEntity,
+		beforeID uint32, limit uint32) ([]models.Comment, error)
+
+	// GetReplies returns replies to comment with ID greater than afterID, the earliest first
+	GetReplies(ctx context.Context, commentID uint32, afterID uint32, limit uint32) ([]models.Comment, error)
+
+	// UpdateText changes text of comment and marks it as edited
+	UpdateText(ctx context.Context, commentID uint32, text string) error
+
+	// MarkDeleted soft deletes comment
+	MarkDeleted(ctx context.Context, commentID uint32) error
+
+	// CountByUserSince returns amount of comments posted by user since given time
+	CountByUserSince(ctx context.Context, userID uint32, since time.Time) (uint32, error)
+
+	// IsModerator checks if user can delete comments of others
+	IsModerator(ctx context.Context, userID uint32) (bool, error)
+
+	InsertLike(ctx context.Context, commentID, userID uint32) (bool, error)
+
+	DeleteLike(ctx context.Context, commentID, userID uint32) (bool, error)
+
+	IsLiked(ctx context.Context, commentID, userID uint32) (bool, error)
+}
+
+// Config includes tunable parameters of comments
+type Config struct {
+	// RateLimit is max amount of comments and replies user can post per RateWindow
+	RateLimit  uint32
+	RateWindow time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		RateLimit:  5,
+		RateWindow: time.Minute,
+	}
+}
+
+// Tables includes methods which return needed tables
+// to work with comments on repository layer
+type Tables interface {
+	Comments() string
+	LikedComments() string
+	Moderators() string
+}
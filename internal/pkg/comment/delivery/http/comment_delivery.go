@@ -0,0 +1,534 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	easyjson "github.com/mailru/easyjson"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+)
+
+type Handler struct {
+	commentServices comment.Usecase
+	userServices    user.Usecase
+	logger          logger.Logger
+}
+
+func NewHandler(cu comment.Usecase, uu user.Usecase, l logger.Logger) *Handler {
+	return &Handler{
+		commentServices: cu,
+		userServices:    uu,
+		logger:          l,
+	}
+}
+
+// @Summary		Comments
+// @Tags		Comment
+// @Description	Get top-level comments of track, album or playlist, the latest first.
+// @Description	Next page is requested with cursor got from previous one.
+// @Produce		json
+// @Param		cursor	query		string	false	"Cursor of page"
+// @Param		limit	query		int		false	"Page size"
+// @Success		200		{object}	commentsResponse	"Comments got"
+// @Failure		400		{object}	http.Error			"Client error"
+// @Failure		500		{object}	http.Error			"Server error"
+// @Router		/api/tracks/{trackID}/comments [get]
+// @Router		/api/albums/{albumID}/comments [get]
+// @Router		/api/playlists/{playlistID}/comments [get]
+func (h *Handler) GetByEntity(w http.ResponseWriter, r *http.Request) {
+	entity, err := entityFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commentsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	cursor, limit, err := commonHTTP.GetCursorPaginationFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	comments, next, err := h.commentServices.GetByEntity(r.Context(), entity, cursor, limit)
+	if err != nil {
+		var errInvalidQuery *models.InvalidCommentQueryError
+		if errors.As(err, &errInvalidQuery) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		if isNoSuchEntityError(err) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				entityNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commentsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	h.commentsResponse(w, r, comments, next, user)
+}
+
+// @Summary		Create Comment
+// @Tags		Comment
+// @Description	Comment track, album or playlist
+// @Accept		json
+// @Produce		json
+// @Param		comment	body		commentInput			true	"Comment text"
+// @Success		200		{object}	commentCreateResponse	"Comment created"
+// @Failure		400		{object}	http.Error				"Client error"
+// @Failure		401		{object}	http.Error				"User unathorized"
+// @Failure		429		{object}	http.Error				"Too many comments"
+// @Failure		500		{object}	http.Error				"Server error"
+// @Router		/api/tracks/{trackID}/comments [post]
+// @Router		/api/albums/{albumID}/comments [post]
+// @Router		/api/playlists/{playlistID}/comments [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	entity, err := entityFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	var ci commentInput
+	if err := easyjson.UnmarshalFromReader(r.Body, &ci); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := ci.validateAndEscape(); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	commentID, err := h.commentServices.Create(r.Context(), entity, ci.Text, user.ID)
+	if err != nil {
+		if isNoSuchEntityError(err) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				entityNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errRateLimit *models.CommentRateLimitError
+		if errors.As(err, &errRateLimit) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commentRateLimited, http.StatusTooManyRequests, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commentCreateServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	ccr := commentCreateResponse{ID: commentID}
+
+	commonHTTP.SuccessResponse(w, r, ccr, h.logger)
+}
+
+// @Summary		Replies
+// @Tags		Comment
+// @Description	Get replies to comment, the earliest first.
+// @Description	Next page is requested with cursor got from previous one.
+// @Produce		json
+// @Param		cursor	query		string	false	"Cursor of page"
+// @Param		limit	query		int		false	"Page size"
+// @Success		200		{object}	commentsResponse	"Replies got"
+// @Failure		400		{object}	http.Error			"Client error"
+// @Failure		500		{object}	http.Error			"Server error"
+// @Router		/api/comments/{commentID}/replies [get]
+func (h *Handler) GetReplies(w http.ResponseWriter, r *http.Request) {
+	commentID, err := commonHTTP.GetCommentIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commentsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	cursor, limit, err := commonHTTP.GetCursorPaginationFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	replies, next, err := h.commentServices.GetReplies(r.Context(), commentID, cursor, limit)
+	if err != nil {
+		var errInvalidQuery *models.InvalidCommentQueryError
+		if errors.As(err, &errInvalidQuery) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errNoSuchComment *models.NoSuchCommentError
+		if errors.As(err, &errNoSuchComment) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commentNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commentsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	h.commentsResponse(w, r, replies, next, user)
+}
+
+// @Summary		Reply
+// @Tags		Comment
+// @Description	Reply to comment
+// @Accept		json
+// @Produce		json
+// @Param		comment	body		commentInput			true	"Reply text"
+// @Success		200		{object}	commentCreateResponse	"Reply created"
+// @Failure		400		{object}	http.Error				"Client error"
+// @Failure		401		{object}	http.Error				"User unathorized"
+// @Failure		429		{object}	http.Error				"Too many comments"
+// @Failure		500		{object}	http.Error				"Server error"
+// @Router		/api/comments/{commentID}/replies [post]
+func (h *Handler) Reply(w http.ResponseWriter, r *http.Request) {
+	commentID, err := commonHTTP.GetCommentIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	var ci commentInput
+	if err := easyjson.UnmarshalFromReader(r.Body, &ci); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := ci.validateAndEscape(); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	replyID, err := h.commentServices.Reply(r.Context(), commentID, ci.Text, user.ID)
+	if err != nil {
+		var errNoSuchComment *models.NoSuchCommentError
+		if errors.As(err, &errNoSuchComment) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commentNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errRateLimit *models.CommentRateLimitError
+		if errors.As(err, &errRateLimit) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commentRateLimited, http.StatusTooManyRequests, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commentCreateServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	ccr := commentCreateResponse{ID: replyID}
+
+	commonHTTP.SuccessResponse(w, r, ccr, h.logger)
+}
+
+// @Summary		Update Comment
+// @Tags		Comment
+// @Description	Change text of comment, only author can do it
+// @Accept		json
+// @Produce		json
+// @Param		comment	body		commentInput	true	"New text"
+// @Success		200		{object}	defaultResponse	"Comment updated"
+// @Failure		400		{object}	http.Error		"Client error"
+// @Failure		401		{object}	http.Error		"User unathorized"
+// @Failure		403		{object}	http.Error		"User hasn't rights"
+// @Failure		500		{object}	http.Error		"Server error"
+// @Router		/api/comments/{commentID}/update [post]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	commentID, err := commonHTTP.GetCommentIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	var ci commentInput
+	if err := easyjson.UnmarshalFromReader(r.Body, &ci); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := ci.validateAndEscape(); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := h.commentServices.Update(r.Context(), commentID, ci.Text, user.ID); err != nil {
+		var errForbiddenUser *models.ForbiddenUserError
+		if errors.As(err, &errForbiddenUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commentUpdateNoRights, http.StatusForbidden, h.logger, err)
+			return
+		}
+
+		var errNoSuchComment *models.NoSuchCommentError
+		if errors.As(err, &errNoSuchComment) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commentNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commentUpdateServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	dr := defaultResponse{Status: commentUpdatedSuccessfully}
+
+	commonHTTP.SuccessResponse(w, r, dr, h.logger)
+}
+
+// @Summary		Delete Comment
+// @Tags		Comment
+// @Description	Delete comment, only author or moderator can do it. Replies to it are kept.
+// @Produce		json
+// @Success		200		{object}	defaultResponse	"Comment deleted"
+// @Failure		400		{object}	http.Error		"Client error"
+// @Failure		401		{object}	http.Error		"User unathorized"
+// @Failure		403		{object}	http.Error		"User hasn't rights"
+// @Failure		500		{object}	http.Error		"Server error"
+// @Router		/api/comments/{commentID} [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	commentID, err := commonHTTP.GetCommentIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	if err := h.commentServices.Delete(r.Context(), commentID, user.ID); err != nil {
+		var errForbiddenUser *models.ForbiddenUserError
+		if errors.As(err, &errForbiddenUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commentDeleteNoRights, http.StatusForbidden, h.logger, err)
+			return
+		}
+
+		var errNoSuchComment *models.NoSuchCommentError
+		if errors.As(err, &errNoSuchComment) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commentNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commentDeleteServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	dr := defaultResponse{Status: commentDeletedSuccessfully}
+
+	commonHTTP.SuccessResponse(w, r, dr, h.logger)
+}
+
+// @Summary		Set like
+// @Tags		Comment
+// @Description	Set like by user to chosen comment
+// @Produce		json
+// @Success		200		{object}	commentLikeResponse	"Like set"
+// @Failure		400		{object}	http.Error			"Client error"
+// @Failure		401		{object}	http.Error  		"User unathorized"
+// @Failure		500		{object}	http.Error			"Server error"
+// @Router		/api/comments/{commentID}/like [post]
+func (h *Handler) Like(w http.ResponseWriter, r *http.Request) {
+	commentID, err := commonHTTP.GetCommentIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	notExisted, err := h.commentServices.SetLike(r.Context(), commentID, user.ID)
+	if err != nil {
+		var errNoSuchComment *models.NoSuchCommentError
+		if errors.As(err, &errNoSuchComment) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commentNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.SetLikeServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	clr := commentLikeResponse{Status: commonHTTP.LikeSuccess}
+	if !notExisted {
+		clr.Status = commonHTTP.LikeAlreadyExists
+	}
+	commonHTTP.SuccessResponse(w, r, clr, h.logger)
+}
+
+// @Summary		Remove like
+// @Tags		Comment
+// @Description	Remove like by user from chosen comment
+// @Produce		json
+// @Success		200		{object}	commentLikeResponse	"Like removed"
+// @Failure		400		{object}	http.Error			"Client error"
+// @Failure		401		{object}	http.Error  		"User unathorized"
+// @Failure		500		{object}	http.Error			"Server error"
+// @Router		/api/comments/{commentID}/unlike [post]
+func (h *Handler) UnLike(w http.ResponseWriter, r *http.Request) {
+	commentID, err := commonHTTP.GetCommentIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	existed, err := h.commentServices.UnLike(r.Context(), commentID, user.ID)
+	if err != nil {
+		var errNoSuchComment *models.NoSuchCommentError
+		if errors.As(err, &errNoSuchComment) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commentNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.DeleteLikeServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	clr := commentLikeResponse{Status: commonHTTP.UnLikeSuccess}
+	if !existed {
+		clr.Status = commonHTTP.LikeDoesntExist
+	}
+	commonHTTP.SuccessResponse(w, r, clr, h.logger)
+}
+
+func (h *Handler) commentsResponse(w http.ResponseWriter, r *http.Request,
+	comments []models.Comment, next string, user *models.User) {
+
+	ct, err := models.CommentTransferFromList(r.Context(), comments, user,
+		h.commentServices.IsLiked, h.userServices.GetByID)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commentsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	cr := commentsResponse{
+		Comments:   ct,
+		NextCursor: next,
+	}
+
+	commonHTTP.SuccessResponse(w, r, cr, h.logger)
+}
+
+// entityFromRequest returns commented entity by URL param of route handler is mounted on
+func entityFromRequest(r *http.Request) (models.CommentEntity, error) {
+	var entity models.CommentEntity
+	var err error
+
+	switch {
+	case chi.URLParam(r, commonHTTP.TrackIdUrlParam) != "":
+		entity.Type = models.CommentEntityTrack
+		entity.ID, err = commonHTTP.GetTrackIDFromRequest(r)
+	case chi.URLParam(r, commonHTTP.AlbumIdUrlParam) != "":
+		entity.Type = models.CommentEntityAlbum
+		entity.ID, err = commonHTTP.GetAlbumIDFromRequest(r)
+	case chi.URLParam(r, commonHTTP.PlaylistIdUrlParam) != "":
+		entity.Type = models.CommentEntityPlaylist
+		entity.ID, err = commonHTTP.GetPlaylistIDFromRequest(r)
+	default:
+		err = errors.New("no commented entity in url")
+	}
+
+	return entity, err
+}
+
+func isNoSuchEntityError(err error) bool {
+	var errNoSuchTrack *models.NoSuchTrackError
+	var errNoSuchAlbum *models.NoSuchAlbumError
+	var errNoSuchPlaylist *models.NoSuchPlaylistError
+
+	return errors.As(err, &errNoSuchTrack) ||
+		errors.As(err, &errNoSuchAlbum) ||
+		errors.As(err, &errNoSuchPlaylist)
+}
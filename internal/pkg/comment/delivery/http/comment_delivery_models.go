@@ -0,0 +1,71 @@
+package http
+
+import (
+	"html"
+
+	valid "github.com/asaskevich/govalidator"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+//go:generate easyjson -no_std_marshalers comment_delivery_models.go
+
+// Response messages
+const (
+	commentNotFound = "no such comment"
+	entityNotFound  = "no such entity to comment"
+
+	commentRateLimited    = "too many comments, try later"
+	commentUpdateNoRights = "no rights to update comment"
+	commentDeleteNoRights = "no rights to delete comment"
+
+	commentCreateServerError = "can't create comment"
+	commentsGetServerError   = "can't get comments"
+	commentUpdateServerError = "can't update comment"
+	commentDeleteServerError = "can't delete comment"
+
+	commentUpdatedSuccessfully = "ok"
+	commentDeletedSuccessfully = "ok"
+)
+
+//easyjson:json
+type commentInput struct {
+	Text string `json:"text" valid:"required,runelength(1|2000)"`
+}
+
+// validateAndEscape checks length of text as typed by user, so it's escaped after that
+func (c *commentInput) validateAndEscape() error {
+	if _, err := valid.ValidateStruct(c); err != nil {
+		return err
+	}
+
+	c.escapeHtml()
+
+	return nil
+}
+
+func (c *commentInput) escapeHtml() {
+	c.Text = html.EscapeString(c.Text)
+}
+
+//easyjson:json
+type commentCreateResponse struct {
+	ID uint32 `json:"id"`
+}
+
+//easyjson:json
+type commentsResponse struct {
+	Comments models.CommentTransfers `json:"comments"`
+	// NextCursor is empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+//easyjson:json
+type commentLikeResponse struct {
+	Status string `json:"status"`
+}
+
+//easyjson:json
+type defaultResponse struct {
+	Status string `json:"status"`
+}
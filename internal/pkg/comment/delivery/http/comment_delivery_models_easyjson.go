@@ -0,0 +1,286 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package http
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson4dc1c839DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp(in *jlexer.Lexer, out *defaultResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4dc1c839EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp(out *jwriter.Writer, in defaultResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v defaultResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4dc1c839EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *defaultResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4dc1c839DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp(l, v)
+}
+func easyjson4dc1c839DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp1(in *jlexer.Lexer, out *commentsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comments":
+			(out.Comments).UnmarshalEasyJSON(in)
+		case "nextCursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4dc1c839EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp1(out *jwriter.Writer, in commentsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix[1:])
+		(in.Comments).MarshalEasyJSON(out)
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v commentsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4dc1c839EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *commentsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4dc1c839DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp1(l, v)
+}
+func easyjson4dc1c839DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp2(in *jlexer.Lexer, out *commentLikeResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4dc1c839EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp2(out *jwriter.Writer, in commentLikeResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v commentLikeResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4dc1c839EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp2(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *commentLikeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4dc1c839DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp2(l, v)
+}
+func easyjson4dc1c839DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp3(in *jlexer.Lexer, out *commentInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4dc1c839EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp3(out *jwriter.Writer, in commentInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v commentInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4dc1c839EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp3(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *commentInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4dc1c839DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp3(l, v)
+}
+func easyjson4dc1c839DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp4(in *jlexer.Lexer, out *commentCreateResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4dc1c839EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp4(out *jwriter.Writer, in commentCreateResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v commentCreateResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4dc1c839EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp4(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *commentCreateResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4dc1c839DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgCommentDeliveryHttp4(l, v)
+}
@@ -0,0 +1,371 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	commentMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment/mocks"
+	userMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/mocks"
+)
+
+var correctUser = models.User{
+	ID:        1,
+	Username:  "yarik_tri",
+	FirstName: "Yaroslav",
+	LastName:  "Kuzmin",
+}
+
+func TestCommentDeliveryHTTP_GetByEntity(t *testing.T) {
+	// Init
+	type mockBehavior func(cu *commentMocks.MockUsecase, uu *userMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	cu := commentMocks.NewMockUsecase(c)
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(cu, uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/tracks/{trackID}/comments", h.GetByEntity)
+	r.Get("/api/playlists/{playlistID}/comments", h.GetByEntity)
+
+	// Test filling
+	trackEntity := models.CommentEntity{Type: models.CommentEntityTrack, ID: 2}
+	createdAt := time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	comments := []models.Comment{
+		{
+			ID:        11,
+			UserID:    &correctUser.ID,
+			Text:      "Fire",
+			CreatedAt: createdAt,
+			Likes:     3,
+		},
+		{
+			ID:        10,
+			Text:      "Deleted one",
+			CreatedAt: createdAt,
+			DeletedAt: &createdAt,
+			Replies:   1,
+		},
+	}
+	next := models.EncodeCommentsCursor(10)
+
+	correctResponse := `{
+		"comments": [
+			{
+				"id": 11,
+				"author": {
+					"id": 1,
+					"username": "yarik_tri",
+					"firstName": "Yaroslav",
+					"lastName": "Kuzmin"
+				},
+				"text": "Fire",
+				"createdAt": "2023-05-01T12:00:00Z",
+				"isDeleted": false,
+				"likes": 3,
+				"isLiked": true,
+				"replies": 0
+			},
+			{
+				"id": 10,
+				"text": "",
+				"createdAt": "2023-05-01T12:00:00Z",
+				"isDeleted": true,
+				"likes": 0,
+				"isLiked": false,
+				"replies": 1
+			}
+		],
+		"nextCursor": "` + next + `"
+	}`
+
+	testTable := []struct {
+		name             string
+		target           string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:   "Common",
+			target: "/api/tracks/2/comments?limit=2",
+			user:   &correctUser,
+			mockBehavior: func(cu *commentMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				cu.EXPECT().GetByEntity(gomock.Any(), trackEntity, "", uint32(2)).Return(comments, next, nil)
+				uu.EXPECT().GetByID(gomock.Any(), correctUser.ID).Return(&correctUser, nil)
+				cu.EXPECT().IsLiked(gomock.Any(), comments[0].ID, correctUser.ID).Return(true, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:   "Last Page Of Playlist",
+			target: "/api/playlists/4/comments?cursor=" + next,
+			mockBehavior: func(cu *commentMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				cu.EXPECT().GetByEntity(gomock.Any(),
+					models.CommentEntity{Type: models.CommentEntityPlaylist, ID: 4}, next, uint32(0)).
+					Return([]models.Comment{}, "", nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"comments": []}`,
+		},
+		{
+			name:             "Incorrect ID In Path",
+			target:           "/api/tracks/0/comments",
+			mockBehavior:     func(cu *commentMocks.MockUsecase, uu *userMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidURLParameter),
+		},
+		{
+			name:   "Invalid Cursor",
+			target: "/api/tracks/2/comments?cursor=abc",
+			mockBehavior: func(cu *commentMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				cu.EXPECT().GetByEntity(gomock.Any(), trackEntity, "abc", uint32(0)).
+					Return(nil, "", &models.InvalidCommentQueryError{})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
+		},
+		{
+			name:   "No Such Track",
+			target: "/api/tracks/2/comments",
+			mockBehavior: func(cu *commentMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				cu.EXPECT().GetByEntity(gomock.Any(), trackEntity, "", uint32(0)).
+					Return(nil, "", &models.NoSuchTrackError{})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(entityNotFound),
+		},
+		{
+			name:   "Server Error",
+			target: "/api/tracks/2/comments",
+			mockBehavior: func(cu *commentMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				cu.EXPECT().GetByEntity(gomock.Any(), trackEntity, "", uint32(0)).
+					Return(nil, "", errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(commentsGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(cu, uu)
+
+			commonTests.DeliveryTestGet(t, r, tc.target, tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestCommentDeliveryHTTP_Create(t *testing.T) {
+	// Init
+	type mockBehavior func(cu *commentMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	cu := commentMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(cu, userMocks.NewMockUsecase(c), l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Post("/api/albums/{albumID}/comments", h.Create)
+
+	// Test filling
+	albumEntity := models.CommentEntity{Type: models.CommentEntityAlbum, ID: 3}
+	const commentID uint32 = 10
+
+	correctRequestBody := `{"text": "<b>Best</b> album"}`
+	escapedText := "&lt;b&gt;Best&lt;/b&gt; album"
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		requestBody      string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:        "Common",
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(cu *commentMocks.MockUsecase) {
+				cu.EXPECT().Create(gomock.Any(), albumEntity, escapedText, correctUser.ID).Return(commentID, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"id": 10}`,
+		},
+		{
+			name:             "Unauthorized User",
+			requestBody:      correctRequestBody,
+			mockBehavior:     func(cu *commentMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:             "Empty Text",
+			user:             &correctUser,
+			requestBody:      `{"text": ""}`,
+			mockBehavior:     func(cu *commentMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:             "Too Long Text",
+			user:             &correctUser,
+			requestBody:      `{"text": "` + strings.Repeat("a", 2001) + `"}`,
+			mockBehavior:     func(cu *commentMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:        "Longest Text Escaped",
+			user:        &correctUser,
+			requestBody: `{"text": "` + strings.Repeat("<", 2000) + `"}`,
+			mockBehavior: func(cu *commentMocks.MockUsecase) {
+				cu.EXPECT().Create(gomock.Any(), albumEntity, strings.Repeat("&lt;", 2000), correctUser.ID).
+					Return(commentID, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"id": 10}`,
+		},
+		{
+			name:        "Rate Limit Exceeded",
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(cu *commentMocks.MockUsecase) {
+				cu.EXPECT().Create(gomock.Any(), albumEntity, escapedText, correctUser.ID).
+					Return(uint32(0), &models.CommentRateLimitError{})
+			},
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedResponse: commonTests.ErrorResponse(commentRateLimited),
+		},
+		{
+			name:        "No Such Album",
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(cu *commentMocks.MockUsecase) {
+				cu.EXPECT().Create(gomock.Any(), albumEntity, escapedText, correctUser.ID).
+					Return(uint32(0), &models.NoSuchAlbumError{})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(entityNotFound),
+		},
+		{
+			name:        "Server Error",
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(cu *commentMocks.MockUsecase) {
+				cu.EXPECT().Create(gomock.Any(), albumEntity, escapedText, correctUser.ID).
+					Return(uint32(0), errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(commentCreateServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(cu)
+
+			commonTests.DeliveryTestPost(t, r, "/api/albums/3/comments", tc.requestBody,
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestCommentDeliveryHTTP_Delete(t *testing.T) {
+	// Init
+	type mockBehavior func(cu *commentMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	cu := commentMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(cu, userMocks.NewMockUsecase(c), l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Delete("/api/comments/{commentID}/", h.Delete)
+
+	// Test filling
+	const commentID uint32 = 10
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Common",
+			user: &correctUser,
+			mockBehavior: func(cu *commentMocks.MockUsecase) {
+				cu.EXPECT().Delete(gomock.Any(), commentID, correctUser.ID).Return(nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(commentDeletedSuccessfully),
+		},
+		{
+			name: "No Rights",
+			user: &correctUser,
+			mockBehavior: func(cu *commentMocks.MockUsecase) {
+				cu.EXPECT().Delete(gomock.Any(), commentID, correctUser.ID).Return(&models.ForbiddenUserError{})
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: commonTests.ErrorResponse(commentDeleteNoRights),
+		},
+		{
+			name: "No Such Comment",
+			user: &correctUser,
+			mockBehavior: func(cu *commentMocks.MockUsecase) {
+				cu.EXPECT().Delete(gomock.Any(), commentID, correctUser.ID).Return(&models.NoSuchCommentError{})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commentNotFound),
+		},
+		{
+			name:             "Unauthorized User",
+			mockBehavior:     func(cu *commentMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(cu)
+
+			commonTests.DeliveryTestDelete(t, r, "/api/comments/10/",
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
@@ -0,0 +1,423 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: comment.go
+
+// Package mock_comment is a generated GoMock package.
+package mock_comment
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUsecase) Create(ctx context.Context, entity models.CommentEntity, text string, userID uint32) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity, text, userID)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUsecaseMockRecorder) Create(ctx, entity, text, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUsecase)(nil).Create), ctx, entity, text, userID)
+}
+
+// Delete mocks base method.
+func (m *MockUsecase) Delete(ctx context.Context, commentID, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, commentID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUsecaseMockRecorder) Delete(ctx, commentID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUsecase)(nil).Delete), ctx, commentID, userID)
+}
+
+// GetByEntity mocks base method.
+func (m *MockUsecase) GetByEntity(ctx context.Context, entity models.CommentEntity, cursor string, limit uint32) ([]models.Comment, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEntity", ctx, entity, cursor, limit)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByEntity indicates an expected call of GetByEntity.
+func (mr *MockUsecaseMockRecorder) GetByEntity(ctx, entity, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEntity", reflect.TypeOf((*MockUsecase)(nil).GetByEntity), ctx, entity, cursor, limit)
+}
+
+// GetReplies mocks base method.
+func (m *MockUsecase) GetReplies(ctx context.Context, commentID uint32, cursor string, limit uint32) ([]models.Comment, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplies", ctx, commentID, cursor, limit)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReplies indicates an expected call of GetReplies.
+func (mr *MockUsecaseMockRecorder) GetReplies(ctx, commentID, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockUsecase)(nil).GetReplies), ctx, commentID, cursor, limit)
+}
+
+// IsLiked mocks base method.
+func (m *MockUsecase) IsLiked(ctx context.Context, commentID, userID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLiked", ctx, commentID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsLiked indicates an expected call of IsLiked.
+func (mr *MockUsecaseMockRecorder) IsLiked(ctx, commentID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLiked", reflect.TypeOf((*MockUsecase)(nil).IsLiked), ctx, commentID, userID)
+}
+
+// Reply mocks base method.
+func (m *MockUsecase) Reply(ctx context.Context, parentID uint32, text string, userID uint32) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reply", ctx, parentID, text, userID)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reply indicates an expected call of Reply.
+func (mr *MockUsecaseMockRecorder) Reply(ctx, parentID, text, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reply", reflect.TypeOf((*MockUsecase)(nil).Reply), ctx, parentID, text, userID)
+}
+
+// SetLike mocks base method.
+func (m *MockUsecase) SetLike(ctx context.Context, commentID, userID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLike", ctx, commentID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLike indicates an expected call of SetLike.
+func (mr *MockUsecaseMockRecorder) SetLike(ctx, commentID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLike", reflect.TypeOf((*MockUsecase)(nil).SetLike), ctx, commentID, userID)
+}
+
+// UnLike mocks base method.
+func (m *MockUsecase) UnLike(ctx context.Context, commentID, userID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnLike", ctx, commentID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnLike indicates an expected call of UnLike.
+func (mr *MockUsecaseMockRecorder) UnLike(ctx, commentID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnLike", reflect.TypeOf((*MockUsecase)(nil).UnLike), ctx, commentID, userID)
+}
+
+// Update mocks base method.
+func (m *MockUsecase) Update(ctx context.Context, commentID uint32, text string, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, commentID, text, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUsecaseMockRecorder) Update(ctx, commentID, text, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUsecase)(nil).Update), ctx, commentID, text, userID)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByUserSince mocks base method.
+func (m *MockRepository) CountByUserSince(ctx context.Context, userID uint32, since time.Time) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByUserSince", ctx, userID, since)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByUserSince indicates an expected call of CountByUserSince.
+func (mr *MockRepositoryMockRecorder) CountByUserSince(ctx, userID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserSince", reflect.TypeOf((*MockRepository)(nil).CountByUserSince), ctx, userID, since)
+}
+
+// DeleteLike mocks base method.
+func (m *MockRepository) DeleteLike(ctx context.Context, commentID, userID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLike", ctx, commentID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLike indicates an expected call of DeleteLike.
+func (mr *MockRepositoryMockRecorder) DeleteLike(ctx, commentID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLike", reflect.TypeOf((*MockRepository)(nil).DeleteLike), ctx, commentID, userID)
+}
+
+// GetByEntity mocks base method.
+func (m *MockRepository) GetByEntity(ctx context.Context, entity models.CommentEntity, beforeID, limit uint32) ([]models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEntity", ctx, entity, beforeID, limit)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEntity indicates an expected call of GetByEntity.
+func (mr *MockRepositoryMockRecorder) GetByEntity(ctx, entity, beforeID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEntity", reflect.TypeOf((*MockRepository)(nil).GetByEntity), ctx, entity, beforeID, limit)
+}
+
+// GetByID mocks base method.
+func (m *MockRepository) GetByID(ctx context.Context, commentID uint32) (*models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, commentID)
+	ret0, _ := ret[0].(*models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRepositoryMockRecorder) GetByID(ctx, commentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, commentID)
+}
+
+// GetReplies mocks base method.
+func (m *MockRepository) GetReplies(ctx context.Context, commentID, afterID, limit uint32) ([]models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplies", ctx, commentID, afterID, limit)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplies indicates an expected call of GetReplies.
+func (mr *MockRepositoryMockRecorder) GetReplies(ctx, commentID, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockRepository)(nil).GetReplies), ctx, commentID, afterID, limit)
+}
+
+// Insert mocks base method.
+func (m *MockRepository) Insert(ctx context.Context, comment models.Comment) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, comment)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockRepositoryMockRecorder) Insert(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockRepository)(nil).Insert), ctx, comment)
+}
+
+// InsertLike mocks base method.
+func (m *MockRepository) InsertLike(ctx context.Context, commentID, userID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertLike", ctx, commentID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertLike indicates an expected call of InsertLike.
+func (mr *MockRepositoryMockRecorder) InsertLike(ctx, commentID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertLike", reflect.TypeOf((*MockRepository)(nil).InsertLike), ctx, commentID, userID)
+}
+
+// IsLiked mocks base method.
+func (m *MockRepository) IsLiked(ctx context.Context, commentID, userID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLiked", ctx, commentID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsLiked indicates an expected call of IsLiked.
+func (mr *MockRepositoryMockRecorder) IsLiked(ctx, commentID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLiked", reflect.TypeOf((*MockRepository)(nil).IsLiked), ctx, commentID, userID)
+}
+
+// IsModerator mocks base method.
+func (m *MockRepository) IsModerator(ctx context.Context, userID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsModerator", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsModerator indicates an expected call of IsModerator.
+func (mr *MockRepositoryMockRecorder) IsModerator(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsModerator", reflect.TypeOf((*MockRepository)(nil).IsModerator), ctx, userID)
+}
+
+// MarkDeleted mocks base method.
+func (m *MockRepository) MarkDeleted(ctx context.Context, commentID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeleted", ctx, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeleted indicates an expected call of MarkDeleted.
+func (mr *MockRepositoryMockRecorder) MarkDeleted(ctx, commentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeleted", reflect.TypeOf((*MockRepository)(nil).MarkDeleted), ctx, commentID)
+}
+
+// UpdateText mocks base method.
+func (m *MockRepository) UpdateText(ctx context.Context, commentID uint32, text string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateText", ctx, commentID, text)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateText indicates an expected call of UpdateText.
+func (mr *MockRepositoryMockRecorder) UpdateText(ctx, commentID, text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateText", reflect.TypeOf((*MockRepository)(nil).UpdateText), ctx, commentID, text)
+}
+
+// MockTables is a mock of Tables interface.
+type MockTables struct {
+	ctrl     *gomock.Controller
+	recorder *MockTablesMockRecorder
+}
+
+// MockTablesMockRecorder is the mock recorder for MockTables.
+type MockTablesMockRecorder struct {
+	mock *MockTables
+}
+
+// NewMockTables creates a new mock instance.
+func NewMockTables(ctrl *gomock.Controller) *MockTables {
+	mock := &MockTables{ctrl: ctrl}
+	mock.recorder = &MockTablesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTables) EXPECT() *MockTablesMockRecorder {
+	return m.recorder
+}
+
+// Comments mocks base method.
+func (m *MockTables) Comments() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Comments")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Comments indicates an expected call of Comments.
+func (mr *MockTablesMockRecorder) Comments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Comments", reflect.TypeOf((*MockTables)(nil).Comments))
+}
+
+// LikedComments mocks base method.
+func (m *MockTables) LikedComments() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedComments")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedComments indicates an expected call of LikedComments.
+func (mr *MockTablesMockRecorder) LikedComments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedComments", reflect.TypeOf((*MockTables)(nil).LikedComments))
+}
+
+// Moderators mocks base method.
+func (m *MockTables) Moderators() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Moderators")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Moderators indicates an expected call of Moderators.
+func (mr *MockTablesMockRecorder) Moderators() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Moderators", reflect.TypeOf((*MockTables)(nil).Moderators))
+}
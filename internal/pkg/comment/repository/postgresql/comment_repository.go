@@ -0,0 +1,289 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment"
+)
+
+// PostgreSQL implements comment.Repository
+type PostgreSQL struct {
+	db     *sqlx.DB
+	tables comment.Tables
+}
+
+func NewPostgreSQL(db *sqlx.DB, t comment.Tables) *PostgreSQL {
+	return &PostgreSQL{
+		db:     db,
+		tables: t,
+	}
+}
+
+const errorLikeExists = "unique_violation"
+
+// entityColumn returns column of Comments referring to entity of given type
+func entityColumn(entityType string) (string, error) {
+	switch entityType {
+	case models.CommentEntityTrack:
+		return "track_id", nil
+	case models.CommentEntityAlbum:
+		return "album_id", nil
+	case models.CommentEntityPlaylist:
+		return "playlist_id", nil
+	}
+
+	return "", fmt.Errorf("unknown type of commented entity: %q", entityType)
+}
+
+// visible returns condition of comment with given alias to be shown in lists:
+// deleted comments are left only to keep their replies
+func (p *PostgreSQL) visible(alias string) string {
+	return fmt.Sprintf(
+		`(%[1]s.deleted_at IS NULL OR EXISTS (
+			SELECT id
+			FROM %[2]s
+			WHERE parent_id = %[1]s.id
+		))`,
+		alias, p.tables.Comments())
+}
+
+// columns returns columns of models.Comment selected from Comments aliased as c
+func (p *PostgreSQL) columns() string {
+	return fmt.Sprintf(
+		`c.id,
+		CASE
+			WHEN c.track_id IS NOT NULL THEN '%s'
+			WHEN c.album_id IS NOT NULL THEN '%s'
+			ELSE '%s'
+		END AS entity_type,
+		COALESCE(c.track_id, c.album_id, c.playlist_id) AS entity_id,
+		c.parent_id, c.user_id, c.text, c.created_at, c.edited_at, c.deleted_at,
+		(
+			SELECT COUNT(*)
+			FROM %s
+			WHERE comment_id = c.id
+		) AS likes,
+		(
+			SELECT COUNT(*)
+			FROM %s r
+			WHERE r.parent_id = c.id AND %s
+		) AS replies`,
+		models.CommentEntityTrack, models.CommentEntityAlbum, models.CommentEntityPlaylist,
+		p.tables.LikedComments(), p.tables.Comments(), p.visible("r"))
+}
+
+func (p *PostgreSQL) Insert(ctx context.Context, c models.Comment) (uint32, error) {
+	column, err := entityColumn(c.EntityType)
+	if err != nil {
+		return 0, fmt.Errorf("(repo) %w", err)
+	}
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s (%s, parent_id, user_id, text)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id;`,
+		p.tables.Comments(), column)
+
+	var commentID uint32
+	if err := p.db.GetContext(ctx, &commentID, query, c.EntityID, c.ParentID, c.UserID, c.Text); err != nil {
+		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return commentID, nil
+}
+
+func (p *PostgreSQL) GetByID(ctx context.Context, commentID uint32) (*models.Comment, error) {
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s c
+		WHERE c.id = $1;`,
+		p.columns(), p.tables.Comments())
+
+	var c models.Comment
+	if err := p.db.GetContext(ctx, &c, query, commentID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchCommentError{CommentID: commentID}, err)
+		}
+
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return &c, nil
+}
+
+func (p *PostgreSQL) GetByEntity(ctx context.Context, entity models.CommentEntity,
+	beforeID uint32, limit uint32) ([]models.Comment, error) {
+
+	column, err := entityColumn(entity.Type)
+	if err != nil {
+		return nil, fmt.Errorf("(repo) %w", err)
+	}
+
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s c
+		WHERE c.%s = $1 AND c.parent_id IS NULL AND ($2 = 0 OR c.id < $2) AND %s
+		ORDER BY c.id DESC
+		LIMIT $3;`,
+		p.columns(), p.tables.Comments(), column, p.visible("c"))
+
+	comments := []models.Comment{}
+	if err := p.db.SelectContext(ctx, &comments, query, entity.ID, beforeID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return comments, nil
+}
+
+func (p *PostgreSQL) GetReplies(ctx context.Context,
+	commentID uint32, afterID uint32, limit uint32) ([]models.Comment, error) {
+
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s c
+		WHERE c.parent_id = $1 AND c.id > $2 AND %s
+		ORDER BY c.id
+		LIMIT $3;`,
+		p.columns(), p.tables.Comments(), p.visible("c"))
+
+	comments := []models.Comment{}
+	if err := p.db.SelectContext(ctx, &comments, query, commentID, afterID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return comments, nil
+}
+
+func (p *PostgreSQL) UpdateText(ctx context.Context, commentID uint32, text string) error {
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET text = $2, edited_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL;`,
+		p.tables.Comments())
+
+	return p.execOnComment(ctx, query, commentID, text)
+}
+
+func (p *PostgreSQL) MarkDeleted(ctx context.Context, commentID uint32) error {
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET deleted_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL;`,
+		p.tables.Comments())
+
+	return p.execOnComment(ctx, query, commentID)
+}
+
+// execOnComment execs query changing comment, which ID is the first of args,
+// and returns models.NoSuchCommentError if nothing is changed
+func (p *PostgreSQL) execOnComment(ctx context.Context, query string, commentID uint32, args ...interface{}) error {
+	resExec, err := p.db.ExecContext(ctx, query, append([]interface{}{commentID}, args...)...)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	changed, err := resExec.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("(repo) failed to check query result: %w", err)
+	}
+	if changed == 0 {
+		return fmt.Errorf("(repo) %w", &models.NoSuchCommentError{CommentID: commentID})
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) CountByUserSince(ctx context.Context, userID uint32, since time.Time) (uint32, error) {
+	query := fmt.Sprintf(
+		`SELECT COUNT(*)
+		FROM %s
+		WHERE user_id = $1 AND created_at >= $2;`,
+		p.tables.Comments())
+
+	var amount uint32
+	if err := p.db.GetContext(ctx, &amount, query, userID, since); err != nil {
+		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return amount, nil
+}
+
+func (p *PostgreSQL) IsModerator(ctx context.Context, userID uint32) (bool, error) {
+	query := fmt.Sprintf(
+		`SELECT EXISTS(
+			SELECT user_id
+			FROM %s
+			WHERE user_id = $1
+		);`,
+		p.tables.Moderators())
+
+	var isModerator bool
+	if err := p.db.GetContext(ctx, &isModerator, query, userID); err != nil {
+		return false, fmt.Errorf("(repo) failed to check if user is moderator: %w", err)
+	}
+
+	return isModerator, nil
+}
+
+func (p *PostgreSQL) InsertLike(ctx context.Context, commentID, userID uint32) (bool, error) {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (comment_id, user_id)
+		VALUES ($1, $2);`,
+		p.tables.LikedComments())
+
+	if _, err := p.db.ExecContext(ctx, query, commentID, userID); err != nil {
+		if pqerr, ok := err.(*pq.Error); ok {
+			if pqerr.Code.Name() == errorLikeExists {
+				return false, nil
+			}
+		}
+
+		return false, fmt.Errorf("(repo) failed to insert: %w", err)
+	}
+
+	return true, nil
+}
+
+func (p *PostgreSQL) DeleteLike(ctx context.Context, commentID, userID uint32) (bool, error) {
+	query := fmt.Sprintf(
+		`DELETE
+		FROM %s
+		WHERE comment_id = $1 AND user_id = $2;`,
+		p.tables.LikedComments())
+
+	resExec, err := p.db.ExecContext(ctx, query, commentID, userID)
+	if err != nil {
+		return false, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+	deleted, err := resExec.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("(repo) failed to check query result: %w", err)
+	}
+
+	return deleted != 0, nil
+}
+
+func (p *PostgreSQL) IsLiked(ctx context.Context, commentID, userID uint32) (bool, error) {
+	query := fmt.Sprintf(
+		`SELECT EXISTS(
+			SELECT comment_id
+			FROM %s
+			WHERE comment_id = $1 AND user_id = $2
+		);`,
+		p.tables.LikedComments())
+
+	var isLiked bool
+	if err := p.db.GetContext(ctx, &isLiked, query, commentID, userID); err != nil {
+		return false, fmt.Errorf("(repo) failed to check if comment is liked by user: %w", err)
+	}
+
+	return isLiked, nil
+}
@@ -0,0 +1,792 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	commentMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment/mocks"
+)
+
+var ctx = context.Background()
+
+const commentsTable = "Comments"
+const likedCommentsTable = "Liked_comments"
+
+var errPqInternal = errors.New("postgres is dead")
+
+var defaultUserID uint32 = 1
+var defaultParentID uint32 = 5
+var defaultCreatedAt = time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+var commentColumns = []string{"id", "entity_type", "entity_id", "parent_id", "user_id",
+	"text", "created_at", "edited_at", "deleted_at", "likes", "replies"}
+
+func commentRows(sqlxMock sqlmock.Sqlmock, comments []models.Comment) *sqlmock.Rows {
+	rows := sqlxMock.NewRows(commentColumns)
+	for _, c := range comments {
+		rows.AddRow(c.ID, c.EntityType, c.EntityID, c.ParentID, c.UserID,
+			c.Text, c.CreatedAt, c.EditedAt, c.DeletedAt, c.Likes, c.Replies)
+	}
+
+	return rows
+}
+
+func TestCommentRepositoryPostgreSQL_Insert(t *testing.T) {
+	// Init
+	type mockBehavior func(c models.Comment)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := commentMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const commentID uint32 = 10
+
+	testTable := []struct {
+		name          string
+		comment       models.Comment
+		mockBehavior  mockBehavior
+		expectedID    uint32
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Track Comment",
+			comment: models.Comment{
+				EntityType: models.CommentEntityTrack,
+				EntityID:   3,
+				UserID:     &defaultUserID,
+				Text:       "Best track",
+			},
+			mockBehavior: func(c models.Comment) {
+				tablesMock.EXPECT().Comments().Return(commentsTable)
+
+				row := sqlxMock.NewRows([]string{"id"}).AddRow(commentID)
+				sqlxMock.ExpectQuery("INSERT INTO "+commentsTable+" \\(track_id, parent_id, user_id, text\\)").
+					WithArgs(c.EntityID, c.ParentID, c.UserID, c.Text).
+					WillReturnRows(row)
+			},
+			expectedID: commentID,
+		},
+		{
+			name: "Reply To Album Comment",
+			comment: models.Comment{
+				EntityType: models.CommentEntityAlbum,
+				EntityID:   3,
+				ParentID:   &defaultParentID,
+				UserID:     &defaultUserID,
+				Text:       "Agree",
+			},
+			mockBehavior: func(c models.Comment) {
+				tablesMock.EXPECT().Comments().Return(commentsTable)
+
+				row := sqlxMock.NewRows([]string{"id"}).AddRow(commentID)
+				sqlxMock.ExpectQuery("INSERT INTO "+commentsTable+" \\(album_id, parent_id, user_id, text\\)").
+					WithArgs(c.EntityID, c.ParentID, c.UserID, c.Text).
+					WillReturnRows(row)
+			},
+			expectedID: commentID,
+		},
+		{
+			name: "Unknown Entity Type",
+			comment: models.Comment{
+				EntityType: "artist",
+				EntityID:   3,
+				UserID:     &defaultUserID,
+				Text:       "Best artist",
+			},
+			mockBehavior:  func(c models.Comment) {},
+			expectError:   true,
+			expectedError: errors.New("unknown type of commented entity"),
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			comment: models.Comment{
+				EntityType: models.CommentEntityPlaylist,
+				EntityID:   3,
+				UserID:     &defaultUserID,
+				Text:       "Best playlist",
+			},
+			mockBehavior: func(c models.Comment) {
+				tablesMock.EXPECT().Comments().Return(commentsTable)
+
+				sqlxMock.ExpectQuery("INSERT INTO "+commentsTable+" \\(playlist_id").
+					WithArgs(c.EntityID, c.ParentID, c.UserID, c.Text).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tc.comment)
+
+			id, err := repo.Insert(ctx, tc.comment)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedID, id)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepositoryPostgreSQL_GetByID(t *testing.T) {
+	// Init
+	type mockBehavior func(commentID uint32, c models.Comment)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := commentMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultCommentID uint32 = 10
+
+	defaultComment := models.Comment{
+		ID:         defaultCommentID,
+		EntityType: models.CommentEntityTrack,
+		EntityID:   3,
+		UserID:     &defaultUserID,
+		Text:       "Best track",
+		CreatedAt:  defaultCreatedAt,
+		Likes:      2,
+		Replies:    1,
+	}
+
+	expectTables := func() {
+		tablesMock.EXPECT().Comments().Return(commentsTable).Times(3)
+		tablesMock.EXPECT().LikedComments().Return(likedCommentsTable)
+	}
+
+	testTable := []struct {
+		name            string
+		mockBehavior    mockBehavior
+		expectedComment models.Comment
+		expectError     bool
+		expectedError   error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(commentID uint32, c models.Comment) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT c.id,(.+)AS entity_type(.+)AS entity_id" +
+					"(.+)FROM " + likedCommentsTable + "(.+)AS likes" +
+					"(.+)r.deleted_at IS NULL OR EXISTS(.+)AS replies" +
+					"(.+)FROM " + commentsTable + " c(.+)WHERE c.id = \\$1").
+					WithArgs(commentID).
+					WillReturnRows(commentRows(sqlxMock, []models.Comment{c}))
+			},
+			expectedComment: defaultComment,
+		},
+		{
+			name: "No Such Comment",
+			mockBehavior: func(commentID uint32, c models.Comment) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + commentsTable + " c").
+					WithArgs(commentID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchCommentError{CommentID: defaultCommentID},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(commentID uint32, c models.Comment) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + commentsTable + " c").
+					WithArgs(commentID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultCommentID, tc.expectedComment)
+
+			comment, err := repo.GetByID(ctx, defaultCommentID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedComment, *comment)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepositoryPostgreSQL_GetByEntity(t *testing.T) {
+	// Init
+	type mockBehavior func(entity models.CommentEntity, beforeID, limit uint32, comments []models.Comment)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := commentMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const limit uint32 = 2
+
+	deletedAt := defaultCreatedAt.Add(time.Hour)
+	defaultComments := []models.Comment{
+		{
+			ID:         12,
+			EntityType: models.CommentEntityPlaylist,
+			EntityID:   3,
+			UserID:     &defaultUserID,
+			Text:       "Best playlist",
+			CreatedAt:  defaultCreatedAt,
+		},
+		{
+			ID:         11,
+			EntityType: models.CommentEntityPlaylist,
+			EntityID:   3,
+			CreatedAt:  defaultCreatedAt,
+			DeletedAt:  &deletedAt,
+			Replies:    1,
+		},
+	}
+
+	expectTables := func() {
+		tablesMock.EXPECT().Comments().Return(commentsTable).Times(4)
+		tablesMock.EXPECT().LikedComments().Return(likedCommentsTable)
+	}
+
+	testTable := []struct {
+		name             string
+		entity           models.CommentEntity
+		beforeID         uint32
+		mockBehavior     mockBehavior
+		expectedComments []models.Comment
+		expectError      bool
+		expectedError    error
+	}{
+		{
+			name:     "First Page (deleted comments are left if they have replies)",
+			entity:   models.CommentEntity{Type: models.CommentEntityPlaylist, ID: 3},
+			beforeID: 0,
+			mockBehavior: func(entity models.CommentEntity, beforeID, limit uint32, comments []models.Comment) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+commentsTable+" c"+
+					"(.+)WHERE c.playlist_id = \\$1 AND c.parent_id IS NULL AND \\(\\$2 = 0 OR c.id < \\$2\\)"+
+					"(.+)c.deleted_at IS NULL OR EXISTS(.+)WHERE parent_id = c.id"+
+					"(.+)ORDER BY c.id DESC(.+)LIMIT \\$3").
+					WithArgs(entity.ID, beforeID, limit).
+					WillReturnRows(commentRows(sqlxMock, comments))
+			},
+			expectedComments: defaultComments,
+		},
+		{
+			name:     "Page After Cursor",
+			entity:   models.CommentEntity{Type: models.CommentEntityTrack, ID: 3},
+			beforeID: 11,
+			mockBehavior: func(entity models.CommentEntity, beforeID, limit uint32, comments []models.Comment) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+commentsTable+" c(.+)WHERE c.track_id = \\$1").
+					WithArgs(entity.ID, beforeID, limit).
+					WillReturnRows(commentRows(sqlxMock, comments))
+			},
+			expectedComments: []models.Comment{},
+		},
+		{
+			name:          "Unknown Entity Type",
+			entity:        models.CommentEntity{Type: "artist", ID: 3},
+			mockBehavior:  func(entity models.CommentEntity, beforeID, limit uint32, comments []models.Comment) {},
+			expectError:   true,
+			expectedError: errors.New("unknown type of commented entity"),
+		},
+		{
+			name:   "Internal PostgreSQL Error",
+			entity: models.CommentEntity{Type: models.CommentEntityAlbum, ID: 3},
+			mockBehavior: func(entity models.CommentEntity, beforeID, limit uint32, comments []models.Comment) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+commentsTable+" c(.+)WHERE c.album_id = \\$1").
+					WithArgs(entity.ID, beforeID, limit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tc.entity, tc.beforeID, limit, tc.expectedComments)
+
+			comments, err := repo.GetByEntity(ctx, tc.entity, tc.beforeID, limit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedComments, comments)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepositoryPostgreSQL_GetReplies(t *testing.T) {
+	// Init
+	type mockBehavior func(commentID, afterID, limit uint32, comments []models.Comment)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := commentMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const afterID uint32 = 6
+	const limit uint32 = 10
+
+	defaultReplies := []models.Comment{
+		{
+			ID:         7,
+			EntityType: models.CommentEntityTrack,
+			EntityID:   3,
+			ParentID:   &defaultParentID,
+			UserID:     &defaultUserID,
+			Text:       "Agree",
+			CreatedAt:  defaultCreatedAt,
+		},
+	}
+
+	testTable := []struct {
+		name            string
+		mockBehavior    mockBehavior
+		expectedReplies []models.Comment
+		expectError     bool
+		expectedError   error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(commentID, afterID, limit uint32, comments []models.Comment) {
+				tablesMock.EXPECT().Comments().Return(commentsTable).Times(4)
+				tablesMock.EXPECT().LikedComments().Return(likedCommentsTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+commentsTable+" c"+
+					"(.+)WHERE c.parent_id = \\$1 AND c.id > \\$2(.+)ORDER BY c.id(.+)LIMIT \\$3").
+					WithArgs(commentID, afterID, limit).
+					WillReturnRows(commentRows(sqlxMock, comments))
+			},
+			expectedReplies: defaultReplies,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(commentID, afterID, limit uint32, comments []models.Comment) {
+				tablesMock.EXPECT().Comments().Return(commentsTable).Times(4)
+				tablesMock.EXPECT().LikedComments().Return(likedCommentsTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+commentsTable+" c").
+					WithArgs(commentID, afterID, limit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultParentID, afterID, limit, tc.expectedReplies)
+
+			replies, err := repo.GetReplies(ctx, defaultParentID, afterID, limit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReplies, replies)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepositoryPostgreSQL_UpdateText(t *testing.T) {
+	// Init
+	type mockBehavior func(commentID uint32, text string)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := commentMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultCommentID uint32 = 10
+	const defaultText = "Best track ever"
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(commentID uint32, text string) {
+				tablesMock.EXPECT().Comments().Return(commentsTable)
+
+				sqlxMock.ExpectExec("UPDATE "+commentsTable+"(.+)SET text = \\$2, edited_at = NOW\\(\\)"+
+					"(.+)WHERE id = \\$1 AND deleted_at IS NULL").
+					WithArgs(commentID, text).
+					WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+		{
+			name: "No Such Comment (or it's deleted)",
+			mockBehavior: func(commentID uint32, text string) {
+				tablesMock.EXPECT().Comments().Return(commentsTable)
+
+				sqlxMock.ExpectExec("UPDATE "+commentsTable).
+					WithArgs(commentID, text).
+					WillReturnResult(driver.RowsAffected(0))
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchCommentError{CommentID: defaultCommentID},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(commentID uint32, text string) {
+				tablesMock.EXPECT().Comments().Return(commentsTable)
+
+				sqlxMock.ExpectExec("UPDATE "+commentsTable).
+					WithArgs(commentID, text).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultCommentID, defaultText)
+
+			err := repo.UpdateText(ctx, defaultCommentID, defaultText)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepositoryPostgreSQL_MarkDeleted(t *testing.T) {
+	// Init
+	type mockBehavior func(commentID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := commentMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultCommentID uint32 = 10
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(commentID uint32) {
+				tablesMock.EXPECT().Comments().Return(commentsTable)
+
+				sqlxMock.ExpectExec("UPDATE " + commentsTable + "(.+)SET deleted_at = NOW\\(\\)" +
+					"(.+)WHERE id = \\$1 AND deleted_at IS NULL").
+					WithArgs(commentID).
+					WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+		{
+			name: "Already Deleted",
+			mockBehavior: func(commentID uint32) {
+				tablesMock.EXPECT().Comments().Return(commentsTable)
+
+				sqlxMock.ExpectExec("UPDATE " + commentsTable).
+					WithArgs(commentID).
+					WillReturnResult(driver.RowsAffected(0))
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchCommentError{CommentID: defaultCommentID},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(commentID uint32) {
+				tablesMock.EXPECT().Comments().Return(commentsTable)
+
+				sqlxMock.ExpectExec("UPDATE " + commentsTable).
+					WithArgs(commentID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultCommentID)
+
+			err := repo.MarkDeleted(ctx, defaultCommentID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepositoryPostgreSQL_InsertLike(t *testing.T) {
+	// Init
+	type mockBehavior func(commentID, userID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := commentMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultCommentID uint32 = 10
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectedInserted bool
+		expectError      bool
+		expectedError    error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(commentID, userID uint32) {
+				tablesMock.EXPECT().LikedComments().Return(likedCommentsTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+likedCommentsTable).
+					WithArgs(commentID, userID).
+					WillReturnResult(driver.RowsAffected(1))
+			},
+			expectedInserted: true,
+		},
+		{
+			name: "Already Liked",
+			mockBehavior: func(commentID, userID uint32) {
+				tablesMock.EXPECT().LikedComments().Return(likedCommentsTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+likedCommentsTable).
+					WithArgs(commentID, userID).
+					WillReturnError(&pq.Error{Code: "23505"})
+			},
+			expectedInserted: false,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(commentID, userID uint32) {
+				tablesMock.EXPECT().LikedComments().Return(likedCommentsTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+likedCommentsTable).
+					WithArgs(commentID, userID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultCommentID, defaultUserID)
+
+			inserted, err := repo.InsertLike(ctx, defaultCommentID, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedInserted, inserted)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepositoryPostgreSQL_DeleteLike(t *testing.T) {
+	// Init
+	type mockBehavior func(commentID, userID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := commentMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultCommentID uint32 = 10
+
+	testTable := []struct {
+		name            string
+		mockBehavior    mockBehavior
+		expectedDeleted bool
+		expectError     bool
+		expectedError   error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(commentID, userID uint32) {
+				tablesMock.EXPECT().LikedComments().Return(likedCommentsTable)
+
+				sqlxMock.ExpectExec("DELETE FROM "+likedCommentsTable).
+					WithArgs(commentID, userID).
+					WillReturnResult(driver.RowsAffected(1))
+			},
+			expectedDeleted: true,
+		},
+		{
+			name: "Wasn't Liked",
+			mockBehavior: func(commentID, userID uint32) {
+				tablesMock.EXPECT().LikedComments().Return(likedCommentsTable)
+
+				sqlxMock.ExpectExec("DELETE FROM "+likedCommentsTable).
+					WithArgs(commentID, userID).
+					WillReturnResult(driver.RowsAffected(0))
+			},
+			expectedDeleted: false,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(commentID, userID uint32) {
+				tablesMock.EXPECT().LikedComments().Return(likedCommentsTable)
+
+				sqlxMock.ExpectExec("DELETE FROM "+likedCommentsTable).
+					WithArgs(commentID, userID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultCommentID, defaultUserID)
+
+			deleted, err := repo.DeleteLike(ctx, defaultCommentID, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeleted, deleted)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track"
+)
+
+// Usecase implements comment.Usecase
+type Usecase struct {
+	repo         comment.Repository
+	trackRepo    track.Repository
+	albumRepo    album.Repository
+	playlistRepo playlist.Repository
+
+	cfg comment.Config
+}
+
+func NewUsecase(cr comment.Repository, tr track.Repository, alr album.Repository,
+	pr playlist.Repository, cfg comment.Config) *Usecase {
+
+	return &Usecase{
+		repo:         cr,
+		trackRepo:    tr,
+		albumRepo:    alr,
+		playlistRepo: pr,
+
+		cfg: cfg,
+	}
+}
+
+func (u *Usecase) Create(ctx context.Context,
+	entity models.CommentEntity, text string, userID uint32) (uint32, error) {
+
+	if err := u.checkEntity(ctx, entity); err != nil {
+		return 0, fmt.Errorf("(usecase) can't find commented entity: %w", err)
+	}
+
+	if err := u.checkRateLimit(ctx, userID); err != nil {
+		return 0, fmt.Errorf("(usecase) comment can't be created by user: %w", err)
+	}
+
+	commentID, err := u.repo.Insert(ctx, models.Comment{
+		EntityType: entity.Type,
+		EntityID:   entity.ID,
+		UserID:     &userID,
+		Text:       text,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("(usecase) can't insert comment into repository: %w", err)
+	}
+
+	return commentID, nil
+}
+
+func (u *Usecase) Reply(ctx context.Context, parentID uint32, text string, userID uint32) (uint32, error) {
+	parent, err := u.getNotDeleted(ctx, parentID)
+	if err != nil {
+		return 0, fmt.Errorf("(usecase) can't find comment to reply: %w", err)
+	}
+
+	if err := u.checkRateLimit(ctx, userID); err != nil {
+		return 0, fmt.Errorf("(usecase) reply can't be created by user: %w", err)
+	}
+
+	commentID, err := u.repo.Insert(ctx, models.Comment{
+		EntityType: parent.EntityType,
+		EntityID:   parent.EntityID,
+		ParentID:   &parentID,
+		UserID:     &userID,
+		Text:       text,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("(usecase) can't insert reply into repository: %w", err)
+	}
+
+	return commentID, nil
+}
+
+func (u *Usecase) GetByEntity(ctx context.Context, entity models.CommentEntity,
+	cursor string, limit uint32) ([]models.Comment, string, error) {
+
+	limit, err := pageLimit(limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("(usecase) %w", err)
+	}
+	beforeID, err := models.ParseCommentsCursor(cursor)
+	if err != nil {
+		return nil, "", fmt.Errorf("(usecase) %w", err)
+	}
+
+	if err := u.checkEntity(ctx, entity); err != nil {
+		return nil, "", fmt.Errorf("(usecase) can't find commented entity: %w", err)
+	}
+
+	// One more comment tells if there is next page
+	comments, err := u.repo.GetByEntity(ctx, entity, beforeID, limit+1)
+	if err != nil {
+		return nil, "", fmt.Errorf("(usecase) can't get comments from repository: %w", err)
+	}
+
+	comments, next := page(comments, limit)
+
+	return comments, next, nil
+}
+
+func (u *Usecase) GetReplies(ctx context.Context,
+	commentID uint32, cursor string, limit uint32) ([]models.Comment, string, error) {
+
+	limit, err := pageLimit(limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("(usecase) %w", err)
+	}
+	afterID, err := models.ParseCommentsCursor(cursor)
+	if err != nil {
+		return nil, "", fmt.Errorf("(usecase) %w", err)
+	}
+
+	// Replies to deleted comment are still shown
+	if _, err := u.repo.GetByID(ctx, commentID); err != nil {
+		return nil, "", fmt.Errorf("(usecase) can't find comment with id #%d: %w", commentID, err)
+	}
+
+	replies, err := u.repo.GetReplies(ctx, commentID, afterID, limit+1)
+	if err != nil {
+		return nil, "", fmt.Errorf("(usecase) can't get replies from repository: %w", err)
+	}
+
+	replies, next := page(replies, limit)
+
+	return replies, next, nil
+}
+
+func (u *Usecase) Update(ctx context.Context, commentID uint32, text string, userID uint32) error {
+	c, err := u.getNotDeleted(ctx, commentID)
+	if err != nil {
+		return fmt.Errorf("(usecase) can't find comment with id #%d: %w", commentID, err)
+	}
+
+	if c.UserID == nil || *c.UserID != userID {
+		return fmt.Errorf("(usecase) comment can't be updated by user: %w", &models.ForbiddenUserError{})
+	}
+
+	if err := u.repo.UpdateText(ctx, commentID, text); err != nil {
+		return fmt.Errorf("(usecase) can't update comment in repository: %w", err)
+	}
+
+	return nil
+}
+
+func (u *Usecase) Delete(ctx context.Context, commentID uint32, userID uint32) error {
+	c, err := u.getNotDeleted(ctx, commentID)
+	if err != nil {
+		return fmt.Errorf("(usecase) can't find comment with id #%d: %w", commentID, err)
+	}
+
+	if c.UserID == nil || *c.UserID != userID {
+		isModerator, err := u.repo.IsModerator(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("(usecase) can't check if user is moderator: %w", err)
+		}
+		if !isModerator {
+			return fmt.Errorf("(usecase) comment can't be deleted by user: %w", &models.ForbiddenUserError{})
+		}
+	}
+
+	if err := u.repo.MarkDeleted(ctx, commentID); err != nil {
+		return fmt.Errorf("(usecase) can't delete comment from repository: %w", err)
+	}
+
+	return nil
+}
+
+func (u *Usecase) SetLike(ctx context.Context, commentID, userID uint32) (bool, error) {
+	if _, err := u.getNotDeleted(ctx, commentID); err != nil {
+		return false, fmt.Errorf("(usecase) can't find comment with id #%d: %w", commentID, err)
+	}
+
+	isInserted, err := u.repo.InsertLike(ctx, commentID, userID)
+	if err != nil {
+		return false, fmt.Errorf("(usecase) failed to set like: %w", err)
+	}
+
+	return isInserted, nil
+}
+
+func (u *Usecase) UnLike(ctx context.Context, commentID, userID uint32) (bool, error) {
+	if _, err := u.repo.GetByID(ctx, commentID); err != nil {
+		return false, fmt.Errorf("(usecase) can't find comment with id #%d: %w", commentID, err)
+	}
+
+	isDeleted, err := u.repo.DeleteLike(ctx, commentID, userID)
+	if err != nil {
+		return false, fmt.Errorf("(usecase) failed to unset like: %w", err)
+	}
+
+	return isDeleted, nil
+}
+
+func (u *Usecase) IsLiked(ctx context.Context, commentID, userID uint32) (bool, error) {
+	isLiked, err := u.repo.IsLiked(ctx, commentID, userID)
+	if err != nil {
+		return false, fmt.Errorf("(usecase) can't check in repository if comment is liked: %w", err)
+	}
+
+	return isLiked, nil
+}
+
+// checkEntity returns error if commented entity doesn't exist
+func (u *Usecase) checkEntity(ctx context.Context, entity models.CommentEntity) error {
+	switch entity.Type {
+	case models.CommentEntityTrack:
+		return u.trackRepo.Check(ctx, entity.ID)
+	case models.CommentEntityAlbum:
+		return u.albumRepo.Check(ctx, entity.ID)
+	case models.CommentEntityPlaylist:
+		return u.playlistRepo.Check(ctx, entity.ID)
+	}
+
+	return &models.InvalidCommentQueryError{Reason: "unknown type of entity"}
+}
+
+// checkRateLimit returns models.CommentRateLimitError if user posted too many comments lately
+func (u *Usecase) checkRateLimit(ctx context.Context, userID uint32) error {
+	posted, err := u.repo.CountByUserSince(ctx, userID, time.Now().Add(-u.cfg.RateWindow))
+	if err != nil {
+		return fmt.Errorf("can't count comments of user: %w", err)
+	}
+
+	if posted >= u.cfg.RateLimit {
+		return &models.CommentRateLimitError{Limit: u.cfg.RateLimit, Window: u.cfg.RateWindow}
+	}
+
+	return nil
+}
+
+// getNotDeleted returns models.NoSuchCommentError if comment is deleted
+func (u *Usecase) getNotDeleted(ctx context.Context, commentID uint32) (*models.Comment, error) {
+	c, err := u.repo.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.IsDeleted() {
+		return nil, &models.NoSuchCommentError{CommentID: commentID}
+	}
+
+	return c, nil
+}
+
+func pageLimit(limit uint32) (uint32, error) {
+	if limit == 0 {
+		return models.CommentDefaultLimit, nil
+	}
+	if limit > models.CommentMaxLimit {
+		return 0, &models.InvalidCommentQueryError{Reason: "limit is too big"}
+	}
+
+	return limit, nil
+}
+
+// page cuts comments fetched with one extra to limit and returns cursor of next page
+func page(comments []models.Comment, limit uint32) ([]models.Comment, string) {
+	if uint32(len(comments)) <= limit {
+		return comments, ""
+	}
+
+	comments = comments[:limit]
+
+	return comments, models.EncodeCommentsCursor(comments[limit-1].ID)
+}
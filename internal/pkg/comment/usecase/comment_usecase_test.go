@@ -0,0 +1,435 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	albumMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/mocks"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment"
+	commentMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/comment/mocks"
+	playlistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/mocks"
+	trackMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/mocks"
+)
+
+var ctx = context.Background()
+
+var testConfig = comment.Config{
+	RateLimit:  2,
+	RateWindow: time.Minute,
+}
+
+func TestCommentUsecase_Create(t *testing.T) {
+	type mockBehavior func(cr *commentMocks.MockRepository, tr *trackMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	cr := commentMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
+	pr := playlistMocks.NewMockRepository(c)
+
+	u := NewUsecase(cr, tr, alr, pr, testConfig)
+
+	const userID uint32 = 1
+	const commentID uint32 = 10
+	const text = "Fire"
+
+	trackEntity := models.CommentEntity{Type: models.CommentEntityTrack, ID: 2}
+
+	testTable := []struct {
+		name          string
+		entity        models.CommentEntity
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name:   "Common",
+			entity: trackEntity,
+			mockBehavior: func(cr *commentMocks.MockRepository, tr *trackMocks.MockRepository) {
+				tr.EXPECT().Check(ctx, trackEntity.ID).Return(nil)
+				cr.EXPECT().CountByUserSince(ctx, userID, gomock.Any()).Return(uint32(1), nil)
+				cr.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, c models.Comment) (uint32, error) {
+						assert.Equal(t, trackEntity, c.Entity())
+						assert.Nil(t, c.ParentID)
+						assert.Equal(t, userID, *c.UserID)
+						assert.Equal(t, text, c.Text)
+						return commentID, nil
+					})
+			},
+		},
+		{
+			name:   "No Such Track",
+			entity: trackEntity,
+			mockBehavior: func(cr *commentMocks.MockRepository, tr *trackMocks.MockRepository) {
+				tr.EXPECT().Check(ctx, trackEntity.ID).Return(&models.NoSuchTrackError{TrackID: trackEntity.ID})
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchTrackError{TrackID: trackEntity.ID},
+		},
+		{
+			name:          "Unknown Entity",
+			entity:        models.CommentEntity{Type: "artist", ID: 2},
+			mockBehavior:  func(cr *commentMocks.MockRepository, tr *trackMocks.MockRepository) {},
+			expectError:   true,
+			expectedError: &models.InvalidCommentQueryError{Reason: "unknown type of entity"},
+		},
+		{
+			name:   "Rate Limit Exceeded",
+			entity: trackEntity,
+			mockBehavior: func(cr *commentMocks.MockRepository, tr *trackMocks.MockRepository) {
+				tr.EXPECT().Check(ctx, trackEntity.ID).Return(nil)
+				cr.EXPECT().CountByUserSince(ctx, userID, gomock.Any()).Return(testConfig.RateLimit, nil)
+			},
+			expectError:   true,
+			expectedError: &models.CommentRateLimitError{Limit: testConfig.RateLimit, Window: testConfig.RateWindow},
+		},
+		{
+			name:   "Insert Issue",
+			entity: trackEntity,
+			mockBehavior: func(cr *commentMocks.MockRepository, tr *trackMocks.MockRepository) {
+				tr.EXPECT().Check(ctx, trackEntity.ID).Return(nil)
+				cr.EXPECT().CountByUserSince(ctx, userID, gomock.Any()).Return(uint32(0), nil)
+				cr.EXPECT().Insert(ctx, gomock.Any()).Return(uint32(0), errors.New(""))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(cr, tr)
+
+			id, err := u.Create(ctx, tc.entity, text, userID)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				if tc.expectedError != nil {
+					assert.ErrorContains(t, err, tc.expectedError.Error())
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, commentID, id)
+			}
+		})
+	}
+}
+
+func TestCommentUsecase_Reply(t *testing.T) {
+	type mockBehavior func(cr *commentMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	cr := commentMocks.NewMockRepository(c)
+
+	u := NewUsecase(cr, trackMocks.NewMockRepository(c), albumMocks.NewMockRepository(c),
+		playlistMocks.NewMockRepository(c), testConfig)
+
+	const userID uint32 = 1
+	const parentID uint32 = 10
+	const replyID uint32 = 11
+
+	deletedAt := time.Now()
+	parent := models.Comment{ID: parentID, EntityType: models.CommentEntityAlbum, EntityID: 3}
+	deletedParent := models.Comment{ID: parentID, EntityType: models.CommentEntityAlbum, EntityID: 3,
+		DeletedAt: &deletedAt}
+
+	testTable := []struct {
+		name         string
+		mockBehavior mockBehavior
+		expectError  bool
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, parentID).Return(&parent, nil)
+				cr.EXPECT().CountByUserSince(ctx, userID, gomock.Any()).Return(uint32(0), nil)
+				cr.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, c models.Comment) (uint32, error) {
+						assert.Equal(t, parent.Entity(), c.Entity())
+						assert.Equal(t, parentID, *c.ParentID)
+						return replyID, nil
+					})
+			},
+		},
+		{
+			name: "Parent Deleted",
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, parentID).Return(&deletedParent, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "No Such Parent",
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, parentID).Return(nil, &models.NoSuchCommentError{CommentID: parentID})
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(cr)
+
+			id, err := u.Reply(ctx, parentID, "Agree", userID)
+
+			if tc.expectError {
+				var errNoSuchComment *models.NoSuchCommentError
+				assert.ErrorAs(t, err, &errNoSuchComment)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, replyID, id)
+			}
+		})
+	}
+}
+
+func TestCommentUsecase_GetByEntity(t *testing.T) {
+	type mockBehavior func(cr *commentMocks.MockRepository, pr *playlistMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	cr := commentMocks.NewMockRepository(c)
+	pr := playlistMocks.NewMockRepository(c)
+
+	u := NewUsecase(cr, trackMocks.NewMockRepository(c), albumMocks.NewMockRepository(c), pr, testConfig)
+
+	entity := models.CommentEntity{Type: models.CommentEntityPlaylist, ID: 4}
+	comments := []models.Comment{{ID: 30}, {ID: 20}, {ID: 10}}
+
+	testTable := []struct {
+		name             string
+		cursor           string
+		limit            uint32
+		mockBehavior     mockBehavior
+		expectedComments []models.Comment
+		expectedNext     string
+		expectError      bool
+	}{
+		{
+			name:  "Has Next Page",
+			limit: 2,
+			mockBehavior: func(cr *commentMocks.MockRepository, pr *playlistMocks.MockRepository) {
+				pr.EXPECT().Check(ctx, entity.ID).Return(nil)
+				cr.EXPECT().GetByEntity(ctx, entity, uint32(0), uint32(3)).Return(comments, nil)
+			},
+			expectedComments: comments[:2],
+			expectedNext:     models.EncodeCommentsCursor(20),
+		},
+		{
+			name:   "Last Page",
+			cursor: models.EncodeCommentsCursor(20),
+			limit:  2,
+			mockBehavior: func(cr *commentMocks.MockRepository, pr *playlistMocks.MockRepository) {
+				pr.EXPECT().Check(ctx, entity.ID).Return(nil)
+				cr.EXPECT().GetByEntity(ctx, entity, uint32(20), uint32(3)).Return(comments[2:], nil)
+			},
+			expectedComments: comments[2:],
+		},
+		{
+			name: "Default Limit",
+			mockBehavior: func(cr *commentMocks.MockRepository, pr *playlistMocks.MockRepository) {
+				pr.EXPECT().Check(ctx, entity.ID).Return(nil)
+				cr.EXPECT().GetByEntity(ctx, entity, uint32(0), models.CommentDefaultLimit+1).Return(comments, nil)
+			},
+			expectedComments: comments,
+		},
+		{
+			name:         "Invalid Cursor",
+			cursor:       "!",
+			mockBehavior: func(cr *commentMocks.MockRepository, pr *playlistMocks.MockRepository) {},
+			expectError:  true,
+		},
+		{
+			name:         "Too Big Limit",
+			limit:        models.CommentMaxLimit + 1,
+			mockBehavior: func(cr *commentMocks.MockRepository, pr *playlistMocks.MockRepository) {},
+			expectError:  true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(cr, pr)
+
+			comments, next, err := u.GetByEntity(ctx, entity, tc.cursor, tc.limit)
+
+			if tc.expectError {
+				var errInvalidQuery *models.InvalidCommentQueryError
+				assert.ErrorAs(t, err, &errInvalidQuery)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedComments, comments)
+				assert.Equal(t, tc.expectedNext, next)
+			}
+		})
+	}
+}
+
+func TestCommentUsecase_Update(t *testing.T) {
+	type mockBehavior func(cr *commentMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	cr := commentMocks.NewMockRepository(c)
+
+	u := NewUsecase(cr, trackMocks.NewMockRepository(c), albumMocks.NewMockRepository(c),
+		playlistMocks.NewMockRepository(c), testConfig)
+
+	const commentID uint32 = 10
+	const text = "Edited"
+	var authorID uint32 = 1
+	var otherUserID uint32 = 2
+
+	authored := models.Comment{ID: commentID, UserID: &authorID}
+
+	testTable := []struct {
+		name          string
+		userID        uint32
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name:   "Common",
+			userID: authorID,
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, commentID).Return(&authored, nil)
+				cr.EXPECT().UpdateText(ctx, commentID, text).Return(nil)
+			},
+		},
+		{
+			name:   "Not Author",
+			userID: otherUserID,
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, commentID).Return(&authored, nil)
+			},
+			expectError:   true,
+			expectedError: &models.ForbiddenUserError{},
+		},
+		{
+			name:   "Update Issue",
+			userID: authorID,
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, commentID).Return(&authored, nil)
+				cr.EXPECT().UpdateText(ctx, commentID, text).Return(errors.New(""))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(cr)
+
+			err := u.Update(ctx, commentID, text, tc.userID)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				if tc.expectedError != nil {
+					assert.ErrorContains(t, err, tc.expectedError.Error())
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCommentUsecase_Delete(t *testing.T) {
+	type mockBehavior func(cr *commentMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	cr := commentMocks.NewMockRepository(c)
+
+	u := NewUsecase(cr, trackMocks.NewMockRepository(c), albumMocks.NewMockRepository(c),
+		playlistMocks.NewMockRepository(c), testConfig)
+
+	const commentID uint32 = 10
+	var authorID uint32 = 1
+	var otherUserID uint32 = 2
+
+	authored := models.Comment{ID: commentID, UserID: &authorID}
+	// Author of orphaned comment was deleted
+	orphaned := models.Comment{ID: commentID}
+
+	testTable := []struct {
+		name          string
+		userID        uint32
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name:   "By Author",
+			userID: authorID,
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, commentID).Return(&authored, nil)
+				cr.EXPECT().MarkDeleted(ctx, commentID).Return(nil)
+			},
+		},
+		{
+			name:   "By Moderator",
+			userID: otherUserID,
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, commentID).Return(&authored, nil)
+				cr.EXPECT().IsModerator(ctx, otherUserID).Return(true, nil)
+				cr.EXPECT().MarkDeleted(ctx, commentID).Return(nil)
+			},
+		},
+		{
+			name:   "Orphaned By Moderator",
+			userID: otherUserID,
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, commentID).Return(&orphaned, nil)
+				cr.EXPECT().IsModerator(ctx, otherUserID).Return(true, nil)
+				cr.EXPECT().MarkDeleted(ctx, commentID).Return(nil)
+			},
+		},
+		{
+			name:   "No Rights",
+			userID: otherUserID,
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, commentID).Return(&authored, nil)
+				cr.EXPECT().IsModerator(ctx, otherUserID).Return(false, nil)
+			},
+			expectError:   true,
+			expectedError: &models.ForbiddenUserError{},
+		},
+		{
+			name:   "No Such Comment",
+			userID: authorID,
+			mockBehavior: func(cr *commentMocks.MockRepository) {
+				cr.EXPECT().GetByID(ctx, commentID).Return(nil, &models.NoSuchCommentError{CommentID: commentID})
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchCommentError{CommentID: commentID},
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(cr)
+
+			err := u.Delete(ctx, commentID, tc.userID)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				if tc.expectedError != nil {
+					assert.ErrorContains(t, err, tc.expectedError.Error())
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
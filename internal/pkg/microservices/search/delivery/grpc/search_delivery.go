@@ -1,9 +1,14 @@
 package grpc
 
 import (
+	"context"
+	"errors"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
 	proto "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/microservices/search/proto/generated"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
@@ -24,20 +29,13 @@ func NewSearchGRPC(searchServices search.Usecase, l logger.Logger) proto.SearchS
 }
 
 func (s *searchGRPC) FindAlbums(msg *proto.SearchMsg, stream proto.Search_FindAlbumsServer) error {
-	albums, err := s.searchServices.FindAlbums(stream.Context(), msg.Query, msg.Amount)
+	albums, err := s.searchServices.FindAlbums(stream.Context(), msg.Query, msg.Amount, msg.UserID, filtersFromProto(msg.Filters))
 	if err != nil {
 		return status.Error(codes.Internal, err.Error())
 	}
 
 	for _, album := range albums {
-		resp := &proto.AlbumResponse{
-			Id:          album.ID,
-			Name:        album.Name,
-			Description: nilCheckString(album.Description),
-			CoverSrc:    album.CoverSrc,
-		}
-
-		if err := stream.Send(resp); err != nil {
+		if err := stream.Send(albumToProto(album)); err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -46,24 +44,13 @@ func (s *searchGRPC) FindAlbums(msg *proto.SearchMsg, stream proto.Search_FindAl
 }
 
 func (s *searchGRPC) FindTracks(msg *proto.SearchMsg, stream proto.Search_FindTracksServer) error {
-	tracks, err := s.searchServices.FindTracks(stream.Context(), msg.Query, msg.Amount)
+	tracks, err := s.searchServices.FindTracks(stream.Context(), msg.Query, msg.Amount, msg.UserID, filtersFromProto(msg.Filters))
 	if err != nil {
 		return status.Error(codes.Internal, err.Error())
 	}
 
 	for _, track := range tracks {
-		resp := &proto.TrackResponse{
-			Id:            track.ID,
-			Name:          track.Name,
-			AlbumID:       nilCheckUint32(track.AlbumID),
-			AlbumPosition: nilCheckUint32(track.AlbumPosition),
-			CoverSrc:      track.CoverSrc,
-			RecordSrc:     track.RecordSrc,
-			Duration:      track.Duration,
-			Listens:       track.Listens,
-		}
-
-		if err := stream.Send(resp); err != nil {
+		if err := stream.Send(trackToProto(track)); err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -72,20 +59,13 @@ func (s *searchGRPC) FindTracks(msg *proto.SearchMsg, stream proto.Search_FindTr
 }
 
 func (s *searchGRPC) FindArtists(msg *proto.SearchMsg, stream proto.Search_FindArtistsServer) error {
-	artists, err := s.searchServices.FindArtists(stream.Context(), msg.Query, msg.Amount)
+	artists, err := s.searchServices.FindArtists(stream.Context(), msg.Query, msg.Amount, msg.UserID, filtersFromProto(msg.Filters))
 	if err != nil {
 		return status.Error(codes.Internal, err.Error())
 	}
 
 	for _, artist := range artists {
-		resp := &proto.ArtistResponse{
-			Id:        artist.ID,
-			UserID:    nilCheckUint32(artist.UserID),
-			Name:      artist.Name,
-			AvatarSrc: artist.AvatarSrc,
-		}
-
-		if err := stream.Send(resp); err != nil {
+		if err := stream.Send(artistToProto(artist)); err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -94,20 +74,13 @@ func (s *searchGRPC) FindArtists(msg *proto.SearchMsg, stream proto.Search_FindA
 }
 
 func (s *searchGRPC) FindPlaylists(msg *proto.SearchMsg, stream proto.Search_FindPlaylistsServer) error {
-	playlists, err := s.searchServices.FindPlaylists(stream.Context(), msg.Query, msg.Amount)
+	playlists, err := s.searchServices.FindPlaylists(stream.Context(), msg.Query, msg.Amount, msg.UserID, filtersFromProto(msg.Filters))
 	if err != nil {
 		return status.Error(codes.Internal, err.Error())
 	}
 
 	for _, playlist := range playlists {
-		resp := &proto.PlaylistResponse{
-			Id:          playlist.ID,
-			Name:        playlist.Name,
-			Description: nilCheckString(playlist.Description),
-			CoverSrc:    playlist.CoverSrc,
-		}
-
-		if err := stream.Send(resp); err != nil {
+		if err := stream.Send(playlistToProto(playlist)); err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -115,6 +88,229 @@ func (s *searchGRPC) FindPlaylists(msg *proto.SearchMsg, stream proto.Search_Fin
 	return nil
 }
 
+func (s *searchGRPC) Search(ctx context.Context, msg *proto.SearchMsg) (*proto.SearchResponse, error) {
+	result, err := s.searchServices.Search(ctx, msg.Query, msg.Amount, msg.UserID, filtersFromProto(msg.Filters))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &proto.SearchResponse{
+		Albums:    make([]*proto.AlbumResponse, 0, len(result.Albums)),
+		Artists:   make([]*proto.ArtistResponse, 0, len(result.Artists)),
+		Tracks:    make([]*proto.TrackResponse, 0, len(result.Tracks)),
+		Playlists: make([]*proto.PlaylistResponse, 0, len(result.Playlists)),
+	}
+	for _, album := range result.Albums {
+		resp.Albums = append(resp.Albums, albumToProto(album))
+	}
+	for _, artist := range result.Artists {
+		resp.Artists = append(resp.Artists, artistToProto(artist))
+	}
+	for _, track := range result.Tracks {
+		resp.Tracks = append(resp.Tracks, trackToProto(track))
+	}
+	for _, playlist := range result.Playlists {
+		resp.Playlists = append(resp.Playlists, playlistToProto(playlist))
+	}
+	if result.Top != nil {
+		resp.Top = &proto.TopResult{
+			Type: result.Top.Type,
+			Id:   result.Top.ID,
+		}
+	}
+	if result.Facets != nil {
+		resp.Facets = facetsToProto(*result.Facets)
+	}
+
+	return resp, nil
+}
+
+func (s *searchGRPC) Suggest(ctx context.Context, msg *proto.SearchMsg) (*proto.SuggestResponse, error) {
+	suggestions, err := s.searchServices.Suggest(ctx, msg.Query, msg.Amount, msg.UserID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &proto.SuggestResponse{
+		Suggestions: make([]*proto.Suggestion, 0, len(suggestions)),
+	}
+	for _, suggestion := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, &proto.Suggestion{
+			Type: suggestion.Type,
+			Id:   suggestion.ID,
+			Name: suggestion.Name,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *searchGRPC) AddRecentSearch(ctx context.Context,
+	msg *proto.AddRecentSearchMsg) (*proto.AddRecentSearchResponse, error) {
+
+	if err := s.searchServices.AddRecentSearch(ctx, msg.UserID, recentSearchFromProto(msg.Recent)); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.AddRecentSearchResponse{}, nil
+}
+
+func (s *searchGRPC) GetRecentSearches(ctx context.Context,
+	msg *proto.GetRecentSearchesMsg) (*proto.GetRecentSearchesResponse, error) {
+
+	recents, err := s.searchServices.GetRecentSearches(ctx, msg.UserID, msg.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &proto.GetRecentSearchesResponse{
+		Recents: make([]*proto.RecentSearch, 0, len(recents)),
+	}
+	for _, recent := range recents {
+		resp.Recents = append(resp.Recents, recentSearchToProto(recent))
+	}
+
+	return resp, nil
+}
+
+func (s *searchGRPC) DeleteRecentSearch(ctx context.Context,
+	msg *proto.DeleteRecentSearchMsg) (*proto.DeleteRecentSearchResponse, error) {
+
+	if err := s.searchServices.DeleteRecentSearch(ctx, msg.RecentSearchID, msg.UserID); err != nil {
+		var errNoSuchRecentSearch *models.NoSuchRecentSearchError
+		if errors.As(err, &errNoSuchRecentSearch) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.DeleteRecentSearchResponse{}, nil
+}
+
+func (s *searchGRPC) ClearRecentSearches(ctx context.Context,
+	msg *proto.ClearRecentSearchesMsg) (*proto.ClearRecentSearchesResponse, error) {
+
+	if err := s.searchServices.ClearRecentSearches(ctx, msg.UserID); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.ClearRecentSearchesResponse{}, nil
+}
+
+func albumToProto(album models.Album) *proto.AlbumResponse {
+	return &proto.AlbumResponse{
+		Id:          album.ID,
+		Name:        album.Name,
+		Description: nilCheckString(album.Description),
+		CoverSrc:    album.CoverSrc,
+		Highlight:   album.Highlight,
+	}
+}
+
+func artistToProto(artist models.Artist) *proto.ArtistResponse {
+	return &proto.ArtistResponse{
+		Id:        artist.ID,
+		UserID:    nilCheckUint32(artist.UserID),
+		Name:      artist.Name,
+		AvatarSrc: artist.AvatarSrc,
+		Highlight: artist.Highlight,
+	}
+}
+
+func trackToProto(track models.Track) *proto.TrackResponse {
+	return &proto.TrackResponse{
+		Id:            track.ID,
+		Name:          track.Name,
+		AlbumID:       nilCheckUint32(track.AlbumID),
+		AlbumPosition: nilCheckUint32(track.AlbumPosition),
+		CoverSrc:      track.CoverSrc,
+		RecordSrc:     track.RecordSrc,
+		Duration:      track.Duration,
+		Listens:       track.Listens,
+		Highlight:     track.Highlight,
+		LyricsSnippet: track.LyricsSnippet,
+	}
+}
+
+func playlistToProto(playlist models.Playlist) *proto.PlaylistResponse {
+	return &proto.PlaylistResponse{
+		Id:          playlist.ID,
+		Name:        playlist.Name,
+		Description: nilCheckString(playlist.Description),
+		CoverSrc:    playlist.CoverSrc,
+		Highlight:   playlist.Highlight,
+	}
+}
+
+func filtersFromProto(filters *proto.SearchFilters) models.SearchFilters {
+	if filters == nil {
+		return models.SearchFilters{}
+	}
+
+	f := models.SearchFilters{
+		MinDuration: filters.MinDuration,
+		MaxDuration: filters.MaxDuration,
+		TrackKind:   filters.TrackKind,
+		ArtistID:    filters.ArtistID,
+		OnlyLiked:   filters.OnlyLiked,
+	}
+	if filters.AddedAfter != nil {
+		addedAfter := filters.AddedAfter.AsTime()
+		f.AddedAfter = &addedAfter
+	}
+
+	return f
+}
+
+func facetsToProto(facets models.SearchFacets) *proto.SearchFacets {
+	facetsProto := &proto.SearchFacets{
+		Counts: &proto.SearchCounts{
+			Albums:    facets.Counts.Albums,
+			Artists:   facets.Counts.Artists,
+			Tracks:    facets.Counts.Tracks,
+			Playlists: facets.Counts.Playlists,
+		},
+		Artists: make([]*proto.ArtistFacet, 0, len(facets.Artists)),
+	}
+	for _, artist := range facets.Artists {
+		facetsProto.Artists = append(facetsProto.Artists, &proto.ArtistFacet{
+			ArtistID: artist.ArtistID,
+			Name:     artist.Name,
+			Count:    artist.Count,
+		})
+	}
+
+	return facetsProto
+}
+
+func recentSearchFromProto(recentProto *proto.RecentSearch) models.RecentSearch {
+	if recentProto == nil {
+		return models.RecentSearch{}
+	}
+
+	recent := models.RecentSearch{
+		Query: recentProto.Query,
+	}
+	if recentProto.EntityType != "" {
+		entityType, entityID := recentProto.EntityType, recentProto.EntityID
+		recent.EntityType = &entityType
+		recent.EntityID = &entityID
+	}
+
+	return recent
+}
+
+func recentSearchToProto(recent models.RecentSearch) *proto.RecentSearch {
+	return &proto.RecentSearch{
+		Id:         recent.ID,
+		Query:      recent.Query,
+		EntityType: nilCheckString(recent.EntityType),
+		EntityID:   nilCheckUint32(recent.EntityID),
+		EntityName: nilCheckString(recent.EntityName),
+		SearchedAt: timestamppb.New(recent.SearchedAt),
+	}
+}
+
 func nilCheckUint32(val *uint32) uint32 {
 	if val == nil {
 		return 0
@@ -7,6 +7,7 @@
 package generated
 
 import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,19 +21,109 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Zero values of filters mean they aren't applied
+type SearchFilters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinDuration uint32               `protobuf:"varint,1,opt,name=minDuration,proto3" json:"minDuration,omitempty"`
+	MaxDuration uint32               `protobuf:"varint,2,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`
+	TrackKind   string               `protobuf:"bytes,3,opt,name=trackKind,proto3" json:"trackKind,omitempty"`
+	ArtistID    uint32               `protobuf:"varint,4,opt,name=artistID,proto3" json:"artistID,omitempty"`
+	OnlyLiked   bool                 `protobuf:"varint,5,opt,name=onlyLiked,proto3" json:"onlyLiked,omitempty"`
+	AddedAfter  *timestamp.Timestamp `protobuf:"bytes,6,opt,name=addedAfter,proto3" json:"addedAfter,omitempty"`
+}
+
+func (x *SearchFilters) Reset() {
+	*x = SearchFilters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilters) ProtoMessage() {}
+
+func (x *SearchFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilters.ProtoReflect.Descriptor instead.
+func (*SearchFilters) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchFilters) GetMinDuration() uint32 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *SearchFilters) GetMaxDuration() uint32 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *SearchFilters) GetTrackKind() string {
+	if x != nil {
+		return x.TrackKind
+	}
+	return ""
+}
+
+func (x *SearchFilters) GetArtistID() uint32 {
+	if x != nil {
+		return x.ArtistID
+	}
+	return 0
+}
+
+func (x *SearchFilters) GetOnlyLiked() bool {
+	if x != nil {
+		return x.OnlyLiked
+	}
+	return false
+}
+
+func (x *SearchFilters) GetAddedAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.AddedAfter
+	}
+	return nil
+}
+
 type SearchMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Amount uint32 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Query   string         `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Amount  uint32         `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	UserID  uint32         `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"` // 0 if user is unauthorized
+	Filters *SearchFilters `protobuf:"bytes,4,opt,name=filters,proto3" json:"filters,omitempty"`
 }
 
 func (x *SearchMsg) Reset() {
 	*x = SearchMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_proto_msgTypes[0]
+		mi := &file_search_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -45,7 +136,7 @@ func (x *SearchMsg) String() string {
 func (*SearchMsg) ProtoMessage() {}
 
 func (x *SearchMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[0]
+	mi := &file_search_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +149,7 @@ func (x *SearchMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchMsg.ProtoReflect.Descriptor instead.
 func (*SearchMsg) Descriptor() ([]byte, []int) {
-	return file_search_proto_rawDescGZIP(), []int{0}
+	return file_search_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *SearchMsg) GetQuery() string {
@@ -75,6 +166,20 @@ func (x *SearchMsg) GetAmount() uint32 {
 	return 0
 }
 
+func (x *SearchMsg) GetUserID() uint32 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *SearchMsg) GetFilters() *SearchFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
 type AlbumResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -84,12 +189,13 @@ type AlbumResponse struct {
 	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	CoverSrc    string `protobuf:"bytes,4,opt,name=coverSrc,proto3" json:"coverSrc,omitempty"`
+	Highlight   string `protobuf:"bytes,5,opt,name=highlight,proto3" json:"highlight,omitempty"`
 }
 
 func (x *AlbumResponse) Reset() {
 	*x = AlbumResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_proto_msgTypes[1]
+		mi := &file_search_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -102,7 +208,7 @@ func (x *AlbumResponse) String() string {
 func (*AlbumResponse) ProtoMessage() {}
 
 func (x *AlbumResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[1]
+	mi := &file_search_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -115,7 +221,7 @@ func (x *AlbumResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumResponse.ProtoReflect.Descriptor instead.
 func (*AlbumResponse) Descriptor() ([]byte, []int) {
-	return file_search_proto_rawDescGZIP(), []int{1}
+	return file_search_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AlbumResponse) GetId() uint32 {
@@ -146,6 +252,13 @@ func (x *AlbumResponse) GetCoverSrc() string {
 	return ""
 }
 
+func (x *AlbumResponse) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
+
 type TrackResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -159,12 +272,14 @@ type TrackResponse struct {
 	RecordSrc     string `protobuf:"bytes,6,opt,name=recordSrc,proto3" json:"recordSrc,omitempty"`
 	Duration      uint32 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
 	Listens       uint32 `protobuf:"varint,8,opt,name=listens,proto3" json:"listens,omitempty"`
+	Highlight     string `protobuf:"bytes,9,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	LyricsSnippet string `protobuf:"bytes,10,opt,name=lyricsSnippet,proto3" json:"lyricsSnippet,omitempty"`
 }
 
 func (x *TrackResponse) Reset() {
 	*x = TrackResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_proto_msgTypes[2]
+		mi := &file_search_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -177,7 +292,7 @@ func (x *TrackResponse) String() string {
 func (*TrackResponse) ProtoMessage() {}
 
 func (x *TrackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[2]
+	mi := &file_search_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -190,7 +305,7 @@ func (x *TrackResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackResponse.ProtoReflect.Descriptor instead.
 func (*TrackResponse) Descriptor() ([]byte, []int) {
-	return file_search_proto_rawDescGZIP(), []int{2}
+	return file_search_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TrackResponse) GetId() uint32 {
@@ -249,6 +364,20 @@ func (x *TrackResponse) GetListens() uint32 {
 	return 0
 }
 
+func (x *TrackResponse) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
+
+func (x *TrackResponse) GetLyricsSnippet() string {
+	if x != nil {
+		return x.LyricsSnippet
+	}
+	return ""
+}
+
 type PlaylistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -258,12 +387,13 @@ type PlaylistResponse struct {
 	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	CoverSrc    string `protobuf:"bytes,4,opt,name=coverSrc,proto3" json:"coverSrc,omitempty"`
+	Highlight   string `protobuf:"bytes,5,opt,name=highlight,proto3" json:"highlight,omitempty"`
 }
 
 func (x *PlaylistResponse) Reset() {
 	*x = PlaylistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_proto_msgTypes[3]
+		mi := &file_search_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -276,7 +406,7 @@ func (x *PlaylistResponse) String() string {
 func (*PlaylistResponse) ProtoMessage() {}
 
 func (x *PlaylistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[3]
+	mi := &file_search_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +419,7 @@ func (x *PlaylistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaylistResponse.ProtoReflect.Descriptor instead.
 func (*PlaylistResponse) Descriptor() ([]byte, []int) {
-	return file_search_proto_rawDescGZIP(), []int{3}
+	return file_search_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PlaylistResponse) GetId() uint32 {
@@ -320,6 +450,13 @@ func (x *PlaylistResponse) GetCoverSrc() string {
 	return ""
 }
 
+func (x *PlaylistResponse) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
+
 type ArtistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -329,12 +466,13 @@ type ArtistResponse struct {
 	UserID    uint32 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
 	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	AvatarSrc string `protobuf:"bytes,4,opt,name=avatarSrc,proto3" json:"avatarSrc,omitempty"`
+	Highlight string `protobuf:"bytes,5,opt,name=highlight,proto3" json:"highlight,omitempty"`
 }
 
 func (x *ArtistResponse) Reset() {
 	*x = ArtistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_search_proto_msgTypes[4]
+		mi := &file_search_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -347,7 +485,7 @@ func (x *ArtistResponse) String() string {
 func (*ArtistResponse) ProtoMessage() {}
 
 func (x *ArtistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_search_proto_msgTypes[4]
+	mi := &file_search_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +498,7 @@ func (x *ArtistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistResponse.ProtoReflect.Descriptor instead.
 func (*ArtistResponse) Descriptor() ([]byte, []int) {
-	return file_search_proto_rawDescGZIP(), []int{4}
+	return file_search_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ArtistResponse) GetId() uint32 {
@@ -391,161 +529,1469 @@ func (x *ArtistResponse) GetAvatarSrc() string {
 	return ""
 }
 
-var File_search_proto protoreflect.FileDescriptor
+func (x *ArtistResponse) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
 
-var file_search_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04,
-	0x61, 0x75, 0x74, 0x68, 0x22, 0x39, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73,
-	0x67, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x71, 0x0a, 0x0d, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53,
-	0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53,
-	0x72, 0x63, 0x22, 0xe3, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x62, 0x75,
-	0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d,
-	0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x61, 0x6c, 0x62, 0x75, 0x6d,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x53, 0x72, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x53, 0x72, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x72,
-	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53,
-	0x72, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x22, 0x74, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79,
-	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x72, 0x63, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x72, 0x63, 0x22, 0x6a,
-	0x0a, 0x0e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x53, 0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x53, 0x72, 0x63, 0x32, 0xf0, 0x01, 0x0a, 0x06, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x62,
-	0x75, 0x6d, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x4d, 0x73, 0x67, 0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x6c, 0x62, 0x75,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x36, 0x0a,
-	0x0a, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x1a, 0x13, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61,
-	0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50,
-	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x4d, 0x73, 0x67, 0x1a, 0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x18, 0x5a,
-	0x16, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type TopResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id   uint32 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-var (
-	file_search_proto_rawDescOnce sync.Once
-	file_search_proto_rawDescData = file_search_proto_rawDesc
-)
+func (x *TopResult) Reset() {
+	*x = TopResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_search_proto_rawDescGZIP() []byte {
-	file_search_proto_rawDescOnce.Do(func() {
-		file_search_proto_rawDescData = protoimpl.X.CompressGZIP(file_search_proto_rawDescData)
-	})
-	return file_search_proto_rawDescData
+func (x *TopResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_search_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_search_proto_goTypes = []interface{}{
-	(*SearchMsg)(nil),        // 0: auth.SearchMsg
-	(*AlbumResponse)(nil),    // 1: auth.AlbumResponse
-	(*TrackResponse)(nil),    // 2: auth.TrackResponse
-	(*PlaylistResponse)(nil), // 3: auth.PlaylistResponse
-	(*ArtistResponse)(nil),   // 4: auth.ArtistResponse
+func (*TopResult) ProtoMessage() {}
+
+func (x *TopResult) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_search_proto_depIdxs = []int32{
-	0, // 0: auth.Search.FindAlbums:input_type -> auth.SearchMsg
-	0, // 1: auth.Search.FindTracks:input_type -> auth.SearchMsg
-	0, // 2: auth.Search.FindPlaylists:input_type -> auth.SearchMsg
-	0, // 3: auth.Search.FindArtists:input_type -> auth.SearchMsg
-	1, // 4: auth.Search.FindAlbums:output_type -> auth.AlbumResponse
-	2, // 5: auth.Search.FindTracks:output_type -> auth.TrackResponse
-	3, // 6: auth.Search.FindPlaylists:output_type -> auth.PlaylistResponse
-	4, // 7: auth.Search.FindArtists:output_type -> auth.ArtistResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use TopResult.ProtoReflect.Descriptor instead.
+func (*TopResult) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{6}
 }
 
-func init() { file_search_proto_init() }
-func file_search_proto_init() {
-	if File_search_proto != nil {
-		return
+func (x *TopResult) GetType() string {
+	if x != nil {
+		return x.Type
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_search_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_search_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_search_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrackResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_search_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaylistResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *TopResult) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SearchCounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Albums    uint32 `protobuf:"varint,1,opt,name=albums,proto3" json:"albums,omitempty"`
+	Artists   uint32 `protobuf:"varint,2,opt,name=artists,proto3" json:"artists,omitempty"`
+	Tracks    uint32 `protobuf:"varint,3,opt,name=tracks,proto3" json:"tracks,omitempty"`
+	Playlists uint32 `protobuf:"varint,4,opt,name=playlists,proto3" json:"playlists,omitempty"`
+}
+
+func (x *SearchCounts) Reset() {
+	*x = SearchCounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCounts) ProtoMessage() {}
+
+func (x *SearchCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_search_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistResponse); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCounts.ProtoReflect.Descriptor instead.
+func (*SearchCounts) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchCounts) GetAlbums() uint32 {
+	if x != nil {
+		return x.Albums
+	}
+	return 0
+}
+
+func (x *SearchCounts) GetArtists() uint32 {
+	if x != nil {
+		return x.Artists
+	}
+	return 0
+}
+
+func (x *SearchCounts) GetTracks() uint32 {
+	if x != nil {
+		return x.Tracks
+	}
+	return 0
+}
+
+func (x *SearchCounts) GetPlaylists() uint32 {
+	if x != nil {
+		return x.Playlists
+	}
+	return 0
+}
+
+type ArtistFacet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistID uint32 `protobuf:"varint,1,opt,name=artistID,proto3" json:"artistID,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count    uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ArtistFacet) Reset() {
+	*x = ArtistFacet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtistFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistFacet) ProtoMessage() {}
+
+func (x *ArtistFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistFacet.ProtoReflect.Descriptor instead.
+func (*ArtistFacet) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ArtistFacet) GetArtistID() uint32 {
+	if x != nil {
+		return x.ArtistID
+	}
+	return 0
+}
+
+func (x *ArtistFacet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArtistFacet) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SearchFacets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts  *SearchCounts  `protobuf:"bytes,1,opt,name=counts,proto3" json:"counts,omitempty"`
+	Artists []*ArtistFacet `protobuf:"bytes,2,rep,name=artists,proto3" json:"artists,omitempty"`
+}
+
+func (x *SearchFacets) Reset() {
+	*x = SearchFacets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacets) ProtoMessage() {}
+
+func (x *SearchFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacets.ProtoReflect.Descriptor instead.
+func (*SearchFacets) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchFacets) GetCounts() *SearchCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetArtists() []*ArtistFacet {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Albums    []*AlbumResponse    `protobuf:"bytes,1,rep,name=albums,proto3" json:"albums,omitempty"`
+	Artists   []*ArtistResponse   `protobuf:"bytes,2,rep,name=artists,proto3" json:"artists,omitempty"`
+	Tracks    []*TrackResponse    `protobuf:"bytes,3,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	Playlists []*PlaylistResponse `protobuf:"bytes,4,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	Top       *TopResult          `protobuf:"bytes,5,opt,name=top,proto3" json:"top,omitempty"`
+	Facets    *SearchFacets       `protobuf:"bytes,6,opt,name=facets,proto3" json:"facets,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchResponse) GetAlbums() []*AlbumResponse {
+	if x != nil {
+		return x.Albums
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetArtists() []*ArtistResponse {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetTracks() []*TrackResponse {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetPlaylists() []*PlaylistResponse {
+	if x != nil {
+		return x.Playlists
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetTop() *TopResult {
+	if x != nil {
+		return x.Top
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetFacets() *SearchFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type Suggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id   uint32 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Suggestion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Suggestion) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Suggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SuggestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suggestions []*Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+}
+
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestResponse) ProtoMessage() {}
+
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SuggestResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// Clicked result is set only if entityType isn't empty
+type RecentSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query      string               `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	EntityType string               `protobuf:"bytes,3,opt,name=entityType,proto3" json:"entityType,omitempty"`
+	EntityID   uint32               `protobuf:"varint,4,opt,name=entityID,proto3" json:"entityID,omitempty"`
+	EntityName string               `protobuf:"bytes,5,opt,name=entityName,proto3" json:"entityName,omitempty"` // empty if entity was deleted
+	SearchedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=searchedAt,proto3" json:"searchedAt,omitempty"`
+}
+
+func (x *RecentSearch) Reset() {
+	*x = RecentSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecentSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecentSearch) ProtoMessage() {}
+
+func (x *RecentSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecentSearch.ProtoReflect.Descriptor instead.
+func (*RecentSearch) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RecentSearch) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RecentSearch) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *RecentSearch) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *RecentSearch) GetEntityID() uint32 {
+	if x != nil {
+		return x.EntityID
+	}
+	return 0
+}
+
+func (x *RecentSearch) GetEntityName() string {
+	if x != nil {
+		return x.EntityName
+	}
+	return ""
+}
+
+func (x *RecentSearch) GetSearchedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.SearchedAt
+	}
+	return nil
+}
+
+type AddRecentSearchMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint32        `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Recent *RecentSearch `protobuf:"bytes,2,opt,name=recent,proto3" json:"recent,omitempty"`
+}
+
+func (x *AddRecentSearchMsg) Reset() {
+	*x = AddRecentSearchMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRecentSearchMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRecentSearchMsg) ProtoMessage() {}
+
+func (x *AddRecentSearchMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecentSearchMsg.ProtoReflect.Descriptor instead.
+func (*AddRecentSearchMsg) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddRecentSearchMsg) GetUserID() uint32 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddRecentSearchMsg) GetRecent() *RecentSearch {
+	if x != nil {
+		return x.Recent
+	}
+	return nil
+}
+
+type AddRecentSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddRecentSearchResponse) Reset() {
+	*x = AddRecentSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRecentSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRecentSearchResponse) ProtoMessage() {}
+
+func (x *AddRecentSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecentSearchResponse.ProtoReflect.Descriptor instead.
+func (*AddRecentSearchResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{15}
+}
+
+type GetRecentSearchesMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint32 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Limit  uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetRecentSearchesMsg) Reset() {
+	*x = GetRecentSearchesMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentSearchesMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentSearchesMsg) ProtoMessage() {}
+
+func (x *GetRecentSearchesMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentSearchesMsg.ProtoReflect.Descriptor instead.
+func (*GetRecentSearchesMsg) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetRecentSearchesMsg) GetUserID() uint32 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetRecentSearchesMsg) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRecentSearchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recents []*RecentSearch `protobuf:"bytes,1,rep,name=recents,proto3" json:"recents,omitempty"`
+}
+
+func (x *GetRecentSearchesResponse) Reset() {
+	*x = GetRecentSearchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentSearchesResponse) ProtoMessage() {}
+
+func (x *GetRecentSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentSearchesResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetRecentSearchesResponse) GetRecents() []*RecentSearch {
+	if x != nil {
+		return x.Recents
+	}
+	return nil
+}
+
+type DeleteRecentSearchMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecentSearchID uint32 `protobuf:"varint,1,opt,name=recentSearchID,proto3" json:"recentSearchID,omitempty"`
+	UserID         uint32 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *DeleteRecentSearchMsg) Reset() {
+	*x = DeleteRecentSearchMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRecentSearchMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecentSearchMsg) ProtoMessage() {}
+
+func (x *DeleteRecentSearchMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecentSearchMsg.ProtoReflect.Descriptor instead.
+func (*DeleteRecentSearchMsg) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteRecentSearchMsg) GetRecentSearchID() uint32 {
+	if x != nil {
+		return x.RecentSearchID
+	}
+	return 0
+}
+
+func (x *DeleteRecentSearchMsg) GetUserID() uint32 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type DeleteRecentSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteRecentSearchResponse) Reset() {
+	*x = DeleteRecentSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRecentSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecentSearchResponse) ProtoMessage() {}
+
+func (x *DeleteRecentSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecentSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecentSearchResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{19}
+}
+
+type ClearRecentSearchesMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint32 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ClearRecentSearchesMsg) Reset() {
+	*x = ClearRecentSearchesMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRecentSearchesMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRecentSearchesMsg) ProtoMessage() {}
+
+func (x *ClearRecentSearchesMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRecentSearchesMsg.ProtoReflect.Descriptor instead.
+func (*ClearRecentSearchesMsg) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ClearRecentSearchesMsg) GetUserID() uint32 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type ClearRecentSearchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearRecentSearchesResponse) Reset() {
+	*x = ClearRecentSearchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_search_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearRecentSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRecentSearchesResponse) ProtoMessage() {}
+
+func (x *ClearRecentSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_search_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRecentSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ClearRecentSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_search_proto_rawDescGZIP(), []int{21}
+}
+
+var File_search_proto protoreflect.FileDescriptor
+
+var file_search_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04,
+	0x61, 0x75, 0x74, 0x68, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69,
+	0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x6e, 0x6c, 0x79, 0x4c, 0x69, 0x6b,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6e, 0x6c, 0x79, 0x4c, 0x69,
+	0x6b, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0x80, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0d, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x53, 0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x53, 0x72, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xa7, 0x02, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c,
+	0x62, 0x75, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x6c, 0x62,
+	0x75, 0x6d, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x61, 0x6c, 0x62,
+	0x75, 0x6d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x53, 0x72, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x53, 0x72, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x53, 0x72, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x53, 0x72, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69,
+	0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68,
+	0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x79, 0x72, 0x69,
+	0x63, 0x73, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x22, 0x92,
+	0x01, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x53, 0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x53, 0x72, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x53, 0x72, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x53, 0x72, 0x63,
+	0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x2f,
+	0x0a, 0x09, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x76, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x0b, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x46, 0x61, 0x63, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x0c,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x06,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x07, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x6c, 0x62, 0x75,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x61,
+	0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x70,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x03, 0x74, 0x6f, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x54, 0x6f, 0x70,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x03, 0x74, 0x6f, 0x70, 0x12, 0x2a, 0x0a, 0x06, 0x66,
+	0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52,
+	0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x22, 0x44, 0x0a, 0x0a, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a,
+	0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x58, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x06, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x19, 0x0a,
+	0x17, 0x41, 0x64, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x4d, 0x73, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x49,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d,
+	0x73, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x30, 0x0a, 0x16, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0xab, 0x05, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x0a,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x1a, 0x13, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4d, 0x73, 0x67, 0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0d,
+	0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x0f, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x1a, 0x16,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0b, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x1a, 0x14, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x0f,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x1a,
+	0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x12, 0x0f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4d, 0x73, 0x67, 0x1a, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x1a, 0x1f, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73,
+	0x67, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65,
+	0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x18, 0x5a, 0x16, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_search_proto_rawDescOnce sync.Once
+	file_search_proto_rawDescData = file_search_proto_rawDesc
+)
+
+func file_search_proto_rawDescGZIP() []byte {
+	file_search_proto_rawDescOnce.Do(func() {
+		file_search_proto_rawDescData = protoimpl.X.CompressGZIP(file_search_proto_rawDescData)
+	})
+	return file_search_proto_rawDescData
+}
+
+var file_search_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_search_proto_goTypes = []interface{}{
+	(*SearchFilters)(nil),               // 0: auth.SearchFilters
+	(*SearchMsg)(nil),                   // 1: auth.SearchMsg
+	(*AlbumResponse)(nil),               // 2: auth.AlbumResponse
+	(*TrackResponse)(nil),               // 3: auth.TrackResponse
+	(*PlaylistResponse)(nil),            // 4: auth.PlaylistResponse
+	(*ArtistResponse)(nil),              // 5: auth.ArtistResponse
+	(*TopResult)(nil),                   // 6: auth.TopResult
+	(*SearchCounts)(nil),                // 7: auth.SearchCounts
+	(*ArtistFacet)(nil),                 // 8: auth.ArtistFacet
+	(*SearchFacets)(nil),                // 9: auth.SearchFacets
+	(*SearchResponse)(nil),              // 10: auth.SearchResponse
+	(*Suggestion)(nil),                  // 11: auth.Suggestion
+	(*SuggestResponse)(nil),             // 12: auth.SuggestResponse
+	(*RecentSearch)(nil),                // 13: auth.RecentSearch
+	(*AddRecentSearchMsg)(nil),          // 14: auth.AddRecentSearchMsg
+	(*AddRecentSearchResponse)(nil),     // 15: auth.AddRecentSearchResponse
+	(*GetRecentSearchesMsg)(nil),        // 16: auth.GetRecentSearchesMsg
+	(*GetRecentSearchesResponse)(nil),   // 17: auth.GetRecentSearchesResponse
+	(*DeleteRecentSearchMsg)(nil),       // 18: auth.DeleteRecentSearchMsg
+	(*DeleteRecentSearchResponse)(nil),  // 19: auth.DeleteRecentSearchResponse
+	(*ClearRecentSearchesMsg)(nil),      // 20: auth.ClearRecentSearchesMsg
+	(*ClearRecentSearchesResponse)(nil), // 21: auth.ClearRecentSearchesResponse
+	(*timestamp.Timestamp)(nil),         // 22: google.protobuf.Timestamp
+}
+var file_search_proto_depIdxs = []int32{
+	22, // 0: auth.SearchFilters.addedAfter:type_name -> google.protobuf.Timestamp
+	0,  // 1: auth.SearchMsg.filters:type_name -> auth.SearchFilters
+	7,  // 2: auth.SearchFacets.counts:type_name -> auth.SearchCounts
+	8,  // 3: auth.SearchFacets.artists:type_name -> auth.ArtistFacet
+	2,  // 4: auth.SearchResponse.albums:type_name -> auth.AlbumResponse
+	5,  // 5: auth.SearchResponse.artists:type_name -> auth.ArtistResponse
+	3,  // 6: auth.SearchResponse.tracks:type_name -> auth.TrackResponse
+	4,  // 7: auth.SearchResponse.playlists:type_name -> auth.PlaylistResponse
+	6,  // 8: auth.SearchResponse.top:type_name -> auth.TopResult
+	9,  // 9: auth.SearchResponse.facets:type_name -> auth.SearchFacets
+	11, // 10: auth.SuggestResponse.suggestions:type_name -> auth.Suggestion
+	22, // 11: auth.RecentSearch.searchedAt:type_name -> google.protobuf.Timestamp
+	13, // 12: auth.AddRecentSearchMsg.recent:type_name -> auth.RecentSearch
+	13, // 13: auth.GetRecentSearchesResponse.recents:type_name -> auth.RecentSearch
+	1,  // 14: auth.Search.FindAlbums:input_type -> auth.SearchMsg
+	1,  // 15: auth.Search.FindTracks:input_type -> auth.SearchMsg
+	1,  // 16: auth.Search.FindPlaylists:input_type -> auth.SearchMsg
+	1,  // 17: auth.Search.FindArtists:input_type -> auth.SearchMsg
+	1,  // 18: auth.Search.Search:input_type -> auth.SearchMsg
+	1,  // 19: auth.Search.Suggest:input_type -> auth.SearchMsg
+	14, // 20: auth.Search.AddRecentSearch:input_type -> auth.AddRecentSearchMsg
+	16, // 21: auth.Search.GetRecentSearches:input_type -> auth.GetRecentSearchesMsg
+	18, // 22: auth.Search.DeleteRecentSearch:input_type -> auth.DeleteRecentSearchMsg
+	20, // 23: auth.Search.ClearRecentSearches:input_type -> auth.ClearRecentSearchesMsg
+	2,  // 24: auth.Search.FindAlbums:output_type -> auth.AlbumResponse
+	3,  // 25: auth.Search.FindTracks:output_type -> auth.TrackResponse
+	4,  // 26: auth.Search.FindPlaylists:output_type -> auth.PlaylistResponse
+	5,  // 27: auth.Search.FindArtists:output_type -> auth.ArtistResponse
+	10, // 28: auth.Search.Search:output_type -> auth.SearchResponse
+	12, // 29: auth.Search.Suggest:output_type -> auth.SuggestResponse
+	15, // 30: auth.Search.AddRecentSearch:output_type -> auth.AddRecentSearchResponse
+	17, // 31: auth.Search.GetRecentSearches:output_type -> auth.GetRecentSearchesResponse
+	19, // 32: auth.Search.DeleteRecentSearch:output_type -> auth.DeleteRecentSearchResponse
+	21, // 33: auth.Search.ClearRecentSearches:output_type -> auth.ClearRecentSearchesResponse
+	24, // [24:34] is the sub-list for method output_type
+	14, // [14:24] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_search_proto_init() }
+func file_search_proto_init() {
+	if File_search_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_search_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFilters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlbumResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtistFacet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFacets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Suggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecentSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRecentSearchMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRecentSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentSearchesMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentSearchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRecentSearchMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRecentSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRecentSearchesMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_search_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearRecentSearchesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -563,7 +2009,7 @@ func file_search_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_search_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,12 @@ type SearchClient interface {
 	FindTracks(ctx context.Context, in *SearchMsg, opts ...grpc.CallOption) (Search_FindTracksClient, error)
 	FindPlaylists(ctx context.Context, in *SearchMsg, opts ...grpc.CallOption) (Search_FindPlaylistsClient, error)
 	FindArtists(ctx context.Context, in *SearchMsg, opts ...grpc.CallOption) (Search_FindArtistsClient, error)
+	Search(ctx context.Context, in *SearchMsg, opts ...grpc.CallOption) (*SearchResponse, error)
+	Suggest(ctx context.Context, in *SearchMsg, opts ...grpc.CallOption) (*SuggestResponse, error)
+	AddRecentSearch(ctx context.Context, in *AddRecentSearchMsg, opts ...grpc.CallOption) (*AddRecentSearchResponse, error)
+	GetRecentSearches(ctx context.Context, in *GetRecentSearchesMsg, opts ...grpc.CallOption) (*GetRecentSearchesResponse, error)
+	DeleteRecentSearch(ctx context.Context, in *DeleteRecentSearchMsg, opts ...grpc.CallOption) (*DeleteRecentSearchResponse, error)
+	ClearRecentSearches(ctx context.Context, in *ClearRecentSearchesMsg, opts ...grpc.CallOption) (*ClearRecentSearchesResponse, error)
 }
 
 type searchClient struct {
@@ -164,6 +170,60 @@ func (x *searchFindArtistsClient) Recv() (*ArtistResponse, error) {
 	return m, nil
 }
 
+func (c *searchClient) Search(ctx context.Context, in *SearchMsg, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/auth.Search/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) Suggest(ctx context.Context, in *SearchMsg, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, "/auth.Search/Suggest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) AddRecentSearch(ctx context.Context, in *AddRecentSearchMsg, opts ...grpc.CallOption) (*AddRecentSearchResponse, error) {
+	out := new(AddRecentSearchResponse)
+	err := c.cc.Invoke(ctx, "/auth.Search/AddRecentSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) GetRecentSearches(ctx context.Context, in *GetRecentSearchesMsg, opts ...grpc.CallOption) (*GetRecentSearchesResponse, error) {
+	out := new(GetRecentSearchesResponse)
+	err := c.cc.Invoke(ctx, "/auth.Search/GetRecentSearches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) DeleteRecentSearch(ctx context.Context, in *DeleteRecentSearchMsg, opts ...grpc.CallOption) (*DeleteRecentSearchResponse, error) {
+	out := new(DeleteRecentSearchResponse)
+	err := c.cc.Invoke(ctx, "/auth.Search/DeleteRecentSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) ClearRecentSearches(ctx context.Context, in *ClearRecentSearchesMsg, opts ...grpc.CallOption) (*ClearRecentSearchesResponse, error) {
+	out := new(ClearRecentSearchesResponse)
+	err := c.cc.Invoke(ctx, "/auth.Search/ClearRecentSearches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SearchServer is the server API for Search service.
 // All implementations must embed UnimplementedSearchServer
 // for forward compatibility
@@ -172,6 +232,12 @@ type SearchServer interface {
 	FindTracks(*SearchMsg, Search_FindTracksServer) error
 	FindPlaylists(*SearchMsg, Search_FindPlaylistsServer) error
 	FindArtists(*SearchMsg, Search_FindArtistsServer) error
+	Search(context.Context, *SearchMsg) (*SearchResponse, error)
+	Suggest(context.Context, *SearchMsg) (*SuggestResponse, error)
+	AddRecentSearch(context.Context, *AddRecentSearchMsg) (*AddRecentSearchResponse, error)
+	GetRecentSearches(context.Context, *GetRecentSearchesMsg) (*GetRecentSearchesResponse, error)
+	DeleteRecentSearch(context.Context, *DeleteRecentSearchMsg) (*DeleteRecentSearchResponse, error)
+	ClearRecentSearches(context.Context, *ClearRecentSearchesMsg) (*ClearRecentSearchesResponse, error)
 	mustEmbedUnimplementedSearchServer()
 }
 
@@ -191,6 +257,24 @@ func (UnimplementedSearchServer) FindPlaylists(*SearchMsg, Search_FindPlaylistsS
 func (UnimplementedSearchServer) FindArtists(*SearchMsg, Search_FindArtistsServer) error {
 	return status.Errorf(codes.Unimplemented, "method FindArtists not implemented")
 }
+func (UnimplementedSearchServer) Search(context.Context, *SearchMsg) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSearchServer) Suggest(context.Context, *SearchMsg) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedSearchServer) AddRecentSearch(context.Context, *AddRecentSearchMsg) (*AddRecentSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRecentSearch not implemented")
+}
+func (UnimplementedSearchServer) GetRecentSearches(context.Context, *GetRecentSearchesMsg) (*GetRecentSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentSearches not implemented")
+}
+func (UnimplementedSearchServer) DeleteRecentSearch(context.Context, *DeleteRecentSearchMsg) (*DeleteRecentSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecentSearch not implemented")
+}
+func (UnimplementedSearchServer) ClearRecentSearches(context.Context, *ClearRecentSearchesMsg) (*ClearRecentSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearRecentSearches not implemented")
+}
 func (UnimplementedSearchServer) mustEmbedUnimplementedSearchServer() {}
 
 // UnsafeSearchServer may be embedded to opt out of forward compatibility for this service.
@@ -288,13 +372,146 @@ func (x *searchFindArtistsServer) Send(m *ArtistResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Search_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.Search/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).Search(ctx, req.(*SearchMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.Search/Suggest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).Suggest(ctx, req.(*SearchMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_AddRecentSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRecentSearchMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).AddRecentSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.Search/AddRecentSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).AddRecentSearch(ctx, req.(*AddRecentSearchMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_GetRecentSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentSearchesMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).GetRecentSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.Search/GetRecentSearches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).GetRecentSearches(ctx, req.(*GetRecentSearchesMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_DeleteRecentSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecentSearchMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).DeleteRecentSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.Search/DeleteRecentSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).DeleteRecentSearch(ctx, req.(*DeleteRecentSearchMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_ClearRecentSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRecentSearchesMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).ClearRecentSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.Search/ClearRecentSearches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).ClearRecentSearches(ctx, req.(*ClearRecentSearchesMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Search_ServiceDesc is the grpc.ServiceDesc for Search service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Search_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "auth.Search",
 	HandlerType: (*SearchServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _Search_Search_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _Search_Suggest_Handler,
+		},
+		{
+			MethodName: "AddRecentSearch",
+			Handler:    _Search_AddRecentSearch_Handler,
+		},
+		{
+			MethodName: "GetRecentSearches",
+			Handler:    _Search_GetRecentSearches_Handler,
+		},
+		{
+			MethodName: "DeleteRecentSearch",
+			Handler:    _Search_DeleteRecentSearch_Handler,
+		},
+		{
+			MethodName: "ClearRecentSearches",
+			Handler:    _Search_ClearRecentSearches_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "FindAlbums",
@@ -148,3 +148,106 @@ func (u *userGRPC) GetByPlaylist(ctx context.Context, msg *proto.GetByPlaylistMs
 
 	return &proto.GetByPlaylistResponse{Users: usersProto}, nil
 }
+
+func (u *userGRPC) Follow(ctx context.Context, msg *proto.FollowMsg) (*proto.FollowResponse, error) {
+	followed, err := u.userServices.Follow(ctx, msg.UserId, msg.FollowerId)
+	if err != nil {
+		var errFollowSelf *models.FollowSelfError
+		if errors.As(err, &errFollowSelf) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		var errNoSuchUser *models.NoSuchUserError
+		if errors.As(err, &errNoSuchUser) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.FollowResponse{Followed: followed}, nil
+}
+
+func (u *userGRPC) Unfollow(ctx context.Context, msg *proto.FollowMsg) (*proto.UnfollowResponse, error) {
+	unfollowed, err := u.userServices.Unfollow(ctx, msg.UserId, msg.FollowerId)
+	if err != nil {
+		var errNoSuchUser *models.NoSuchUserError
+		if errors.As(err, &errNoSuchUser) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.UnfollowResponse{Unfollowed: unfollowed}, nil
+}
+
+func (u *userGRPC) IsFollowed(ctx context.Context, msg *proto.FollowMsg) (*proto.IsFollowedResponse, error) {
+	isFollowed, err := u.userServices.IsFollowed(ctx, msg.UserId, msg.FollowerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.IsFollowedResponse{IsFollowed: isFollowed}, nil
+}
+
+func (u *userGRPC) GetFollowCounts(ctx context.Context, msg *proto.Id) (*proto.GetFollowCountsResponse, error) {
+	counts, err := u.userServices.GetFollowCounts(ctx, msg.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.GetFollowCountsResponse{
+		Followers: counts.Followers,
+		Following: counts.Following,
+	}, nil
+}
+
+func (u *userGRPC) GetFollowers(ctx context.Context, msg *proto.FollowListMsg) (*proto.FollowListResponse, error) {
+	users, total, err := u.userServices.GetFollowers(ctx, msg.UserId, protoToFavoritesQuery(msg))
+	if err != nil {
+		return nil, followListError(err)
+	}
+
+	return followListToProto(users, total), nil
+}
+
+func (u *userGRPC) GetFollowing(ctx context.Context, msg *proto.FollowListMsg) (*proto.FollowListResponse, error) {
+	users, total, err := u.userServices.GetFollowing(ctx, msg.UserId, protoToFavoritesQuery(msg))
+	if err != nil {
+		return nil, followListError(err)
+	}
+
+	return followListToProto(users, total), nil
+}
+
+func protoToFavoritesQuery(msg *proto.FollowListMsg) models.FavoritesQuery {
+	return models.FavoritesQuery{
+		SortBy: msg.SortBy,
+		Order:  msg.Order,
+		Filter: msg.Filter,
+		Limit:  msg.Limit,
+		Offset: msg.Offset,
+	}
+}
+
+func followListError(err error) error {
+	var errInvalidQuery *models.InvalidFavoritesQueryError
+	if errors.As(err, &errInvalidQuery) {
+		return status.Error(codes.InvalidArgument, errInvalidQuery.Reason)
+	}
+	var errNoSuchUser *models.NoSuchUserError
+	if errors.As(err, &errNoSuchUser) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func followListToProto(users []models.User, total uint32) *proto.FollowListResponse {
+	usersProto := make([]*commonProto.UserResponse, 0, len(users))
+	for _, u := range users {
+		usersProto = append(usersProto, commonProtoUtils.UserToProto(u))
+	}
+
+	return &proto.FollowListResponse{Users: usersProto, Total: total}
+}
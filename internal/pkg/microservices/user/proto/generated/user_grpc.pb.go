@@ -27,6 +27,12 @@ type UserClient interface {
 	UpdateInfo(ctx context.Context, in *UpdateInfoMsg, opts ...grpc.CallOption) (*UpdateInfoResponse, error)
 	UploadAvatar(ctx context.Context, opts ...grpc.CallOption) (User_UploadAvatarClient, error)
 	GetByPlaylist(ctx context.Context, in *GetByPlaylistMsg, opts ...grpc.CallOption) (*GetByPlaylistResponse, error)
+	Follow(ctx context.Context, in *FollowMsg, opts ...grpc.CallOption) (*FollowResponse, error)
+	Unfollow(ctx context.Context, in *FollowMsg, opts ...grpc.CallOption) (*UnfollowResponse, error)
+	IsFollowed(ctx context.Context, in *FollowMsg, opts ...grpc.CallOption) (*IsFollowedResponse, error)
+	GetFollowCounts(ctx context.Context, in *Id, opts ...grpc.CallOption) (*GetFollowCountsResponse, error)
+	GetFollowers(ctx context.Context, in *FollowListMsg, opts ...grpc.CallOption) (*FollowListResponse, error)
+	GetFollowing(ctx context.Context, in *FollowListMsg, opts ...grpc.CallOption) (*FollowListResponse, error)
 }
 
 type userClient struct {
@@ -98,6 +104,60 @@ func (c *userClient) GetByPlaylist(ctx context.Context, in *GetByPlaylistMsg, op
 	return out, nil
 }
 
+func (c *userClient) Follow(ctx context.Context, in *FollowMsg, opts ...grpc.CallOption) (*FollowResponse, error) {
+	out := new(FollowResponse)
+	err := c.cc.Invoke(ctx, "/user.User/Follow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Unfollow(ctx context.Context, in *FollowMsg, opts ...grpc.CallOption) (*UnfollowResponse, error) {
+	out := new(UnfollowResponse)
+	err := c.cc.Invoke(ctx, "/user.User/Unfollow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) IsFollowed(ctx context.Context, in *FollowMsg, opts ...grpc.CallOption) (*IsFollowedResponse, error) {
+	out := new(IsFollowedResponse)
+	err := c.cc.Invoke(ctx, "/user.User/IsFollowed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetFollowCounts(ctx context.Context, in *Id, opts ...grpc.CallOption) (*GetFollowCountsResponse, error) {
+	out := new(GetFollowCountsResponse)
+	err := c.cc.Invoke(ctx, "/user.User/GetFollowCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetFollowers(ctx context.Context, in *FollowListMsg, opts ...grpc.CallOption) (*FollowListResponse, error) {
+	out := new(FollowListResponse)
+	err := c.cc.Invoke(ctx, "/user.User/GetFollowers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetFollowing(ctx context.Context, in *FollowListMsg, opts ...grpc.CallOption) (*FollowListResponse, error) {
+	out := new(FollowListResponse)
+	err := c.cc.Invoke(ctx, "/user.User/GetFollowing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility
@@ -106,6 +166,12 @@ type UserServer interface {
 	UpdateInfo(context.Context, *UpdateInfoMsg) (*UpdateInfoResponse, error)
 	UploadAvatar(User_UploadAvatarServer) error
 	GetByPlaylist(context.Context, *GetByPlaylistMsg) (*GetByPlaylistResponse, error)
+	Follow(context.Context, *FollowMsg) (*FollowResponse, error)
+	Unfollow(context.Context, *FollowMsg) (*UnfollowResponse, error)
+	IsFollowed(context.Context, *FollowMsg) (*IsFollowedResponse, error)
+	GetFollowCounts(context.Context, *Id) (*GetFollowCountsResponse, error)
+	GetFollowers(context.Context, *FollowListMsg) (*FollowListResponse, error)
+	GetFollowing(context.Context, *FollowListMsg) (*FollowListResponse, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -125,6 +191,24 @@ func (UnimplementedUserServer) UploadAvatar(User_UploadAvatarServer) error {
 func (UnimplementedUserServer) GetByPlaylist(context.Context, *GetByPlaylistMsg) (*GetByPlaylistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByPlaylist not implemented")
 }
+func (UnimplementedUserServer) Follow(context.Context, *FollowMsg) (*FollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedUserServer) Unfollow(context.Context, *FollowMsg) (*UnfollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
+}
+func (UnimplementedUserServer) IsFollowed(context.Context, *FollowMsg) (*IsFollowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFollowed not implemented")
+}
+func (UnimplementedUserServer) GetFollowCounts(context.Context, *Id) (*GetFollowCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowCounts not implemented")
+}
+func (UnimplementedUserServer) GetFollowers(context.Context, *FollowListMsg) (*FollowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowers not implemented")
+}
+func (UnimplementedUserServer) GetFollowing(context.Context, *FollowListMsg) (*FollowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowing not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
@@ -218,6 +302,114 @@ func _User_GetByPlaylist_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/Follow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Follow(ctx, req.(*FollowMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Unfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Unfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/Unfollow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Unfollow(ctx, req.(*FollowMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_IsFollowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).IsFollowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/IsFollowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).IsFollowed(ctx, req.(*FollowMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetFollowCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetFollowCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/GetFollowCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetFollowCounts(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowListMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/GetFollowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetFollowers(ctx, req.(*FollowListMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowListMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.User/GetFollowing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetFollowing(ctx, req.(*FollowListMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +429,30 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByPlaylist",
 			Handler:    _User_GetByPlaylist_Handler,
 		},
+		{
+			MethodName: "Follow",
+			Handler:    _User_Follow_Handler,
+		},
+		{
+			MethodName: "Unfollow",
+			Handler:    _User_Unfollow_Handler,
+		},
+		{
+			MethodName: "IsFollowed",
+			Handler:    _User_IsFollowed_Handler,
+		},
+		{
+			MethodName: "GetFollowCounts",
+			Handler:    _User_GetFollowCounts_Handler,
+		},
+		{
+			MethodName: "GetFollowers",
+			Handler:    _User_GetFollowers_Handler,
+		},
+		{
+			MethodName: "GetFollowing",
+			Handler:    _User_GetFollowing_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
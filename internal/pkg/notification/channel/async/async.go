@@ -0,0 +1,63 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+)
+
+var errQueueIsFull = errors.New("(async) queue is full, notifications are dropped")
+
+// Channel implements notification.Channel by queueing notifications
+// for wrapped channel, so slow delivery (e.g. email) doesn't block request.
+// Queue is served by single worker for the whole life of process.
+type Channel struct {
+	channel notification.Channel
+	queue   chan []models.Notification
+	timeout time.Duration
+
+	logger logger.Logger
+}
+
+// NewChannel creates Channel with queue of queueSize batches of notifications
+// and starts its worker. Each batch is sent to wrapped channel within timeout.
+func NewChannel(c notification.Channel, queueSize int, timeout time.Duration, l logger.Logger) *Channel {
+	ac := &Channel{
+		channel: c,
+		queue:   make(chan []models.Notification, queueSize),
+		timeout: timeout,
+		logger:  l,
+	}
+
+	go ac.serve()
+
+	return ac
+}
+
+// Send only puts notifications into queue: delivery errors are logged by worker
+func (c *Channel) Send(ctx context.Context, notifications []models.Notification) error {
+	batch := make([]models.Notification, len(notifications))
+	copy(batch, notifications)
+
+	select {
+	case c.queue <- batch:
+		return nil
+	default:
+		return errQueueIsFull
+	}
+}
+
+func (c *Channel) serve() {
+	for batch := range c.queue {
+		// Request which produced notifications is already over, so its context isn't used
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		if err := c.channel.Send(ctx, batch); err != nil {
+			c.logger.Errorf("can't deliver %d queued notifications: %v", len(batch), err)
+		}
+		cancel()
+	}
+}
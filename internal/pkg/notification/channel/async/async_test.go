@@ -0,0 +1,67 @@
+package async
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/channel/stub"
+)
+
+var ctx = context.Background()
+
+// blockingChannel doesn't deliver anything until it's released
+type blockingChannel struct {
+	release chan struct{}
+}
+
+func (c *blockingChannel) Send(ctx context.Context, notifications []models.Notification) error {
+	<-c.release
+	return nil
+}
+
+func TestAsyncChannel_Send(t *testing.T) {
+	c := gomock.NewController(t)
+
+	wrapped := stub.NewChannel()
+	ch := NewChannel(wrapped, 1, time.Second, commonTests.MockLogger(c))
+
+	notifications := []models.Notification{{UserID: 1, Type: models.NotificationTypeFollower}}
+
+	err := ch.Send(ctx, notifications)
+	assert.NoError(t, err)
+
+	// Caller can reuse its slice right after Send
+	notifications[0].UserID = 2
+
+	assert.Eventually(t, func() bool {
+		return len(wrapped.Sent()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, uint32(1), wrapped.Sent()[0].UserID)
+}
+
+func TestAsyncChannel_SendQueueIsFull(t *testing.T) {
+	c := gomock.NewController(t)
+
+	wrapped := &blockingChannel{release: make(chan struct{})}
+	defer close(wrapped.release)
+
+	ch := NewChannel(wrapped, 1, time.Second, commonTests.MockLogger(c))
+
+	notifications := []models.Notification{{UserID: 1, Type: models.NotificationTypeFollower}}
+
+	// The first batch is taken by worker, the second one waits in queue
+	assert.NoError(t, ch.Send(ctx, notifications))
+	assert.Eventually(t, func() bool {
+		return len(ch.queue) == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.NoError(t, ch.Send(ctx, notifications))
+
+	err := ch.Send(ctx, notifications)
+	assert.ErrorIs(t, err, errQueueIsFull)
+}
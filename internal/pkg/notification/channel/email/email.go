@@ -0,0 +1,78 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user"
+)
+
+//go:generate mockgen -source=email.go -destination=mocks/mock.go
+
+// Mailer sends single email letter
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// Channel implements notification.Channel by sending emails to users
+type Channel struct {
+	mailer   Mailer
+	userRepo user.Repository
+	siteURL  string
+}
+
+// NewChannel creates Channel which puts links to siteURL pages into letters
+func NewChannel(m Mailer, ur user.Repository, siteURL string) *Channel {
+	return &Channel{
+		mailer:   m,
+		userRepo: ur,
+		siteURL:  siteURL,
+	}
+}
+
+// Send tries to send all of notifications even if some of them fail
+func (c *Channel) Send(ctx context.Context, notifications []models.Notification) error {
+	var errs []error
+	for _, n := range notifications {
+		if err := c.send(ctx, n); err != nil {
+			errs = append(errs, fmt.Errorf("(email) can't send notification to user #%d: %w", n.UserID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Channel) send(ctx context.Context, n models.Notification) error {
+	subject, body, ok := c.letter(n)
+	if !ok {
+		return nil
+	}
+
+	u, err := c.userRepo.GetByID(ctx, n.UserID)
+	if err != nil {
+		return fmt.Errorf("can't get user: %w", err)
+	}
+
+	return c.mailer.Send(ctx, u.Email, subject, body)
+}
+
+// letter returns subject and body of letter, false means notification isn't sent by email
+func (c *Channel) letter(n models.Notification) (string, string, bool) {
+	switch {
+	case n.Type == models.NotificationTypePlaylistMember && n.PlaylistID != nil:
+		return "You were added to playlist",
+			fmt.Sprintf("You are now one of authors of playlist: %s/playlist/%d", c.siteURL, *n.PlaylistID), true
+
+	case n.Type == models.NotificationTypeRelease && n.AlbumID != nil:
+		return "New release",
+			fmt.Sprintf("Artist you like has released new album: %s/album/%d", c.siteURL, *n.AlbumID), true
+
+	case n.Type == models.NotificationTypeFollower && n.ActorID != nil:
+		return "New follower",
+			fmt.Sprintf("You have new follower: %s/user/%d", c.siteURL, *n.ActorID), true
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,98 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	mailerMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/channel/email/mocks"
+	userMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/mocks"
+)
+
+var ctx = context.Background()
+
+func TestEmailChannel_Send(t *testing.T) {
+	type mockBehavior func(m *mailerMocks.MockMailer, ur *userMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	m := mailerMocks.NewMockMailer(c)
+	ur := userMocks.NewMockRepository(c)
+
+	ch := NewChannel(m, ur, "https://fluire.ru")
+
+	var followerID uint32 = 2
+	var albumID uint32 = 3
+
+	recipient := &models.User{ID: 1, Email: "yarik1448kuzmin@gmail.com"}
+
+	testTable := []struct {
+		name             string
+		notifications    []models.Notification
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Common",
+			notifications: []models.Notification{
+				{UserID: recipient.ID, Type: models.NotificationTypeFollower, ActorID: &followerID},
+			},
+			mockBehavior: func(m *mailerMocks.MockMailer, ur *userMocks.MockRepository) {
+				ur.EXPECT().GetByID(ctx, recipient.ID).Return(recipient, nil)
+				m.EXPECT().Send(ctx, recipient.Email, "New follower",
+					"You have new follower: https://fluire.ru/user/2").Return(nil)
+			},
+		},
+		{
+			name: "Unknown Type",
+			notifications: []models.Notification{
+				{UserID: recipient.ID, Type: "unknown"},
+			},
+			mockBehavior: func(m *mailerMocks.MockMailer, ur *userMocks.MockRepository) {},
+		},
+		{
+			name: "Mailer Issue Doesn't Stop Others",
+			notifications: []models.Notification{
+				{UserID: recipient.ID, Type: models.NotificationTypeFollower, ActorID: &followerID},
+				{UserID: recipient.ID, Type: models.NotificationTypeRelease, AlbumID: &albumID},
+			},
+			mockBehavior: func(m *mailerMocks.MockMailer, ur *userMocks.MockRepository) {
+				ur.EXPECT().GetByID(ctx, recipient.ID).Return(recipient, nil).Times(2)
+				m.EXPECT().Send(ctx, recipient.Email, "New follower", gomock.Any()).Return(errors.New(""))
+				m.EXPECT().Send(ctx, recipient.Email, "New release", gomock.Any()).Return(nil)
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't send notification to user #1",
+		},
+		{
+			name: "User Issue",
+			notifications: []models.Notification{
+				{UserID: recipient.ID, Type: models.NotificationTypeRelease, AlbumID: &albumID},
+			},
+			mockBehavior: func(m *mailerMocks.MockMailer, ur *userMocks.MockRepository) {
+				ur.EXPECT().GetByID(ctx, recipient.ID).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get user",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(m, ur)
+
+			err := ch.Send(ctx, tc.notifications)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
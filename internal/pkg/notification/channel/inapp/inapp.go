@@ -0,0 +1,28 @@
+package inapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification"
+)
+
+// Channel implements notification.Channel by putting notifications to users' inboxes
+type Channel struct {
+	repo notification.Repository
+}
+
+func NewChannel(r notification.Repository) *Channel {
+	return &Channel{
+		repo: r,
+	}
+}
+
+func (c *Channel) Send(ctx context.Context, notifications []models.Notification) error {
+	if err := c.repo.Insert(ctx, notifications); err != nil {
+		return fmt.Errorf("(in-app) can't insert notifications into repository: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package push
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime"
+)
+
+// Channel implements notification.Channel by pushing real-time events
+// to users' notifications topics, so clients refresh their inboxes
+type Channel struct {
+	publisher realtime.Publisher
+}
+
+func NewChannel(p realtime.Publisher) *Channel {
+	return &Channel{
+		publisher: p,
+	}
+}
+
+// Send publishes single event for each of users
+func (c *Channel) Send(ctx context.Context, notifications []models.Notification) error {
+	notified := make(map[uint32]struct{}, len(notifications))
+	for _, n := range notifications {
+		if _, ok := notified[n.UserID]; ok {
+			continue
+		}
+		notified[n.UserID] = struct{}{}
+
+		event := models.RealtimeEvent{
+			Topic: models.NotificationsTopic(n.UserID),
+			Type:  models.RealtimeEventNotification,
+		}
+		if err := c.publisher.Publish(ctx, event); err != nil {
+			return fmt.Errorf("(push) can't publish notification event: %w", err)
+		}
+	}
+
+	return nil
+}
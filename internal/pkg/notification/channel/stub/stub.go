@@ -0,0 +1,49 @@
+package stub
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+// Channel implements notification.Channel by keeping notifications in memory.
+// It's used in tests and local runs where nothing should be really delivered.
+type Channel struct {
+	mu   sync.Mutex
+	sent []models.Notification
+	err  error
+}
+
+func NewChannel() *Channel {
+	return &Channel{}
+}
+
+// NewFailingChannel returns Channel which fails to send anything with err
+func NewFailingChannel(err error) *Channel {
+	return &Channel{err: err}
+}
+
+func (c *Channel) Send(ctx context.Context, notifications []models.Notification) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sent = append(c.sent, notifications...)
+
+	return nil
+}
+
+// Sent returns all notifications sent through channel
+func (c *Channel) Sent() []models.Notification {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sent := make([]models.Notification, len(c.sent))
+	copy(sent, c.sent)
+
+	return sent
+}
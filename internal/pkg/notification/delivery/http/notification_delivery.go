@@ -0,0 +1,249 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+)
+
+type Handler struct {
+	notificationServices notification.Usecase
+	albumServices        album.Usecase
+	artistServices       artist.Usecase
+	playlistServices     playlist.Usecase
+	userServices         user.Usecase
+	logger               logger.Logger
+}
+
+func NewHandler(nu notification.Usecase, alu album.Usecase, aru artist.Usecase,
+	pu playlist.Usecase, uu user.Usecase, l logger.Logger) *Handler {
+
+	return &Handler{
+		notificationServices: nu,
+		albumServices:        alu,
+		artistServices:       aru,
+		playlistServices:     pu,
+		userServices:         uu,
+
+		logger: l,
+	}
+}
+
+// @Summary      Notifications
+// @Tags         Notification
+// @Description  Get inbox of user: additions to playlists, new releases of liked artists
+// @Description  and new followers. The latest go first.
+// @Produce      json
+// @Param		 limit	query		int		false	"Page size"
+// @Param		 offset	query		int		false	"Page offset"
+// @Success      200    {object}  	models.NotificationTransfers	"Notifications got"
+// @Header		 200	{integer}	X-Total-Count					"Total amount of notifications"
+// @Failure		 400	{object}	http.Error						"Incorrect input"
+// @Failure      401    {object}  	http.Error  					"User unathorized"
+// @Failure      500    {object}  	http.Error  					"Server error"
+// @Router       /api/notifications [get]
+func (h *Handler) GetInbox(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		if errors.Is(err, commonHTTP.ErrUnauthorized) {
+			commonHTTP.ErrorResponse(w, r, commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger)
+			return
+		}
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			notificationsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	limit, offset, err := commonHTTP.GetPaginationFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	notifications, total, err := h.notificationServices.GetInbox(r.Context(), user.ID, limit, offset)
+	if err != nil {
+		var errInvalidQuery *models.InvalidNotificationQueryError
+		if errors.As(err, &errInvalidQuery) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			notificationsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	nt := make(models.NotificationTransfers, 0, len(notifications))
+	for _, n := range notifications {
+		t, err := h.notificationTransferFromEntry(r.Context(), n, user)
+		if err != nil {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				notificationsGetServerError, http.StatusInternalServerError, h.logger, err)
+			return
+		}
+
+		nt = append(nt, t)
+	}
+
+	w.Header().Set(commonHTTP.TotalCountHeader, strconv.FormatUint(uint64(total), 10))
+	commonHTTP.SuccessResponse(w, r, nt, h.logger)
+}
+
+// @Summary      Unread notifications
+// @Tags         Notification
+// @Description  Get amount of unread notifications of user
+// @Produce      json
+// @Success      200    {object}  	unreadCountResponse	"Amount got"
+// @Failure      401    {object}  	http.Error  		"User unathorized"
+// @Failure      500    {object}  	http.Error  		"Server error"
+// @Router       /api/notifications/unread [get]
+func (h *Handler) UnreadCount(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	count, err := h.notificationServices.CountUnread(r.Context(), user.ID)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			notificationsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := unreadCountResponse{Count: count}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
+
+// @Summary      Read notification
+// @Tags         Notification
+// @Description  Mark chosen notification of user as read
+// @Produce      json
+// @Success      200    {object}  	defaultResponse	"Notification read"
+// @Failure		 400	{object}	http.Error		"Client error"
+// @Failure      401    {object}  	http.Error  	"User unathorized"
+// @Failure      500    {object}  	http.Error  	"Server error"
+// @Router       /api/notifications/{notificationID}/read [post]
+func (h *Handler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	notificationID, err := commonHTTP.GetNotificationIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	if err := h.notificationServices.MarkRead(r.Context(), notificationID, user.ID); err != nil {
+		var errNoSuchNotification *models.NoSuchNotificationError
+		if errors.As(err, &errNoSuchNotification) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				notificationNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			notificationsReadServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	dr := defaultResponse{Status: notificationsReadSuccessfully}
+
+	commonHTTP.SuccessResponse(w, r, dr, h.logger)
+}
+
+// @Summary      Read all notifications
+// @Tags         Notification
+// @Description  Mark all notifications of user as read
+// @Produce      json
+// @Success      200    {object}  	defaultResponse	"Notifications read"
+// @Failure      401    {object}  	http.Error  	"User unathorized"
+// @Failure      500    {object}  	http.Error  	"Server error"
+// @Router       /api/notifications/read [post]
+func (h *Handler) MarkAllRead(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	if err := h.notificationServices.MarkAllRead(r.Context(), user.ID); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			notificationsReadServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	dr := defaultResponse{Status: notificationsReadSuccessfully}
+
+	commonHTTP.SuccessResponse(w, r, dr, h.logger)
+}
+
+// notificationTransferFromEntry fills NotificationTransfer with entities notification refers to
+func (h *Handler) notificationTransferFromEntry(ctx context.Context,
+	n models.Notification, user *models.User) (models.NotificationTransfer, error) {
+
+	nt := models.NotificationTransfer{
+		ID:        n.ID,
+		Type:      n.Type,
+		IsRead:    n.IsRead,
+		CreatedAt: n.CreatedAt,
+	}
+
+	if n.ActorID != nil {
+		u, err := h.userServices.GetByID(ctx, *n.ActorID)
+		if err != nil {
+			return models.NotificationTransfer{}, err
+		}
+		ut := models.UserPublicTransferFromEntry(*u)
+		nt.Actor = &ut
+	}
+
+	if n.AlbumID != nil {
+		album, err := h.albumServices.GetByID(ctx, *n.AlbumID)
+		if err != nil {
+			return models.NotificationTransfer{}, err
+		}
+		alt, err := models.AlbumTransferFromEntry(ctx, *album, user,
+			h.albumServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByAlbum)
+		if err != nil {
+			return models.NotificationTransfer{}, err
+		}
+		nt.Album = &alt
+	}
+
+	if n.PlaylistID != nil {
+		playlist, err := h.playlistServices.GetByID(ctx, *n.PlaylistID)
+		if err != nil {
+			return models.NotificationTransfer{}, err
+		}
+		pt, err := models.PlaylistTransferFromEntry(ctx, *playlist, user,
+			h.playlistServices.IsLiked, h.userServices.GetByPlaylist)
+		if err != nil {
+			return models.NotificationTransfer{}, err
+		}
+		nt.Playlist = &pt
+	}
+
+	return nt, nil
+}
@@ -0,0 +1,23 @@
+package http
+
+//go:generate easyjson -no_std_marshalers notification_delivery_models.go
+
+// Response messages
+const (
+	notificationNotFound = "no such notification"
+
+	notificationsGetServerError  = "can't get notifications"
+	notificationsReadServerError = "can't mark notifications as read"
+
+	notificationsReadSuccessfully = "ok"
+)
+
+//easyjson:json
+type unreadCountResponse struct {
+	Count uint32 `json:"count"`
+}
+
+//easyjson:json
+type defaultResponse struct {
+	Status string `json:"status"`
+}
@@ -0,0 +1,123 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package http
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson742ed251DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgNotificationDeliveryHttp(in *jlexer.Lexer, out *unreadCountResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "count":
+			out.Count = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson742ed251EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgNotificationDeliveryHttp(out *jwriter.Writer, in unreadCountResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v unreadCountResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson742ed251EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgNotificationDeliveryHttp(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *unreadCountResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson742ed251DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgNotificationDeliveryHttp(l, v)
+}
+func easyjson742ed251DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgNotificationDeliveryHttp1(in *jlexer.Lexer, out *defaultResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson742ed251EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgNotificationDeliveryHttp1(out *jwriter.Writer, in defaultResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v defaultResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson742ed251EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgNotificationDeliveryHttp1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *defaultResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson742ed251DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgNotificationDeliveryHttp1(l, v)
+}
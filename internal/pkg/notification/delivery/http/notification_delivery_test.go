@@ -0,0 +1,308 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	albumMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/mocks"
+	artistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/mocks"
+	notificationMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/mocks"
+	playlistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/mocks"
+	userMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/mocks"
+)
+
+func TestNotificationDeliveryHTTP_GetInbox(t *testing.T) {
+	// Init
+	type mockBehavior func(nu *notificationMocks.MockUsecase, uu *userMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	nu := notificationMocks.NewMockUsecase(c)
+	alu := albumMocks.NewMockUsecase(c)
+	aru := artistMocks.NewMockUsecase(c)
+	pu := playlistMocks.NewMockUsecase(c)
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(nu, alu, aru, pu, uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/notifications", h.GetInbox)
+
+	// Test filling
+	user := &models.User{ID: 1}
+
+	var followerID uint32 = 2
+	createdAt := time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	notifications := []models.Notification{
+		{
+			ID:        1,
+			UserID:    user.ID,
+			Type:      models.NotificationTypeFollower,
+			ActorID:   &followerID,
+			CreatedAt: createdAt,
+		},
+	}
+
+	follower := &models.User{
+		ID:        followerID,
+		Username:  "yarik_tri",
+		FirstName: "Yaroslav",
+		LastName:  "Kuzmin",
+	}
+
+	correctResponse := `[
+		{
+			"id": 1,
+			"type": "newFollower",
+			"actor": {
+				"id": 2,
+				"username": "yarik_tri",
+				"firstName": "Yaroslav",
+				"lastName": "Kuzmin"
+			},
+			"isRead": false,
+			"createdAt": "2023-05-01T12:00:00Z"
+		}
+	]`
+
+	testTable := []struct {
+		name             string
+		query            string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:  "Common",
+			query: "?limit=10&offset=0",
+			user:  user,
+			mockBehavior: func(nu *notificationMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				nu.EXPECT().GetInbox(gomock.Any(), user.ID, uint32(10), uint32(0)).Return(notifications, uint32(1), nil)
+				uu.EXPECT().GetByID(gomock.Any(), followerID).Return(follower, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name: "Empty Inbox",
+			user: user,
+			mockBehavior: func(nu *notificationMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				nu.EXPECT().GetInbox(gomock.Any(), user.ID, uint32(0), uint32(0)).Return([]models.Notification{}, uint32(0), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `[]`,
+		},
+		{
+			name:             "Unauthorized",
+			user:             nil,
+			mockBehavior:     func(nu *notificationMocks.MockUsecase, uu *userMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:             "Incorrect Query",
+			query:            "?limit=-1",
+			user:             user,
+			mockBehavior:     func(nu *notificationMocks.MockUsecase, uu *userMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
+		},
+		{
+			name:  "Too Big Limit",
+			query: "?limit=1000",
+			user:  user,
+			mockBehavior: func(nu *notificationMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				nu.EXPECT().GetInbox(gomock.Any(), user.ID, uint32(1000), uint32(0)).
+					Return(nil, uint32(0), &models.InvalidNotificationQueryError{Reason: "limit is too big"})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
+		},
+		{
+			name: "Server Error",
+			user: user,
+			mockBehavior: func(nu *notificationMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				nu.EXPECT().GetInbox(gomock.Any(), user.ID, uint32(0), uint32(0)).Return(nil, uint32(0), errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(notificationsGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(nu, uu)
+
+			commonTests.DeliveryTestGet(t, r, "/api/notifications"+tc.query, tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestNotificationDeliveryHTTP_UnreadCount(t *testing.T) {
+	// Init
+	type mockBehavior func(nu *notificationMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	nu := notificationMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(nu, albumMocks.NewMockUsecase(c), artistMocks.NewMockUsecase(c),
+		playlistMocks.NewMockUsecase(c), userMocks.NewMockUsecase(c), l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/notifications/unread", h.UnreadCount)
+
+	// Test filling
+	user := &models.User{ID: 1}
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Common",
+			user: user,
+			mockBehavior: func(nu *notificationMocks.MockUsecase) {
+				nu.EXPECT().CountUnread(gomock.Any(), user.ID).Return(uint32(3), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"count": 3}`,
+		},
+		{
+			name:             "Unauthorized",
+			user:             nil,
+			mockBehavior:     func(nu *notificationMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name: "Server Error",
+			user: user,
+			mockBehavior: func(nu *notificationMocks.MockUsecase) {
+				nu.EXPECT().CountUnread(gomock.Any(), user.ID).Return(uint32(0), errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(notificationsGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(nu)
+
+			commonTests.DeliveryTestGet(t, r, "/api/notifications/unread", tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestNotificationDeliveryHTTP_MarkRead(t *testing.T) {
+	// Init
+	type mockBehavior func(nu *notificationMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	nu := notificationMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(nu, albumMocks.NewMockUsecase(c), artistMocks.NewMockUsecase(c),
+		playlistMocks.NewMockUsecase(c), userMocks.NewMockUsecase(c), l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Post("/api/notifications/{notificationID}/read", h.MarkRead)
+
+	// Test filling
+	user := &models.User{ID: 1}
+	const notificationID uint32 = 2
+
+	testTable := []struct {
+		name             string
+		notificationID   string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:           "Common",
+			notificationID: "2",
+			user:           user,
+			mockBehavior: func(nu *notificationMocks.MockUsecase) {
+				nu.EXPECT().MarkRead(gomock.Any(), notificationID, user.ID).Return(nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(notificationsReadSuccessfully),
+		},
+		{
+			name:             "Incorrect ID",
+			notificationID:   "0",
+			user:             user,
+			mockBehavior:     func(nu *notificationMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidURLParameter),
+		},
+		{
+			name:             "Unauthorized",
+			notificationID:   "2",
+			user:             nil,
+			mockBehavior:     func(nu *notificationMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:           "No Such Notification",
+			notificationID: "2",
+			user:           user,
+			mockBehavior: func(nu *notificationMocks.MockUsecase) {
+				nu.EXPECT().MarkRead(gomock.Any(), notificationID, user.ID).
+					Return(&models.NoSuchNotificationError{NotificationID: notificationID})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(notificationNotFound),
+		},
+		{
+			name:           "Server Error",
+			notificationID: "2",
+			user:           user,
+			mockBehavior: func(nu *notificationMocks.MockUsecase) {
+				nu.EXPECT().MarkRead(gomock.Any(), notificationID, user.ID).Return(errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(notificationsReadServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(nu)
+
+			commonTests.DeliveryTestPost(t, r, "/api/notifications/"+tc.notificationID+"/read", "",
+				tc.expectedStatus, tc.expectedResponse, commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
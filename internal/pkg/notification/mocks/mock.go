@@ -0,0 +1,341 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification.go
+
+// Package mock_notification is a generated GoMock package.
+package mock_notification
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CountUnread mocks base method.
+func (m *MockUsecase) CountUnread(ctx context.Context, userID uint32) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnread", ctx, userID)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnread indicates an expected call of CountUnread.
+func (mr *MockUsecaseMockRecorder) CountUnread(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnread", reflect.TypeOf((*MockUsecase)(nil).CountUnread), ctx, userID)
+}
+
+// GetInbox mocks base method.
+func (m *MockUsecase) GetInbox(ctx context.Context, userID, limit, offset uint32) ([]models.Notification, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInbox", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInbox indicates an expected call of GetInbox.
+func (mr *MockUsecaseMockRecorder) GetInbox(ctx, userID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInbox", reflect.TypeOf((*MockUsecase)(nil).GetInbox), ctx, userID, limit, offset)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockUsecase) MarkAllRead(ctx context.Context, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockUsecaseMockRecorder) MarkAllRead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockUsecase)(nil).MarkAllRead), ctx, userID)
+}
+
+// MarkRead mocks base method.
+func (m *MockUsecase) MarkRead(ctx context.Context, notificationID, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, notificationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockUsecaseMockRecorder) MarkRead(ctx, notificationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockUsecase)(nil).MarkRead), ctx, notificationID, userID)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(ctx context.Context, n models.Notification, usersID []uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Notify", ctx, n, usersID)
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(ctx, n, usersID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, n, usersID)
+}
+
+// NotifyArtistsFans mocks base method.
+func (m *MockNotifier) NotifyArtistsFans(ctx context.Context, n models.Notification, artistsID []uint32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyArtistsFans", ctx, n, artistsID)
+}
+
+// NotifyArtistsFans indicates an expected call of NotifyArtistsFans.
+func (mr *MockNotifierMockRecorder) NotifyArtistsFans(ctx, n, artistsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyArtistsFans", reflect.TypeOf((*MockNotifier)(nil).NotifyArtistsFans), ctx, n, artistsID)
+}
+
+// MockChannel is a mock of Channel interface.
+type MockChannel struct {
+	ctrl     *gomock.Controller
+	recorder *MockChannelMockRecorder
+}
+
+// MockChannelMockRecorder is the mock recorder for MockChannel.
+type MockChannelMockRecorder struct {
+	mock *MockChannel
+}
+
+// NewMockChannel creates a new mock instance.
+func NewMockChannel(ctrl *gomock.Controller) *MockChannel {
+	mock := &MockChannel{ctrl: ctrl}
+	mock.recorder = &MockChannelMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChannel) EXPECT() *MockChannelMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockChannel) Send(ctx context.Context, notifications []models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, notifications)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockChannelMockRecorder) Send(ctx, notifications interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockChannel)(nil).Send), ctx, notifications)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUnread mocks base method.
+func (m *MockRepository) CountUnread(ctx context.Context, userID uint32) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnread", ctx, userID)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnread indicates an expected call of CountUnread.
+func (mr *MockRepositoryMockRecorder) CountUnread(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnread", reflect.TypeOf((*MockRepository)(nil).CountUnread), ctx, userID)
+}
+
+// GetArtistsFans mocks base method.
+func (m *MockRepository) GetArtistsFans(ctx context.Context, artistsID []uint32) ([]uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArtistsFans", ctx, artistsID)
+	ret0, _ := ret[0].([]uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArtistsFans indicates an expected call of GetArtistsFans.
+func (mr *MockRepositoryMockRecorder) GetArtistsFans(ctx, artistsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistsFans", reflect.TypeOf((*MockRepository)(nil).GetArtistsFans), ctx, artistsID)
+}
+
+// GetByUser mocks base method.
+func (m *MockRepository) GetByUser(ctx context.Context, userID, limit, offset uint32) ([]models.Notification, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUser", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByUser indicates an expected call of GetByUser.
+func (mr *MockRepositoryMockRecorder) GetByUser(ctx, userID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MockRepository)(nil).GetByUser), ctx, userID, limit, offset)
+}
+
+// Insert mocks base method.
+func (m *MockRepository) Insert(ctx context.Context, notifications []models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, notifications)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockRepositoryMockRecorder) Insert(ctx, notifications interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockRepository)(nil).Insert), ctx, notifications)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockRepository) MarkAllRead(ctx context.Context, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockRepositoryMockRecorder) MarkAllRead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockRepository)(nil).MarkAllRead), ctx, userID)
+}
+
+// MarkRead mocks base method.
+func (m *MockRepository) MarkRead(ctx context.Context, notificationID, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, notificationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockRepositoryMockRecorder) MarkRead(ctx, notificationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockRepository)(nil).MarkRead), ctx, notificationID, userID)
+}
+
+// MockTables is a mock of Tables interface.
+type MockTables struct {
+	ctrl     *gomock.Controller
+	recorder *MockTablesMockRecorder
+}
+
+// MockTablesMockRecorder is the mock recorder for MockTables.
+type MockTablesMockRecorder struct {
+	mock *MockTables
+}
+
+// NewMockTables creates a new mock instance.
+func NewMockTables(ctrl *gomock.Controller) *MockTables {
+	mock := &MockTables{ctrl: ctrl}
+	mock.recorder = &MockTablesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTables) EXPECT() *MockTablesMockRecorder {
+	return m.recorder
+}
+
+// LikedArtists mocks base method.
+func (m *MockTables) LikedArtists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedArtists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedArtists indicates an expected call of LikedArtists.
+func (mr *MockTablesMockRecorder) LikedArtists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedArtists", reflect.TypeOf((*MockTables)(nil).LikedArtists))
+}
+
+// Notifications mocks base method.
+func (m *MockTables) Notifications() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notifications")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Notifications indicates an expected call of Notifications.
+func (mr *MockTablesMockRecorder) Notifications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notifications", reflect.TypeOf((*MockTables)(nil).Notifications))
+}
@@ -0,0 +1,60 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+//go:generate mockgen -source=notification.go -destination=mocks/mock.go
+
+// Usecase includes bussiness logics methods to work with user's inbox of notifications
+type Usecase interface {
+	// GetInbox returns page of user's notifications, the latest first, and total amount of them
+	GetInbox(ctx context.Context, userID uint32, limit, offset uint32) ([]models.Notification, uint32, error)
+	CountUnread(ctx context.Context, userID uint32) (uint32, error)
+
+	// MarkRead returns models.NoSuchNotificationError if user doesn't have such notification
+	MarkRead(ctx context.Context, notificationID, userID uint32) error
+	MarkAllRead(ctx context.Context, userID uint32) error
+}
+
+// Notifier is used by other usecases to notify users about events.
+// Notifications are best-effort: failures are logged and never fail the caller.
+type Notifier interface {
+	// Notify sends notification to each of users through all delivery channels.
+	// Actor of notification isn't notified about their own actions.
+	Notify(ctx context.Context, n models.Notification, usersID []uint32)
+
+	// NotifyArtistsFans sends notification to users who like any of artists
+	NotifyArtistsFans(ctx context.Context, n models.Notification, artistsID []uint32)
+}
+
+// Channel delivers notifications to their users: in-app inbox, email etc.
+type Channel interface {
+	Send(ctx context.Context, notifications []models.Notification) error
+}
+
+// Repository includes DBMS-relatable methods to work with notifications
+type Repository interface {
+	// Insert adds all notifications at once
+	Insert(ctx context.Context, notifications []models.Notification) error
+
+	// GetArtistsFans returns IDs of users who like any of artists
+	GetArtistsFans(ctx context.Context, artistsID []uint32) ([]uint32, error)
+
+	// GetByUser returns page of user's notifications, the latest first, and total amount of them
+	GetByUser(ctx context.Context, userID uint32, limit, offset uint32) ([]models.Notification, uint32, error)
+	CountUnread(ctx context.Context, userID uint32) (uint32, error)
+
+	// MarkRead returns models.NoSuchNotificationError if user doesn't have such notification
+	MarkRead(ctx context.Context, notificationID, userID uint32) error
+	MarkAllRead(ctx context.Context, userID uint32) error
+}
+
+// Tables includes methods which return needed tables
+// to work with notifications on repository layer
+type Tables interface {
+	Notifications() string
+	LikedArtists() string
+}
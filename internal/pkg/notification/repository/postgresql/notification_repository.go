@@ -0,0 +1,148 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification"
+
+	commonSQL "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/db"
+)
+
+// PostgreSQL implements notification.Repository
+type PostgreSQL struct {
+	db     *sqlx.DB
+	tables notification.Tables
+}
+
+func NewPostgreSQL(db *sqlx.DB, t notification.Tables) *PostgreSQL {
+	return &PostgreSQL{
+		db:     db,
+		tables: t,
+	}
+}
+
+func (p *PostgreSQL) Insert(ctx context.Context, notifications []models.Notification) (repoErr error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s (user_id, type, actor_id, album_id, playlist_id)
+		VALUES ($1, $2, $3, $4, $5);`,
+		p.tables.Notifications())
+
+	for _, n := range notifications {
+		if _, err := tx.ExecContext(ctx, query,
+			n.UserID, n.Type, n.ActorID, n.AlbumID, n.PlaylistID); err != nil {
+
+			return fmt.Errorf("(repo) failed to exec query: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) GetArtistsFans(ctx context.Context, artistsID []uint32) ([]uint32, error) {
+	query := fmt.Sprintf(
+		`SELECT DISTINCT user_id
+		FROM %s
+		WHERE artist_id = ANY($1);`,
+		p.tables.LikedArtists())
+
+	usersID := []uint32{}
+	if err := p.db.SelectContext(ctx, &usersID, query, pq.Array(artistsID)); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return usersID, nil
+}
+
+func (p *PostgreSQL) GetByUser(ctx context.Context,
+	userID uint32, limit, offset uint32) ([]models.Notification, uint32, error) {
+
+	countQuery := fmt.Sprintf(
+		`SELECT COUNT(*)
+		FROM %s
+		WHERE user_id = $1;`,
+		p.tables.Notifications())
+
+	var total uint32
+	if err := p.db.GetContext(ctx, &total, countQuery, userID); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	query := fmt.Sprintf(
+		`SELECT id, user_id, type, actor_id, album_id, playlist_id, is_read, created_at
+		FROM %s
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2 OFFSET $3;`,
+		p.tables.Notifications())
+
+	notifications := []models.Notification{}
+	if err := p.db.SelectContext(ctx, &notifications, query, userID, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return notifications, total, nil
+}
+
+func (p *PostgreSQL) CountUnread(ctx context.Context, userID uint32) (uint32, error) {
+	query := fmt.Sprintf(
+		`SELECT COUNT(*)
+		FROM %s
+		WHERE user_id = $1 AND NOT is_read;`,
+		p.tables.Notifications())
+
+	var count uint32
+	if err := p.db.GetContext(ctx, &count, query, userID); err != nil {
+		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return count, nil
+}
+
+func (p *PostgreSQL) MarkRead(ctx context.Context, notificationID, userID uint32) error {
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET is_read = TRUE
+		WHERE id = $1 AND user_id = $2;`,
+		p.tables.Notifications())
+
+	resExec, err := p.db.ExecContext(ctx, query, notificationID, userID)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	updated, err := resExec.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("(repo) failed to check RowsAffected: %w", err)
+	}
+
+	if updated == 0 {
+		return fmt.Errorf("(repo) %w", &models.NoSuchNotificationError{NotificationID: notificationID})
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) MarkAllRead(ctx context.Context, userID uint32) error {
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET is_read = TRUE
+		WHERE user_id = $1 AND NOT is_read;`,
+		p.tables.Notifications())
+
+	if _, err := p.db.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
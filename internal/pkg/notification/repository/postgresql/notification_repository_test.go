@@ -0,0 +1,380 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	notificationMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/mocks"
+)
+
+var ctx = context.Background()
+
+const notificationsTable = "Notifications"
+const likedArtistsTable = "Liked_artists"
+
+var errPqInternal = errors.New("postgres is dead")
+
+func TestNotificationRepositoryPostgreSQL_Insert(t *testing.T) {
+	// Init
+	type mockBehavior func(notifications []models.Notification)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := notificationMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	var albumID uint32 = 3
+	defaultNotifications := []models.Notification{
+		{
+			UserID:  1,
+			Type:    models.NotificationTypeRelease,
+			AlbumID: &albumID,
+		},
+		{
+			UserID:  2,
+			Type:    models.NotificationTypeRelease,
+			AlbumID: &albumID,
+		},
+	}
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(notifications []models.Notification) {
+				tablesMock.EXPECT().Notifications().Return(notificationsTable)
+
+				sqlxMock.ExpectBegin()
+				for _, n := range notifications {
+					sqlxMock.ExpectExec("INSERT INTO "+notificationsTable).
+						WithArgs(n.UserID, n.Type, n.ActorID, n.AlbumID, n.PlaylistID).
+						WillReturnResult(driver.RowsAffected(1))
+				}
+				sqlxMock.ExpectCommit()
+			},
+		},
+		{
+			name: "Batch Is Rolled Back",
+			mockBehavior: func(notifications []models.Notification) {
+				tablesMock.EXPECT().Notifications().Return(notificationsTable)
+
+				sqlxMock.ExpectBegin()
+				sqlxMock.ExpectExec("INSERT INTO "+notificationsTable).
+					WithArgs(notifications[0].UserID, notifications[0].Type,
+						notifications[0].ActorID, notifications[0].AlbumID, notifications[0].PlaylistID).
+					WillReturnResult(driver.RowsAffected(1))
+				sqlxMock.ExpectExec("INSERT INTO "+notificationsTable).
+					WithArgs(notifications[1].UserID, notifications[1].Type,
+						notifications[1].ActorID, notifications[1].AlbumID, notifications[1].PlaylistID).
+					WillReturnError(errPqInternal)
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultNotifications)
+
+			err := repo.Insert(ctx, defaultNotifications)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNotificationRepositoryPostgreSQL_GetArtistsFans(t *testing.T) {
+	// Init
+	type mockBehavior func(artistsID []uint32, usersID []uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := notificationMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	defaultArtistsID := []uint32{1, 2}
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectedUsers []uint32
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(artistsID []uint32, usersID []uint32) {
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+
+				rows := sqlxMock.NewRows([]string{"user_id"})
+				for _, id := range usersID {
+					rows.AddRow(id)
+				}
+				sqlxMock.ExpectQuery("SELECT DISTINCT user_id(.+)FROM " + likedArtistsTable +
+					"(.+)WHERE artist_id = ANY\\(\\$1\\)").
+					WithArgs(pq.Array(artistsID)).
+					WillReturnRows(rows)
+			},
+			expectedUsers: []uint32{3, 4, 5},
+		},
+		{
+			name: "No Fans",
+			mockBehavior: func(artistsID []uint32, usersID []uint32) {
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+
+				sqlxMock.ExpectQuery("SELECT DISTINCT user_id").
+					WithArgs(pq.Array(artistsID)).
+					WillReturnRows(sqlxMock.NewRows([]string{"user_id"}))
+			},
+			expectedUsers: []uint32{},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(artistsID []uint32, usersID []uint32) {
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+
+				sqlxMock.ExpectQuery("SELECT DISTINCT user_id").
+					WithArgs(pq.Array(artistsID)).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultArtistsID, tc.expectedUsers)
+
+			usersID, err := repo.GetArtistsFans(ctx, defaultArtistsID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedUsers, usersID)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNotificationRepositoryPostgreSQL_GetByUser(t *testing.T) {
+	// Init
+	type mockBehavior func(userID, limit, offset uint32, notifications []models.Notification)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := notificationMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultUserID uint32 = 1
+	const limit uint32 = 20
+	const offset uint32 = 0
+
+	var actorID uint32 = 2
+	defaultNotifications := []models.Notification{
+		{
+			ID:        5,
+			UserID:    defaultUserID,
+			Type:      models.NotificationTypeFollower,
+			ActorID:   &actorID,
+			CreatedAt: time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testTable := []struct {
+		name                  string
+		mockBehavior          mockBehavior
+		expectedNotifications []models.Notification
+		expectedTotal         uint32
+		expectError           bool
+		expectedError         error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID, limit, offset uint32, notifications []models.Notification) {
+				tablesMock.EXPECT().Notifications().Return(notificationsTable).Times(2)
+
+				countRow := sqlxMock.NewRows([]string{"count"}).AddRow(len(notifications))
+				sqlxMock.ExpectQuery("SELECT COUNT(.+) FROM " + notificationsTable).
+					WithArgs(userID).
+					WillReturnRows(countRow)
+
+				rows := sqlxMock.NewRows([]string{"id", "user_id", "type", "actor_id",
+					"album_id", "playlist_id", "is_read", "created_at"})
+				for _, n := range notifications {
+					rows.AddRow(n.ID, n.UserID, n.Type, n.ActorID, n.AlbumID, n.PlaylistID, n.IsRead, n.CreatedAt)
+				}
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+notificationsTable+
+					"(.+)ORDER BY created_at DESC, id DESC").
+					WithArgs(userID, limit, offset).
+					WillReturnRows(rows)
+			},
+			expectedNotifications: defaultNotifications,
+			expectedTotal:         1,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID, limit, offset uint32, notifications []models.Notification) {
+				tablesMock.EXPECT().Notifications().Return(notificationsTable)
+
+				sqlxMock.ExpectQuery("SELECT COUNT(.+) FROM " + notificationsTable).
+					WithArgs(userID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultUserID, limit, offset, tc.expectedNotifications)
+
+			notifications, total, err := repo.GetByUser(ctx, defaultUserID, limit, offset)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedNotifications, notifications)
+				assert.Equal(t, tc.expectedTotal, total)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNotificationRepositoryPostgreSQL_MarkRead(t *testing.T) {
+	// Init
+	type mockBehavior func(notificationID, userID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := notificationMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultNotificationID uint32 = 5
+	const defaultUserID uint32 = 1
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(notificationID, userID uint32) {
+				tablesMock.EXPECT().Notifications().Return(notificationsTable)
+
+				sqlxMock.ExpectExec("UPDATE "+notificationsTable+"(.+)SET is_read = TRUE"+
+					"(.+)WHERE id = \\$1 AND user_id = \\$2").
+					WithArgs(notificationID, userID).
+					WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+		{
+			name: "Notification Of Another User",
+			mockBehavior: func(notificationID, userID uint32) {
+				tablesMock.EXPECT().Notifications().Return(notificationsTable)
+
+				sqlxMock.ExpectExec("UPDATE "+notificationsTable).
+					WithArgs(notificationID, userID).
+					WillReturnResult(driver.RowsAffected(0))
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchNotificationError{NotificationID: defaultNotificationID},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(notificationID, userID uint32) {
+				tablesMock.EXPECT().Notifications().Return(notificationsTable)
+
+				sqlxMock.ExpectExec("UPDATE "+notificationsTable).
+					WithArgs(notificationID, userID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultNotificationID, defaultUserID)
+
+			err := repo.MarkRead(ctx, defaultNotificationID, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
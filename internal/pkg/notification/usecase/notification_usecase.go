@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+)
+
+// Usecase implements notification.Usecase and notification.Notifier
+type Usecase struct {
+	repo     notification.Repository
+	channels []notification.Channel
+
+	logger logger.Logger
+}
+
+// NewUsecase creates Usecase which delivers notifications through all of channels
+func NewUsecase(r notification.Repository, l logger.Logger, channels ...notification.Channel) *Usecase {
+	return &Usecase{
+		repo:     r,
+		channels: channels,
+		logger:   l,
+	}
+}
+
+func (u *Usecase) Notify(ctx context.Context, n models.Notification, usersID []uint32) {
+	notifications := make([]models.Notification, 0, len(usersID))
+	for _, userID := range usersID {
+		if n.ActorID != nil && *n.ActorID == userID {
+			continue
+		}
+
+		n.UserID = userID
+		notifications = append(notifications, n)
+	}
+
+	if len(notifications) == 0 {
+		return
+	}
+
+	// Failure of one channel doesn't prevent delivery through others
+	for _, c := range u.channels {
+		if err := c.Send(ctx, notifications); err != nil {
+			u.logger.ErrorfReqID(ctx, "can't send %s notifications: %v", n.Type, err)
+		}
+	}
+}
+
+func (u *Usecase) NotifyArtistsFans(ctx context.Context, n models.Notification, artistsID []uint32) {
+	fansID, err := u.repo.GetArtistsFans(ctx, artistsID)
+	if err != nil {
+		u.logger.ErrorfReqID(ctx, "can't get fans of artists to send %s notifications: %v", n.Type, err)
+		return
+	}
+
+	u.Notify(ctx, n, fansID)
+}
+
+func (u *Usecase) GetInbox(ctx context.Context,
+	userID uint32, limit, offset uint32) ([]models.Notification, uint32, error) {
+
+	if limit == 0 {
+		limit = models.NotificationDefaultLimit
+	}
+	if limit > models.NotificationMaxLimit {
+		return nil, 0, fmt.Errorf("(usecase) %w", &models.InvalidNotificationQueryError{Reason: "limit is too big"})
+	}
+
+	notifications, total, err := u.repo.GetByUser(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("(usecase) can't get notifications from repository: %w", err)
+	}
+
+	return notifications, total, nil
+}
+
+func (u *Usecase) CountUnread(ctx context.Context, userID uint32) (uint32, error) {
+	count, err := u.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("(usecase) can't count unread notifications in repository: %w", err)
+	}
+
+	return count, nil
+}
+
+func (u *Usecase) MarkRead(ctx context.Context, notificationID, userID uint32) error {
+	if err := u.repo.MarkRead(ctx, notificationID, userID); err != nil {
+		return fmt.Errorf("(usecase) can't mark notification as read: %w", err)
+	}
+
+	return nil
+}
+
+func (u *Usecase) MarkAllRead(ctx context.Context, userID uint32) error {
+	if err := u.repo.MarkAllRead(ctx, userID); err != nil {
+		return fmt.Errorf("(usecase) can't mark notifications as read: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/channel/stub"
+	notificationMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/mocks"
+)
+
+var ctx = context.Background()
+
+func TestNotificationUsecase_Notify(t *testing.T) {
+	var actorID uint32 = 1
+	var playlistID uint32 = 2
+
+	n := models.Notification{
+		Type:       models.NotificationTypePlaylistMember,
+		ActorID:    &actorID,
+		PlaylistID: &playlistID,
+	}
+	addressed := func(usersID ...uint32) []models.Notification {
+		notifications := make([]models.Notification, 0, len(usersID))
+		for _, userID := range usersID {
+			an := n
+			an.UserID = userID
+			notifications = append(notifications, an)
+		}
+		return notifications
+	}
+
+	testTable := []struct {
+		name           string
+		usersID        []uint32
+		failingChannel bool
+		expectedSent   []models.Notification
+	}{
+		{
+			name:         "Common",
+			usersID:      []uint32{3, 4},
+			expectedSent: addressed(3, 4),
+		},
+		{
+			name:         "Actor Isn't Notified",
+			usersID:      []uint32{actorID, 3},
+			expectedSent: addressed(3),
+		},
+		{
+			name:         "No Users",
+			usersID:      []uint32{actorID},
+			expectedSent: []models.Notification{},
+		},
+		{
+			name:           "Channel Issue Is Only Logged",
+			usersID:        []uint32{3},
+			failingChannel: true,
+			expectedSent:   addressed(3),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+
+			r := notificationMocks.NewMockRepository(c)
+			channel := stub.NewChannel()
+
+			// Failing channel goes first to check that others still get notifications
+			channels := []notification.Channel{channel}
+			if tc.failingChannel {
+				channels = []notification.Channel{stub.NewFailingChannel(errors.New("")), channel}
+			}
+
+			u := NewUsecase(r, commonTests.MockLogger(c), channels...)
+
+			u.Notify(ctx, n, tc.usersID)
+
+			assert.Equal(t, tc.expectedSent, channel.Sent())
+		})
+	}
+}
+
+func TestNotificationUsecase_NotifyArtistsFans(t *testing.T) {
+	type mockBehavior func(r *notificationMocks.MockRepository, artistsID []uint32)
+
+	c := gomock.NewController(t)
+
+	r := notificationMocks.NewMockRepository(c)
+
+	var albumID uint32 = 1
+	n := models.Notification{Type: models.NotificationTypeRelease, AlbumID: &albumID}
+	artistsID := []uint32{1, 2}
+
+	testTable := []struct {
+		name         string
+		mockBehavior mockBehavior
+		expectedSent []models.Notification
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(r *notificationMocks.MockRepository, artistsID []uint32) {
+				r.EXPECT().GetArtistsFans(ctx, artistsID).Return([]uint32{5}, nil)
+			},
+			expectedSent: []models.Notification{
+				{UserID: 5, Type: models.NotificationTypeRelease, AlbumID: &albumID},
+			},
+		},
+		{
+			name: "Repository Issue Is Only Logged",
+			mockBehavior: func(r *notificationMocks.MockRepository, artistsID []uint32) {
+				r.EXPECT().GetArtistsFans(ctx, artistsID).Return(nil, errors.New(""))
+			},
+			expectedSent: []models.Notification{},
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			channel := stub.NewChannel()
+			u := NewUsecase(r, commonTests.MockLogger(c), channel)
+
+			tc.mockBehavior(r, artistsID)
+
+			u.NotifyArtistsFans(ctx, n, artistsID)
+
+			assert.Equal(t, tc.expectedSent, channel.Sent())
+		})
+	}
+}
+
+func TestNotificationUsecase_GetInbox(t *testing.T) {
+	type mockBehavior func(r *notificationMocks.MockRepository, userID, limit, offset uint32)
+
+	c := gomock.NewController(t)
+
+	r := notificationMocks.NewMockRepository(c)
+
+	u := NewUsecase(r, commonTests.MockLogger(c))
+
+	const userID uint32 = 1
+	var actorID uint32 = 2
+
+	notifications := []models.Notification{
+		{ID: 1, UserID: userID, Type: models.NotificationTypeFollower, ActorID: &actorID},
+	}
+	const total uint32 = 3
+
+	testTable := []struct {
+		name             string
+		limit            uint32
+		offset           uint32
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:   "Common",
+			limit:  10,
+			offset: 2,
+			mockBehavior: func(r *notificationMocks.MockRepository, userID, limit, offset uint32) {
+				r.EXPECT().GetByUser(ctx, userID, limit, offset).Return(notifications, total, nil)
+			},
+		},
+		{
+			name: "Default Limit",
+			mockBehavior: func(r *notificationMocks.MockRepository, userID, limit, offset uint32) {
+				r.EXPECT().GetByUser(ctx, userID, models.NotificationDefaultLimit, offset).Return(notifications, total, nil)
+			},
+		},
+		{
+			name:             "Too Big Limit",
+			limit:            models.NotificationMaxLimit + 1,
+			mockBehavior:     func(r *notificationMocks.MockRepository, userID, limit, offset uint32) {},
+			expectError:      true,
+			expectedErrorMsg: "limit is too big",
+		},
+		{
+			name:  "Repository Issue",
+			limit: 10,
+			mockBehavior: func(r *notificationMocks.MockRepository, userID, limit, offset uint32) {
+				r.EXPECT().GetByUser(ctx, userID, limit, offset).Return(nil, uint32(0), errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get notifications",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(r, userID, tc.limit, tc.offset)
+
+			got, gotTotal, err := u.GetInbox(ctx, userID, tc.limit, tc.offset)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, notifications, got)
+				assert.Equal(t, total, gotTotal)
+			}
+		})
+	}
+}
+
+func TestNotificationUsecase_MarkRead(t *testing.T) {
+	type mockBehavior func(r *notificationMocks.MockRepository, notificationID, userID uint32)
+
+	c := gomock.NewController(t)
+
+	r := notificationMocks.NewMockRepository(c)
+
+	u := NewUsecase(r, commonTests.MockLogger(c))
+
+	const userID uint32 = 1
+	const notificationID uint32 = 2
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(r *notificationMocks.MockRepository, notificationID, userID uint32) {
+				r.EXPECT().MarkRead(ctx, notificationID, userID).Return(nil)
+			},
+		},
+		{
+			name: "No Such Notification",
+			mockBehavior: func(r *notificationMocks.MockRepository, notificationID, userID uint32) {
+				r.EXPECT().MarkRead(ctx, notificationID, userID).
+					Return(&models.NoSuchNotificationError{NotificationID: notificationID})
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchNotificationError{NotificationID: notificationID},
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(r, notificationID, userID)
+
+			err := u.MarkRead(ctx, notificationID, userID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
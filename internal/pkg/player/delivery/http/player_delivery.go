@@ -0,0 +1,119 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	easyjson "github.com/mailru/easyjson"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/player"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+)
+
+type Handler struct {
+	playerServices player.Usecase
+	logger         logger.Logger
+}
+
+func NewHandler(pu player.Usecase, l logger.Logger) *Handler {
+	return &Handler{
+		playerServices: pu,
+		logger:         l,
+	}
+}
+
+// @Summary		Player State
+// @Tags		Player
+// @Description	Get what user is listening now on any of devices: current track, position, queue and modes.
+// @Description	State of user who hasn't played anything yet has version 0.
+// @Produce		json
+// @Success		200		{object}	models.PlayerStateTransfer	"Player state got"
+// @Failure		401		{object}	http.Error					"User unathorized"
+// @Failure		500		{object}	http.Error					"Server error"
+// @Router		/api/player [get]
+func (h *Handler) GetState(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	state, err := h.playerServices.GetState(r.Context(), user.ID)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			playerGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	commonHTTP.SuccessResponse(w, r, models.PlayerStateTransferFromEntry(*state), h.logger)
+}
+
+// @Summary		Update Player State
+// @Tags		Player
+// @Description	Replace player state with one of device which is playing now. Version is version of state
+// @Description	device has changed: if state was changed on other device since then, it's rejected with 409,
+// @Description	so device gets the current state and tries again. Other devices get event of user:{id}:player topic.
+// @Accept		json
+// @Produce		json
+// @Param		state	body		playerStateInput			true	"New player state"
+// @Success		200		{object}	models.PlayerStateTransfer	"Player state updated"
+// @Failure		400		{object}	http.Error					"Client error"
+// @Failure		401		{object}	http.Error					"User unathorized"
+// @Failure		409		{object}	http.Error					"State is outdated"
+// @Failure		500		{object}	http.Error					"Server error"
+// @Router		/api/player [put]
+func (h *Handler) UpdateState(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	var psi playerStateInput
+	if err := easyjson.UnmarshalFromReader(r.Body, &psi); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := psi.validateAndEscape(); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	state, err := h.playerServices.UpdateState(r.Context(), psi.ToPlayerState(user.ID))
+	if err != nil {
+		var errInvalidState *models.InvalidPlayerStateError
+		if errors.As(err, &errInvalidState) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errNoSuchTrack *models.NoSuchTrackError
+		if errors.As(err, &errNoSuchTrack) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playerTrackNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errConflict *models.PlayerStateConflictError
+		if errors.As(err, &errConflict) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playerStateOutdated, http.StatusConflict, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			playerUpdateServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	commonHTTP.SuccessResponse(w, r, models.PlayerStateTransferFromEntry(*state), h.logger)
+}
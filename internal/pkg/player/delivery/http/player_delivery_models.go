@@ -0,0 +1,62 @@
+package http
+
+import (
+	"html"
+
+	valid "github.com/asaskevich/govalidator"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+//go:generate easyjson -no_std_marshalers player_delivery_models.go
+
+// Response messages
+const (
+	playerTrackNotFound = "no such track"
+	playerStateOutdated = "player state was changed on other device"
+
+	playerGetServerError    = "can't get player state"
+	playerUpdateServerError = "can't update player state"
+)
+
+//easyjson:json
+type playerStateInput struct {
+	TrackID    *uint32  `json:"trackID"`
+	PositionMs uint32   `json:"positionMs"`
+	Queue      []uint32 `json:"queue"`
+	Shuffle    bool     `json:"shuffle"`
+	RepeatMode string   `json:"repeat"`
+	DeviceID   string   `json:"deviceID"`
+	DeviceName string   `json:"deviceName" valid:"runelength(1|64)"`
+	// Version is version of state which device has changed
+	Version uint32 `json:"version"`
+}
+
+// validateAndEscape checks length of device name as typed by user, so it's escaped after that
+func (psi *playerStateInput) validateAndEscape() error {
+	if _, err := valid.ValidateStruct(psi); err != nil {
+		return err
+	}
+
+	psi.escapeHtml()
+
+	return nil
+}
+
+func (psi *playerStateInput) escapeHtml() {
+	psi.DeviceName = html.EscapeString(psi.DeviceName)
+}
+
+func (psi *playerStateInput) ToPlayerState(userID uint32) models.PlayerState {
+	return models.PlayerState{
+		UserID:     userID,
+		TrackID:    psi.TrackID,
+		PositionMs: psi.PositionMs,
+		Queue:      psi.Queue,
+		Shuffle:    psi.Shuffle,
+		RepeatMode: psi.RepeatMode,
+		DeviceID:   psi.DeviceID,
+		DeviceName: psi.DeviceName,
+		Version:    psi.Version,
+	}
+}
@@ -0,0 +1,164 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package http
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson219bb4ffDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlayerDeliveryHttp(in *jlexer.Lexer, out *playerStateInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "trackID":
+			if in.IsNull() {
+				in.Skip()
+				out.TrackID = nil
+			} else {
+				if out.TrackID == nil {
+					out.TrackID = new(uint32)
+				}
+				*out.TrackID = uint32(in.Uint32())
+			}
+		case "positionMs":
+			out.PositionMs = uint32(in.Uint32())
+		case "queue":
+			if in.IsNull() {
+				in.Skip()
+				out.Queue = nil
+			} else {
+				in.Delim('[')
+				if out.Queue == nil {
+					if !in.IsDelim(']') {
+						out.Queue = make([]uint32, 0, 16)
+					} else {
+						out.Queue = []uint32{}
+					}
+				} else {
+					out.Queue = (out.Queue)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 uint32
+					v1 = uint32(in.Uint32())
+					out.Queue = append(out.Queue, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "shuffle":
+			out.Shuffle = bool(in.Bool())
+		case "repeat":
+			out.RepeatMode = string(in.String())
+		case "deviceID":
+			out.DeviceID = string(in.String())
+		case "deviceName":
+			out.DeviceName = string(in.String())
+		case "version":
+			out.Version = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson219bb4ffEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlayerDeliveryHttp(out *jwriter.Writer, in playerStateInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"trackID\":"
+		out.RawString(prefix[1:])
+		if in.TrackID == nil {
+			out.RawString("null")
+		} else {
+			out.Uint32(uint32(*in.TrackID))
+		}
+	}
+	{
+		const prefix string = ",\"positionMs\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.PositionMs))
+	}
+	{
+		const prefix string = ",\"queue\":"
+		out.RawString(prefix)
+		if in.Queue == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Queue {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint32(uint32(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"shuffle\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Shuffle))
+	}
+	{
+		const prefix string = ",\"repeat\":"
+		out.RawString(prefix)
+		out.String(string(in.RepeatMode))
+	}
+	{
+		const prefix string = ",\"deviceID\":"
+		out.RawString(prefix)
+		out.String(string(in.DeviceID))
+	}
+	{
+		const prefix string = ",\"deviceName\":"
+		out.RawString(prefix)
+		out.String(string(in.DeviceName))
+	}
+	{
+		const prefix string = ",\"version\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Version))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v playerStateInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson219bb4ffEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlayerDeliveryHttp(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *playerStateInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson219bb4ffDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlayerDeliveryHttp(l, v)
+}
@@ -0,0 +1,297 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	playerMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/player/mocks"
+)
+
+func TestPlayerDeliveryHTTP_GetState(t *testing.T) {
+	// Init
+	type mockBehavior func(pu *playerMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	pu := playerMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(pu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/player", h.GetState)
+
+	// Test filling
+	user := &models.User{ID: 1}
+
+	var trackID uint32 = 2
+	state := &models.PlayerState{
+		UserID:     user.ID,
+		TrackID:    &trackID,
+		PositionMs: 15000,
+		Queue:      []uint32{trackID, 3},
+		Shuffle:    true,
+		RepeatMode: models.PlayerRepeatQueue,
+		DeviceID:   "web-1",
+		DeviceName: "Chrome",
+		Version:    4,
+		UpdatedAt:  time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	correctResponse := `{
+		"trackID": 2,
+		"positionMs": 15000,
+		"queue": [2, 3],
+		"shuffle": true,
+		"repeat": "queue",
+		"deviceID": "web-1",
+		"deviceName": "Chrome",
+		"version": 4,
+		"updatedAt": "2023-05-01T12:00:00Z"
+	}`
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Common",
+			user: user,
+			mockBehavior: func(pu *playerMocks.MockUsecase) {
+				pu.EXPECT().GetState(gomock.Any(), user.ID).Return(state, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:             "Unauthorized",
+			user:             nil,
+			mockBehavior:     func(pu *playerMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name: "Server Error",
+			user: user,
+			mockBehavior: func(pu *playerMocks.MockUsecase) {
+				pu.EXPECT().GetState(gomock.Any(), user.ID).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(playerGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(pu)
+
+			commonTests.DeliveryTestGet(t, r, "/api/player", tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestPlayerDeliveryHTTP_UpdateState(t *testing.T) {
+	// Init
+	type mockBehavior func(pu *playerMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	pu := playerMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(pu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Put("/api/player", h.UpdateState)
+
+	// Test filling
+	user := &models.User{ID: 1}
+
+	var trackID uint32 = 2
+	state := models.PlayerState{
+		UserID:     user.ID,
+		TrackID:    &trackID,
+		PositionMs: 15000,
+		Queue:      []uint32{trackID},
+		DeviceID:   "mobile-1",
+		DeviceName: "Phone",
+		Version:    4,
+	}
+
+	updated := state
+	updated.RepeatMode = models.PlayerRepeatOff
+	updated.Version = 5
+	updated.UpdatedAt = time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	correctRequestBody := `{
+		"trackID": 2,
+		"positionMs": 15000,
+		"queue": [2],
+		"deviceID": "mobile-1",
+		"deviceName": "Phone",
+		"version": 4
+	}`
+
+	correctResponse := `{
+		"trackID": 2,
+		"positionMs": 15000,
+		"queue": [2],
+		"shuffle": false,
+		"repeat": "off",
+		"deviceID": "mobile-1",
+		"deviceName": "Phone",
+		"version": 5,
+		"updatedAt": "2023-05-01T12:00:00Z"
+	}`
+
+	escapedState := state
+	escapedState.DeviceName = "Tom&#39;s &lt;Phone&gt;"
+
+	deviceNameRequestBody := func(deviceName string) string {
+		return `{
+			"trackID": 2,
+			"positionMs": 15000,
+			"queue": [2],
+			"deviceID": "mobile-1",
+			"deviceName": "` + deviceName + `",
+			"version": 4
+		}`
+	}
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		requestBody      string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:        "Common",
+			user:        user,
+			requestBody: correctRequestBody,
+			mockBehavior: func(pu *playerMocks.MockUsecase) {
+				pu.EXPECT().UpdateState(gomock.Any(), state).Return(&updated, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:             "Unauthorized",
+			user:             nil,
+			requestBody:      correctRequestBody,
+			mockBehavior:     func(pu *playerMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:             "Incorrect Body",
+			user:             user,
+			requestBody:      `{"trackID": "two"}`,
+			mockBehavior:     func(pu *playerMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:        "Device Name Escaped",
+			user:        user,
+			requestBody: deviceNameRequestBody("Tom's <Phone>"),
+			mockBehavior: func(pu *playerMocks.MockUsecase) {
+				pu.EXPECT().UpdateState(gomock.Any(), escapedState).Return(&updated, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:             "Too Long Device Name",
+			user:             user,
+			requestBody:      deviceNameRequestBody(strings.Repeat("a", 65)),
+			mockBehavior:     func(pu *playerMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:        "Longest Device Name Escaped",
+			user:        user,
+			requestBody: deviceNameRequestBody(strings.Repeat("<", 64)),
+			mockBehavior: func(pu *playerMocks.MockUsecase) {
+				longestState := state
+				longestState.DeviceName = strings.Repeat("&lt;", 64)
+				pu.EXPECT().UpdateState(gomock.Any(), longestState).Return(&updated, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:        "Invalid State",
+			user:        user,
+			requestBody: correctRequestBody,
+			mockBehavior: func(pu *playerMocks.MockUsecase) {
+				pu.EXPECT().UpdateState(gomock.Any(), state).
+					Return(nil, &models.InvalidPlayerStateError{Reason: "no device"})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:        "No Such Track",
+			user:        user,
+			requestBody: correctRequestBody,
+			mockBehavior: func(pu *playerMocks.MockUsecase) {
+				pu.EXPECT().UpdateState(gomock.Any(), state).
+					Return(nil, &models.NoSuchTrackError{TrackID: trackID})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(playerTrackNotFound),
+		},
+		{
+			name:        "Outdated Version",
+			user:        user,
+			requestBody: correctRequestBody,
+			mockBehavior: func(pu *playerMocks.MockUsecase) {
+				pu.EXPECT().UpdateState(gomock.Any(), state).
+					Return(nil, &models.PlayerStateConflictError{Version: state.Version})
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: commonTests.ErrorResponse(playerStateOutdated),
+		},
+		{
+			name:        "Server Error",
+			user:        user,
+			requestBody: correctRequestBody,
+			mockBehavior: func(pu *playerMocks.MockUsecase) {
+				pu.EXPECT().UpdateState(gomock.Any(), state).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(playerUpdateServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(pu)
+
+			commonTests.DeliveryTestPut(t, r, "/api/player", tc.requestBody, tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: player.go
+
+// Package mock_player is a generated GoMock package.
+package mock_player
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetState mocks base method.
+func (m *MockUsecase) GetState(ctx context.Context, userID uint32) (*models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetState", ctx, userID)
+	ret0, _ := ret[0].(*models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetState indicates an expected call of GetState.
+func (mr *MockUsecaseMockRecorder) GetState(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockUsecase)(nil).GetState), ctx, userID)
+}
+
+// UpdateState mocks base method.
+func (m *MockUsecase) UpdateState(ctx context.Context, state models.PlayerState) (*models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateState", ctx, state)
+	ret0, _ := ret[0].(*models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateState indicates an expected call of UpdateState.
+func (mr *MockUsecaseMockRecorder) UpdateState(ctx, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateState", reflect.TypeOf((*MockUsecase)(nil).UpdateState), ctx, state)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockRepository) Get(ctx context.Context, userID uint32) (*models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(*models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRepositoryMockRecorder) Get(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepository)(nil).Get), ctx, userID)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, state models.PlayerState) (*models.PlayerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, state)
+	ret0, _ := ret[0].(*models.PlayerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(ctx, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, state)
+}
+
+// MockTables is a mock of Tables interface.
+type MockTables struct {
+	ctrl     *gomock.Controller
+	recorder *MockTablesMockRecorder
+}
+
+// MockTablesMockRecorder is the mock recorder for MockTables.
+type MockTablesMockRecorder struct {
+	mock *MockTables
+}
+
+// NewMockTables creates a new mock instance.
+func NewMockTables(ctrl *gomock.Controller) *MockTables {
+	mock := &MockTables{ctrl: ctrl}
+	mock.recorder = &MockTablesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTables) EXPECT() *MockTablesMockRecorder {
+	return m.recorder
+}
+
+// PlayerStates mocks base method.
+func (m *MockTables) PlayerStates() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlayerStates")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// PlayerStates indicates an expected call of PlayerStates.
+func (mr *MockTablesMockRecorder) PlayerStates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayerStates", reflect.TypeOf((*MockTables)(nil).PlayerStates))
+}
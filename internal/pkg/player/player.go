@@ -0,0 +1,36 @@
+package player
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+//go:generate mockgen -source=player.go -destination=mocks/mock.go
+
+// Usecase includes bussiness logics methods to sync player between user's devices
+type Usecase interface {
+	// GetState returns empty state of version 0 if user hasn't played anything yet
+	GetState(ctx context.Context, userID uint32) (*models.PlayerState, error)
+
+	// UpdateState replaces state if its version is the current one and pushes change to user's devices.
+	// It returns models.PlayerStateConflictError if state was changed on other device since that version.
+	UpdateState(ctx context.Context, state models.PlayerState) (*models.PlayerState, error)
+}
+
+// Repository includes DBMS-relatable methods to work with player states
+type Repository interface {
+	// Get returns models.NoSuchPlayerStateError if user has no saved state
+	Get(ctx context.Context, userID uint32) (*models.PlayerState, error)
+
+	// Update saves state with increased version if stored one has the same version as state,
+	// state of version 0 is saved only if user has no saved one. It returns
+	// models.PlayerStateConflictError otherwise.
+	Update(ctx context.Context, state models.PlayerState) (*models.PlayerState, error)
+}
+
+// Tables includes methods which return needed tables
+// to work with player states on repository layer
+type Tables interface {
+	PlayerStates() string
+}
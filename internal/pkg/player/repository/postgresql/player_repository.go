@@ -0,0 +1,133 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/player"
+)
+
+// PostgreSQL implements player.Repository
+type PostgreSQL struct {
+	db     *sqlx.DB
+	tables player.Tables
+}
+
+func NewPostgreSQL(db *sqlx.DB, t player.Tables) *PostgreSQL {
+	return &PostgreSQL{
+		db:     db,
+		tables: t,
+	}
+}
+
+// playerStateRow is player state as it's stored: queue is array of INT
+type playerStateRow struct {
+	UserID     uint32        `db:"user_id"`
+	TrackID    *uint32       `db:"track_id"`
+	PositionMs uint32        `db:"position_ms"`
+	Queue      pq.Int64Array `db:"queue"`
+	Shuffle    bool          `db:"shuffle"`
+	RepeatMode string        `db:"repeat_mode"`
+	DeviceID   string        `db:"device_id"`
+	DeviceName string        `db:"device_name"`
+	Version    uint32        `db:"version"`
+	UpdatedAt  time.Time     `db:"updated_at"`
+}
+
+func (r playerStateRow) state() *models.PlayerState {
+	queue := make([]uint32, 0, len(r.Queue))
+	for _, trackID := range r.Queue {
+		queue = append(queue, uint32(trackID))
+	}
+
+	return &models.PlayerState{
+		UserID:     r.UserID,
+		TrackID:    r.TrackID,
+		PositionMs: r.PositionMs,
+		Queue:      queue,
+		Shuffle:    r.Shuffle,
+		RepeatMode: r.RepeatMode,
+		DeviceID:   r.DeviceID,
+		DeviceName: r.DeviceName,
+		Version:    r.Version,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+func (p *PostgreSQL) Get(ctx context.Context, userID uint32) (*models.PlayerState, error) {
+	query := fmt.Sprintf(
+		`SELECT user_id, track_id, position_ms, queue, shuffle, repeat_mode,
+			device_id, device_name, version, updated_at
+		FROM %s
+		WHERE user_id = $1;`,
+		p.tables.PlayerStates())
+
+	var row playerStateRow
+	if err := p.db.GetContext(ctx, &row, query, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchPlayerStateError{UserID: userID}, err)
+		}
+
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return row.state(), nil
+}
+
+func (p *PostgreSQL) Update(ctx context.Context, state models.PlayerState) (*models.PlayerState, error) {
+	// Nothing is returned if state was changed since state.Version:
+	// state of version 0 is inserted only if user has no state yet
+	var query string
+	if state.Version == 0 {
+		query = fmt.Sprintf(
+			`INSERT INTO %s (user_id, track_id, position_ms, queue, shuffle, repeat_mode,
+				device_id, device_name, version)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9 + 1)
+			ON CONFLICT (user_id) DO NOTHING
+			RETURNING user_id, track_id, position_ms, queue, shuffle, repeat_mode,
+				device_id, device_name, version, updated_at;`,
+			p.tables.PlayerStates())
+	} else {
+		query = fmt.Sprintf(
+			`UPDATE %s
+			SET track_id = $2,
+				position_ms = $3,
+				queue = $4,
+				shuffle = $5,
+				repeat_mode = $6,
+				device_id = $7,
+				device_name = $8,
+				version = version + 1,
+				updated_at = NOW()
+			WHERE user_id = $1 AND version = $9
+			RETURNING user_id, track_id, position_ms, queue, shuffle, repeat_mode,
+				device_id, device_name, version, updated_at;`,
+			p.tables.PlayerStates())
+	}
+
+	queue := make(pq.Int64Array, 0, len(state.Queue))
+	for _, trackID := range state.Queue {
+		queue = append(queue, int64(trackID))
+	}
+
+	var row playerStateRow
+	if err := p.db.GetContext(ctx, &row, query,
+		state.UserID, state.TrackID, state.PositionMs, queue, state.Shuffle, state.RepeatMode,
+		state.DeviceID, state.DeviceName, state.Version); err != nil {
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("(repo) %w: %w", &models.PlayerStateConflictError{Version: state.Version}, err)
+		}
+
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return row.state(), nil
+}
@@ -0,0 +1,263 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	playerMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/player/mocks"
+)
+
+var ctx = context.Background()
+
+const playerStatesTable = "Player_states"
+
+var errPqInternal = errors.New("postgres is dead")
+
+var playerStateColumns = []string{"user_id", "track_id", "position_ms", "queue", "shuffle", "repeat_mode",
+	"device_id", "device_name", "version", "updated_at"}
+
+func playerStateRows(sqlxMock sqlmock.Sqlmock, s models.PlayerState) *sqlmock.Rows {
+	return sqlxMock.NewRows(playerStateColumns).
+		AddRow(s.UserID, s.TrackID, s.PositionMs, "{2,3}", s.Shuffle, s.RepeatMode,
+			s.DeviceID, s.DeviceName, s.Version, s.UpdatedAt)
+}
+
+func TestPlayerRepositoryPostgreSQL_Get(t *testing.T) {
+	// Init
+	type mockBehavior func(userID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := playerMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultUserID uint32 = 1
+
+	var trackID uint32 = 2
+	expectedState := models.PlayerState{
+		UserID:     defaultUserID,
+		TrackID:    &trackID,
+		PositionMs: 15000,
+		Queue:      []uint32{2, 3},
+		RepeatMode: models.PlayerRepeatQueue,
+		DeviceID:   "mobile-1",
+		DeviceName: "Phone",
+		Version:    4,
+		UpdatedAt:  time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectedState *models.PlayerState
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID uint32) {
+				tablesMock.EXPECT().PlayerStates().Return(playerStatesTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + playerStatesTable + "(.+)WHERE user_id = \\$1").
+					WithArgs(userID).
+					WillReturnRows(playerStateRows(sqlxMock, expectedState))
+			},
+			expectedState: &expectedState,
+		},
+		{
+			name: "No Such State",
+			mockBehavior: func(userID uint32) {
+				tablesMock.EXPECT().PlayerStates().Return(playerStatesTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + playerStatesTable).
+					WithArgs(userID).
+					WillReturnRows(sqlxMock.NewRows(playerStateColumns))
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchPlayerStateError{UserID: defaultUserID},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID uint32) {
+				tablesMock.EXPECT().PlayerStates().Return(playerStatesTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + playerStatesTable).
+					WithArgs(userID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultUserID)
+
+			state, err := repo.Get(ctx, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedState, state)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPlayerRepositoryPostgreSQL_Update(t *testing.T) {
+	// Init
+	type mockBehavior func(s models.PlayerState)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := playerMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	var trackID uint32 = 2
+	newState := func(version uint32) models.PlayerState {
+		return models.PlayerState{
+			UserID:     1,
+			TrackID:    &trackID,
+			PositionMs: 15000,
+			Queue:      []uint32{2, 3},
+			RepeatMode: models.PlayerRepeatQueue,
+			DeviceID:   "mobile-1",
+			DeviceName: "Phone",
+			Version:    version,
+		}
+	}
+
+	savedState := func(version uint32) *models.PlayerState {
+		s := newState(version + 1)
+		s.UpdatedAt = time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+		return &s
+	}
+
+	stateArgs := func(s models.PlayerState) []driver.Value {
+		return []driver.Value{s.UserID, s.TrackID, s.PositionMs, pq.Int64Array{2, 3}, s.Shuffle, s.RepeatMode,
+			s.DeviceID, s.DeviceName, s.Version}
+	}
+
+	testTable := []struct {
+		name          string
+		state         models.PlayerState
+		mockBehavior  mockBehavior
+		expectedState *models.PlayerState
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name:  "Common",
+			state: newState(4),
+			mockBehavior: func(s models.PlayerState) {
+				tablesMock.EXPECT().PlayerStates().Return(playerStatesTable)
+
+				sqlxMock.ExpectQuery("UPDATE " + playerStatesTable + "(.+)version = version \\+ 1" +
+					"(.+)WHERE user_id = \\$1 AND version = \\$9").
+					WithArgs(stateArgs(s)...).
+					WillReturnRows(playerStateRows(sqlxMock, *savedState(s.Version)))
+			},
+			expectedState: savedState(4),
+		},
+		{
+			name:  "Changed On Other Device",
+			state: newState(4),
+			mockBehavior: func(s models.PlayerState) {
+				tablesMock.EXPECT().PlayerStates().Return(playerStatesTable)
+
+				sqlxMock.ExpectQuery("UPDATE " + playerStatesTable).
+					WithArgs(stateArgs(s)...).
+					WillReturnRows(sqlxMock.NewRows(playerStateColumns))
+			},
+			expectError:   true,
+			expectedError: &models.PlayerStateConflictError{Version: 4},
+		},
+		{
+			name:  "First State",
+			state: newState(0),
+			mockBehavior: func(s models.PlayerState) {
+				tablesMock.EXPECT().PlayerStates().Return(playerStatesTable)
+
+				sqlxMock.ExpectQuery("INSERT INTO " + playerStatesTable + "(.+)ON CONFLICT \\(user_id\\) DO NOTHING").
+					WithArgs(stateArgs(s)...).
+					WillReturnRows(playerStateRows(sqlxMock, *savedState(s.Version)))
+			},
+			expectedState: savedState(0),
+		},
+		{
+			name:  "First State Saved On Other Device",
+			state: newState(0),
+			mockBehavior: func(s models.PlayerState) {
+				tablesMock.EXPECT().PlayerStates().Return(playerStatesTable)
+
+				sqlxMock.ExpectQuery("INSERT INTO " + playerStatesTable).
+					WithArgs(stateArgs(s)...).
+					WillReturnRows(sqlxMock.NewRows(playerStateColumns))
+			},
+			expectError:   true,
+			expectedError: &models.PlayerStateConflictError{Version: 0},
+		},
+		{
+			name:  "Internal PostgreSQL Error",
+			state: newState(4),
+			mockBehavior: func(s models.PlayerState) {
+				tablesMock.EXPECT().PlayerStates().Return(playerStatesTable)
+
+				sqlxMock.ExpectQuery("UPDATE " + playerStatesTable).
+					WithArgs(stateArgs(s)...).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tc.state)
+
+			state, err := repo.Update(ctx, tc.state)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedState, state)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/player"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+)
+
+// Usecase implements player.Usecase
+type Usecase struct {
+	playerRepo player.Repository
+	trackRepo  track.Repository
+	publisher  realtime.Publisher
+
+	logger logger.Logger
+}
+
+func NewUsecase(pr player.Repository, tr track.Repository, pub realtime.Publisher, l logger.Logger) *Usecase {
+	return &Usecase{
+		playerRepo: pr,
+		trackRepo:  tr,
+		publisher:  pub,
+		logger:     l,
+	}
+}
+
+func (u *Usecase) GetState(ctx context.Context, userID uint32) (*models.PlayerState, error) {
+	state, err := u.playerRepo.Get(ctx, userID)
+	if err != nil {
+		var errNoSuchState *models.NoSuchPlayerStateError
+		if errors.As(err, &errNoSuchState) {
+			return &models.PlayerState{
+				UserID:     userID,
+				Queue:      []uint32{},
+				RepeatMode: models.PlayerRepeatOff,
+			}, nil
+		}
+
+		return nil, fmt.Errorf("(usecase) can't get player state from repository: %w", err)
+	}
+
+	return state, nil
+}
+
+func (u *Usecase) UpdateState(ctx context.Context, state models.PlayerState) (*models.PlayerState, error) {
+	if err := state.Validate(); err != nil {
+		return nil, fmt.Errorf("(usecase) %w", err)
+	}
+
+	tracksID := state.Queue
+	if state.TrackID != nil {
+		tracksID = append([]uint32{*state.TrackID}, state.Queue...)
+	}
+	if len(tracksID) > 0 {
+		if err := u.trackRepo.CheckMany(ctx, tracksID); err != nil {
+			return nil, fmt.Errorf("(usecase) can't find tracks of player state: %w", err)
+		}
+	}
+
+	updated, err := u.playerRepo.Update(ctx, state)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't update player state in repository: %w", err)
+	}
+
+	// Device which made update also gets event: it knows the version and skips it
+	event := models.RealtimeEvent{
+		Topic:   models.PlayerTopic(updated.UserID),
+		Type:    models.RealtimeEventPlayerStateChanged,
+		Version: updated.Version,
+	}
+	if err := u.publisher.Publish(ctx, event); err != nil {
+		u.logger.ErrorfReqID(ctx, "can't publish event of player state of user #%d: %v", updated.UserID, err)
+	}
+
+	return updated, nil
+}
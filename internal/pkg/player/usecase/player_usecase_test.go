@@ -0,0 +1,306 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	playerMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/player/mocks"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/broker/local"
+	realtimeMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/mocks"
+	trackMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/mocks"
+)
+
+var ctx = context.Background()
+
+func TestPlayerUsecase_GetState(t *testing.T) {
+	type mockBehavior func(pr *playerMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	pr := playerMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+
+	u := NewUsecase(pr, tr, local.NewBroker(), commonTests.MockLogger(c))
+
+	const userID uint32 = 1
+	var trackID uint32 = 2
+
+	state := &models.PlayerState{
+		UserID:     userID,
+		TrackID:    &trackID,
+		Queue:      []uint32{trackID, 3},
+		RepeatMode: models.PlayerRepeatQueue,
+		DeviceID:   "web",
+		Version:    4,
+	}
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectedState    *models.PlayerState
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(pr *playerMocks.MockRepository) {
+				pr.EXPECT().Get(ctx, userID).Return(state, nil)
+			},
+			expectedState: state,
+		},
+		{
+			name: "No State Yet",
+			mockBehavior: func(pr *playerMocks.MockRepository) {
+				pr.EXPECT().Get(ctx, userID).Return(nil, &models.NoSuchPlayerStateError{UserID: userID})
+			},
+			expectedState: &models.PlayerState{
+				UserID:     userID,
+				Queue:      []uint32{},
+				RepeatMode: models.PlayerRepeatOff,
+			},
+		},
+		{
+			name: "Repository Issue",
+			mockBehavior: func(pr *playerMocks.MockRepository) {
+				pr.EXPECT().Get(ctx, userID).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get player state from repository",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(pr)
+
+			state, err := u.GetState(ctx, userID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedState, state)
+			}
+		})
+	}
+}
+
+func TestPlayerUsecase_UpdateState(t *testing.T) {
+	type mockBehavior func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	pr := playerMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+
+	broker := local.NewBroker()
+	u := NewUsecase(pr, tr, broker, commonTests.MockLogger(c))
+
+	const userID uint32 = 1
+	var trackID uint32 = 2
+	const queuedTrackID uint32 = 3
+
+	newState := func() models.PlayerState {
+		return models.PlayerState{
+			UserID:     userID,
+			TrackID:    &trackID,
+			PositionMs: 15000,
+			Queue:      []uint32{trackID, queuedTrackID},
+			DeviceID:   "mobile",
+			DeviceName: "Phone",
+			Version:    4,
+		}
+	}
+
+	stateTracksID := []uint32{trackID, trackID, queuedTrackID}
+
+	validated := newState()
+	validated.RepeatMode = models.PlayerRepeatOff
+
+	updated := validated
+	updated.Version = 5
+	updated.UpdatedAt = time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	stateChanged := models.RealtimeEvent{
+		Topic:   models.PlayerTopic(userID),
+		Type:    models.RealtimeEventPlayerStateChanged,
+		Version: updated.Version,
+	}
+
+	testTable := []struct {
+		name             string
+		state            func() models.PlayerState
+		mockBehavior     mockBehavior
+		expectedState    *models.PlayerState
+		expectedEvent    *models.RealtimeEvent
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:  "Common",
+			state: newState,
+			mockBehavior: func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository) {
+				tr.EXPECT().CheckMany(ctx, stateTracksID).Return(nil)
+				pr.EXPECT().Update(ctx, validated).Return(&updated, nil)
+			},
+			expectedState: &updated,
+			expectedEvent: &stateChanged,
+		},
+		{
+			name: "Nothing Playing",
+			state: func() models.PlayerState {
+				s := newState()
+				s.TrackID = nil
+				s.Queue = nil
+				return s
+			},
+			mockBehavior: func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository) {
+				pr.EXPECT().Update(ctx, gomock.Any()).Return(&updated, nil)
+			},
+			expectedState: &updated,
+			expectedEvent: &stateChanged,
+		},
+		{
+			name: "Unknown Repeat Mode",
+			state: func() models.PlayerState {
+				s := newState()
+				s.RepeatMode = "forever"
+				return s
+			},
+			mockBehavior:     func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository) {},
+			expectError:      true,
+			expectedErrorMsg: "invalid player state",
+		},
+		{
+			name: "No Device",
+			state: func() models.PlayerState {
+				s := newState()
+				s.DeviceID = ""
+				return s
+			},
+			mockBehavior:     func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository) {},
+			expectError:      true,
+			expectedErrorMsg: "invalid player state",
+		},
+		{
+			name: "Too Long Queue",
+			state: func() models.PlayerState {
+				s := newState()
+				s.Queue = make([]uint32, models.PlayerQueueMaxLen+1)
+				return s
+			},
+			mockBehavior:     func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository) {},
+			expectError:      true,
+			expectedErrorMsg: "invalid player state",
+		},
+		{
+			name: "Queue Without Track",
+			state: func() models.PlayerState {
+				s := newState()
+				s.TrackID = nil
+				return s
+			},
+			mockBehavior: func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository) {
+				tr.EXPECT().CheckMany(ctx, []uint32{trackID, queuedTrackID}).Return(nil)
+				pr.EXPECT().Update(ctx, gomock.Any()).Return(&updated, nil)
+			},
+			expectedState: &updated,
+			expectedEvent: &stateChanged,
+		},
+		{
+			name:  "No Such Track",
+			state: newState,
+			mockBehavior: func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository) {
+				tr.EXPECT().CheckMany(ctx, stateTracksID).Return(&models.NoSuchTrackError{TrackID: trackID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "track #2 doesn't exist",
+		},
+		{
+			name:  "No Such Queued Track",
+			state: newState,
+			mockBehavior: func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository) {
+				tr.EXPECT().CheckMany(ctx, stateTracksID).Return(&models.NoSuchTrackError{TrackID: queuedTrackID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "track #3 doesn't exist",
+		},
+		{
+			name:  "Outdated Version",
+			state: newState,
+			mockBehavior: func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository) {
+				tr.EXPECT().CheckMany(ctx, stateTracksID).Return(nil)
+				pr.EXPECT().Update(ctx, validated).Return(nil, &models.PlayerStateConflictError{Version: 4})
+			},
+			expectError:      true,
+			expectedErrorMsg: "player state was changed since version 4",
+		},
+		{
+			name:  "Repository Issue",
+			state: newState,
+			mockBehavior: func(pr *playerMocks.MockRepository, tr *trackMocks.MockRepository) {
+				tr.EXPECT().CheckMany(ctx, stateTracksID).Return(nil)
+				pr.EXPECT().Update(ctx, validated).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't update player state in repository",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(pr, tr)
+
+			events := make(chan models.RealtimeEvent, 1)
+			cancel := broker.Subscribe(models.PlayerTopic(userID), events)
+			defer cancel()
+
+			state, err := u.UpdateState(ctx, tc.state())
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+				assert.Empty(t, events)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedState, state)
+				assert.Equal(t, *tc.expectedEvent, <-events)
+			}
+		})
+	}
+}
+
+func TestPlayerUsecase_UpdateStatePublishIssue(t *testing.T) {
+	c := gomock.NewController(t)
+
+	pr := playerMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+	pub := realtimeMocks.NewMockPublisher(c)
+
+	u := NewUsecase(pr, tr, pub, commonTests.MockLogger(c))
+
+	state := models.PlayerState{
+		UserID:     1,
+		Queue:      []uint32{},
+		RepeatMode: models.PlayerRepeatOff,
+		DeviceID:   "mobile",
+		Version:    4,
+	}
+	updated := state
+	updated.Version = 5
+
+	pr.EXPECT().Update(ctx, state).Return(&updated, nil)
+	pub.EXPECT().Publish(ctx, gomock.Any()).Return(errors.New(""))
+
+	// State is already saved, so other devices just miss this push
+	got, err := u.UpdateState(ctx, state)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &updated, got)
+}
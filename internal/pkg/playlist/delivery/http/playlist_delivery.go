@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"path/filepath"
+	"strconv"
 
 	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
@@ -74,6 +75,13 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		var errInvalidRules *models.InvalidSmartRulesError
+		if errors.As(err, &errInvalidRules) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistInvalidRules, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			playlistCreateServerError, http.StatusInternalServerError, h.logger, err)
 		return
@@ -278,6 +286,86 @@ func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
 	commonHTTP.SuccessResponse(w, r, dr, h.logger)
 }
 
+// @Summary		Update Rules
+// @Tags		Playlist
+// @Description	Update rules of smart playlist
+// @Accept		json
+// @Produce		json
+// @Param		rules	body		playlistRulesInput	true	"Smart playlist rules"
+// @Success		200		{object}	defaultResponse				"Rules updated"
+// @Failure		400		{object}	http.Error					"Client error"
+// @Failure		401		{object}	http.Error  				"User unathorized"
+// @Failure		403		{object}	http.Error					"User hasn't rights"
+// @Failure		500		{object}	http.Error					"Server error"
+// @Router		/api/playlists/{playlistID}/rules [post]
+func (h *Handler) UpdateRules(w http.ResponseWriter, r *http.Request) {
+	playlistID, err := commonHTTP.GetPlaylistIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	var pri playlistRulesInput
+	if err := easyjson.UnmarshalFromReader(r.Body, &pri); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := pri.validate(); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			playlistInvalidRules, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	err = h.playlistServices.UpdateRules(r.Context(), playlistID, pri.Rules, user.ID)
+	if err != nil {
+		var errForbiddenUser *models.ForbiddenUserError
+		if errors.As(err, &errForbiddenUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistUpdateRulesNoRights, http.StatusForbidden, h.logger, err)
+			return
+		}
+
+		var errNoSuchPlaylist *models.NoSuchPlaylistError
+		if errors.As(err, &errNoSuchPlaylist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errNotSmart *models.NotSmartPlaylistError
+		if errors.As(err, &errNotSmart) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistNotSmart, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errInvalidRules *models.InvalidSmartRulesError
+		if errors.As(err, &errInvalidRules) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistInvalidRules, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			playlistUpdateRulesServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	dr := defaultResponse{Status: playlistRulesUpdatedSuccessfully}
+
+	commonHTTP.SuccessResponse(w, r, dr, h.logger)
+}
+
 // @Summary		Delete Playlist
 // @Tags		Playlist
 // @Description	Delete playlist with chosen ID
@@ -329,6 +417,162 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 	commonHTTP.SuccessResponse(w, r, dr, h.logger)
 }
 
+// @Summary		Fork Playlist
+// @Tags		Playlist
+// @Description	Create copy of playlist with chosen ID owned by user
+// @Produce		json
+// @Success		200		{object}	playlistCreateResponse	"Playlist forked"
+// @Failure		400		{object}	http.Error				"Client error"
+// @Failure		401		{object}	http.Error  			"User unathorized"
+// @Failure		500		{object}	http.Error				"Server error"
+// @Router		/api/playlists/{playlistID}/fork [post]
+func (h *Handler) Fork(w http.ResponseWriter, r *http.Request) {
+	playlistID, err := commonHTTP.GetPlaylistIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	forkID, err := h.playlistServices.Fork(r.Context(), playlistID, user.ID)
+	if err != nil {
+		var errNoSuchPlaylist *models.NoSuchPlaylistError
+		if errors.As(err, &errNoSuchPlaylist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			playlistForkServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	pcr := playlistCreateResponse{ID: forkID}
+
+	commonHTTP.SuccessResponse(w, r, pcr, h.logger)
+}
+
+// @Summary		Playlist History
+// @Tags		Playlist
+// @Description	The latest changes of playlist with chosen ID, the newest first
+// @Produce		json
+// @Success		200		{object}	models.PlaylistHistoryTransfers	"Playlist history"
+// @Failure		400		{object}	http.Error						"Client error"
+// @Failure		401		{object}	http.Error  					"User unathorized"
+// @Failure		403		{object}	http.Error						"User hasn't rights"
+// @Failure		500		{object}	http.Error						"Server error"
+// @Router		/api/playlists/{playlistID}/history [get]
+func (h *Handler) History(w http.ResponseWriter, r *http.Request) {
+	playlistID, err := commonHTTP.GetPlaylistIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	entries, err := h.playlistServices.GetHistory(r.Context(), playlistID, user.ID)
+	if err != nil {
+		var errForbiddenUser *models.ForbiddenUserError
+		if errors.As(err, &errForbiddenUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistHistoryNoRights, http.StatusForbidden, h.logger, err)
+			return
+		}
+
+		var errNoSuchPlaylist *models.NoSuchPlaylistError
+		if errors.As(err, &errNoSuchPlaylist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			playlistHistoryServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	commonHTTP.SuccessResponse(w, r, models.PlaylistHistoryTransferFromList(entries), h.logger)
+}
+
+// @Summary		Restore Playlist
+// @Tags		Playlist
+// @Description	Revert all changes of playlist made after revision with chosen ID
+// @Produce		json
+// @Success		200		{object}	defaultResponse	"Playlist restored"
+// @Failure		400		{object}	http.Error		"Client error"
+// @Failure		401		{object}	http.Error  	"User unathorized"
+// @Failure		403		{object}	http.Error		"User hasn't rights"
+// @Failure		500		{object}	http.Error		"Server error"
+// @Router		/api/playlists/{playlistID}/history/{revisionID}/restore [post]
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	playlistID, err := commonHTTP.GetPlaylistIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	revisionID, err := commonHTTP.GetRevisionIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	if err := h.playlistServices.RestoreRevision(r.Context(), playlistID, revisionID, user.ID); err != nil {
+		var errForbiddenUser *models.ForbiddenUserError
+		if errors.As(err, &errForbiddenUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistRestoreNoRights, http.StatusForbidden, h.logger, err)
+			return
+		}
+
+		var errNoSuchPlaylist *models.NoSuchPlaylistError
+		if errors.As(err, &errNoSuchPlaylist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errNoSuchRevision *models.NoSuchPlaylistRevisionError
+		if errors.As(err, &errNoSuchRevision) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistRevisionNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			playlistRestoreServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	dr := defaultResponse{Status: playlistRestoredSuccessfully}
+
+	commonHTTP.SuccessResponse(w, r, dr, h.logger)
+}
+
 // @Summary		Playlists of User
 // @Tags		User
 // @Description	All playlists of user with chosen ID
@@ -424,6 +668,13 @@ func (h *Handler) AddTrack(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		var errSmartPlaylist *models.SmartPlaylistModifyError
+		if errors.As(err, &errSmartPlaylist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistSmartTracksNoChange, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
 		var errNoSuchTrack *models.NoSuchTrackError
 		if errors.As(err, &errNoSuchTrack) {
 			commonHTTP.ErrorResponseWithErrLogging(w, r,
@@ -488,6 +739,13 @@ func (h *Handler) DeleteTrack(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		var errSmartPlaylist *models.SmartPlaylistModifyError
+		if errors.As(err, &errSmartPlaylist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistSmartTracksNoChange, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
 		var errNoSuchTrack *models.NoSuchTrackError
 		if errors.As(err, &errNoSuchTrack) {
 			commonHTTP.ErrorResponseWithErrLogging(w, r,
@@ -505,23 +763,202 @@ func (h *Handler) DeleteTrack(w http.ResponseWriter, r *http.Request) {
 	commonHTTP.SuccessResponse(w, r, dr, h.logger)
 }
 
+// @Summary		Add Tracks
+// @Tags		Playlist
+// @Description	Add tracks and tracks of album into playlist in one request
+// @Accept		json
+// @Produce		json
+// @Param		tracks	body		playlistTracksBatchInput	true	"Tracks and album"
+// @Success		200		{object}	playlistTracksBatchResponse	"Result for every track"
+// @Failure		400		{object}	http.Error					"Client error"
+// @Failure		401		{object}	http.Error  				"User unathorized"
+// @Failure		403		{object}	http.Error					"User hasn't rights"
+// @Failure		500		{object}	http.Error					"Server error"
+// @Router		/api/playlists/{playlistID}/tracks/ [post]
+func (h *Handler) AddTracks(w http.ResponseWriter, r *http.Request) {
+	playlistID, err := commonHTTP.GetPlaylistIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	var pti playlistTracksBatchInput
+	if err := easyjson.UnmarshalFromReader(r.Body, &pti); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := pti.validate(); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	results, err := h.playlistServices.AddTracks(r.Context(), pti.TracksID, pti.AlbumID, playlistID, user.ID)
+	if err != nil {
+		var errForbiddenUser *models.ForbiddenUserError
+		if errors.As(err, &errForbiddenUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistAddTrackNoRights, http.StatusForbidden, h.logger, err)
+			return
+		}
+
+		var errNoSuchPlaylist *models.NoSuchPlaylistError
+		if errors.As(err, &errNoSuchPlaylist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errSmartPlaylist *models.SmartPlaylistModifyError
+		if errors.As(err, &errSmartPlaylist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistSmartTracksNoChange, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errNoSuchAlbum *models.NoSuchAlbumError
+		if errors.As(err, &errNoSuchAlbum) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				albumNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errTooBigBatch *models.TooBigTracksBatchError
+		if errors.As(err, &errTooBigBatch) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistTooBigBatch, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			playlistBatchAddServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := playlistTracksBatchResponse{Results: results}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
+
+// @Summary		Delete Tracks
+// @Tags		Playlist
+// @Description	Delete tracks and tracks of album from playlist in one request
+// @Accept		json
+// @Produce		json
+// @Param		tracks	body		playlistTracksBatchInput	true	"Tracks and album"
+// @Success		200		{object}	playlistTracksBatchResponse	"Result for every track"
+// @Failure		400		{object}	http.Error					"Client error"
+// @Failure		401		{object}	http.Error  				"User unathorized"
+// @Failure		403		{object}	http.Error					"User hasn't rights"
+// @Failure		500		{object}	http.Error					"Server error"
+// @Router		/api/playlists/{playlistID}/tracks/delete [post]
+func (h *Handler) DeleteTracks(w http.ResponseWriter, r *http.Request) {
+	playlistID, err := commonHTTP.GetPlaylistIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	var pti playlistTracksBatchInput
+	if err := easyjson.UnmarshalFromReader(r.Body, &pti); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := pti.validate(); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	results, err := h.playlistServices.DeleteTracks(r.Context(), pti.TracksID, pti.AlbumID, playlistID, user.ID)
+	if err != nil {
+		var errForbiddenUser *models.ForbiddenUserError
+		if errors.As(err, &errForbiddenUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistDeleteTrackNoRights, http.StatusForbidden, h.logger, err)
+			return
+		}
+
+		var errNoSuchPlaylist *models.NoSuchPlaylistError
+		if errors.As(err, &errNoSuchPlaylist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errSmartPlaylist *models.SmartPlaylistModifyError
+		if errors.As(err, &errSmartPlaylist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistSmartTracksNoChange, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errNoSuchAlbum *models.NoSuchAlbumError
+		if errors.As(err, &errNoSuchAlbum) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				albumNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errTooBigBatch *models.TooBigTracksBatchError
+		if errors.As(err, &errTooBigBatch) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				playlistTooBigBatch, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			playlistBatchDeleteServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := playlistTracksBatchResponse{Results: results}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
+
 // @Summary		Playlist Feed
 // @Tags		Feed
-// @Description	Feed playlists
+// @Description	Feed playlists: personal ones for logged in user, general ones for anonymous
 // @Produce		json
 // @Success		200		{object}	models.PlaylistTransfers	 "Playlist feed"
 // @Failure		500		{object}	http.Error "Server error"
 // @Router		/api/playlists/feed [get]
 func (h *Handler) Feed(w http.ResponseWriter, r *http.Request) {
-	playlists, err := h.playlistServices.GetFeed(r.Context())
-	if err != nil {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			playlistsGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
 	}
 
-	user, err := commonHTTP.GetUserFromRequest(r)
-	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+	var playlists []models.Playlist
+	if user != nil {
+		playlists, err = h.playlistServices.GetPersonalFeed(r.Context(), user.ID)
+	} else {
+		playlists, err = h.playlistServices.GetFeed(r.Context())
+	}
+	if err != nil {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			playlistsGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
@@ -542,7 +979,13 @@ func (h *Handler) Feed(w http.ResponseWriter, r *http.Request) {
 // @Tags         Favorite
 // @Description  Get user's favorite playlists
 // @Produce      json
+// @Param		 sort	query		string	false	"Sorting: likedAt or name"
+// @Param		 order	query		string	false	"Order: asc or desc"
+// @Param		 q		query		string	false	"Filter by name of playlist"
+// @Param		 limit	query		int		false	"Page size"
+// @Param		 offset	query		int		false	"Page offset"
 // @Success      200    {object}  	models.PlaylistTransfers 	"Playlists got"
+// @Header		 200	{integer}	X-Total-Count				"Total amount of liked playlists"
 // @Failure		 400	{object}	http.Error					"Incorrect input"
 // @Failure      401    {object}  	http.Error  				"Unauthorized user"
 // @Failure      403    {object}  	http.Error  				"Forbidden user"
@@ -556,8 +999,22 @@ func (h *Handler) GetFavorite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	favPlaylists, err := h.playlistServices.GetLikedByUser(r.Context(), user.ID)
+	q, err := commonHTTP.GetFavoritesQueryFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	favPlaylists, total, err := h.playlistServices.GetLikedByUser(r.Context(), user.ID, q)
 	if err != nil {
+		var errInvalidQuery *models.InvalidFavoritesQueryError
+		if errors.As(err, &errInvalidQuery) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			playlistsGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
@@ -571,6 +1028,7 @@ func (h *Handler) GetFavorite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set(commonHTTP.TotalCountHeader, strconv.FormatUint(uint64(total), 10))
 	commonHTTP.SuccessResponse(w, r, at, h.logger)
 }
 
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"html"
 
 	valid "github.com/asaskevich/govalidator"
@@ -18,7 +19,13 @@ const (
 	playlistNotFound = "no such playlist"
 	trackNotFound    = "no such track"
 	userNotFound     = "no such user"
+	albumNotFound    = "no such album"
 
+	playlistNotSmart             = "playlist isn't smart"
+	playlistSmartTracksNoChange  = "tracks of smart playlist can't be changed manually"
+	playlistTooBigBatch          = "too many tracks in batch"
+	playlistInvalidRules         = "invalid smart playlist rules"
+	playlistRevisionNotFound     = "no such revision of playlist"
 	playlistCoverInvalidData     = "invalid cover data"
 	playlistCoverInvalidDataType = "invalid cover data type"
 	playlistCoverUploadNoRights  = "no rights to upload cover"
@@ -29,6 +36,9 @@ const (
 	playlistDeleteNoRights      = "no rights to delete playlist"
 	playlistAddTrackNoRights    = "no rights to add track into playlist"
 	playlistDeleteTrackNoRights = "no rights to delete track from playlist"
+	playlistUpdateRulesNoRights = "no rights to update rules of playlist"
+	playlistHistoryNoRights     = "no rights to get history of playlist"
+	playlistRestoreNoRights     = "no rights to restore playlist"
 
 	playlistCreateServerError      = "can't create playlist"
 	playlistGetServerError         = "can't get playlist"
@@ -37,29 +47,44 @@ const (
 	playlistDeleteServerError      = "can't delete playlist"
 	playlistAddTrackServerError    = "can't add track into playlist"
 	playlistDeleteTrackServerError = "can't delete track from playlist"
+	playlistUpdateRulesServerError = "can't update rules of playlist"
+	playlistForkServerError        = "can't fork playlist"
+	playlistHistoryServerError     = "can't get history of playlist"
+	playlistRestoreServerError     = "can't restore playlist"
+	playlistBatchAddServerError    = "can't add tracks into playlist"
+	playlistBatchDeleteServerError = "can't delete tracks from playlist"
 
 	playlistUpdatedSuccessfully       = "ok"
 	playlistDeletedSuccessfully       = "ok"
 	playlistTrackAddedSuccessfully    = "ok"
 	playlistTrackDeletedSuccessfully  = "ok"
 	playlistCoverUploadedSuccessfully = "ok"
+	playlistRulesUpdatedSuccessfully  = "ok"
+	playlistRestoredSuccessfully      = "ok"
 )
 
 // Create
 //
 //easyjson:json
 type playlistCreateInput struct {
-	Name        string   `json:"name" valid:"required"`
-	UsersID     []uint32 `json:"users" valid:"required"`
-	Description *string  `json:"description"`
+	Name        string                     `json:"name" valid:"required"`
+	UsersID     []uint32                   `json:"users" valid:"required"`
+	Description *string                    `json:"description"`
+	Rules       *models.SmartPlaylistRules `json:"rules" valid:"-"`
 }
 
 func (pci *playlistCreateInput) validateAndEscape() error {
 	pci.escapeHtml()
 
-	_, err := valid.ValidateStruct(pci)
+	if _, err := valid.ValidateStruct(pci); err != nil {
+		return err
+	}
 
-	return err
+	if pci.Rules != nil {
+		return pci.Rules.Validate()
+	}
+
+	return nil
 }
 
 func (pci *playlistCreateInput) escapeHtml() {
@@ -73,6 +98,7 @@ func (pci *playlistCreateInput) ToPlaylist() models.Playlist {
 	return models.Playlist{
 		Name:        pci.Name,
 		Description: pci.Description,
+		Rules:       pci.Rules,
 	}
 }
 
@@ -108,6 +134,46 @@ func (pui *playlistUpdateInput) ToPlaylist(playlistID uint32) models.Playlist {
 	}
 }
 
+// Rules
+//
+//easyjson:json
+type playlistRulesInput struct {
+	Rules models.SmartPlaylistRules `json:"rules"`
+}
+
+func (pri *playlistRulesInput) validate() error {
+	return pri.Rules.Validate()
+}
+
+// Tracks batch
+//
+//easyjson:json
+type playlistTracksBatchInput struct {
+	TracksID []uint32 `json:"tracks"`
+	AlbumID  uint32   `json:"albumID"`
+}
+
+func (pti *playlistTracksBatchInput) validate() error {
+	if len(pti.TracksID) == 0 && pti.AlbumID == 0 {
+		return errors.New("no tracks or album in batch")
+	}
+	if len(pti.TracksID) > models.PlaylistTracksBatchMaxSize {
+		return &models.TooBigTracksBatchError{Size: uint32(len(pti.TracksID))}
+	}
+	for _, trackID := range pti.TracksID {
+		if trackID == 0 {
+			return errors.New("invalid track id in batch")
+		}
+	}
+
+	return nil
+}
+
+//easyjson:json
+type playlistTracksBatchResponse struct {
+	Results []models.PlaylistTrackResult `json:"results"`
+}
+
 //easyjson:json
 type playlistCreateResponse struct {
 	ID uint32 `json:"id"`
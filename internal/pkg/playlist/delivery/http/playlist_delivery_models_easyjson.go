@@ -4,6 +4,7 @@ package http
 
 import (
 	json "encoding/json"
+	models "github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -127,7 +128,234 @@ func (v playlistUpdateInput) MarshalEasyJSON(w *jwriter.Writer) {
 func (v *playlistUpdateInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp(l, v)
 }
-func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp1(in *jlexer.Lexer, out *playlistCreateResponse) {
+func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp1(in *jlexer.Lexer, out *playlistTracksBatchResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "results":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make([]models.PlaylistTrackResult, 0, 2)
+					} else {
+						out.Results = []models.PlaylistTrackResult{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 models.PlaylistTrackResult
+					(v4).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp1(out *jwriter.Writer, in playlistTracksBatchResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Results {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v playlistTracksBatchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *playlistTracksBatchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp1(l, v)
+}
+func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp2(in *jlexer.Lexer, out *playlistTracksBatchInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.TracksID = nil
+			} else {
+				in.Delim('[')
+				if out.TracksID == nil {
+					if !in.IsDelim(']') {
+						out.TracksID = make([]uint32, 0, 16)
+					} else {
+						out.TracksID = []uint32{}
+					}
+				} else {
+					out.TracksID = (out.TracksID)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 uint32
+					v7 = uint32(in.Uint32())
+					out.TracksID = append(out.TracksID, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "albumID":
+			out.AlbumID = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp2(out *jwriter.Writer, in playlistTracksBatchInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix[1:])
+		if in.TracksID == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.TracksID {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint32(uint32(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"albumID\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.AlbumID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v playlistTracksBatchInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp2(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *playlistTracksBatchInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp2(l, v)
+}
+func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp3(in *jlexer.Lexer, out *playlistRulesInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rules":
+			(out.Rules).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp3(out *jwriter.Writer, in playlistRulesInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rules\":"
+		out.RawString(prefix[1:])
+		(in.Rules).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v playlistRulesInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp3(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *playlistRulesInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp3(l, v)
+}
+func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp4(in *jlexer.Lexer, out *playlistCreateResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -158,7 +386,7 @@ func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylis
 		in.Consumed()
 	}
 }
-func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp1(out *jwriter.Writer, in playlistCreateResponse) {
+func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp4(out *jwriter.Writer, in playlistCreateResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -172,14 +400,14 @@ func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylis
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v playlistCreateResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp1(w, v)
+	easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp4(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *playlistCreateResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp1(l, v)
+	easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp4(l, v)
 }
-func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp2(in *jlexer.Lexer, out *playlistCreateInput) {
+func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp5(in *jlexer.Lexer, out *playlistCreateInput) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -216,9 +444,9 @@ func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylis
 					out.UsersID = (out.UsersID)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 uint32
-					v4 = uint32(in.Uint32())
-					out.UsersID = append(out.UsersID, v4)
+					var v10 uint32
+					v10 = uint32(in.Uint32())
+					out.UsersID = append(out.UsersID, v10)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -233,6 +461,16 @@ func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylis
 				}
 				*out.Description = string(in.String())
 			}
+		case "rules":
+			if in.IsNull() {
+				in.Skip()
+				out.Rules = nil
+			} else {
+				if out.Rules == nil {
+					out.Rules = new(models.SmartPlaylistRules)
+				}
+				(*out.Rules).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -243,7 +481,7 @@ func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylis
 		in.Consumed()
 	}
 }
-func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp2(out *jwriter.Writer, in playlistCreateInput) {
+func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp5(out *jwriter.Writer, in playlistCreateInput) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -259,11 +497,11 @@ func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylis
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.UsersID {
-				if v5 > 0 {
+			for v11, v12 := range in.UsersID {
+				if v11 > 0 {
 					out.RawByte(',')
 				}
-				out.Uint32(uint32(v6))
+				out.Uint32(uint32(v12))
 			}
 			out.RawByte(']')
 		}
@@ -277,19 +515,28 @@ func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylis
 			out.String(string(*in.Description))
 		}
 	}
+	{
+		const prefix string = ",\"rules\":"
+		out.RawString(prefix)
+		if in.Rules == nil {
+			out.RawString("null")
+		} else {
+			(*in.Rules).MarshalEasyJSON(out)
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v playlistCreateInput) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp2(w, v)
+	easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp5(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *playlistCreateInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp2(l, v)
+	easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp5(l, v)
 }
-func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp3(in *jlexer.Lexer, out *defaultResponse) {
+func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp6(in *jlexer.Lexer, out *defaultResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -320,7 +567,7 @@ func easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylis
 		in.Consumed()
 	}
 }
-func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp3(out *jwriter.Writer, in defaultResponse) {
+func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp6(out *jwriter.Writer, in defaultResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -334,10 +581,10 @@ func easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylis
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v defaultResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp3(w, v)
+	easyjsonE5772aeEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp6(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *defaultResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp3(l, v)
+	easyjsonE5772aeDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgPlaylistDeliveryHttp6(l, v)
 }
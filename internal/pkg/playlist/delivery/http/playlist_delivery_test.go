@@ -198,11 +198,9 @@ func TestPlaylistDeliveryHTTP_Get(t *testing.T) {
 		"users": [
 			{
 				"id": 1,
-				"email": "yarik1448kuzmin@gmail.com",
 				"username": "yarik_tri",
 				"firstName": "Yaroslav",
 				"lastName": "Kuzmin",
-				"birthDate": "2003-08-23T00:00:00Z",
 				"avatarSrc": "/users/avatars/yarik_tri.png"
 			}
 		],
@@ -423,6 +421,142 @@ func TestPlaylistDeliveryHTTP_Update(t *testing.T) {
 	}
 }
 
+func TestPlaylistDeliveryHTTP_UpdateRules(t *testing.T) {
+	// Init
+	type mockBehavior func(pu *playlistMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	pu := playlistMocks.NewMockUsecase(c)
+	tu := trackMocks.NewMockUsecase(c)
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(pu, tu, uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Post("/api/playlists/{playlistID}/rules", h.UpdateRules)
+
+	// Test filling
+	correctRequestBody := `{
+		"rules": {
+			"match": "all",
+			"rules": [{"field": "liked", "op": "eq", "value": "true"}],
+			"orderBy": "likedAt"
+		}
+	}`
+
+	expectedCallRules := models.SmartPlaylistRules{
+		Match: models.SmartMatchAll,
+		Rules: []models.SmartPlaylistRule{
+			{Field: models.SmartFieldLiked, Op: models.SmartOpEq, Value: "true"},
+		},
+		OrderBy: models.SmartOrderLikedAt,
+		Limit:   models.SmartPlaylistDefaultLimit,
+	}
+
+	const correctPlaylistID uint32 = 1
+	correctPlaylistIDPath := fmt.Sprint(correctPlaylistID)
+
+	testTable := []struct {
+		name             string
+		playlistIDPath   string
+		user             *models.User
+		requestBody      string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:           "Common",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			requestBody:    correctRequestBody,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().UpdateRules(
+					gomock.Any(), correctPlaylistID, expectedCallRules, correctUser.ID,
+				).Return(nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(playlistRulesUpdatedSuccessfully),
+		},
+		{
+			name:             "No User",
+			playlistIDPath:   correctPlaylistIDPath,
+			user:             nil,
+			mockBehavior:     func(pu *playlistMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:           "Invalid Rules",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			requestBody: `{
+				"rules": {
+					"match": "all",
+					"rules": [{"field": "liked", "op": "gt", "value": "true"}]
+				}
+			}`,
+			mockBehavior:     func(pu *playlistMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(playlistInvalidRules),
+		},
+		{
+			name:           "Not Smart Playlist",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			requestBody:    correctRequestBody,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().UpdateRules(
+					gomock.Any(), correctPlaylistID, expectedCallRules, correctUser.ID,
+				).Return(&models.NotSmartPlaylistError{})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(playlistNotSmart),
+		},
+		{
+			name:           "User Has No Rights",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			requestBody:    correctRequestBody,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().UpdateRules(
+					gomock.Any(), correctPlaylistID, expectedCallRules, correctUser.ID,
+				).Return(&models.ForbiddenUserError{})
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: commonTests.ErrorResponse(playlistUpdateRulesNoRights),
+		},
+		{
+			name:           "Server Error",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			requestBody:    correctRequestBody,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().UpdateRules(
+					gomock.Any(), correctPlaylistID, expectedCallRules, correctUser.ID,
+				).Return(errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(playlistUpdateRulesServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(pu)
+
+			commonTests.DeliveryTestPost(t, r, "/api/playlists/"+tc.playlistIDPath+"/rules",
+				tc.requestBody, tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
 func TestPlaylistDeliveryHTTP_Delete(t *testing.T) {
 	// Init
 	type mockBehavior func(pu *playlistMocks.MockUsecase)
@@ -529,6 +663,101 @@ func TestPlaylistDeliveryHTTP_Delete(t *testing.T) {
 	}
 }
 
+func TestPlaylistDeliveryHTTP_Fork(t *testing.T) {
+	// Init
+	type mockBehavior func(pu *playlistMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	pu := playlistMocks.NewMockUsecase(c)
+	tu := trackMocks.NewMockUsecase(c)
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(pu, tu, uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Post("/api/playlists/{playlistID}/fork", h.Fork)
+
+	// Test filling
+	const correctPlaylistID uint32 = 1
+	correctPlaylistIDPath := fmt.Sprint(correctPlaylistID)
+
+	testTable := []struct {
+		name             string
+		playlistIDPath   string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:           "Common",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().Fork(
+					gomock.Any(), correctPlaylistID, correctUser.ID,
+				).Return(uint32(2), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"id": 2}`,
+		},
+		{
+			name:             "Incorrect ID In Path",
+			playlistIDPath:   "incorrect",
+			mockBehavior:     func(pu *playlistMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidURLParameter),
+		},
+		{
+			name:             "No User",
+			playlistIDPath:   correctPlaylistIDPath,
+			user:             nil,
+			mockBehavior:     func(pu *playlistMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:           "No Playlist",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().Fork(
+					gomock.Any(), correctPlaylistID, correctUser.ID,
+				).Return(uint32(0), &models.NoSuchPlaylistError{})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(playlistNotFound),
+		},
+		{
+			name:           "Server Error",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().Fork(
+					gomock.Any(), correctPlaylistID, correctUser.ID,
+				).Return(uint32(0), errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(playlistForkServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(pu)
+
+			commonTests.DeliveryTestPost(t, r, "/api/playlists/"+tc.playlistIDPath+"/fork",
+				"", tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
 func TestPlaylistDeliveryHTTP_AddTrack(t *testing.T) {
 	// Init
 	type mockBehavior func(pu *playlistMocks.MockUsecase)
@@ -803,6 +1032,232 @@ func TestPlaylistDeliveryHTTP_DeleteTrack(t *testing.T) {
 	}
 }
 
+func TestPlaylistDeliveryHTTP_AddTracks(t *testing.T) {
+	// Init
+	type mockBehavior func(pu *playlistMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	pu := playlistMocks.NewMockUsecase(c)
+	tu := trackMocks.NewMockUsecase(c)
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(pu, tu, uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Post("/api/playlists/{playlistID}/tracks/", h.AddTracks)
+
+	// Test filling
+	const correctPlaylistID uint32 = 1
+	correctPlaylistIDPath := fmt.Sprint(correctPlaylistID)
+
+	const correctAlbumID uint32 = 2
+	correctTracksID := []uint32{1, 2}
+	correctRequestBody := `{"tracks": [1, 2], "albumID": 2}`
+
+	correctResults := []models.PlaylistTrackResult{
+		{TrackID: 1, Status: models.PlaylistTrackAdded},
+		{TrackID: 2, Status: models.PlaylistTrackNotFound},
+	}
+	correctResponse := `{"results": [
+		{"trackID": 1, "status": "added"},
+		{"trackID": 2, "status": "notFound"}
+	]}`
+
+	testTable := []struct {
+		name             string
+		playlistIDPath   string
+		user             *models.User
+		requestBody      string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:           "Common",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			requestBody:    correctRequestBody,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().AddTracks(
+					gomock.Any(), correctTracksID, correctAlbumID, correctPlaylistID, correctUser.ID,
+				).Return(correctResults, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:             "No User",
+			playlistIDPath:   correctPlaylistIDPath,
+			user:             nil,
+			mockBehavior:     func(pu *playlistMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:             "Empty Batch",
+			playlistIDPath:   correctPlaylistIDPath,
+			user:             &correctUser,
+			requestBody:      `{"tracks": []}`,
+			mockBehavior:     func(pu *playlistMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:           "User Has No Rights",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			requestBody:    correctRequestBody,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().AddTracks(
+					gomock.Any(), correctTracksID, correctAlbumID, correctPlaylistID, correctUser.ID,
+				).Return(nil, &models.ForbiddenUserError{})
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: commonTests.ErrorResponse(playlistAddTrackNoRights),
+		},
+		{
+			name:           "No Album",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			requestBody:    correctRequestBody,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().AddTracks(
+					gomock.Any(), correctTracksID, correctAlbumID, correctPlaylistID, correctUser.ID,
+				).Return(nil, &models.NoSuchAlbumError{})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(albumNotFound),
+		},
+		{
+			name:           "Server Error",
+			playlistIDPath: correctPlaylistIDPath,
+			user:           &correctUser,
+			requestBody:    correctRequestBody,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().AddTracks(
+					gomock.Any(), correctTracksID, correctAlbumID, correctPlaylistID, correctUser.ID,
+				).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(playlistBatchAddServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(pu)
+
+			commonTests.DeliveryTestPost(t, r, "/api/playlists/"+tc.playlistIDPath+"/tracks/",
+				tc.requestBody, tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestPlaylistDeliveryHTTP_History(t *testing.T) {
+	// Init
+	type mockBehavior func(pu *playlistMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	pu := playlistMocks.NewMockUsecase(c)
+	tu := trackMocks.NewMockUsecase(c)
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(pu, tu, uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/playlists/{playlistID}/history", h.History)
+
+	// Test filling
+	const correctPlaylistID uint32 = 1
+	correctPlaylistIDPath := fmt.Sprint(correctPlaylistID)
+
+	var trackID uint32 = 3
+	createdAt := time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+	entries := []models.PlaylistHistoryEntry{
+		{
+			ID:         2,
+			PlaylistID: correctPlaylistID,
+			UserID:     &correctUser.ID,
+			Action:     models.PlaylistActionDeleteTrack,
+			TrackID:    &trackID,
+			CreatedAt:  createdAt,
+		},
+	}
+	correctResponse := `[{
+		"id": 2,
+		"userID": 1,
+		"action": "deleteTrack",
+		"trackID": 3,
+		"createdAt": "2023-05-01T12:00:00Z"
+	}]`
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Common",
+			user: &correctUser,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().GetHistory(gomock.Any(), correctPlaylistID, correctUser.ID).Return(entries, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:             "No User",
+			user:             nil,
+			mockBehavior:     func(pu *playlistMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name: "User Has No Rights",
+			user: &correctUser,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().GetHistory(gomock.Any(), correctPlaylistID, correctUser.ID).
+					Return(nil, &models.ForbiddenUserError{})
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: commonTests.ErrorResponse(playlistHistoryNoRights),
+		},
+		{
+			name: "Server Error",
+			user: &correctUser,
+			mockBehavior: func(pu *playlistMocks.MockUsecase) {
+				pu.EXPECT().GetHistory(gomock.Any(), correctPlaylistID, correctUser.ID).
+					Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(playlistHistoryServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(pu)
+
+			commonTests.DeliveryTestGet(t, r, "/api/playlists/"+correctPlaylistIDPath+"/history",
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
 func TestPlaylistDeliveryHTTP_Feed(t *testing.T) {
 	// Init
 	type mockBehavior func(pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase)
@@ -847,11 +1302,9 @@ func TestPlaylistDeliveryHTTP_Feed(t *testing.T) {
 			"users": [
 				{
 					"id": 1,
-					"email": "yarik1448kuzmin@gmail.com",
 					"username": "yarik_tri",
 					"firstName": "Yaroslav",
 					"lastName": "Kuzmin",
-					"birthDate": "2003-08-23T00:00:00Z",
 					"avatarSrc": "/users/avatars/yarik_tri.png"
 				}
 			],
@@ -865,11 +1318,9 @@ func TestPlaylistDeliveryHTTP_Feed(t *testing.T) {
 			"users": [
 				{
 					"id": 1,
-					"email": "yarik1448kuzmin@gmail.com",
 					"username": "yarik_tri",
 					"firstName": "Yaroslav",
 					"lastName": "Kuzmin",
-					"birthDate": "2003-08-23T00:00:00Z",
 					"avatarSrc": "/users/avatars/yarik_tri.png"
 				}
 			],
@@ -881,6 +1332,7 @@ func TestPlaylistDeliveryHTTP_Feed(t *testing.T) {
 
 	testTable := []struct {
 		name             string
+		user             *models.User
 		mockBehavior     mockBehavior
 		expectedStatus   int
 		expectedResponse string
@@ -897,6 +1349,28 @@ func TestPlaylistDeliveryHTTP_Feed(t *testing.T) {
 			expectedStatus:   http.StatusOK,
 			expectedResponse: correctResponse,
 		},
+		{
+			name: "Personal",
+			user: &correctUser,
+			mockBehavior: func(pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				pu.EXPECT().GetPersonalFeed(gomock.Any(), correctUser.ID).Return(expectedReturnPlaylists, nil)
+				for _, p := range expectedReturnPlaylists {
+					pu.EXPECT().IsLiked(gomock.Any(), p.ID, correctUser.ID).Return(false, nil)
+					uu.EXPECT().GetByPlaylist(gomock.Any(), p.ID).Return(expectedReturnUsers[0:], nil)
+				}
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name: "Personal Playlists Issues",
+			user: &correctUser,
+			mockBehavior: func(pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				pu.EXPECT().GetPersonalFeed(gomock.Any(), correctUser.ID).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(playlistsGetServerError),
+		},
 		{
 			name: "No Playlists",
 			mockBehavior: func(pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase) {
@@ -931,7 +1405,7 @@ func TestPlaylistDeliveryHTTP_Feed(t *testing.T) {
 
 			commonTests.DeliveryTestGet(t, r, "/api/playlists/feed",
 				tc.expectedStatus, tc.expectedResponse,
-				func(req *http.Request) *http.Request { return req })
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
 		})
 	}
 }
@@ -983,11 +1457,9 @@ func TestPlaylistDeliveryHTTP_GetByUser(t *testing.T) {
 			"users": [
 				{
 					"id": 1,
-					"email": "yarik1448kuzmin@gmail.com",
 					"username": "yarik_tri",
 					"firstName": "Yaroslav",
 					"lastName": "Kuzmin",
-					"birthDate": "2003-08-23T00:00:00Z",
 					"avatarSrc": "/users/avatars/yarik_tri.png"
 				}
 			],
@@ -1001,11 +1473,9 @@ func TestPlaylistDeliveryHTTP_GetByUser(t *testing.T) {
 			"users": [
 				{
 					"id": 1,
-					"email": "yarik1448kuzmin@gmail.com",
 					"username": "yarik_tri",
 					"firstName": "Yaroslav",
 					"lastName": "Kuzmin",
-					"birthDate": "2003-08-23T00:00:00Z",
 					"avatarSrc": "/users/avatars/yarik_tri.png"
 				}
 			],
@@ -1116,11 +1586,9 @@ func TestPlaylistDeliveryHTTP_GetFavorite(t *testing.T) {
 			"users": [
 				{
 					"id": 1,
-					"email": "yarik1448kuzmin@gmail.com",
 					"username": "yarik_tri",
 					"firstName": "Yaroslav",
 					"lastName": "Kuzmin",
-					"birthDate": "2003-08-23T00:00:00Z",
 					"avatarSrc": "/users/avatars/yarik_tri.png"
 				}
 			],
@@ -1134,11 +1602,9 @@ func TestPlaylistDeliveryHTTP_GetFavorite(t *testing.T) {
 			"users": [
 				{
 					"id": 1,
-					"email": "yarik1448kuzmin@gmail.com",
 					"username": "yarik_tri",
 					"firstName": "Yaroslav",
 					"lastName": "Kuzmin",
-					"birthDate": "2003-08-23T00:00:00Z",
 					"avatarSrc": "/users/avatars/yarik_tri.png"
 				}
 			],
@@ -1159,7 +1625,7 @@ func TestPlaylistDeliveryHTTP_GetFavorite(t *testing.T) {
 			name: "Common",
 			user: &correctUser,
 			mockBehavior: func(pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase, userID uint32) {
-				pu.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(expectedReturnPlaylists, nil)
+				pu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(expectedReturnPlaylists, uint32(len(expectedReturnPlaylists)), nil)
 				for _, playlist := range expectedReturnPlaylists {
 					pu.EXPECT().IsLiked(gomock.Any(), playlist.ID, correctUserID).Return(true, nil)
 					uu.EXPECT().GetByPlaylist(gomock.Any(), playlist.ID).Return(expectedReturnUsers, nil)
@@ -1172,7 +1638,7 @@ func TestPlaylistDeliveryHTTP_GetFavorite(t *testing.T) {
 			name: "Playlists Issue",
 			user: &correctUser,
 			mockBehavior: func(pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase, userID uint32) {
-				pu.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(nil, errors.New(""))
+				pu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(nil, uint32(0), errors.New(""))
 			},
 			expectedStatus:   http.StatusInternalServerError,
 			expectedResponse: commonTests.ErrorResponse(playlistsGetServerError),
@@ -1181,7 +1647,7 @@ func TestPlaylistDeliveryHTTP_GetFavorite(t *testing.T) {
 			name: "Users Issue",
 			user: &correctUser,
 			mockBehavior: func(pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase, userID uint32) {
-				pu.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(expectedReturnPlaylists, nil)
+				pu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(expectedReturnPlaylists, uint32(len(expectedReturnPlaylists)), nil)
 				uu.EXPECT().GetByPlaylist(gomock.Any(), expectedReturnPlaylists[0].ID).Return(nil, errors.New(""))
 			},
 			expectedStatus:   http.StatusInternalServerError,
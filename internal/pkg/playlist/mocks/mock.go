@@ -50,6 +50,21 @@ func (mr *MockUsecaseMockRecorder) AddTrack(ctx, trackID, playlistID, userID int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrack", reflect.TypeOf((*MockUsecase)(nil).AddTrack), ctx, trackID, playlistID, userID)
 }
 
+// AddTracks mocks base method.
+func (m *MockUsecase) AddTracks(ctx context.Context, tracksID []uint32, albumID, playlistID, userID uint32) ([]models.PlaylistTrackResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTracks", ctx, tracksID, albumID, playlistID, userID)
+	ret0, _ := ret[0].([]models.PlaylistTrackResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTracks indicates an expected call of AddTracks.
+func (mr *MockUsecaseMockRecorder) AddTracks(ctx, tracksID, albumID, playlistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTracks", reflect.TypeOf((*MockUsecase)(nil).AddTracks), ctx, tracksID, albumID, playlistID, userID)
+}
+
 // Create mocks base method.
 func (m *MockUsecase) Create(ctx context.Context, playlist models.Playlist, usersID []uint32, userID uint32) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +108,36 @@ func (mr *MockUsecaseMockRecorder) DeleteTrack(ctx, trackID, playlistID, userID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrack", reflect.TypeOf((*MockUsecase)(nil).DeleteTrack), ctx, trackID, playlistID, userID)
 }
 
+// DeleteTracks mocks base method.
+func (m *MockUsecase) DeleteTracks(ctx context.Context, tracksID []uint32, albumID, playlistID, userID uint32) ([]models.PlaylistTrackResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTracks", ctx, tracksID, albumID, playlistID, userID)
+	ret0, _ := ret[0].([]models.PlaylistTrackResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTracks indicates an expected call of DeleteTracks.
+func (mr *MockUsecaseMockRecorder) DeleteTracks(ctx, tracksID, albumID, playlistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTracks", reflect.TypeOf((*MockUsecase)(nil).DeleteTracks), ctx, tracksID, albumID, playlistID, userID)
+}
+
+// Fork mocks base method.
+func (m *MockUsecase) Fork(ctx context.Context, playlistID, userID uint32) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fork", ctx, playlistID, userID)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fork indicates an expected call of Fork.
+func (mr *MockUsecaseMockRecorder) Fork(ctx, playlistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fork", reflect.TypeOf((*MockUsecase)(nil).Fork), ctx, playlistID, userID)
+}
+
 // GetByID mocks base method.
 func (m *MockUsecase) GetByID(ctx context.Context, playlistID uint32) (*models.Playlist, error) {
 	m.ctrl.T.Helper()
@@ -138,19 +183,50 @@ func (mr *MockUsecaseMockRecorder) GetFeed(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockUsecase)(nil).GetFeed), ctx)
 }
 
+// GetHistory mocks base method.
+func (m *MockUsecase) GetHistory(ctx context.Context, playlistID, userID uint32) ([]models.PlaylistHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, playlistID, userID)
+	ret0, _ := ret[0].([]models.PlaylistHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockUsecaseMockRecorder) GetHistory(ctx, playlistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockUsecase)(nil).GetHistory), ctx, playlistID, userID)
+}
+
 // GetLikedByUser mocks base method.
-func (m *MockUsecase) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Playlist, error) {
+func (m *MockUsecase) GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Playlist, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID, q)
+	ret0, _ := ret[0].([]models.Playlist)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLikedByUser indicates an expected call of GetLikedByUser.
+func (mr *MockUsecaseMockRecorder) GetLikedByUser(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockUsecase)(nil).GetLikedByUser), ctx, userID, q)
+}
+
+// GetPersonalFeed mocks base method.
+func (m *MockUsecase) GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Playlist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID)
+	ret := m.ctrl.Call(m, "GetPersonalFeed", ctx, userID)
 	ret0, _ := ret[0].([]models.Playlist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLikedByUser indicates an expected call of GetLikedByUser.
-func (mr *MockUsecaseMockRecorder) GetLikedByUser(ctx, userID interface{}) *gomock.Call {
+// GetPersonalFeed indicates an expected call of GetPersonalFeed.
+func (mr *MockUsecaseMockRecorder) GetPersonalFeed(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockUsecase)(nil).GetLikedByUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalFeed", reflect.TypeOf((*MockUsecase)(nil).GetPersonalFeed), ctx, userID)
 }
 
 // IsLiked mocks base method.
@@ -168,6 +244,20 @@ func (mr *MockUsecaseMockRecorder) IsLiked(ctx, artistID, userID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLiked", reflect.TypeOf((*MockUsecase)(nil).IsLiked), ctx, artistID, userID)
 }
 
+// RestoreRevision mocks base method.
+func (m *MockUsecase) RestoreRevision(ctx context.Context, playlistID, revisionID, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", ctx, playlistID, revisionID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockUsecaseMockRecorder) RestoreRevision(ctx, playlistID, revisionID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockUsecase)(nil).RestoreRevision), ctx, playlistID, revisionID, userID)
+}
+
 // SetLike mocks base method.
 func (m *MockUsecase) SetLike(ctx context.Context, playlistID, userID uint32) (bool, error) {
 	m.ctrl.T.Helper()
@@ -212,6 +302,20 @@ func (mr *MockUsecaseMockRecorder) UpdateInfoAndMembers(ctx, playlist, usersID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInfoAndMembers", reflect.TypeOf((*MockUsecase)(nil).UpdateInfoAndMembers), ctx, playlist, usersID, userID)
 }
 
+// UpdateRules mocks base method.
+func (m *MockUsecase) UpdateRules(ctx context.Context, playlistID uint32, rules models.SmartPlaylistRules, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRules", ctx, playlistID, rules, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRules indicates an expected call of UpdateRules.
+func (mr *MockUsecaseMockRecorder) UpdateRules(ctx, playlistID, rules, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRules", reflect.TypeOf((*MockUsecase)(nil).UpdateRules), ctx, playlistID, rules, userID)
+}
+
 // UploadCover mocks base method.
 func (m *MockUsecase) UploadCover(ctx context.Context, playlistID, userID uint32, file io.ReadSeeker, fileSize int64, fileExtension string) error {
 	m.ctrl.T.Helper()
@@ -250,17 +354,32 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // AddTrack mocks base method.
-func (m *MockRepository) AddTrack(ctx context.Context, trackID, playlistID uint32) error {
+func (m *MockRepository) AddTrack(ctx context.Context, trackID, playlistID, userID uint32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTrack", ctx, trackID, playlistID)
+	ret := m.ctrl.Call(m, "AddTrack", ctx, trackID, playlistID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddTrack indicates an expected call of AddTrack.
-func (mr *MockRepositoryMockRecorder) AddTrack(ctx, trackID, playlistID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) AddTrack(ctx, trackID, playlistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrack", reflect.TypeOf((*MockRepository)(nil).AddTrack), ctx, trackID, playlistID, userID)
+}
+
+// AddTracks mocks base method.
+func (m *MockRepository) AddTracks(ctx context.Context, tracksID []uint32, playlistID, userID uint32) ([]models.PlaylistTrackResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTracks", ctx, tracksID, playlistID, userID)
+	ret0, _ := ret[0].([]models.PlaylistTrackResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTracks indicates an expected call of AddTracks.
+func (mr *MockRepositoryMockRecorder) AddTracks(ctx, tracksID, playlistID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrack", reflect.TypeOf((*MockRepository)(nil).AddTrack), ctx, trackID, playlistID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTracks", reflect.TypeOf((*MockRepository)(nil).AddTracks), ctx, tracksID, playlistID, userID)
 }
 
 // Check mocks base method.
@@ -307,17 +426,47 @@ func (mr *MockRepositoryMockRecorder) DeleteLike(ctx, playlistID, userID interfa
 }
 
 // DeleteTrack mocks base method.
-func (m *MockRepository) DeleteTrack(ctx context.Context, trackID, playlistID uint32) error {
+func (m *MockRepository) DeleteTrack(ctx context.Context, trackID, playlistID, userID uint32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTrack", ctx, trackID, playlistID)
+	ret := m.ctrl.Call(m, "DeleteTrack", ctx, trackID, playlistID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTrack indicates an expected call of DeleteTrack.
-func (mr *MockRepositoryMockRecorder) DeleteTrack(ctx, trackID, playlistID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) DeleteTrack(ctx, trackID, playlistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrack", reflect.TypeOf((*MockRepository)(nil).DeleteTrack), ctx, trackID, playlistID, userID)
+}
+
+// DeleteTracks mocks base method.
+func (m *MockRepository) DeleteTracks(ctx context.Context, tracksID []uint32, playlistID, userID uint32) ([]models.PlaylistTrackResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTracks", ctx, tracksID, playlistID, userID)
+	ret0, _ := ret[0].([]models.PlaylistTrackResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTracks indicates an expected call of DeleteTracks.
+func (mr *MockRepositoryMockRecorder) DeleteTracks(ctx, tracksID, playlistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTracks", reflect.TypeOf((*MockRepository)(nil).DeleteTracks), ctx, tracksID, playlistID, userID)
+}
+
+// Fork mocks base method.
+func (m *MockRepository) Fork(ctx context.Context, playlistID, userID uint32) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fork", ctx, playlistID, userID)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fork indicates an expected call of Fork.
+func (mr *MockRepositoryMockRecorder) Fork(ctx, playlistID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrack", reflect.TypeOf((*MockRepository)(nil).DeleteTrack), ctx, trackID, playlistID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fork", reflect.TypeOf((*MockRepository)(nil).Fork), ctx, playlistID, userID)
 }
 
 // GetByID mocks base method.
@@ -365,19 +514,80 @@ func (mr *MockRepositoryMockRecorder) GetFeed(ctx, limit interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockRepository)(nil).GetFeed), ctx, limit)
 }
 
+// GetHistory mocks base method.
+func (m *MockRepository) GetHistory(ctx context.Context, playlistID, limit uint32) ([]models.PlaylistHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, playlistID, limit)
+	ret0, _ := ret[0].([]models.PlaylistHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockRepositoryMockRecorder) GetHistory(ctx, playlistID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockRepository)(nil).GetHistory), ctx, playlistID, limit)
+}
+
 // GetLikedByUser mocks base method.
-func (m *MockRepository) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Playlist, error) {
+func (m *MockRepository) GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Playlist, uint32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID)
+	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID, q)
+	ret0, _ := ret[0].([]models.Playlist)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLikedByUser indicates an expected call of GetLikedByUser.
+func (mr *MockRepositoryMockRecorder) GetLikedByUser(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockRepository)(nil).GetLikedByUser), ctx, userID, q)
+}
+
+// GetPersonalFeed mocks base method.
+func (m *MockRepository) GetPersonalFeed(ctx context.Context, userID, limit uint32) ([]models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalFeed", ctx, userID, limit)
 	ret0, _ := ret[0].([]models.Playlist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLikedByUser indicates an expected call of GetLikedByUser.
-func (mr *MockRepositoryMockRecorder) GetLikedByUser(ctx, userID interface{}) *gomock.Call {
+// GetPersonalFeed indicates an expected call of GetPersonalFeed.
+func (mr *MockRepositoryMockRecorder) GetPersonalFeed(ctx, userID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockRepository)(nil).GetLikedByUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalFeed", reflect.TypeOf((*MockRepository)(nil).GetPersonalFeed), ctx, userID, limit)
+}
+
+// GetSmart mocks base method.
+func (m *MockRepository) GetSmart(ctx context.Context, playlistID uint32) (*models.SmartPlaylist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSmart", ctx, playlistID)
+	ret0, _ := ret[0].(*models.SmartPlaylist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSmart indicates an expected call of GetSmart.
+func (mr *MockRepositoryMockRecorder) GetSmart(ctx, playlistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSmart", reflect.TypeOf((*MockRepository)(nil).GetSmart), ctx, playlistID)
+}
+
+// GetSmartTracks mocks base method.
+func (m *MockRepository) GetSmartTracks(ctx context.Context, smart models.SmartPlaylist) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSmartTracks", ctx, smart)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSmartTracks indicates an expected call of GetSmartTracks.
+func (mr *MockRepositoryMockRecorder) GetSmartTracks(ctx, smart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSmartTracks", reflect.TypeOf((*MockRepository)(nil).GetSmartTracks), ctx, smart)
 }
 
 // Insert mocks base method.
@@ -410,6 +620,21 @@ func (mr *MockRepositoryMockRecorder) InsertLike(ctx, playlistID, userID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertLike", reflect.TypeOf((*MockRepository)(nil).InsertLike), ctx, playlistID, userID)
 }
 
+// InsertSmart mocks base method.
+func (m *MockRepository) InsertSmart(ctx context.Context, playlist models.Playlist, usersID []uint32, ownerID uint32) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSmart", ctx, playlist, usersID, ownerID)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertSmart indicates an expected call of InsertSmart.
+func (mr *MockRepositoryMockRecorder) InsertSmart(ctx, playlist, usersID, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSmart", reflect.TypeOf((*MockRepository)(nil).InsertSmart), ctx, playlist, usersID, ownerID)
+}
+
 // IsLiked mocks base method.
 func (m *MockRepository) IsLiked(ctx context.Context, artistID, userID uint32) (bool, error) {
 	m.ctrl.T.Helper()
@@ -425,32 +650,60 @@ func (mr *MockRepositoryMockRecorder) IsLiked(ctx, artistID, userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLiked", reflect.TypeOf((*MockRepository)(nil).IsLiked), ctx, artistID, userID)
 }
 
+// RestoreRevision mocks base method.
+func (m *MockRepository) RestoreRevision(ctx context.Context, playlistID, revisionID, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", ctx, playlistID, revisionID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockRepositoryMockRecorder) RestoreRevision(ctx, playlistID, revisionID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockRepository)(nil).RestoreRevision), ctx, playlistID, revisionID, userID)
+}
+
 // Update mocks base method.
-func (m *MockRepository) Update(ctx context.Context, playlist models.Playlist) error {
+func (m *MockRepository) Update(ctx context.Context, playlist models.Playlist, userID uint32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, playlist)
+	ret := m.ctrl.Call(m, "Update", ctx, playlist, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockRepositoryMockRecorder) Update(ctx, playlist interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Update(ctx, playlist, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, playlist)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, playlist, userID)
+}
+
+// UpdateSmartRules mocks base method.
+func (m *MockRepository) UpdateSmartRules(ctx context.Context, playlistID uint32, rules models.SmartPlaylistRules) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSmartRules", ctx, playlistID, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSmartRules indicates an expected call of UpdateSmartRules.
+func (mr *MockRepositoryMockRecorder) UpdateSmartRules(ctx, playlistID, rules interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSmartRules", reflect.TypeOf((*MockRepository)(nil).UpdateSmartRules), ctx, playlistID, rules)
 }
 
 // UpdateWithMembers mocks base method.
-func (m *MockRepository) UpdateWithMembers(ctx context.Context, playlist models.Playlist, usersID []uint32) error {
+func (m *MockRepository) UpdateWithMembers(ctx context.Context, playlist models.Playlist, usersID []uint32, userID uint32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateWithMembers", ctx, playlist, usersID)
+	ret := m.ctrl.Call(m, "UpdateWithMembers", ctx, playlist, usersID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateWithMembers indicates an expected call of UpdateWithMembers.
-func (mr *MockRepositoryMockRecorder) UpdateWithMembers(ctx, playlist, usersID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) UpdateWithMembers(ctx, playlist, usersID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithMembers", reflect.TypeOf((*MockRepository)(nil).UpdateWithMembers), ctx, playlist, usersID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithMembers", reflect.TypeOf((*MockRepository)(nil).UpdateWithMembers), ctx, playlist, usersID, userID)
 }
 
 // MockTables is a mock of Tables interface.
@@ -476,6 +729,62 @@ func (m *MockTables) EXPECT() *MockTablesMockRecorder {
 	return m.recorder
 }
 
+// Activities mocks base method.
+func (m *MockTables) Activities() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activities")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Activities indicates an expected call of Activities.
+func (mr *MockTablesMockRecorder) Activities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activities", reflect.TypeOf((*MockTables)(nil).Activities))
+}
+
+// ActivityTimeline mocks base method.
+func (m *MockTables) ActivityTimeline() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivityTimeline")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ActivityTimeline indicates an expected call of ActivityTimeline.
+func (mr *MockTablesMockRecorder) ActivityTimeline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivityTimeline", reflect.TypeOf((*MockTables)(nil).ActivityTimeline))
+}
+
+// ArtistsTracks mocks base method.
+func (m *MockTables) ArtistsTracks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistsTracks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ArtistsTracks indicates an expected call of ArtistsTracks.
+func (mr *MockTablesMockRecorder) ArtistsTracks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistsTracks", reflect.TypeOf((*MockTables)(nil).ArtistsTracks))
+}
+
+// LikedArtists mocks base method.
+func (m *MockTables) LikedArtists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedArtists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedArtists indicates an expected call of LikedArtists.
+func (mr *MockTablesMockRecorder) LikedArtists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedArtists", reflect.TypeOf((*MockTables)(nil).LikedArtists))
+}
+
 // LikedPlaylists mocks base method.
 func (m *MockTables) LikedPlaylists() string {
 	m.ctrl.T.Helper()
@@ -490,6 +799,34 @@ func (mr *MockTablesMockRecorder) LikedPlaylists() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedPlaylists", reflect.TypeOf((*MockTables)(nil).LikedPlaylists))
 }
 
+// LikedTracks mocks base method.
+func (m *MockTables) LikedTracks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedTracks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedTracks indicates an expected call of LikedTracks.
+func (mr *MockTablesMockRecorder) LikedTracks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedTracks", reflect.TypeOf((*MockTables)(nil).LikedTracks))
+}
+
+// Listens mocks base method.
+func (m *MockTables) Listens() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Listens")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Listens indicates an expected call of Listens.
+func (mr *MockTablesMockRecorder) Listens() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Listens", reflect.TypeOf((*MockTables)(nil).Listens))
+}
+
 // Playlists mocks base method.
 func (m *MockTables) Playlists() string {
 	m.ctrl.T.Helper()
@@ -504,6 +841,20 @@ func (mr *MockTablesMockRecorder) Playlists() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Playlists", reflect.TypeOf((*MockTables)(nil).Playlists))
 }
 
+// PlaylistsHistory mocks base method.
+func (m *MockTables) PlaylistsHistory() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistsHistory")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// PlaylistsHistory indicates an expected call of PlaylistsHistory.
+func (mr *MockTablesMockRecorder) PlaylistsHistory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistsHistory", reflect.TypeOf((*MockTables)(nil).PlaylistsHistory))
+}
+
 // PlaylistsTracks mocks base method.
 func (m *MockTables) PlaylistsTracks() string {
 	m.ctrl.T.Helper()
@@ -518,6 +869,34 @@ func (mr *MockTablesMockRecorder) PlaylistsTracks() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistsTracks", reflect.TypeOf((*MockTables)(nil).PlaylistsTracks))
 }
 
+// SmartPlaylists mocks base method.
+func (m *MockTables) SmartPlaylists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SmartPlaylists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SmartPlaylists indicates an expected call of SmartPlaylists.
+func (mr *MockTablesMockRecorder) SmartPlaylists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SmartPlaylists", reflect.TypeOf((*MockTables)(nil).SmartPlaylists))
+}
+
+// Tracks mocks base method.
+func (m *MockTables) Tracks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tracks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Tracks indicates an expected call of Tracks.
+func (mr *MockTablesMockRecorder) Tracks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tracks", reflect.TypeOf((*MockTables)(nil).Tracks))
+}
+
 // UsersPlaylists mocks base method.
 func (m *MockTables) UsersPlaylists() string {
 	m.ctrl.T.Helper()
@@ -10,18 +10,38 @@ import (
 //go:generate mockgen -source=playlist.go -destination=mocks/mock.go
 
 type Usecase interface {
+	// Create creates playlist with given authors, user with given ID must be one of them.
+	// If playlist.Rules are set, they are validated and smart playlist owned by this user is created:
+	// its tracks are evaluated from rules instead of being added manually.
 	Create(ctx context.Context, playlist models.Playlist, usersID []uint32, userID uint32) (uint32, error)
 	GetByID(ctx context.Context, playlistID uint32) (*models.Playlist, error)
 	UpdateInfoAndMembers(ctx context.Context, playlist models.Playlist, usersID []uint32, userID uint32) error
+	UpdateRules(ctx context.Context, playlistID uint32, rules models.SmartPlaylistRules, userID uint32) error
 	UploadCover(ctx context.Context, playlistID uint32, userID uint32, file io.ReadSeeker, fileSize int64, fileExtension string) error
 	Delete(ctx context.Context, playlistID uint32, userID uint32) error
 
+	// Fork creates copy of playlist owned by user with given ID
+	Fork(ctx context.Context, playlistID, userID uint32) (uint32, error)
+
+	// GetHistory returns the latest changes of playlist, the newest first
+	GetHistory(ctx context.Context, playlistID, userID uint32) ([]models.PlaylistHistoryEntry, error)
+	// RestoreRevision reverts all changes of playlist made after history entry with revisionID
+	RestoreRevision(ctx context.Context, playlistID, revisionID, userID uint32) error
+
 	AddTrack(ctx context.Context, trackID, playlistID, userID uint32) error
 	DeleteTrack(ctx context.Context, trackID, playlistID, userID uint32) error
 
+	// AddTracks adds tracks with given IDs and tracks of album with albumID (if it isn't 0)
+	AddTracks(ctx context.Context, tracksID []uint32, albumID, playlistID, userID uint32) ([]models.PlaylistTrackResult, error)
+	// DeleteTracks deletes tracks with given IDs and tracks of album with albumID (if it isn't 0)
+	DeleteTracks(ctx context.Context, tracksID []uint32, albumID, playlistID, userID uint32) ([]models.PlaylistTrackResult, error)
+
 	GetFeed(ctx context.Context) ([]models.Playlist, error)
+	// GetPersonalFeed returns feed for logged in user: playlists from user's activity timeline go first,
+	// the rest is filled with general feed
+	GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Playlist, error)
 	GetByUser(ctx context.Context, userID uint32) ([]models.Playlist, error)
-	GetLikedByUser(ctx context.Context, userID uint32) ([]models.Playlist, error)
+	GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Playlist, uint32, error)
 	SetLike(ctx context.Context, playlistID, userID uint32) (bool, error)
 	UnLike(ctx context.Context, playlistID, userID uint32) (bool, error)
 	IsLiked(ctx context.Context, artistID, userID uint32) (bool, error)
@@ -31,17 +51,50 @@ type Repository interface {
 	// Check returns models.NoSuchPlaylistError if playlist-entry with given ID doesn't exist in DB
 	Check(ctx context.Context, playlistID uint32) error
 	Insert(ctx context.Context, playlist models.Playlist, usersID []uint32) (uint32, error)
+
+	// InsertSmart inserts playlist with its playlist.Rules owned by user with given ownerID
+	InsertSmart(ctx context.Context, playlist models.Playlist, usersID []uint32, ownerID uint32) (uint32, error)
+
+	// GetByID returns playlist with rules if it's smart
 	GetByID(ctx context.Context, playlistID uint32) (*models.Playlist, error)
-	Update(ctx context.Context, playlist models.Playlist) error
-	UpdateWithMembers(ctx context.Context, playlist models.Playlist, usersID []uint32) error
+	// Update and other mutating methods record changes made by user with given userID into history
+	Update(ctx context.Context, playlist models.Playlist, userID uint32) error
+	UpdateWithMembers(ctx context.Context, playlist models.Playlist, usersID []uint32, userID uint32) error
 	DeleteByID(ctx context.Context, playlistID uint32) error
 
-	AddTrack(ctx context.Context, trackID, playlistID uint32) error
-	DeleteTrack(ctx context.Context, trackID, playlistID uint32) error
+	// Fork copies playlist with its tracks and rules in one transaction,
+	// user with given ID becomes the only author of the copy
+	Fork(ctx context.Context, playlistID, userID uint32) (uint32, error)
+
+	GetHistory(ctx context.Context, playlistID uint32, limit uint32) ([]models.PlaylistHistoryEntry, error)
+	// RestoreRevision reverts changes made after revision in one transaction, reverts are recorded too.
+	// Returns models.NoSuchPlaylistRevisionError if playlist has no such revision.
+	RestoreRevision(ctx context.Context, playlistID, revisionID, userID uint32) error
+
+	// GetSmart returns models.NotSmartPlaylistError if playlist with given ID isn't smart
+	GetSmart(ctx context.Context, playlistID uint32) (*models.SmartPlaylist, error)
+
+	// UpdateSmartRules returns models.NotSmartPlaylistError if playlist with given ID isn't smart
+	UpdateSmartRules(ctx context.Context, playlistID uint32, rules models.SmartPlaylistRules) error
+
+	// GetSmartTracks compiles rules of smart playlist into SQL and returns matching tracks
+	GetSmartTracks(ctx context.Context, smart models.SmartPlaylist) ([]models.Track, error)
+
+	AddTrack(ctx context.Context, trackID, playlistID, userID uint32) error
+	DeleteTrack(ctx context.Context, trackID, playlistID, userID uint32) error
+
+	// AddTracks adds tracks into playlist in one transaction and returns result for every track
+	AddTracks(ctx context.Context, tracksID []uint32, playlistID, userID uint32) ([]models.PlaylistTrackResult, error)
+	// DeleteTracks deletes tracks from playlist in one transaction and returns result for every track
+	DeleteTracks(ctx context.Context, tracksID []uint32, playlistID, userID uint32) ([]models.PlaylistTrackResult, error)
 
 	GetFeed(ctx context.Context, limit uint32) ([]models.Playlist, error)
+	// GetPersonalFeed returns playlists from activity timeline of user: ones created
+	// or updated by followed users, the latest first
+	GetPersonalFeed(ctx context.Context, userID uint32, limit uint32) ([]models.Playlist, error)
 	GetByUser(ctx context.Context, userID uint32) ([]models.Playlist, error)
-	GetLikedByUser(ctx context.Context, userID uint32) ([]models.Playlist, error)
+	// GetLikedByUser returns page of user's liked playlists and their total amount
+	GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Playlist, uint32, error)
 	InsertLike(ctx context.Context, playlistID, userID uint32) (bool, error)
 	DeleteLike(ctx context.Context, playlistID, userID uint32) (bool, error)
 	IsLiked(ctx context.Context, artistID, userID uint32) (bool, error)
@@ -54,4 +107,13 @@ type Tables interface {
 	UsersPlaylists() string
 	PlaylistsTracks() string
 	LikedPlaylists() string
+	SmartPlaylists() string
+	PlaylistsHistory() string
+	Tracks() string
+	ArtistsTracks() string
+	LikedTracks() string
+	LikedArtists() string
+	Listens() string
+	Activities() string
+	ActivityTimeline() string
 }
@@ -0,0 +1,224 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	commonSQL "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/db"
+)
+
+func (p *PostgreSQL) GetHistory(ctx context.Context,
+	playlistID uint32, limit uint32) ([]models.PlaylistHistoryEntry, error) {
+
+	query := fmt.Sprintf(
+		`SELECT id, playlist_id, user_id, action, track_id, track_added_at,
+			prev_name, prev_description, prev_cover_src, created_at
+		FROM %s
+		WHERE playlist_id = $1
+		ORDER BY id DESC
+		LIMIT $2;`,
+		p.tables.PlaylistsHistory())
+
+	var entries []models.PlaylistHistoryEntry
+	if err := p.db.SelectContext(ctx, &entries, query, playlistID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (p *PostgreSQL) RestoreRevision(ctx context.Context, playlistID, revisionID, userID uint32) (repoErr error) {
+	tx, err := p.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx.Tx, &repoErr)
+
+	existsQuery := fmt.Sprintf(
+		`SELECT EXISTS(
+			SELECT id
+			FROM %s
+			WHERE id = $1 AND playlist_id = $2
+		);`,
+		p.tables.PlaylistsHistory())
+
+	var exists bool
+	if err := tx.GetContext(ctx, &exists, existsQuery, revisionID, playlistID); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("(repo) %w",
+			&models.NoSuchPlaylistRevisionError{PlaylistID: playlistID, RevisionID: revisionID})
+	}
+
+	laterEntriesQuery := fmt.Sprintf(
+		`SELECT id, playlist_id, user_id, action, track_id, track_added_at,
+			prev_name, prev_description, prev_cover_src, created_at
+		FROM %s
+		WHERE playlist_id = $1 AND id > $2
+		ORDER BY id DESC;`,
+		p.tables.PlaylistsHistory())
+
+	var laterEntries []models.PlaylistHistoryEntry
+	if err := tx.SelectContext(ctx, &laterEntries, laterEntriesQuery, playlistID, revisionID); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	// Changes are reverted from the newest one. Reverts are recorded too,
+	// so history stays append-only and restore itself can be reverted.
+	for _, entry := range laterEntries {
+		if err := p.revertHistoryEntry(ctx, tx.Tx, entry, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) revertHistoryEntry(ctx context.Context,
+	tx *sql.Tx, entry models.PlaylistHistoryEntry, userID uint32) error {
+
+	switch entry.Action {
+	case models.PlaylistActionAddTrack:
+		if entry.TrackID == nil { // track was deleted from service
+			return nil
+		}
+		_, err := p.deleteTrackWithHistory(ctx, tx, *entry.TrackID, entry.PlaylistID, userID)
+		return err
+
+	case models.PlaylistActionDeleteTrack:
+		if entry.TrackID == nil {
+			return nil
+		}
+		return p.restoreTrackWithHistory(ctx, tx, *entry.TrackID, entry.TrackAddedAt, entry.PlaylistID, userID)
+
+	case models.PlaylistActionUpdateInfo, models.PlaylistActionUpdateCover:
+		pl, err := p.getForUpdate(ctx, tx, entry.PlaylistID)
+		if err != nil {
+			return err
+		}
+
+		if entry.Action == models.PlaylistActionUpdateInfo && entry.PrevName != nil {
+			pl.Name = *entry.PrevName
+			pl.Description = entry.PrevDescription
+		}
+		if entry.Action == models.PlaylistActionUpdateCover && entry.PrevCoverSrc != nil {
+			pl.CoverSrc = *entry.PrevCoverSrc
+		}
+
+		return p.updateWithHistory(ctx, tx, *pl, userID)
+	}
+
+	return fmt.Errorf("(repo) unknown action %q in history of playlist", entry.Action)
+}
+
+func (p *PostgreSQL) getForUpdate(ctx context.Context, tx *sql.Tx, playlistID uint32) (*models.Playlist, error) {
+	query := fmt.Sprintf(
+		`SELECT id, name, description, cover_src
+		FROM %s
+		WHERE id = $1
+		FOR UPDATE;`,
+		p.tables.Playlists())
+
+	var pl models.Playlist
+	row := tx.QueryRowContext(ctx, query, playlistID)
+	if err := row.Scan(&pl.ID, &pl.Name, &pl.Description, &pl.CoverSrc); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchPlaylistError{PlaylistID: playlistID}, err)
+		}
+
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return &pl, nil
+}
+
+// deleteTrackWithHistory returns false if there is no such track in playlist
+func (p *PostgreSQL) deleteTrackWithHistory(ctx context.Context,
+	tx *sql.Tx, trackID, playlistID, userID uint32) (bool, error) {
+
+	query := fmt.Sprintf(
+		`DELETE
+		FROM %s
+		WHERE track_id = $1 AND playlist_id = $2
+		RETURNING added_at;`,
+		p.tables.PlaylistsTracks())
+
+	var addedAt time.Time
+	if err := tx.QueryRowContext(ctx, query, trackID, playlistID).Scan(&addedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	// added_at is kept to restore track on its place
+	err := p.insertHistory(ctx, tx, models.PlaylistHistoryEntry{
+		PlaylistID:   playlistID,
+		UserID:       &userID,
+		Action:       models.PlaylistActionDeleteTrack,
+		TrackID:      &trackID,
+		TrackAddedAt: &addedAt,
+	})
+
+	return err == nil, err
+}
+
+func (p *PostgreSQL) restoreTrackWithHistory(ctx context.Context,
+	tx *sql.Tx, trackID uint32, addedAt *time.Time, playlistID, userID uint32) error {
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s (track_id, playlist_id, added_at)
+		VALUES ($1, $2, COALESCE($3, NOW()))
+		ON CONFLICT DO NOTHING;`,
+		p.tables.PlaylistsTracks())
+
+	resExec, err := tx.ExecContext(ctx, query, trackID, playlistID, addedAt)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+	inserted, err := resExec.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("(repo) failed to check RowsAffected: %w", err)
+	}
+
+	if inserted == 0 {
+		return nil
+	}
+
+	return p.insertHistory(ctx, tx, models.PlaylistHistoryEntry{
+		PlaylistID: playlistID,
+		UserID:     &userID,
+		Action:     models.PlaylistActionAddTrack,
+		TrackID:    &trackID,
+	})
+}
+
+func (p *PostgreSQL) insertHistory(ctx context.Context, tx *sql.Tx, entry models.PlaylistHistoryEntry) error {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (playlist_id, user_id, action, track_id, track_added_at,
+			prev_name, prev_description, prev_cover_src)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8);`,
+		p.tables.PlaylistsHistory())
+
+	if _, err := tx.ExecContext(ctx, query, entry.PlaylistID, entry.UserID, entry.Action, entry.TrackID,
+		entry.TrackAddedAt, entry.PrevName, entry.PrevDescription, entry.PrevCoverSrc); err != nil {
+
+		return fmt.Errorf("(repo) failed to insert history entry: %w", err)
+	}
+
+	return nil
+}
+
+func equalStringPtrs(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -59,13 +59,110 @@ func (p *PostgreSQL) Insert(ctx context.Context, playlist models.Playlist, users
 	}
 	defer commonSQL.CheckTransaction(tx, &repoErr)
 
-	insertAlbumQuery := fmt.Sprintf(
-		`INSERT INTO %s (name, description, cover_src)
-		VALUES ($1, $2, $3) RETURNING id;`,
+	return p.insertWithMembers(ctx, tx, playlist, usersID)
+}
+
+func (p *PostgreSQL) InsertSmart(ctx context.Context,
+	playlist models.Playlist, usersID []uint32, ownerID uint32) (_ uint32, repoErr error) {
+
+	if playlist.Rules == nil {
+		return 0, fmt.Errorf("(repo) %w", &models.InvalidSmartRulesError{Reason: "no rules"})
+	}
+
+	tx, err := p.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	playlistID, err := p.insertWithMembers(ctx, tx, playlist, usersID)
+	if err != nil {
+		return 0, err
+	}
+
+	insertRulesQuery := fmt.Sprintf(
+		`INSERT INTO %s (playlist_id, owner_id, rules)
+		VALUES ($1, $2, $3);`,
+		p.tables.SmartPlaylists())
+
+	if _, err := tx.ExecContext(ctx, insertRulesQuery, playlistID, ownerID, *playlist.Rules); err != nil {
+		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return playlistID, nil
+}
+
+func (p *PostgreSQL) Fork(ctx context.Context, playlistID, userID uint32) (_ uint32, repoErr error) {
+	tx, err := p.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	forkPlaylistQuery := fmt.Sprintf(
+		`INSERT INTO %[1]s (name, description, cover_src, forked_from, lang)
+		SELECT name, description, cover_src, id, lang
+		FROM %[1]s
+		WHERE id = $1
+		RETURNING id;`,
+		p.tables.Playlists())
+
+	var forkID uint32
+	if err := tx.QueryRowContext(ctx, forkPlaylistQuery, playlistID).Scan(&forkID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("(repo) %w: %w", &models.NoSuchPlaylistError{PlaylistID: playlistID}, err)
+		}
+
+		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	insertPlaylistUserQuery := fmt.Sprintf(
+		`INSERT INTO %s (user_id, playlist_id)
+		VALUES ($1, $2);`,
+		p.tables.UsersPlaylists())
+
+	if _, err := tx.ExecContext(ctx, insertPlaylistUserQuery, userID, forkID); err != nil {
+		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	// added_at is copied to keep tracks' order
+	copyTracksQuery := fmt.Sprintf(
+		`INSERT INTO %[1]s (playlist_id, track_id, added_at)
+		SELECT $1, track_id, added_at
+		FROM %[1]s
+		WHERE playlist_id = $2;`,
+		p.tables.PlaylistsTracks())
+
+	if _, err := tx.ExecContext(ctx, copyTracksQuery, forkID, playlistID); err != nil {
+		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	// Fork of smart playlist is smart too, but evaluated for its new owner
+	copyRulesQuery := fmt.Sprintf(
+		`INSERT INTO %[1]s (playlist_id, owner_id, rules)
+		SELECT $1, $2, rules
+		FROM %[1]s
+		WHERE playlist_id = $3;`,
+		p.tables.SmartPlaylists())
+
+	if _, err := tx.ExecContext(ctx, copyRulesQuery, forkID, userID, playlistID); err != nil {
+		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return forkID, nil
+}
+
+func (p *PostgreSQL) insertWithMembers(ctx context.Context,
+	tx *sql.Tx, playlist models.Playlist, usersID []uint32) (uint32, error) {
+
+	insertPlaylistQuery := fmt.Sprintf(
+		`INSERT INTO %s (name, description, cover_src, lang)
+		VALUES ($1, $2, $3, $4) RETURNING id;`,
 		p.tables.Playlists())
 
 	var playlistID uint32
-	row := tx.QueryRowContext(ctx, insertAlbumQuery, playlist.Name, playlist.Description, playlist.CoverSrc)
+	row := tx.QueryRowContext(ctx, insertPlaylistQuery,
+		playlist.Name, playlist.Description, playlist.CoverSrc, commonSQL.DetectLang(playlist.Name))
 	if err := row.Scan(&playlistID); err != nil {
 		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
@@ -86,10 +183,11 @@ func (p *PostgreSQL) Insert(ctx context.Context, playlist models.Playlist, users
 
 func (p *PostgreSQL) GetByID(ctx context.Context, playlistID uint32) (*models.Playlist, error) {
 	query := fmt.Sprintf(
-		`SELECT id, name, description, cover_src 
-		FROM %s 
-		WHERE id = $1;`,
-		p.tables.Playlists())
+		`SELECT p.id, p.name, p.description, p.cover_src, p.forked_from, sp.rules
+		FROM %s p
+			LEFT JOIN %s sp ON p.id = sp.playlist_id
+		WHERE p.id = $1;`,
+		p.tables.Playlists(), p.tables.SmartPlaylists())
 
 	var playlist models.Playlist
 	if err := p.db.GetContext(ctx, &playlist, query, playlistID); err != nil {
@@ -103,23 +201,17 @@ func (p *PostgreSQL) GetByID(ctx context.Context, playlistID uint32) (*models.Pl
 	return &playlist, nil
 }
 
-func (p *PostgreSQL) UpdateWithMembers(ctx context.Context, pl models.Playlist, usersID []uint32) (repoErr error) {
+func (p *PostgreSQL) UpdateWithMembers(ctx context.Context,
+	pl models.Playlist, usersID []uint32, userID uint32) (repoErr error) {
+
 	tx, err := p.db.Begin()
 	if err != nil {
 		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
 	}
 	defer commonSQL.CheckTransaction(tx, &repoErr)
 
-	updatePlaylistQuery := fmt.Sprintf(
-		`UPDATE %s
-		SET name = $2,
-			description = $3,
-			cover_src = $4
-		WHERE id = $1;`,
-		p.tables.Playlists())
-
-	if _, err := p.db.ExecContext(ctx, updatePlaylistQuery, pl.ID, pl.Name, pl.Description, pl.CoverSrc); err != nil {
-		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	if err := p.updateWithHistory(ctx, tx, pl, userID); err != nil {
+		return err
 	}
 
 	insertPlaylistUsersQuery := fmt.Sprintf(
@@ -127,8 +219,8 @@ func (p *PostgreSQL) UpdateWithMembers(ctx context.Context, pl models.Playlist,
 		VALUES ($1, $2);`,
 		p.tables.UsersPlaylists())
 
-	for _, userID := range usersID {
-		if _, err := tx.ExecContext(ctx, insertPlaylistUsersQuery, userID, pl.ID); err != nil {
+	for _, uid := range usersID {
+		if _, err := tx.ExecContext(ctx, insertPlaylistUsersQuery, uid, pl.ID); err != nil {
 			return fmt.Errorf("(repo) failed to exec query: %w", err)
 		}
 	}
@@ -136,19 +228,60 @@ func (p *PostgreSQL) UpdateWithMembers(ctx context.Context, pl models.Playlist,
 	return nil
 }
 
-func (p *PostgreSQL) Update(ctx context.Context, pl models.Playlist) error {
+func (p *PostgreSQL) Update(ctx context.Context, pl models.Playlist, userID uint32) (repoErr error) {
+	tx, err := p.db.Begin()
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	return p.updateWithHistory(ctx, tx, pl, userID)
+}
+
+// updateWithHistory updates info and cover of playlist and records changed ones into history
+func (p *PostgreSQL) updateWithHistory(ctx context.Context, tx *sql.Tx, pl models.Playlist, userID uint32) error {
+	prev, err := p.getForUpdate(ctx, tx, pl.ID)
+	if err != nil {
+		return err
+	}
+
 	updatePlaylistQuery := fmt.Sprintf(
 		`UPDATE %s
 		SET name = $2,
 			description = $3,
-			cover_src = $4
+			cover_src = $4,
+			lang = $5
 		WHERE id = $1;`,
 		p.tables.Playlists())
 
-	if _, err := p.db.ExecContext(ctx, updatePlaylistQuery, pl.ID, pl.Name, pl.Description, pl.CoverSrc); err != nil {
+	if _, err := tx.ExecContext(ctx, updatePlaylistQuery,
+		pl.ID, pl.Name, pl.Description, pl.CoverSrc, commonSQL.DetectLang(pl.Name)); err != nil {
 		return fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
 
+	if prev.Name != pl.Name || !equalStringPtrs(prev.Description, pl.Description) {
+		if err := p.insertHistory(ctx, tx, models.PlaylistHistoryEntry{
+			PlaylistID:      pl.ID,
+			UserID:          &userID,
+			Action:          models.PlaylistActionUpdateInfo,
+			PrevName:        &prev.Name,
+			PrevDescription: prev.Description,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if prev.CoverSrc != pl.CoverSrc {
+		if err := p.insertHistory(ctx, tx, models.PlaylistHistoryEntry{
+			PlaylistID:   pl.ID,
+			UserID:       &userID,
+			Action:       models.PlaylistActionUpdateCover,
+			PrevCoverSrc: &prev.CoverSrc,
+		}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -175,13 +308,76 @@ func (p *PostgreSQL) DeleteByID(ctx context.Context, playlistID uint32) error {
 	return nil
 }
 
-func (p *PostgreSQL) AddTrack(ctx context.Context, trackID, playlistID uint32) error {
+func (p *PostgreSQL) GetSmart(ctx context.Context, playlistID uint32) (*models.SmartPlaylist, error) {
+	query := fmt.Sprintf(
+		`SELECT playlist_id, owner_id, rules, updated_at
+		FROM %s
+		WHERE playlist_id = $1;`,
+		p.tables.SmartPlaylists())
+
+	var smart models.SmartPlaylist
+	if err := p.db.GetContext(ctx, &smart, query, playlistID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("(repo) %w: %w", &models.NotSmartPlaylistError{PlaylistID: playlistID}, err)
+		}
+
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return &smart, nil
+}
+
+func (p *PostgreSQL) UpdateSmartRules(ctx context.Context, playlistID uint32, rules models.SmartPlaylistRules) error {
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET rules = $2,
+			updated_at = NOW()
+		WHERE playlist_id = $1;`,
+		p.tables.SmartPlaylists())
+
+	resExec, err := p.db.ExecContext(ctx, query, playlistID, rules)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+	updated, err := resExec.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("(repo) failed to check RowsAffected: %w", err)
+	}
+
+	if updated == 0 {
+		return fmt.Errorf("(repo): %w", &models.NotSmartPlaylistError{PlaylistID: playlistID})
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) GetSmartTracks(ctx context.Context, smart models.SmartPlaylist) ([]models.Track, error) {
+	query, args, err := p.compileSmartRules(smart)
+	if err != nil {
+		return nil, fmt.Errorf("(repo) can't compile rules of playlist #%d: %w", smart.PlaylistID, err)
+	}
+
+	var tracks []models.Track
+	if err := p.db.SelectContext(ctx, &tracks, query, args...); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return tracks, nil
+}
+
+func (p *PostgreSQL) AddTrack(ctx context.Context, trackID, playlistID, userID uint32) (repoErr error) {
+	tx, err := p.db.Begin()
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
 	query := fmt.Sprintf(
 		`INSERT INTO %s (track_id, playlist_id)
 		VALUES ($1, $2);`,
 		p.tables.PlaylistsTracks())
 
-	if _, err := p.db.ExecContext(ctx, query, trackID, playlistID); err != nil {
+	if _, err := tx.ExecContext(ctx, query, trackID, playlistID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return fmt.Errorf("(repo) %w: %w", &models.NoSuchPlaylistError{PlaylistID: playlistID}, err)
 		}
@@ -195,36 +391,161 @@ func (p *PostgreSQL) AddTrack(ctx context.Context, trackID, playlistID uint32) e
 		return fmt.Errorf("(repo) failed to insert: %w", err)
 	}
 
+	return p.insertHistory(ctx, tx, models.PlaylistHistoryEntry{
+		PlaylistID: playlistID,
+		UserID:     &userID,
+		Action:     models.PlaylistActionAddTrack,
+		TrackID:    &trackID,
+	})
+}
+
+func (p *PostgreSQL) DeleteTrack(ctx context.Context, trackID, playlistID, userID uint32) (repoErr error) {
+	tx, err := p.db.Begin()
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	deleted, err := p.deleteTrackWithHistory(ctx, tx, trackID, playlistID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return fmt.Errorf("(repo) no such track or playlist")
+	}
+
 	return nil
 }
 
-func (p *PostgreSQL) DeleteTrack(ctx context.Context, trackID, playlistID uint32) error {
+func (p *PostgreSQL) AddTracks(ctx context.Context,
+	tracksID []uint32, playlistID, userID uint32) (_ []models.PlaylistTrackResult, repoErr error) {
+
+	tx, err := p.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	existing, err := p.existingTracks(ctx, tx, tracksID)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOW() is the same for the whole transaction, so tracks are shifted
+	// by their position in batch to keep its order in playlist
 	query := fmt.Sprintf(
-		`DELETE
-		FROM %s
-		WHERE track_id = $1 AND playlist_id = $2;`,
+		`INSERT INTO %s (track_id, playlist_id, added_at)
+		VALUES ($1, $2, NOW() + $3 * INTERVAL '1 microsecond')
+		ON CONFLICT DO NOTHING;`,
 		p.tables.PlaylistsTracks())
 
-	resExec, err := p.db.ExecContext(ctx, query, trackID, playlistID)
+	results := make([]models.PlaylistTrackResult, 0, len(tracksID))
+	for position, trackID := range tracksID {
+		if _, ok := existing[trackID]; !ok {
+			results = append(results, models.PlaylistTrackResult{TrackID: trackID, Status: models.PlaylistTrackNotFound})
+			continue
+		}
+
+		resExec, err := tx.ExecContext(ctx, query, trackID, playlistID, position)
+		if err != nil {
+			return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+		}
+		inserted, err := resExec.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("(repo) failed to check RowsAffected: %w", err)
+		}
+
+		if inserted == 0 {
+			results = append(results, models.PlaylistTrackResult{TrackID: trackID, Status: models.PlaylistTrackAlreadyAdded})
+			continue
+		}
+
+		trackID := trackID
+		if err := p.insertHistory(ctx, tx, models.PlaylistHistoryEntry{
+			PlaylistID: playlistID,
+			UserID:     &userID,
+			Action:     models.PlaylistActionAddTrack,
+			TrackID:    &trackID,
+		}); err != nil {
+			return nil, err
+		}
+		results = append(results, models.PlaylistTrackResult{TrackID: trackID, Status: models.PlaylistTrackAdded})
+	}
+
+	return results, nil
+}
+
+func (p *PostgreSQL) DeleteTracks(ctx context.Context,
+	tracksID []uint32, playlistID, userID uint32) (_ []models.PlaylistTrackResult, repoErr error) {
+
+	tx, err := p.db.Begin()
 	if err != nil {
-		return fmt.Errorf("(repo) failed to exec query: %w", err)
+		return nil, fmt.Errorf("(repo) failed to begin transaction: %w", err)
 	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
 
-	rowsDeleted, err := resExec.RowsAffected()
+	existing, err := p.existingTracks(ctx, tx, tracksID)
 	if err != nil {
-		return fmt.Errorf("(repo) failed to check RowsAffected: %w", err)
+		return nil, err
 	}
 
-	if rowsDeleted == 0 {
-		return fmt.Errorf("(repo) no such track or playlist")
+	results := make([]models.PlaylistTrackResult, 0, len(tracksID))
+	for _, trackID := range tracksID {
+		if _, ok := existing[trackID]; !ok {
+			results = append(results, models.PlaylistTrackResult{TrackID: trackID, Status: models.PlaylistTrackNotFound})
+			continue
+		}
+
+		deleted, err := p.deleteTrackWithHistory(ctx, tx, trackID, playlistID, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		status := models.PlaylistTrackDeleted
+		if !deleted {
+			status = models.PlaylistTrackNotInPlaylist
+		}
+		results = append(results, models.PlaylistTrackResult{TrackID: trackID, Status: status})
 	}
 
-	return nil
+	return results, nil
+}
+
+// existingTracks returns set of IDs from tracksID which exist in DB
+func (p *PostgreSQL) existingTracks(ctx context.Context,
+	tx *sql.Tx, tracksID []uint32) (map[uint32]struct{}, error) {
+
+	query := fmt.Sprintf(
+		`SELECT id
+		FROM %s
+		WHERE id = ANY($1);`,
+		p.tables.Tracks())
+
+	rows, err := tx.QueryContext(ctx, query, pq.Array(tracksID))
+	if err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+	defer rows.Close()
+
+	existing := make(map[uint32]struct{}, len(tracksID))
+	for rows.Next() {
+		var id uint32
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("(repo) failed to scan: %w", err)
+		}
+		existing[id] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("(repo) failed to read rows: %w", err)
+	}
+
+	return existing, nil
 }
 
 func (p *PostgreSQL) GetFeed(ctx context.Context, limit uint32) ([]models.Playlist, error) {
 	query := fmt.Sprintf(
-		`SELECT id, name, description, cover_src  
+		`SELECT id, name, description, cover_src, forked_from
 		FROM %s 
 		LIMIT $1;`,
 		p.tables.Playlists())
@@ -237,9 +558,32 @@ func (p *PostgreSQL) GetFeed(ctx context.Context, limit uint32) ([]models.Playli
 	return playlists, nil
 }
 
+func (p *PostgreSQL) GetPersonalFeed(ctx context.Context, userID uint32, limit uint32) ([]models.Playlist, error) {
+	query := fmt.Sprintf(
+		`SELECT pl.id, pl.name, pl.description, pl.cover_src, pl.forked_from
+		FROM %s pl
+			INNER JOIN (
+				SELECT ac.playlist_id, MAX(tl.created_at) AS active_at
+				FROM %s tl
+					INNER JOIN %s ac ON ac.id = tl.activity_id
+				WHERE tl.user_id = $1 AND ac.playlist_id IS NOT NULL
+				GROUP BY ac.playlist_id
+			) f ON f.playlist_id = pl.id
+		ORDER BY f.active_at DESC, pl.id DESC
+		LIMIT $2;`,
+		p.tables.Playlists(), p.tables.ActivityTimeline(), p.tables.Activities())
+
+	playlists := []models.Playlist{}
+	if err := p.db.SelectContext(ctx, &playlists, query, userID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return playlists, nil
+}
+
 func (p *PostgreSQL) GetByUser(ctx context.Context, userID uint32) ([]models.Playlist, error) {
 	query := fmt.Sprintf(
-		`SELECT p.id, p.name, p.description, p.cover_src 
+		`SELECT p.id, p.name, p.description, p.cover_src, p.forked_from
 		FROM %s p
 			INNER JOIN %s up ON p.id = up.playlist_id
 		WHERE up.user_id = $1
@@ -258,25 +602,47 @@ func (p *PostgreSQL) GetByUser(ctx context.Context, userID uint32) ([]models.Pla
 	return playlists, nil
 }
 
-func (p *PostgreSQL) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Playlist, error) {
+func (p *PostgreSQL) GetLikedByUser(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.Playlist, uint32, error) {
+
+	const filter = `up.user_id = $1 AND ($2 = '' OR STRPOS(LOWER(p.name), LOWER($2)) > 0)`
+
+	countQuery := fmt.Sprintf(
+		`SELECT COUNT(*)
+		FROM %s p
+			INNER JOIN %s up ON p.id = up.playlist_id
+		WHERE %s;`,
+		p.tables.Playlists(), p.tables.LikedPlaylists(), filter)
+
+	var total uint32
+	if err := p.db.GetContext(ctx, &total, countQuery, userID, q.Filter); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	sortColumn := "up.liked_at"
+	if q.SortBy == models.FavoritesSortName {
+		sortColumn = "p.name"
+	}
+
 	query := fmt.Sprintf(
-		`SELECT p.id, p.name, p.description, p.cover_src
-		FROM %s p 
-			INNER JOIN %s up ON p.id = up.playlist_id 
-		WHERE up.user_id = $1
-		ORDER BY liked_at DESC;`,
-		p.tables.Playlists(), p.tables.LikedPlaylists())
+		`SELECT p.id, p.name, p.description, p.cover_src, p.forked_from, up.liked_at
+		FROM %s p
+			INNER JOIN %s up ON p.id = up.playlist_id
+		WHERE %s
+		ORDER BY %s %s, p.id
+		LIMIT $3 OFFSET $4;`,
+		p.tables.Playlists(), p.tables.LikedPlaylists(), filter, sortColumn, q.OrderSQL())
 
 	var playlists []models.Playlist
-	if err := p.db.SelectContext(ctx, &playlists, query, userID); err != nil {
+	if err := p.db.SelectContext(ctx, &playlists, query, userID, q.Filter, q.LimitValue(), q.Offset); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchUserError{UserID: userID}, err)
+			return nil, 0, fmt.Errorf("(repo) %w: %w", &models.NoSuchUserError{UserID: userID}, err)
 		}
 
-		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
 
-	return playlists, nil
+	return playlists, total, nil
 }
 
 func (p *PostgreSQL) InsertLike(ctx context.Context, playlistID, userID uint32) (bool, error) {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/golang/mock/gomock"
@@ -23,6 +24,11 @@ const playlistTable = "Playlists"
 const likedPlaylistsTable = "Liked_playlists"
 const usersPlaylistsTable = "Users_Playlists"
 const playlistsTracksTable = "Playlists_Tracks"
+const smartPlaylistsTable = "Smart_playlists"
+const tracksTable = "Tracks"
+const playlistsHistoryTable = "Playlists_history"
+const activityTimelineTable = "Activity_timeline"
+const activitiesTable = "Activities"
 
 var errPqInternal = errors.New("postgres is dead")
 
@@ -156,7 +162,7 @@ func TestPlaylistRepositoryPostgreSQL_Insert(t *testing.T) {
 
 				row := sqlxMock.NewRows([]string{"id"}).AddRow(id)
 				sqlxMock.ExpectQuery("INSERT INTO "+playlistTable).
-					WithArgs(p.Name, p.Description, p.CoverSrc).
+					WithArgs(p.Name, p.Description, p.CoverSrc, "russian").
 					WillReturnRows(row)
 
 				for _, userID := range usersID {
@@ -181,7 +187,7 @@ func TestPlaylistRepositoryPostgreSQL_Insert(t *testing.T) {
 
 				row := sqlxMock.NewRows([]string{"id"}).AddRow(id)
 				sqlxMock.ExpectQuery("INSERT INTO "+playlistTable).
-					WithArgs(p.Name, p.Description, p.CoverSrc).
+					WithArgs(p.Name, p.Description, p.CoverSrc, "russian").
 					WillReturnRows(row)
 
 				sqlxMock.ExpectExec("INSERT INTO "+usersPlaylistsTable).
@@ -204,7 +210,7 @@ func TestPlaylistRepositoryPostgreSQL_Insert(t *testing.T) {
 				sqlxMock.ExpectBegin()
 
 				sqlxMock.ExpectQuery("INSERT INTO "+playlistTable).
-					WithArgs(p.Name, p.Description, p.CoverSrc).
+					WithArgs(p.Name, p.Description, p.CoverSrc, "russian").
 					WillReturnError(errPqInternal)
 
 				sqlxMock.ExpectRollback()
@@ -272,6 +278,7 @@ func TestPlaylistRepositoryPostgreSQL_GetByID(t *testing.T) {
 			playlistToGetID: defaultPlaylistToGetID,
 			mockBehavior: func(playlistID uint32, p models.Playlist) {
 				tablesMock.EXPECT().Playlists().Return(playlistTable)
+				tablesMock.EXPECT().SmartPlaylists().Return(smartPlaylistsTable)
 
 				row := sqlxMock.NewRows([]string{"id", "name", "description", "cover_src"}).
 					AddRow(p.ID, p.Name, p.Description, p.CoverSrc)
@@ -286,6 +293,7 @@ func TestPlaylistRepositoryPostgreSQL_GetByID(t *testing.T) {
 			playlistToGetID: defaultPlaylistToGetID,
 			mockBehavior: func(playlistID uint32, p models.Playlist) {
 				tablesMock.EXPECT().Playlists().Return(playlistTable)
+				tablesMock.EXPECT().SmartPlaylists().Return(smartPlaylistsTable)
 
 				sqlxMock.ExpectQuery("SELECT (.+) FROM " + playlistTable).
 					WithArgs(playlistID).
@@ -299,6 +307,7 @@ func TestPlaylistRepositoryPostgreSQL_GetByID(t *testing.T) {
 			playlistToGetID: defaultPlaylistToGetID,
 			mockBehavior: func(playlistID uint32, p models.Playlist) {
 				tablesMock.EXPECT().Playlists().Return(playlistTable)
+				tablesMock.EXPECT().SmartPlaylists().Return(smartPlaylistsTable)
 
 				sqlxMock.ExpectQuery("SELECT (.+) FROM " + playlistTable).
 					WithArgs(playlistID).
@@ -427,6 +436,7 @@ func TestPlaylistRepositoryPostgreSQL_AddTrack(t *testing.T) {
 
 	var defaultTrackToInsertID uint32 = 1
 	var defaultPlaylistID uint32 = 1
+	var defaultUserID uint32 = 1
 
 	testTable := []struct {
 		name          string
@@ -442,10 +452,20 @@ func TestPlaylistRepositoryPostgreSQL_AddTrack(t *testing.T) {
 			trackID:    defaultTrackToInsertID,
 			mockBehavior: func(trackID, playlistID uint32) {
 				tablesMock.EXPECT().PlaylistsTracks().Return(playlistsTracksTable)
+				tablesMock.EXPECT().PlaylistsHistory().Return(playlistsHistoryTable)
+
+				sqlxMock.ExpectBegin()
 
 				sqlxMock.ExpectExec("INSERT INTO "+playlistsTracksTable).
 					WithArgs(trackID, playlistID).
 					WillReturnResult(driver.ResultNoRows)
+
+				sqlxMock.ExpectExec("INSERT INTO "+playlistsHistoryTable).
+					WithArgs(playlistID, defaultUserID, models.PlaylistActionAddTrack, trackID,
+						nil, nil, nil, nil).
+					WillReturnResult(driver.ResultNoRows)
+
+				sqlxMock.ExpectCommit()
 			},
 		},
 		{
@@ -455,9 +475,13 @@ func TestPlaylistRepositoryPostgreSQL_AddTrack(t *testing.T) {
 			mockBehavior: func(trackID, playlistID uint32) {
 				tablesMock.EXPECT().PlaylistsTracks().Return(playlistsTracksTable)
 
+				sqlxMock.ExpectBegin()
+
 				sqlxMock.ExpectExec("INSERT INTO "+playlistTable).
 					WithArgs(trackID, playlistID).
 					WillReturnError(sql.ErrNoRows)
+
+				sqlxMock.ExpectRollback()
 			},
 			expectError:   true,
 			expectedError: &models.NoSuchPlaylistError{PlaylistID: defaultPlaylistID},
@@ -469,9 +493,13 @@ func TestPlaylistRepositoryPostgreSQL_AddTrack(t *testing.T) {
 			mockBehavior: func(trackID, playlistID uint32) {
 				tablesMock.EXPECT().PlaylistsTracks().Return(playlistsTracksTable)
 
+				sqlxMock.ExpectBegin()
+
 				sqlxMock.ExpectExec("INSERT INTO "+playlistsTracksTable).
 					WithArgs(trackID, playlistID).
 					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
 			},
 			expectError:   true,
 			expectedError: errPqInternal,
@@ -483,7 +511,7 @@ func TestPlaylistRepositoryPostgreSQL_AddTrack(t *testing.T) {
 			// Call mock
 			tc.mockBehavior(tc.trackID, tc.playlistID)
 
-			err := repo.AddTrack(ctx, tc.trackID, tc.playlistID)
+			err := repo.AddTrack(ctx, tc.trackID, tc.playlistID, defaultUserID)
 
 			// Test
 			if tc.expectError {
@@ -582,6 +610,114 @@ func TestPlaylistRepositoryPostgreSQL_GetFeed(t *testing.T) {
 	}
 }
 
+func TestPlaylistRepositoryPostgreSQL_GetPersonalFeed(t *testing.T) {
+	// Init
+	type mockBehavior func(userID uint32, playlists []models.Playlist)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := playlistMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const userID uint32 = 1
+	const feedAmountLimit uint32 = 100
+
+	description := "Лучшее за неделю"
+	defaultPlaylists := []models.Playlist{
+		{
+			ID:          1,
+			Name:        "Week",
+			Description: &description,
+			CoverSrc:    "/playlists/covers/week.png",
+		},
+		{
+			ID:       2,
+			Name:     "Gym",
+			CoverSrc: "/playlists/covers/gym.png",
+		},
+	}
+
+	expectTables := func() {
+		tablesMock.EXPECT().Playlists().Return(playlistTable)
+		tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+		tablesMock.EXPECT().Activities().Return(activitiesTable)
+	}
+
+	testTable := []struct {
+		name              string
+		mockBehavior      mockBehavior
+		expectedPlaylists []models.Playlist
+		expectError       bool
+		expectedError     error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID uint32, playlists []models.Playlist) {
+				expectTables()
+
+				rows := sqlxMock.NewRows([]string{"id", "name", "description", "cover_src"})
+				for _, p := range playlists {
+					rows.AddRow(p.ID, p.Name, p.Description, p.CoverSrc)
+				}
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+playlistTable+"(.+)"+activityTimelineTable+
+					"(.+)ORDER BY f.active_at DESC").
+					WithArgs(userID, feedAmountLimit).
+					WillReturnRows(rows)
+			},
+			expectedPlaylists: defaultPlaylists,
+		},
+		{
+			name: "No Activity",
+			mockBehavior: func(userID uint32, playlists []models.Playlist) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+playlistTable).
+					WithArgs(userID, feedAmountLimit).
+					WillReturnRows(sqlxMock.NewRows([]string{"id", "name", "description", "cover_src"}))
+			},
+			expectedPlaylists: []models.Playlist{},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID uint32, playlists []models.Playlist) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+playlistTable).
+					WithArgs(userID, feedAmountLimit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(userID, tc.expectedPlaylists)
+
+			playlists, err := repo.GetPersonalFeed(ctx, userID, feedAmountLimit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPlaylists, playlists)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestPlaylistRepositoryPostgreSQL_GetByUser(t *testing.T) {
 	// Init
 	type mockBehavior func(userID uint32, playlists []models.Playlist)
@@ -742,16 +878,20 @@ func TestPlaylistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "Common",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, p []models.Playlist) {
-				tablesMock.EXPECT().Playlists().Return(playlistTable)
-				tablesMock.EXPECT().LikedPlaylists().Return(likedPlaylistsTable)
+				tablesMock.EXPECT().Playlists().Return(playlistTable).Times(2)
+				tablesMock.EXPECT().LikedPlaylists().Return(likedPlaylistsTable).Times(2)
 
 				rows := sqlxMock.NewRows([]string{"id", "name", "description", "cover_src"})
 				for ind := range p {
 					rows.AddRow(p[ind].ID, p[ind].Name, p[ind].Description, p[ind].CoverSrc)
 				}
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s p INNER JOIN %s",
+					playlistTable, likedPlaylistsTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(p)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s p INNER JOIN %s",
 					playlistTable, likedPlaylistsTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnRows(rows)
 			},
 			expectedPlaylists: defaultPlaylists,
@@ -760,12 +900,16 @@ func TestPlaylistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "No Such User",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, p []models.Playlist) {
-				tablesMock.EXPECT().Playlists().Return(playlistTable)
-				tablesMock.EXPECT().LikedPlaylists().Return(likedPlaylistsTable)
+				tablesMock.EXPECT().Playlists().Return(playlistTable).Times(2)
+				tablesMock.EXPECT().LikedPlaylists().Return(likedPlaylistsTable).Times(2)
 
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s p INNER JOIN %s",
+					playlistTable, likedPlaylistsTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(p)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s p INNER JOIN %s",
 					playlistTable, likedPlaylistsTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expectError:   true,
@@ -775,12 +919,16 @@ func TestPlaylistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "Internal PostgreSQL Error",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, p []models.Playlist) {
-				tablesMock.EXPECT().Playlists().Return(playlistTable)
-				tablesMock.EXPECT().LikedPlaylists().Return(likedPlaylistsTable)
+				tablesMock.EXPECT().Playlists().Return(playlistTable).Times(2)
+				tablesMock.EXPECT().LikedPlaylists().Return(likedPlaylistsTable).Times(2)
 
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s p INNER JOIN %s",
+					playlistTable, likedPlaylistsTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(p)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s p INNER JOIN %s",
 					playlistTable, likedPlaylistsTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnError(errPqInternal)
 			},
 			expectError:   true,
@@ -793,7 +941,7 @@ func TestPlaylistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			// Call mock
 			tc.mockBehavior(tc.userID, tc.expectedPlaylists)
 
-			a, err := repo.GetLikedByUser(ctx, tc.userID)
+			a, total, err := repo.GetLikedByUser(ctx, tc.userID, models.FavoritesQuery{})
 
 			// Test
 			if tc.expectError {
@@ -801,6 +949,7 @@ func TestPlaylistRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tc.expectedPlaylists, a)
+				assert.Equal(t, uint32(len(tc.expectedPlaylists)), total)
 			}
 		})
 	}
@@ -1083,3 +1232,688 @@ func TestPlaylistRepositoryPostgreSQL_IsLiked(t *testing.T) {
 		})
 	}
 }
+
+func TestPlaylistRepositoryPostgreSQL_GetSmart(t *testing.T) {
+	// Init
+	type mockBehavior func(playlistID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := playlistMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultPlaylistID uint32 = 1
+	const defaultOwnerID uint32 = 2
+
+	updatedAt := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+	rulesJSON := `{"match":"all","rules":[{"field":"liked","op":"eq","value":"true"}],"limit":100}`
+
+	expectedSmart := models.SmartPlaylist{
+		PlaylistID: defaultPlaylistID,
+		OwnerID:    defaultOwnerID,
+		Rules: models.SmartPlaylistRules{
+			Match: models.SmartMatchAll,
+			Rules: []models.SmartPlaylistRule{
+				{Field: models.SmartFieldLiked, Op: models.SmartOpEq, Value: "true"},
+			},
+			Limit: 100,
+		},
+		UpdatedAt: updatedAt,
+	}
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectedSmart models.SmartPlaylist
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(playlistID uint32) {
+				tablesMock.EXPECT().SmartPlaylists().Return(smartPlaylistsTable)
+
+				row := sqlxMock.NewRows([]string{"playlist_id", "owner_id", "rules", "updated_at"}).
+					AddRow(playlistID, defaultOwnerID, []byte(rulesJSON), updatedAt)
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + smartPlaylistsTable).
+					WithArgs(playlistID).
+					WillReturnRows(row)
+			},
+			expectedSmart: expectedSmart,
+		},
+		{
+			name: "Not Smart Playlist",
+			mockBehavior: func(playlistID uint32) {
+				tablesMock.EXPECT().SmartPlaylists().Return(smartPlaylistsTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + smartPlaylistsTable).
+					WithArgs(playlistID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError:   true,
+			expectedError: &models.NotSmartPlaylistError{PlaylistID: defaultPlaylistID},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(playlistID uint32) {
+				tablesMock.EXPECT().SmartPlaylists().Return(smartPlaylistsTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + smartPlaylistsTable).
+					WithArgs(playlistID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultPlaylistID)
+
+			smart, err := repo.GetSmart(ctx, defaultPlaylistID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSmart, *smart)
+			}
+		})
+	}
+}
+
+func TestPlaylistRepositoryPostgreSQL_GetSmartTracks(t *testing.T) {
+	// Init
+	type mockBehavior func(smart models.SmartPlaylist)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := playlistMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultOwnerID uint32 = 2
+	const defaultLimit uint32 = 10
+
+	smartWithName := func(value string) models.SmartPlaylist {
+		return models.SmartPlaylist{
+			PlaylistID: 1,
+			OwnerID:    defaultOwnerID,
+			Rules: models.SmartPlaylistRules{
+				Match: models.SmartMatchAll,
+				Rules: []models.SmartPlaylistRule{
+					{Field: models.SmartFieldName, Op: models.SmartOpContains, Value: value},
+				},
+				Limit: defaultLimit,
+			},
+		}
+	}
+
+	expectedTracks := []models.Track{
+		{ID: 1, Name: "100% хит", CoverSrc: "/tracks/covers/1.png", RecordSrc: "/tracks/records/1.wav"},
+	}
+
+	testTable := []struct {
+		name           string
+		smart          models.SmartPlaylist
+		mockBehavior   mockBehavior
+		expectedTracks []models.Track
+		expectError    bool
+		expectedError  error
+	}{
+		{
+			name:  "Common",
+			smart: smartWithName("хит"),
+			mockBehavior: func(smart models.SmartPlaylist) {
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+
+				rows := sqlxMock.NewRows([]string{"id", "name", "cover_src", "record_src"})
+				for _, t := range expectedTracks {
+					rows.AddRow(t.ID, t.Name, t.CoverSrc, t.RecordSrc)
+				}
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+tracksTable+" t WHERE (.+) LIKE (.+) ESCAPE").
+					WithArgs(defaultOwnerID, "хит", defaultLimit).
+					WillReturnRows(rows)
+			},
+			expectedTracks: expectedTracks,
+		},
+		{
+			name:  "Wildcards In Value",
+			smart: smartWithName(`100%_\`),
+			mockBehavior: func(smart models.SmartPlaylist) {
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+
+				rows := sqlxMock.NewRows([]string{"id", "name", "cover_src", "record_src"})
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+tracksTable+" t WHERE (.+) LIKE (.+) ESCAPE").
+					WithArgs(defaultOwnerID, `100\%\_\\`, defaultLimit).
+					WillReturnRows(rows)
+			},
+			expectedTracks: nil,
+		},
+		{
+			name: "Invalid Rules",
+			smart: models.SmartPlaylist{
+				PlaylistID: 1,
+				OwnerID:    defaultOwnerID,
+			},
+			mockBehavior:  func(smart models.SmartPlaylist) {},
+			expectError:   true,
+			expectedError: &models.InvalidSmartRulesError{Reason: "no rules"},
+		},
+		{
+			name:  "Internal PostgreSQL Error",
+			smart: smartWithName("хит"),
+			mockBehavior: func(smart models.SmartPlaylist) {
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+tracksTable).
+					WithArgs(defaultOwnerID, "хит", defaultLimit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tc.smart)
+
+			tracks, err := repo.GetSmartTracks(ctx, tc.smart)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTracks, tracks)
+			}
+		})
+	}
+}
+
+func TestPlaylistRepositoryPostgreSQL_Fork(t *testing.T) {
+	// Init
+	type mockBehavior func(playlistID, userID, forkID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := playlistMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultPlaylistID uint32 = 1
+	const defaultUserID uint32 = 2
+	const defaultForkID uint32 = 3
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectedID    uint32
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(playlistID, userID, forkID uint32) {
+				tablesMock.EXPECT().Playlists().Return(playlistTable)
+				tablesMock.EXPECT().UsersPlaylists().Return(usersPlaylistsTable)
+				tablesMock.EXPECT().PlaylistsTracks().Return(playlistsTracksTable)
+				tablesMock.EXPECT().SmartPlaylists().Return(smartPlaylistsTable)
+
+				sqlxMock.ExpectBegin()
+
+				row := sqlxMock.NewRows([]string{"id"}).AddRow(forkID)
+				sqlxMock.ExpectQuery("INSERT INTO " + playlistTable).
+					WithArgs(playlistID).
+					WillReturnRows(row)
+
+				sqlxMock.ExpectExec("INSERT INTO "+usersPlaylistsTable).
+					WithArgs(userID, forkID).
+					WillReturnResult(driver.ResultNoRows)
+
+				sqlxMock.ExpectExec("INSERT INTO "+playlistsTracksTable).
+					WithArgs(forkID, playlistID).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+
+				sqlxMock.ExpectExec("INSERT INTO "+smartPlaylistsTable).
+					WithArgs(forkID, userID, playlistID).
+					WillReturnResult(driver.ResultNoRows)
+
+				sqlxMock.ExpectCommit()
+			},
+			expectedID: defaultForkID,
+		},
+		{
+			name: "No Such Playlist",
+			mockBehavior: func(playlistID, userID, forkID uint32) {
+				tablesMock.EXPECT().Playlists().Return(playlistTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectQuery("INSERT INTO " + playlistTable).
+					WithArgs(playlistID).
+					WillReturnError(sql.ErrNoRows)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchPlaylistError{PlaylistID: defaultPlaylistID},
+		},
+		{
+			name: "Copy Tracks Issue",
+			mockBehavior: func(playlistID, userID, forkID uint32) {
+				tablesMock.EXPECT().Playlists().Return(playlistTable)
+				tablesMock.EXPECT().UsersPlaylists().Return(usersPlaylistsTable)
+				tablesMock.EXPECT().PlaylistsTracks().Return(playlistsTracksTable)
+
+				sqlxMock.ExpectBegin()
+
+				row := sqlxMock.NewRows([]string{"id"}).AddRow(forkID)
+				sqlxMock.ExpectQuery("INSERT INTO " + playlistTable).
+					WithArgs(playlistID).
+					WillReturnRows(row)
+
+				sqlxMock.ExpectExec("INSERT INTO "+usersPlaylistsTable).
+					WithArgs(userID, forkID).
+					WillReturnResult(driver.ResultNoRows)
+
+				sqlxMock.ExpectExec("INSERT INTO "+playlistsTracksTable).
+					WithArgs(forkID, playlistID).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultPlaylistID, defaultUserID, defaultForkID)
+
+			id, err := repo.Fork(ctx, defaultPlaylistID, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedID, id)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPlaylistRepositoryPostgreSQL_AddTracks(t *testing.T) {
+	// Init
+	type mockBehavior func(tracksID []uint32, playlistID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := playlistMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultPlaylistID uint32 = 1
+	const defaultUserID uint32 = 1
+	defaultTracksID := []uint32{1, 2, 3}
+
+	// Every row is added later than previous one of batch
+	const insertQuery = "INSERT INTO " + playlistsTracksTable + `(.+) NOW\(\) \+ \$3 \* INTERVAL`
+
+	testTable := []struct {
+		name            string
+		mockBehavior    mockBehavior
+		expectedResults []models.PlaylistTrackResult
+		expectError     bool
+		expectedError   error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(tracksID []uint32, playlistID uint32) {
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+				tablesMock.EXPECT().PlaylistsTracks().Return(playlistsTracksTable)
+				tablesMock.EXPECT().PlaylistsHistory().Return(playlistsHistoryTable)
+
+				sqlxMock.ExpectBegin()
+
+				rows := sqlxMock.NewRows([]string{"id"}).AddRow(1).AddRow(2)
+				sqlxMock.ExpectQuery("SELECT id FROM " + tracksTable).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(rows)
+
+				sqlxMock.ExpectExec(insertQuery).
+					WithArgs(tracksID[0], playlistID, 0).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlxMock.ExpectExec("INSERT INTO "+playlistsHistoryTable).
+					WithArgs(playlistID, defaultUserID, models.PlaylistActionAddTrack, tracksID[0],
+						nil, nil, nil, nil).
+					WillReturnResult(driver.ResultNoRows)
+				sqlxMock.ExpectExec(insertQuery).
+					WithArgs(tracksID[1], playlistID, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				sqlxMock.ExpectCommit()
+			},
+			expectedResults: []models.PlaylistTrackResult{
+				{TrackID: 1, Status: models.PlaylistTrackAdded},
+				{TrackID: 2, Status: models.PlaylistTrackAlreadyAdded},
+				{TrackID: 3, Status: models.PlaylistTrackNotFound},
+			},
+		},
+		{
+			name: "First Track Not Found",
+			mockBehavior: func(tracksID []uint32, playlistID uint32) {
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+				tablesMock.EXPECT().PlaylistsTracks().Return(playlistsTracksTable)
+				tablesMock.EXPECT().PlaylistsHistory().Return(playlistsHistoryTable).Times(2)
+
+				sqlxMock.ExpectBegin()
+
+				rows := sqlxMock.NewRows([]string{"id"}).AddRow(2).AddRow(3)
+				sqlxMock.ExpectQuery("SELECT id FROM " + tracksTable).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(rows)
+
+				for position := 1; position < len(tracksID); position++ {
+					sqlxMock.ExpectExec(insertQuery).
+						WithArgs(tracksID[position], playlistID, position).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					sqlxMock.ExpectExec("INSERT INTO "+playlistsHistoryTable).
+						WithArgs(playlistID, defaultUserID, models.PlaylistActionAddTrack, tracksID[position],
+							nil, nil, nil, nil).
+						WillReturnResult(driver.ResultNoRows)
+				}
+
+				sqlxMock.ExpectCommit()
+			},
+			expectedResults: []models.PlaylistTrackResult{
+				{TrackID: 1, Status: models.PlaylistTrackNotFound},
+				{TrackID: 2, Status: models.PlaylistTrackAdded},
+				{TrackID: 3, Status: models.PlaylistTrackAdded},
+			},
+		},
+		{
+			name: "Insert Issue",
+			mockBehavior: func(tracksID []uint32, playlistID uint32) {
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+				tablesMock.EXPECT().PlaylistsTracks().Return(playlistsTracksTable)
+
+				sqlxMock.ExpectBegin()
+
+				rows := sqlxMock.NewRows([]string{"id"}).AddRow(1)
+				sqlxMock.ExpectQuery("SELECT id FROM " + tracksTable).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(rows)
+
+				sqlxMock.ExpectExec(insertQuery).
+					WithArgs(tracksID[0], playlistID, 0).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultTracksID, defaultPlaylistID)
+
+			results, err := repo.AddTracks(ctx, defaultTracksID, defaultPlaylistID, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResults, results)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPlaylistRepositoryPostgreSQL_DeleteTracks(t *testing.T) {
+	// Init
+	type mockBehavior func(tracksID []uint32, playlistID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := playlistMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultPlaylistID uint32 = 1
+	const defaultUserID uint32 = 1
+	defaultTracksID := []uint32{1, 2, 3}
+	addedAt := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	testTable := []struct {
+		name            string
+		mockBehavior    mockBehavior
+		expectedResults []models.PlaylistTrackResult
+		expectError     bool
+		expectedError   error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(tracksID []uint32, playlistID uint32) {
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+				tablesMock.EXPECT().PlaylistsTracks().Return(playlistsTracksTable).Times(2)
+				tablesMock.EXPECT().PlaylistsHistory().Return(playlistsHistoryTable)
+
+				sqlxMock.ExpectBegin()
+
+				rows := sqlxMock.NewRows([]string{"id"}).AddRow(1).AddRow(2)
+				sqlxMock.ExpectQuery("SELECT id FROM " + tracksTable).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnRows(rows)
+
+				deletedRow := sqlxMock.NewRows([]string{"added_at"}).AddRow(addedAt)
+				sqlxMock.ExpectQuery("DELETE FROM "+playlistsTracksTable).
+					WithArgs(tracksID[0], playlistID).
+					WillReturnRows(deletedRow)
+				sqlxMock.ExpectExec("INSERT INTO "+playlistsHistoryTable).
+					WithArgs(playlistID, defaultUserID, models.PlaylistActionDeleteTrack, tracksID[0],
+						addedAt, nil, nil, nil).
+					WillReturnResult(driver.ResultNoRows)
+
+				sqlxMock.ExpectQuery("DELETE FROM "+playlistsTracksTable).
+					WithArgs(tracksID[1], playlistID).
+					WillReturnError(sql.ErrNoRows)
+
+				sqlxMock.ExpectCommit()
+			},
+			expectedResults: []models.PlaylistTrackResult{
+				{TrackID: 1, Status: models.PlaylistTrackDeleted},
+				{TrackID: 2, Status: models.PlaylistTrackNotInPlaylist},
+				{TrackID: 3, Status: models.PlaylistTrackNotFound},
+			},
+		},
+		{
+			name: "Check Tracks Issue",
+			mockBehavior: func(tracksID []uint32, playlistID uint32) {
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectQuery("SELECT id FROM " + tracksTable).
+					WithArgs(sqlmock.AnyArg()).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultTracksID, defaultPlaylistID)
+
+			results, err := repo.DeleteTracks(ctx, defaultTracksID, defaultPlaylistID, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResults, results)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPlaylistRepositoryPostgreSQL_RestoreRevision(t *testing.T) {
+	// Init
+	type mockBehavior func(playlistID, revisionID, userID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := playlistMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultPlaylistID uint32 = 1
+	const defaultRevisionID uint32 = 10
+	const defaultUserID uint32 = 2
+
+	var deletedTrackID uint32 = 5
+	deletedAt := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	historyColumns := []string{"id", "playlist_id", "user_id", "action", "track_id", "track_added_at",
+		"prev_name", "prev_description", "prev_cover_src", "created_at"}
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(playlistID, revisionID, userID uint32) {
+				tablesMock.EXPECT().PlaylistsHistory().Return(playlistsHistoryTable).Times(3)
+				tablesMock.EXPECT().PlaylistsTracks().Return(playlistsTracksTable)
+
+				sqlxMock.ExpectBegin()
+
+				existsRow := sqlxMock.NewRows([]string{"exists"}).AddRow(true)
+				sqlxMock.ExpectQuery("SELECT EXISTS").
+					WithArgs(revisionID, playlistID).
+					WillReturnRows(existsRow)
+
+				entries := sqlxMock.NewRows(historyColumns).
+					AddRow(revisionID+1, playlistID, userID, models.PlaylistActionDeleteTrack, deletedTrackID,
+						deletedAt, nil, nil, nil, deletedAt)
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+playlistsHistoryTable).
+					WithArgs(playlistID, revisionID).
+					WillReturnRows(entries)
+
+				sqlxMock.ExpectExec("INSERT INTO "+playlistsTracksTable).
+					WithArgs(deletedTrackID, playlistID, deletedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				sqlxMock.ExpectExec("INSERT INTO "+playlistsHistoryTable).
+					WithArgs(playlistID, userID, models.PlaylistActionAddTrack, deletedTrackID,
+						nil, nil, nil, nil).
+					WillReturnResult(driver.ResultNoRows)
+
+				sqlxMock.ExpectCommit()
+			},
+		},
+		{
+			name: "No Such Revision",
+			mockBehavior: func(playlistID, revisionID, userID uint32) {
+				tablesMock.EXPECT().PlaylistsHistory().Return(playlistsHistoryTable)
+
+				sqlxMock.ExpectBegin()
+
+				existsRow := sqlxMock.NewRows([]string{"exists"}).AddRow(false)
+				sqlxMock.ExpectQuery("SELECT EXISTS").
+					WithArgs(revisionID, playlistID).
+					WillReturnRows(existsRow)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError: true,
+			expectedError: &models.NoSuchPlaylistRevisionError{
+				PlaylistID: defaultPlaylistID,
+				RevisionID: defaultRevisionID,
+			},
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultPlaylistID, defaultRevisionID, defaultUserID)
+
+			err := repo.RestoreRevision(ctx, defaultPlaylistID, defaultRevisionID, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
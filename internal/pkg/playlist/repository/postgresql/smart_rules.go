@@ -0,0 +1,180 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+var smartComparisonOps = map[string]string{
+	models.SmartOpEq:  "=",
+	models.SmartOpNeq: "<>",
+	models.SmartOpLt:  "<",
+	models.SmartOpLte: "<=",
+	models.SmartOpGt:  ">",
+	models.SmartOpGte: ">=",
+}
+
+// smartQueryBuilder accumulates positional arguments of compiled query.
+// First argument is always ID of smart playlist's owner.
+type smartQueryBuilder struct {
+	args []interface{}
+}
+
+func (b *smartQueryBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+const smartOwnerArg = "$1"
+
+// smartLikeEscaper makes wildcards of LIKE pattern match themselves
+var smartLikeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// compileSmartRules builds query selecting tracks which match rules of smart playlist
+func (p *PostgreSQL) compileSmartRules(smart models.SmartPlaylist) (string, []interface{}, error) {
+	rules := smart.Rules
+	if err := rules.Validate(); err != nil {
+		return "", nil, err
+	}
+
+	b := &smartQueryBuilder{args: []interface{}{smart.OwnerID}}
+
+	conditions := make([]string, 0, len(rules.Rules))
+	for _, rule := range rules.Rules {
+		cond, err := p.compileSmartRule(b, rule)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions = append(conditions, cond)
+	}
+
+	joiner := " AND "
+	if rules.Match == models.SmartMatchAny {
+		joiner = " OR "
+	}
+
+	order := p.compileSmartOrder(b, rules)
+	limit := b.arg(rules.Limit)
+
+	query := fmt.Sprintf(
+		`SELECT t.id, t.name, t.album_id, t.cover_src, t.record_src, t.listens, t.duration
+		FROM %s t
+		WHERE %s
+		ORDER BY %s
+		LIMIT %s;`,
+		p.tables.Tracks(), strings.Join(conditions, joiner), order, limit)
+
+	return query, b.args, nil
+}
+
+func (p *PostgreSQL) compileSmartRule(b *smartQueryBuilder, rule models.SmartPlaylistRule) (string, error) {
+	switch rule.Field {
+	case models.SmartFieldName:
+		if rule.Op == models.SmartOpContains {
+			return fmt.Sprintf(`LOWER(t.name) LIKE LOWER('%%' || %s || '%%') ESCAPE '\'`,
+				b.arg(smartLikeEscaper.Replace(rule.Value))), nil
+		}
+		return fmt.Sprintf("t.name %s %s", smartComparisonOps[rule.Op], b.arg(rule.Value)), nil
+
+	case models.SmartFieldDuration, models.SmartFieldListens:
+		v, err := rule.UintValue()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("t.%s %s %s", rule.Field, smartComparisonOps[rule.Op], b.arg(v)), nil
+
+	case models.SmartFieldArtist:
+		v, err := rule.UintValue()
+		if err != nil {
+			return "", err
+		}
+		cond := fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s at WHERE at.track_id = t.id AND at.artist_id = %s)",
+			p.tables.ArtistsTracks(), b.arg(v))
+		if rule.Op == models.SmartOpNeq {
+			cond = "NOT " + cond
+		}
+		return cond, nil
+
+	case models.SmartFieldLiked:
+		v, err := rule.BoolValue()
+		if err != nil {
+			return "", err
+		}
+		cond := fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s lt WHERE lt.track_id = t.id AND lt.user_id = %s)",
+			p.tables.LikedTracks(), smartOwnerArg)
+		if !v {
+			cond = "NOT " + cond
+		}
+		return cond, nil
+
+	case models.SmartFieldLikedArtist:
+		v, err := rule.BoolValue()
+		if err != nil {
+			return "", err
+		}
+		cond := fmt.Sprintf(
+			`EXISTS (SELECT 1 FROM %s at INNER JOIN %s la ON at.artist_id = la.artist_id
+				WHERE at.track_id = t.id AND la.user_id = %s)`,
+			p.tables.ArtistsTracks(), p.tables.LikedArtists(), smartOwnerArg)
+		if !v {
+			cond = "NOT " + cond
+		}
+		return cond, nil
+
+	case models.SmartFieldLikedAt:
+		v, err := rule.UintValue()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			`EXISTS (SELECT 1 FROM %s lt WHERE lt.track_id = t.id AND lt.user_id = %s
+				AND lt.liked_at >= NOW() - make_interval(days => %s))`,
+			p.tables.LikedTracks(), smartOwnerArg, b.arg(v)), nil
+
+	case models.SmartFieldListenedAt:
+		v, err := rule.UintValue()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			`EXISTS (SELECT 1 FROM %s l WHERE l.track_id = t.id AND l.user_id = %s
+				AND l.commited_at >= NOW() - make_interval(days => %s))`,
+			p.tables.Listens(), smartOwnerArg, b.arg(v)), nil
+	}
+
+	return "", &models.InvalidSmartRulesError{Reason: fmt.Sprintf("unknown field %q", rule.Field)}
+}
+
+func (p *PostgreSQL) compileSmartOrder(b *smartQueryBuilder, rules models.SmartPlaylistRules) string {
+	switch rules.OrderBy {
+	case models.SmartOrderListens:
+		return "t.listens DESC, t.id"
+
+	case models.SmartOrderUserListens:
+		period := ""
+		if rules.OrderPeriodDays != 0 {
+			period = fmt.Sprintf(" AND l.commited_at >= NOW() - make_interval(days => %s)",
+				b.arg(rules.OrderPeriodDays))
+		}
+		return fmt.Sprintf(
+			"(SELECT COUNT(*) FROM %s l WHERE l.track_id = t.id AND l.user_id = %s%s) DESC, t.id",
+			p.tables.Listens(), smartOwnerArg, period)
+
+	case models.SmartOrderLikedAt:
+		return fmt.Sprintf(
+			"(SELECT lt.liked_at FROM %s lt WHERE lt.track_id = t.id AND lt.user_id = %s) DESC NULLS LAST, t.id",
+			p.tables.LikedTracks(), smartOwnerArg)
+
+	case models.SmartOrderName:
+		return "t.name, t.id"
+
+	case models.SmartOrderDuration:
+		return "t.duration, t.id"
+	}
+
+	return "t.id"
+}
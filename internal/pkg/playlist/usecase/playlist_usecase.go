@@ -2,25 +2,37 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
 
 	commonFile "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/file"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
 )
 
 const feedPlaylistsAmountLimit uint32 = 100
+const historyEntriesAmountLimit uint32 = 200
 
 // Usecase implements album.Usecase
 type Usecase struct {
 	playlistRepo playlist.Repository
 	trackRepo    track.Repository
+	albumRepo    album.Repository
 	userRepo     user.Repository
+	activityRepo activity.Repository
+	notifier     notification.Notifier
+	publisher    realtime.Publisher
 	coverSaver   CoverSaver
+	logger       logger.Logger
 }
 
 //go:generate mockgen -source=playlist_usecase.go -destination=../mocks/saver.go -package mock_playlist
@@ -28,12 +40,20 @@ type CoverSaver interface {
 	Save(ctx context.Context, cover io.Reader, objectName string, size int64) error
 }
 
-func NewUsecase(pr playlist.Repository, tr track.Repository, ur user.Repository, saver CoverSaver) *Usecase {
+func NewUsecase(pr playlist.Repository, tr track.Repository, alr album.Repository,
+	ur user.Repository, acr activity.Repository, n notification.Notifier,
+	pub realtime.Publisher, saver CoverSaver, l logger.Logger) *Usecase {
+
 	return &Usecase{
 		playlistRepo: pr,
 		trackRepo:    tr,
+		albumRepo:    alr,
 		userRepo:     ur,
+		activityRepo: acr,
+		notifier:     n,
+		publisher:    pub,
 		coverSaver:   saver,
+		logger:       l,
 	}
 }
 
@@ -55,11 +75,28 @@ func (u *Usecase) Create(ctx context.Context,
 		return 0, fmt.Errorf("(usecase) playlist can't be created by user: %w", &models.ForbiddenUserError{})
 	}
 
+	if playlist.Rules != nil {
+		if err := playlist.Rules.Validate(); err != nil {
+			return 0, fmt.Errorf("(usecase) can't create smart playlist: %w", err)
+		}
+
+		playlistID, err := u.playlistRepo.InsertSmart(ctx, playlist, usersID, userID)
+		if err != nil {
+			return 0, fmt.Errorf("(usecase) can't insert smart playlist into repository: %w", err)
+		}
+
+		u.addActivity(ctx, models.ActivityTypePlaylistCreated, playlistID, userID)
+
+		return playlistID, nil
+	}
+
 	playlistID, err := u.playlistRepo.Insert(ctx, playlist, usersID)
 	if err != nil {
 		return 0, fmt.Errorf("(usecase) can't insert playlist into repository: %w", err)
 	}
 
+	u.addActivity(ctx, models.ActivityTypePlaylistCreated, playlistID, userID)
+
 	return playlistID, nil
 }
 
@@ -105,10 +142,49 @@ func (u *Usecase) UpdateInfoAndMembers(ctx context.Context,
 		}
 	}
 
-	if err := u.playlistRepo.UpdateWithMembers(ctx, playlist, newAuthorsID); err != nil {
+	if err := u.playlistRepo.UpdateWithMembers(ctx, playlist, newAuthorsID, userID); err != nil {
 		return fmt.Errorf("(usecase) can't update playlist in repository: %w", err)
 	}
 
+	memberNotification := models.Notification{
+		Type:       models.NotificationTypePlaylistMember,
+		ActorID:    &userID,
+		PlaylistID: &playlist.ID,
+	}
+	u.notifier.Notify(ctx, memberNotification, newAuthorsID)
+
+	u.publish(ctx, models.RealtimeEventPlaylistUpdated, playlist.ID, 0)
+
+	u.addActivity(ctx, models.ActivityTypePlaylistUpdated, playlist.ID, userID)
+
+	return nil
+}
+
+func (u *Usecase) UpdateRules(ctx context.Context,
+	playlistID uint32, rules models.SmartPlaylistRules, userID uint32) error {
+
+	if err := u.playlistRepo.Check(ctx, playlistID); err != nil {
+		return fmt.Errorf("(usecase) can't find playlist with id #%d: %w", playlistID, err)
+	}
+
+	userInAuthors, err := u.checkUserInAuthors(ctx, playlistID, userID)
+	if err != nil {
+		return err
+	}
+	if !userInAuthors {
+		return fmt.Errorf("(usecase) playlist rules can't be updated by user: %w", &models.ForbiddenUserError{})
+	}
+
+	if err := rules.Validate(); err != nil {
+		return fmt.Errorf("(usecase) can't update rules: %w", err)
+	}
+
+	if err := u.playlistRepo.UpdateSmartRules(ctx, playlistID, rules); err != nil {
+		return fmt.Errorf("(usecase) can't update rules of playlist in repository: %w", err)
+	}
+
+	u.publish(ctx, models.RealtimeEventPlaylistUpdated, playlistID, 0)
+
 	return nil
 }
 
@@ -143,9 +219,12 @@ func (u *Usecase) UploadCover(ctx context.Context,
 	}
 
 	playlist.CoverSrc = filepath.Join(commonFile.PlaylistCoverFolder(), filenameWithExtension)
-	if err := u.playlistRepo.Update(ctx, *playlist); err != nil {
+	if err := u.playlistRepo.Update(ctx, *playlist, userID); err != nil {
 		return fmt.Errorf("(usecase) can't update playlist: %w", err)
 	}
+
+	u.publish(ctx, models.RealtimeEventPlaylistUpdated, playlistID, 0)
+
 	return nil
 }
 
@@ -166,6 +245,66 @@ func (u *Usecase) Delete(ctx context.Context, playlistID uint32, userID uint32)
 		return fmt.Errorf("(usecase) can't delete playlist from repository: %w", err)
 	}
 
+	u.publish(ctx, models.RealtimeEventPlaylistDeleted, playlistID, 0)
+
+	return nil
+}
+
+func (u *Usecase) Fork(ctx context.Context, playlistID, userID uint32) (uint32, error) {
+	if err := u.playlistRepo.Check(ctx, playlistID); err != nil {
+		return 0, fmt.Errorf("(usecase) can't find playlist with id #%d: %w", playlistID, err)
+	}
+
+	forkID, err := u.playlistRepo.Fork(ctx, playlistID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("(usecase) can't fork playlist in repository: %w", err)
+	}
+
+	u.addActivity(ctx, models.ActivityTypePlaylistCreated, forkID, userID)
+
+	return forkID, nil
+}
+
+func (u *Usecase) GetHistory(ctx context.Context, playlistID, userID uint32) ([]models.PlaylistHistoryEntry, error) {
+	if err := u.playlistRepo.Check(ctx, playlistID); err != nil {
+		return nil, fmt.Errorf("(usecase) can't find playlist with id #%d: %w", playlistID, err)
+	}
+
+	userInAuthors, err := u.checkUserInAuthors(ctx, playlistID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !userInAuthors {
+		return nil, fmt.Errorf("(usecase) playlist history can't be got by user: %w", &models.ForbiddenUserError{})
+	}
+
+	entries, err := u.playlistRepo.GetHistory(ctx, playlistID, historyEntriesAmountLimit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get history of playlist from repository: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (u *Usecase) RestoreRevision(ctx context.Context, playlistID, revisionID, userID uint32) error {
+	if err := u.playlistRepo.Check(ctx, playlistID); err != nil {
+		return fmt.Errorf("(usecase) can't find playlist with id #%d: %w", playlistID, err)
+	}
+
+	userInAuthors, err := u.checkUserInAuthors(ctx, playlistID, userID)
+	if err != nil {
+		return err
+	}
+	if !userInAuthors {
+		return fmt.Errorf("(usecase) playlist can't be restored by user: %w", &models.ForbiddenUserError{})
+	}
+
+	if err := u.playlistRepo.RestoreRevision(ctx, playlistID, revisionID, userID); err != nil {
+		return fmt.Errorf("(usecase) can't restore revision of playlist in repository: %w", err)
+	}
+
+	u.publish(ctx, models.RealtimeEventPlaylistUpdated, playlistID, 0)
+
 	return nil
 }
 
@@ -186,10 +325,18 @@ func (u *Usecase) AddTrack(ctx context.Context, trackID, playlistID, userID uint
 		return fmt.Errorf("(usecase) playlist can't be updated by user: %w", &models.ForbiddenUserError{})
 	}
 
-	if err := u.playlistRepo.AddTrack(ctx, trackID, playlistID); err != nil {
+	if err := u.checkNotSmart(ctx, playlistID); err != nil {
+		return err
+	}
+
+	if err := u.playlistRepo.AddTrack(ctx, trackID, playlistID, userID); err != nil {
 		return fmt.Errorf("(usecase) can't add track into playlist in repository: %w", err)
 	}
 
+	u.publish(ctx, models.RealtimeEventPlaylistTracksChanged, playlistID, trackID)
+
+	u.addActivity(ctx, models.ActivityTypePlaylistUpdated, playlistID, userID)
+
 	return nil
 }
 
@@ -210,13 +357,118 @@ func (u *Usecase) DeleteTrack(ctx context.Context, trackID, playlistID, userID u
 		return fmt.Errorf("(usecase) playlist can't be updated by user: %w", &models.ForbiddenUserError{})
 	}
 
-	if err := u.playlistRepo.DeleteTrack(ctx, trackID, playlistID); err != nil {
+	if err := u.checkNotSmart(ctx, playlistID); err != nil {
+		return err
+	}
+
+	if err := u.playlistRepo.DeleteTrack(ctx, trackID, playlistID, userID); err != nil {
 		return fmt.Errorf("(usecase) can't delete track of playlist in repository: %w", err)
 	}
 
+	u.publish(ctx, models.RealtimeEventPlaylistTracksChanged, playlistID, trackID)
+
 	return nil
 }
 
+func (u *Usecase) AddTracks(ctx context.Context,
+	tracksID []uint32, albumID, playlistID, userID uint32) ([]models.PlaylistTrackResult, error) {
+
+	tracksID, err := u.prepareTracksBatch(ctx, tracksID, albumID, playlistID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := u.playlistRepo.AddTracks(ctx, tracksID, playlistID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't add tracks into playlist in repository: %w", err)
+	}
+
+	u.publish(ctx, models.RealtimeEventPlaylistTracksChanged, playlistID, 0)
+
+	for _, res := range results {
+		if res.Status == models.PlaylistTrackAdded {
+			u.addActivity(ctx, models.ActivityTypePlaylistUpdated, playlistID, userID)
+			break
+		}
+	}
+
+	return results, nil
+}
+
+func (u *Usecase) DeleteTracks(ctx context.Context,
+	tracksID []uint32, albumID, playlistID, userID uint32) ([]models.PlaylistTrackResult, error) {
+
+	tracksID, err := u.prepareTracksBatch(ctx, tracksID, albumID, playlistID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := u.playlistRepo.DeleteTracks(ctx, tracksID, playlistID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't delete tracks from playlist in repository: %w", err)
+	}
+
+	u.publish(ctx, models.RealtimeEventPlaylistTracksChanged, playlistID, 0)
+
+	return results, nil
+}
+
+// prepareTracksBatch checks rights to modify playlist and returns
+// unique tracks' IDs with tracks of album expanded
+func (u *Usecase) prepareTracksBatch(ctx context.Context,
+	tracksID []uint32, albumID, playlistID, userID uint32) ([]uint32, error) {
+
+	if err := u.playlistRepo.Check(ctx, playlistID); err != nil {
+		return nil, fmt.Errorf("(usecase) can't find playlist with id #%d: %w", playlistID, err)
+	}
+
+	userInAuthors, err := u.checkUserInAuthors(ctx, playlistID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !userInAuthors {
+		return nil, fmt.Errorf("(usecase) playlist can't be updated by user: %w", &models.ForbiddenUserError{})
+	}
+
+	if err := u.checkNotSmart(ctx, playlistID); err != nil {
+		return nil, err
+	}
+
+	batch := make([]uint32, 0, len(tracksID))
+	batch = append(batch, tracksID...)
+
+	if albumID != 0 {
+		if err := u.albumRepo.Check(ctx, albumID); err != nil {
+			return nil, fmt.Errorf("(usecase) can't find album with id #%d: %w", albumID, err)
+		}
+
+		albumTracks, err := u.trackRepo.GetByAlbum(ctx, albumID)
+		if err != nil {
+			return nil, fmt.Errorf("(usecase) can't get tracks of album: %w", err)
+		}
+		for _, t := range albumTracks {
+			batch = append(batch, t.ID)
+		}
+	}
+
+	seen := make(map[uint32]struct{}, len(batch))
+	uniqueBatch := make([]uint32, 0, len(batch))
+	for _, trackID := range batch {
+		if _, ok := seen[trackID]; ok {
+			continue
+		}
+		seen[trackID] = struct{}{}
+		uniqueBatch = append(uniqueBatch, trackID)
+	}
+
+	if len(uniqueBatch) > models.PlaylistTracksBatchMaxSize {
+		return nil, fmt.Errorf("(usecase) can't modify playlist: %w",
+			&models.TooBigTracksBatchError{Size: uint32(len(uniqueBatch))})
+	}
+
+	return uniqueBatch, nil
+}
+
 func (u *Usecase) GetFeed(ctx context.Context) ([]models.Playlist, error) {
 	playlists, err := u.playlistRepo.GetFeed(ctx, feedPlaylistsAmountLimit)
 	if err != nil {
@@ -226,6 +478,36 @@ func (u *Usecase) GetFeed(ctx context.Context) ([]models.Playlist, error) {
 	return playlists, nil
 }
 
+func (u *Usecase) GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Playlist, error) {
+	playlists, err := u.playlistRepo.GetPersonalFeed(ctx, userID, feedPlaylistsAmountLimit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get personal feed playlists from repository: %w", err)
+	}
+	if len(playlists) >= int(feedPlaylistsAmountLimit) {
+		return playlists, nil
+	}
+
+	general, err := u.playlistRepo.GetFeed(ctx, feedPlaylistsAmountLimit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get feed playlists from repository: %w", err)
+	}
+
+	inFeed := make(map[uint32]struct{}, len(playlists))
+	for _, p := range playlists {
+		inFeed[p.ID] = struct{}{}
+	}
+	for _, p := range general {
+		if len(playlists) >= int(feedPlaylistsAmountLimit) {
+			break
+		}
+		if _, ok := inFeed[p.ID]; !ok {
+			playlists = append(playlists, p)
+		}
+	}
+
+	return playlists, nil
+}
+
 func (u *Usecase) GetByUser(ctx context.Context, userID uint32) ([]models.Playlist, error) {
 	if err := u.userRepo.Check(ctx, userID); err != nil {
 		return nil, fmt.Errorf("(usecase) can't find user with id #%d: %w", userID, err)
@@ -239,13 +521,19 @@ func (u *Usecase) GetByUser(ctx context.Context, userID uint32) ([]models.Playli
 	return playlists, nil
 }
 
-func (u *Usecase) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Playlist, error) {
-	playlists, err := u.playlistRepo.GetLikedByUser(ctx, userID)
+func (u *Usecase) GetLikedByUser(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.Playlist, uint32, error) {
+
+	if err := q.Validate(models.FavoritesSortLikedAt, models.FavoritesSortName); err != nil {
+		return nil, 0, fmt.Errorf("(usecase) %w", err)
+	}
+
+	playlists, total, err := u.playlistRepo.GetLikedByUser(ctx, userID, q)
 	if err != nil {
-		return nil, fmt.Errorf("(usecase) can't get playlists from repository: %w", err)
+		return nil, 0, fmt.Errorf("(usecase) can't get playlists from repository: %w", err)
 	}
 
-	return playlists, nil
+	return playlists, total, nil
 }
 
 func (u *Usecase) SetLike(ctx context.Context, playlistID, userID uint32) (bool, error) {
@@ -274,6 +562,26 @@ func (u *Usecase) UnLike(ctx context.Context, playlistID, userID uint32) (bool,
 	return isDeleted, nil
 }
 
+// addActivity announces playlist activity of user to their followers
+func (u *Usecase) addActivity(ctx context.Context, activityType string, playlistID, userID uint32) {
+	a := models.Activity{Type: activityType, UserID: &userID, PlaylistID: &playlistID}
+	if err := u.activityRepo.AddForFollowers(ctx, a); err != nil {
+		u.logger.ErrorfReqID(ctx, "can't add activity of playlist #%d to activity feed: %v", playlistID, err)
+	}
+}
+
+// publish pushes event to subscribers of playlist, entityID is optional
+func (u *Usecase) publish(ctx context.Context, eventType string, playlistID, entityID uint32) {
+	event := models.RealtimeEvent{
+		Topic:    models.PlaylistTopic(playlistID),
+		Type:     eventType,
+		EntityID: entityID,
+	}
+	if err := u.publisher.Publish(ctx, event); err != nil {
+		u.logger.ErrorfReqID(ctx, "can't publish %s event of playlist #%d: %v", eventType, playlistID, err)
+	}
+}
+
 func (u *Usecase) checkUserInAuthors(ctx context.Context, playlistID, userID uint32) (bool, error) {
 	userInAuthors := false
 	users, err := u.userRepo.GetByPlaylist(ctx, playlistID)
@@ -290,6 +598,21 @@ func (u *Usecase) checkUserInAuthors(ctx context.Context, playlistID, userID uin
 	return userInAuthors, nil
 }
 
+// checkNotSmart returns models.SmartPlaylistModifyError if tracks of playlist are defined by rules
+func (u *Usecase) checkNotSmart(ctx context.Context, playlistID uint32) error {
+	_, err := u.playlistRepo.GetSmart(ctx, playlistID)
+	if err == nil {
+		return fmt.Errorf("(usecase) %w", &models.SmartPlaylistModifyError{PlaylistID: playlistID})
+	}
+
+	var errNotSmart *models.NotSmartPlaylistError
+	if !errors.As(err, &errNotSmart) {
+		return fmt.Errorf("(usecase) can't check if playlist is smart: %w", err)
+	}
+
+	return nil
+}
+
 func (u *Usecase) IsLiked(ctx context.Context, albumID, userID uint32) (bool, error) {
 	isLiked, err := u.playlistRepo.IsLiked(ctx, albumID, userID)
 	if err != nil {
@@ -5,8 +5,14 @@ import (
 	"errors"
 	"testing"
 
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	activityMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity/mocks"
+	albumMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/mocks"
+	notificationMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/mocks"
 	playlistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/mocks"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/broker/local"
+	realtimeMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/mocks"
 	trackMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/mocks"
 	userMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/mocks"
 	"github.com/golang/mock/gomock"
@@ -23,10 +29,13 @@ func TestPlaylistUsecase_Create(t *testing.T) {
 
 	pr := playlistMocks.NewMockRepository(c)
 	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
 	ur := userMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
 	cs := playlistMocks.NewMockCoverSaver(c)
 
-	u := NewUsecase(pr, tr, ur, cs)
+	u := NewUsecase(pr, tr, alr, ur, acr, n, local.NewBroker(), cs, commonTests.MockLogger(c))
 
 	var correctUserID uint32 = 1
 	correctUsers := []models.User{
@@ -60,6 +69,7 @@ func TestPlaylistUsecase_Create(t *testing.T) {
 					ur.EXPECT().GetByID(ctx, id).Return(&correctUsers[ind], nil)
 				}
 				pr.EXPECT().Insert(ctx, playlist, usersID).Return(correctPlaylist.ID, nil)
+				acr.EXPECT().AddForFollowers(ctx, gomock.Any()).Return(nil)
 			},
 		},
 		{
@@ -126,10 +136,13 @@ func TestPlaylistUsecase_Delete(t *testing.T) {
 
 	pr := playlistMocks.NewMockRepository(c)
 	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
 	ur := userMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
 	cs := playlistMocks.NewMockCoverSaver(c)
 
-	u := NewUsecase(pr, tr, ur, cs)
+	u := NewUsecase(pr, tr, alr, ur, acr, n, local.NewBroker(), cs, commonTests.MockLogger(c))
 
 	var correctUserID uint32 = 1
 	var correctPlaylistID uint32 = 1
@@ -223,6 +236,37 @@ func TestPlaylistUsecase_Delete(t *testing.T) {
 	}
 }
 
+func TestPlaylistUsecase_DeletePublishIssue(t *testing.T) {
+	c := gomock.NewController(t)
+
+	pr := playlistMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
+	ur := userMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
+	pub := realtimeMocks.NewMockPublisher(c)
+	cs := playlistMocks.NewMockCoverSaver(c)
+
+	u := NewUsecase(pr, tr, alr, ur, acr, n, pub, cs, commonTests.MockLogger(c))
+
+	var userID uint32 = 1
+	var playlistID uint32 = 1
+
+	pr.EXPECT().Check(ctx, playlistID).Return(nil)
+	ur.EXPECT().GetByPlaylist(ctx, playlistID).Return([]models.User{{ID: userID}}, nil)
+	pr.EXPECT().DeleteByID(ctx, playlistID).Return(nil)
+	pub.EXPECT().Publish(ctx, models.RealtimeEvent{
+		Topic: models.PlaylistTopic(playlistID),
+		Type:  models.RealtimeEventPlaylistDeleted,
+	}).Return(errors.New(""))
+
+	// Playlist is already deleted, so failed push doesn't fail deletion
+	err := u.Delete(ctx, playlistID, userID)
+
+	assert.NoError(t, err)
+}
+
 func TestPlaylistUsecase_AddTrack(t *testing.T) {
 	type mockBehavior func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
 		tr *trackMocks.MockRepository, playlistID, trackID, userID uint32)
@@ -231,15 +275,30 @@ func TestPlaylistUsecase_AddTrack(t *testing.T) {
 
 	pr := playlistMocks.NewMockRepository(c)
 	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
 	ur := userMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
 	cs := playlistMocks.NewMockCoverSaver(c)
 
-	u := NewUsecase(pr, tr, ur, cs)
+	broker := local.NewBroker()
+
+	u := NewUsecase(pr, tr, alr, ur, acr, n, broker, cs, commonTests.MockLogger(c))
 
 	var correctUserID uint32 = 1
 	var correctPlaylistID uint32 = 1
 	var correctTrackID uint32 = 1
 
+	events := make(chan models.RealtimeEvent, 1)
+	unsubscribe := broker.Subscribe(models.PlaylistTopic(correctPlaylistID), events)
+	defer unsubscribe()
+
+	trackAdded := models.RealtimeEvent{
+		Topic:    models.PlaylistTopic(correctPlaylistID),
+		Type:     models.RealtimeEventPlaylistTracksChanged,
+		EntityID: correctTrackID,
+	}
+
 	correctUsers := []models.User{
 		{
 			ID: 1,
@@ -262,7 +321,9 @@ func TestPlaylistUsecase_AddTrack(t *testing.T) {
 				pr.EXPECT().Check(ctx, playlistID).Return(nil)
 				tr.EXPECT().Check(ctx, trackID).Return(nil)
 				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
-				pr.EXPECT().AddTrack(ctx, trackID, playlistID).Return(nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(nil, &models.NotSmartPlaylistError{PlaylistID: playlistID})
+				pr.EXPECT().AddTrack(ctx, trackID, playlistID, userID).Return(nil)
+				acr.EXPECT().AddForFollowers(ctx, gomock.Any()).Return(nil)
 			},
 		},
 		{
@@ -314,6 +375,20 @@ func TestPlaylistUsecase_AddTrack(t *testing.T) {
 			expectError:      true,
 			expectedErrorMsg: "playlist can't be updated",
 		},
+		{
+			name:   "Smart Playlist",
+			userID: correctUserID,
+			mockBehavior: func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
+				tr *trackMocks.MockRepository, playlistID, trackID, userID uint32) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				tr.EXPECT().Check(ctx, trackID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(&models.SmartPlaylist{PlaylistID: playlistID}, nil)
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't be changed manually",
+		},
 		{
 			name:   "Add Track Issue",
 			userID: correctUserID,
@@ -323,7 +398,8 @@ func TestPlaylistUsecase_AddTrack(t *testing.T) {
 				pr.EXPECT().Check(ctx, playlistID).Return(nil)
 				tr.EXPECT().Check(ctx, trackID).Return(nil)
 				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
-				pr.EXPECT().AddTrack(ctx, trackID, playlistID).Return(errors.New(""))
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(nil, &models.NotSmartPlaylistError{PlaylistID: playlistID})
+				pr.EXPECT().AddTrack(ctx, trackID, playlistID, userID).Return(errors.New(""))
 			},
 			expectError:      true,
 			expectedErrorMsg: "can't add track into playlist",
@@ -338,8 +414,10 @@ func TestPlaylistUsecase_AddTrack(t *testing.T) {
 
 			if tc.expectError {
 				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+				assert.Empty(t, events)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, trackAdded, <-events)
 			}
 		})
 	}
@@ -353,10 +431,13 @@ func TestPlaylistUsecase_DeleteTrack(t *testing.T) {
 
 	pr := playlistMocks.NewMockRepository(c)
 	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
 	ur := userMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
 	cs := playlistMocks.NewMockCoverSaver(c)
 
-	u := NewUsecase(pr, tr, ur, cs)
+	u := NewUsecase(pr, tr, alr, ur, acr, n, local.NewBroker(), cs, commonTests.MockLogger(c))
 
 	var correctUserID uint32 = 1
 	var correctPlaylistID uint32 = 1
@@ -384,7 +465,8 @@ func TestPlaylistUsecase_DeleteTrack(t *testing.T) {
 				pr.EXPECT().Check(ctx, playlistID).Return(nil)
 				tr.EXPECT().Check(ctx, trackID).Return(nil)
 				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
-				pr.EXPECT().DeleteTrack(ctx, trackID, playlistID).Return(nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(nil, &models.NotSmartPlaylistError{PlaylistID: playlistID})
+				pr.EXPECT().DeleteTrack(ctx, trackID, playlistID, userID).Return(nil)
 			},
 		},
 		{
@@ -445,7 +527,8 @@ func TestPlaylistUsecase_DeleteTrack(t *testing.T) {
 				pr.EXPECT().Check(ctx, playlistID).Return(nil)
 				tr.EXPECT().Check(ctx, trackID).Return(nil)
 				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
-				pr.EXPECT().DeleteTrack(ctx, trackID, playlistID).Return(errors.New(""))
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(nil, &models.NotSmartPlaylistError{PlaylistID: playlistID})
+				pr.EXPECT().DeleteTrack(ctx, trackID, playlistID, userID).Return(errors.New(""))
 			},
 			expectError:      true,
 			expectedErrorMsg: "can't delete track of playlist",
@@ -475,10 +558,13 @@ func TestPlaylistUsecase_UpdateInfoAndMembers(t *testing.T) {
 
 	pr := playlistMocks.NewMockRepository(c)
 	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
 	ur := userMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
 	cs := playlistMocks.NewMockCoverSaver(c)
 
-	u := NewUsecase(pr, tr, ur, cs)
+	u := NewUsecase(pr, tr, alr, ur, acr, n, local.NewBroker(), cs, commonTests.MockLogger(c))
 
 	var correctUserID uint32 = 1
 	var newUserID uint32 = 2
@@ -500,6 +586,12 @@ func TestPlaylistUsecase_UpdateInfoAndMembers(t *testing.T) {
 	}
 	newAuthorsID := []uint32{correctUserID, newUserID}
 
+	memberNotification := models.Notification{
+		Type:       models.NotificationTypePlaylistMember,
+		ActorID:    &correctUserID,
+		PlaylistID: &correctPlaylistID,
+	}
+
 	testTable := []struct {
 		name             string
 		updatedPlaylist  models.Playlist
@@ -520,7 +612,9 @@ func TestPlaylistUsecase_UpdateInfoAndMembers(t *testing.T) {
 				pr.EXPECT().GetByID(ctx, playlist.ID).Return(oldPlaylist, nil)
 				ur.EXPECT().GetByPlaylist(ctx, playlist.ID).Return(oldAuthors, nil)
 				ur.EXPECT().GetByPlaylist(ctx, playlist.ID).Return(oldAuthors, nil)
-				pr.EXPECT().UpdateWithMembers(ctx, playlist, []uint32{newUserID}).Return(nil)
+				pr.EXPECT().UpdateWithMembers(ctx, playlist, []uint32{newUserID}, userID).Return(nil)
+				n.EXPECT().Notify(ctx, memberNotification, []uint32{newUserID})
+				acr.EXPECT().AddForFollowers(ctx, gomock.Any()).Return(nil)
 			},
 		},
 		{
@@ -561,7 +655,7 @@ func TestPlaylistUsecase_UpdateInfoAndMembers(t *testing.T) {
 				pr.EXPECT().GetByID(ctx, playlist.ID).Return(oldPlaylist, nil)
 				ur.EXPECT().GetByPlaylist(ctx, playlist.ID).Return(oldAuthors, nil)
 				ur.EXPECT().GetByPlaylist(ctx, playlist.ID).Return(oldAuthors, nil)
-				pr.EXPECT().UpdateWithMembers(ctx, playlist, []uint32{newUserID}).Return(errors.New(""))
+				pr.EXPECT().UpdateWithMembers(ctx, playlist, []uint32{newUserID}, userID).Return(errors.New(""))
 			},
 			expectError:      true,
 			expectedErrorMsg: "can't update playlist",
@@ -582,3 +676,412 @@ func TestPlaylistUsecase_UpdateInfoAndMembers(t *testing.T) {
 		})
 	}
 }
+
+func TestPlaylistUsecase_UpdateRules(t *testing.T) {
+	type mockBehavior func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
+		playlistID, userID uint32, rules models.SmartPlaylistRules)
+
+	c := gomock.NewController(t)
+
+	pr := playlistMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
+	ur := userMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
+	cs := playlistMocks.NewMockCoverSaver(c)
+
+	u := NewUsecase(pr, tr, alr, ur, acr, n, local.NewBroker(), cs, commonTests.MockLogger(c))
+
+	var correctUserID uint32 = 1
+	var correctPlaylistID uint32 = 1
+
+	correctUsers := []models.User{
+		{
+			ID: correctUserID,
+		},
+	}
+
+	correctRules := models.SmartPlaylistRules{
+		Match: models.SmartMatchAll,
+		Rules: []models.SmartPlaylistRule{
+			{Field: models.SmartFieldLiked, Op: models.SmartOpEq, Value: "true"},
+		},
+		Limit: models.SmartPlaylistDefaultLimit,
+	}
+
+	testTable := []struct {
+		name             string
+		userID           uint32
+		rules            models.SmartPlaylistRules
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:   "Common",
+			userID: correctUserID,
+			rules:  correctRules,
+			mockBehavior: func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
+				playlistID, userID uint32, rules models.SmartPlaylistRules) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+				pr.EXPECT().UpdateSmartRules(ctx, playlistID, rules).Return(nil)
+			},
+		},
+		{
+			name:   "Forbidden User",
+			userID: uint32(2),
+			rules:  correctRules,
+			mockBehavior: func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
+				playlistID, userID uint32, rules models.SmartPlaylistRules) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+			},
+			expectError:      true,
+			expectedErrorMsg: "rules can't be updated",
+		},
+		{
+			name:   "Invalid Rules",
+			userID: correctUserID,
+			rules:  models.SmartPlaylistRules{Match: models.SmartMatchAny},
+			mockBehavior: func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
+				playlistID, userID uint32, rules models.SmartPlaylistRules) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+			},
+			expectError:      true,
+			expectedErrorMsg: "invalid smart playlist rules",
+		},
+		{
+			name:   "Not Smart Playlist",
+			userID: correctUserID,
+			rules:  correctRules,
+			mockBehavior: func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
+				playlistID, userID uint32, rules models.SmartPlaylistRules) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+				pr.EXPECT().UpdateSmartRules(ctx, playlistID, rules).
+					Return(&models.NotSmartPlaylistError{PlaylistID: playlistID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "isn't smart",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(pr, ur, correctPlaylistID, tc.userID, tc.rules)
+
+			err := u.UpdateRules(ctx, correctPlaylistID, tc.rules, tc.userID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPlaylistUsecase_Fork(t *testing.T) {
+	type mockBehavior func(pr *playlistMocks.MockRepository, playlistID, userID uint32)
+
+	c := gomock.NewController(t)
+
+	pr := playlistMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
+	ur := userMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
+	cs := playlistMocks.NewMockCoverSaver(c)
+
+	u := NewUsecase(pr, tr, alr, ur, acr, n, local.NewBroker(), cs, commonTests.MockLogger(c))
+
+	var correctUserID uint32 = 1
+	var correctPlaylistID uint32 = 1
+	var forkID uint32 = 2
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(pr *playlistMocks.MockRepository, playlistID, userID uint32) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				pr.EXPECT().Fork(ctx, playlistID, userID).Return(forkID, nil)
+				acr.EXPECT().AddForFollowers(ctx, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "No Such Playlist",
+			mockBehavior: func(pr *playlistMocks.MockRepository, playlistID, userID uint32) {
+				pr.EXPECT().Check(ctx, playlistID).Return(errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't find playlist",
+		},
+		{
+			name: "Fork Issue",
+			mockBehavior: func(pr *playlistMocks.MockRepository, playlistID, userID uint32) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				pr.EXPECT().Fork(ctx, playlistID, userID).Return(uint32(0), errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't fork playlist",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(pr, correctPlaylistID, correctUserID)
+
+			id, err := u.Fork(ctx, correctPlaylistID, correctUserID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, forkID, id)
+			}
+		})
+	}
+}
+
+func TestPlaylistUsecase_AddTracks(t *testing.T) {
+	type mockBehavior func(pr *playlistMocks.MockRepository, tr *trackMocks.MockRepository,
+		alr *albumMocks.MockRepository, ur *userMocks.MockRepository, playlistID uint32)
+
+	c := gomock.NewController(t)
+
+	pr := playlistMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
+	ur := userMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
+	cs := playlistMocks.NewMockCoverSaver(c)
+
+	u := NewUsecase(pr, tr, alr, ur, acr, n, local.NewBroker(), cs, commonTests.MockLogger(c))
+
+	var correctUserID uint32 = 1
+	var correctPlaylistID uint32 = 1
+	var correctAlbumID uint32 = 1
+
+	correctUsers := []models.User{
+		{
+			ID: correctUserID,
+		},
+	}
+
+	albumTracks := []models.Track{{ID: 2}, {ID: 3}}
+
+	hugeAlbumTracks := make([]models.Track, 0, models.PlaylistTracksBatchMaxSize)
+	for id := uint32(2); id <= models.PlaylistTracksBatchMaxSize+1; id++ {
+		hugeAlbumTracks = append(hugeAlbumTracks, models.Track{ID: id})
+	}
+
+	correctResults := []models.PlaylistTrackResult{
+		{TrackID: 1, Status: models.PlaylistTrackAdded},
+		{TrackID: 2, Status: models.PlaylistTrackAlreadyAdded},
+		{TrackID: 3, Status: models.PlaylistTrackAdded},
+	}
+
+	testTable := []struct {
+		name             string
+		userID           uint32
+		tracksID         []uint32
+		albumID          uint32
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:     "Common",
+			userID:   correctUserID,
+			tracksID: []uint32{1, 2},
+			albumID:  correctAlbumID,
+			mockBehavior: func(pr *playlistMocks.MockRepository, tr *trackMocks.MockRepository,
+				alr *albumMocks.MockRepository, ur *userMocks.MockRepository, playlistID uint32) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(nil, &models.NotSmartPlaylistError{PlaylistID: playlistID})
+				alr.EXPECT().Check(ctx, correctAlbumID).Return(nil)
+				tr.EXPECT().GetByAlbum(ctx, correctAlbumID).Return(albumTracks, nil)
+				pr.EXPECT().AddTracks(ctx, []uint32{1, 2, 3}, playlistID, correctUserID).Return(correctResults, nil)
+				acr.EXPECT().AddForFollowers(ctx, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "Forbidden User",
+			userID:   uint32(2),
+			tracksID: []uint32{1, 2},
+			mockBehavior: func(pr *playlistMocks.MockRepository, tr *trackMocks.MockRepository,
+				alr *albumMocks.MockRepository, ur *userMocks.MockRepository, playlistID uint32) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+			},
+			expectError:      true,
+			expectedErrorMsg: "playlist can't be updated",
+		},
+		{
+			name:    "No Such Album",
+			userID:  correctUserID,
+			albumID: correctAlbumID,
+			mockBehavior: func(pr *playlistMocks.MockRepository, tr *trackMocks.MockRepository,
+				alr *albumMocks.MockRepository, ur *userMocks.MockRepository, playlistID uint32) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(nil, &models.NotSmartPlaylistError{PlaylistID: playlistID})
+				alr.EXPECT().Check(ctx, correctAlbumID).Return(&models.NoSuchAlbumError{AlbumID: correctAlbumID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't find album",
+		},
+		{
+			name:     "Too Big Album",
+			userID:   correctUserID,
+			tracksID: []uint32{1},
+			albumID:  correctAlbumID,
+			mockBehavior: func(pr *playlistMocks.MockRepository, tr *trackMocks.MockRepository,
+				alr *albumMocks.MockRepository, ur *userMocks.MockRepository, playlistID uint32) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(nil, &models.NotSmartPlaylistError{PlaylistID: playlistID})
+				alr.EXPECT().Check(ctx, correctAlbumID).Return(nil)
+				tr.EXPECT().GetByAlbum(ctx, correctAlbumID).Return(hugeAlbumTracks, nil)
+			},
+			expectError:      true,
+			expectedErrorMsg: "too many tracks in batch",
+		},
+		{
+			name:     "Add Tracks Issue",
+			userID:   correctUserID,
+			tracksID: []uint32{1},
+			mockBehavior: func(pr *playlistMocks.MockRepository, tr *trackMocks.MockRepository,
+				alr *albumMocks.MockRepository, ur *userMocks.MockRepository, playlistID uint32) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(nil, &models.NotSmartPlaylistError{PlaylistID: playlistID})
+				pr.EXPECT().AddTracks(ctx, []uint32{1}, playlistID, correctUserID).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't add tracks into playlist",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(pr, tr, alr, ur, correctPlaylistID)
+
+			results, err := u.AddTracks(ctx, tc.tracksID, tc.albumID, correctPlaylistID, tc.userID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, correctResults, results)
+			}
+		})
+	}
+}
+
+func TestPlaylistUsecase_RestoreRevision(t *testing.T) {
+	type mockBehavior func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
+		playlistID, revisionID, userID uint32)
+
+	c := gomock.NewController(t)
+
+	pr := playlistMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
+	ur := userMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
+	cs := playlistMocks.NewMockCoverSaver(c)
+
+	u := NewUsecase(pr, tr, alr, ur, acr, n, local.NewBroker(), cs, commonTests.MockLogger(c))
+
+	var correctUserID uint32 = 1
+	var correctPlaylistID uint32 = 1
+	var correctRevisionID uint32 = 1
+
+	correctUsers := []models.User{
+		{
+			ID: correctUserID,
+		},
+	}
+
+	testTable := []struct {
+		name             string
+		userID           uint32
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:   "Common",
+			userID: correctUserID,
+			mockBehavior: func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
+				playlistID, revisionID, userID uint32) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+				pr.EXPECT().RestoreRevision(ctx, playlistID, revisionID, userID).Return(nil)
+			},
+		},
+		{
+			name:   "Forbidden User",
+			userID: uint32(2),
+			mockBehavior: func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
+				playlistID, revisionID, userID uint32) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+			},
+			expectError:      true,
+			expectedErrorMsg: "playlist can't be restored",
+		},
+		{
+			name:   "No Such Revision",
+			userID: correctUserID,
+			mockBehavior: func(pr *playlistMocks.MockRepository, ur *userMocks.MockRepository,
+				playlistID, revisionID, userID uint32) {
+
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				ur.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctUsers, nil)
+				pr.EXPECT().RestoreRevision(ctx, playlistID, revisionID, userID).
+					Return(&models.NoSuchPlaylistRevisionError{PlaylistID: playlistID, RevisionID: revisionID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "has no revision",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(pr, ur, correctPlaylistID, correctRevisionID, tc.userID)
+
+			err := u.RestoreRevision(ctx, correctPlaylistID, correctRevisionID, tc.userID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package local
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+// Broker implements realtime.Broker in memory of process.
+// Events published by other processes, e.g. microservices, don't reach its subscribers.
+type Broker struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	sink chan<- models.RealtimeEvent
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subscribers: make(map[string]map[*subscriber]struct{}),
+	}
+}
+
+func (b *Broker) Publish(ctx context.Context, event models.RealtimeEvent) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.subscribers[event.Topic] {
+		select {
+		case s.sink <- event:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (b *Broker) Subscribe(topic string, sink chan<- models.RealtimeEvent) func() {
+	s := &subscriber{sink: sink}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subscribers[topic] == nil {
+		b.subscribers[topic] = make(map[*subscriber]struct{})
+	}
+	b.subscribers[topic][s] = struct{}{}
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.subscribers[topic], s)
+		if len(b.subscribers[topic]) == 0 {
+			delete(b.subscribers, topic)
+		}
+	}
+}
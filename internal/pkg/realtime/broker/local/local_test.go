@@ -0,0 +1,53 @@
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+var ctx = context.Background()
+
+func TestLocalBroker(t *testing.T) {
+	b := NewBroker()
+
+	playlistEvent := models.RealtimeEvent{
+		Topic: models.PlaylistTopic(1),
+		Type:  models.RealtimeEventPlaylistUpdated,
+	}
+	otherEvent := models.RealtimeEvent{
+		Topic: models.PlaylistTopic(2),
+		Type:  models.RealtimeEventPlaylistUpdated,
+	}
+
+	first := make(chan models.RealtimeEvent, 2)
+	second := make(chan models.RealtimeEvent, 2)
+	cancelFirst := b.Subscribe(playlistEvent.Topic, first)
+	cancelSecond := b.Subscribe(playlistEvent.Topic, second)
+	defer cancelSecond()
+
+	// Every subscriber of topic gets event
+	assert.NoError(t, b.Publish(ctx, playlistEvent))
+	assert.NoError(t, b.Publish(ctx, otherEvent))
+	assert.Equal(t, playlistEvent, <-first)
+	assert.Equal(t, playlistEvent, <-second)
+	assert.Empty(t, first)
+	assert.Empty(t, second)
+
+	// Cancelled subscriber doesn't get events
+	cancelFirst()
+	cancelFirst()
+	assert.NoError(t, b.Publish(ctx, playlistEvent))
+	assert.Empty(t, first)
+	assert.Equal(t, playlistEvent, <-second)
+
+	// Full sink doesn't block publisher
+	full := make(chan models.RealtimeEvent)
+	cancelFull := b.Subscribe(playlistEvent.Topic, full)
+	defer cancelFull()
+	assert.NoError(t, b.Publish(ctx, playlistEvent))
+	assert.Equal(t, playlistEvent, <-second)
+}
@@ -0,0 +1,234 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	easyjson "github.com/mailru/easyjson"
+	"golang.org/x/net/websocket"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+)
+
+const (
+	// eventsBufferSize is amount of events waiting to be sent to client, the rest are dropped
+	eventsBufferSize = 64
+	maxSubscriptions = 100
+	maxMessageSize   = 1 << 10
+
+	// idleTimeout closes connection if client sends nothing: clients ping to keep it alive
+	idleTimeout  = 2 * time.Minute
+	writeTimeout = 10 * time.Second
+)
+
+type Handler struct {
+	realtimeServices realtime.Usecase
+	logger           logger.Logger
+}
+
+func NewHandler(ru realtime.Usecase, l logger.Logger) *Handler {
+	return &Handler{
+		realtimeServices: ru,
+		logger:           l,
+	}
+}
+
+// @Summary		Real-time updates
+// @Tags		Realtime
+// @Description	Upgrade connection to WebSocket. Client sends {"action": "subscribe"|"unsubscribe"|"ping", "topic": "..."}
+// @Description	and gets {"action", "topic", "status"|"error"} in response. Topics are playlist:{id}, user:{id}:notifications and user:{id}:player.
+// @Description	Events of subscribed topics are pushed as {"topic", "type", "entityID", "version"}.
+// @Success		101		"Switching protocols"
+// @Failure		401		{object}	http.Error	"User unathorized"
+// @Failure		403		"Origin isn't allowed"
+// @Router		/api/realtime [get]
+func (h *Handler) Connect(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	server := websocket.Server{
+		Handshake: checkSameOrigin,
+		Handler: func(conn *websocket.Conn) {
+			s := &session{
+				conn:          conn,
+				userID:        user.ID,
+				events:        make(chan models.RealtimeEvent, eventsBufferSize),
+				subscriptions: make(map[string]func()),
+			}
+			h.serve(s)
+		},
+	}
+	server.ServeHTTP(w, r)
+}
+
+// checkSameOrigin rejects connections opened by pages of other sites:
+// browser sends cookies with handshake, but it can't be protected with CSRF token
+func checkSameOrigin(cfg *websocket.Config, r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return nil // not browser
+	}
+
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return fmt.Errorf("invalid origin: %w", err)
+	}
+	if originURL.Host != r.Host {
+		return fmt.Errorf("origin %q isn't allowed", origin)
+	}
+	cfg.Origin = originURL
+
+	return nil
+}
+
+// session is WebSocket connection of single user
+type session struct {
+	conn   *websocket.Conn
+	userID uint32
+
+	events        chan models.RealtimeEvent
+	subscriptions map[string]func()
+
+	// writeMu serializes responses and pushed events
+	writeMu sync.Mutex
+}
+
+func (s *session) send(v easyjson.Marshaler) error {
+	data, err := easyjson.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if err := s.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+
+	return websocket.Message.Send(s.conn, string(data))
+}
+
+func (h *Handler) serve(s *session) {
+	defer s.conn.Close()
+	s.conn.MaxPayloadBytes = maxMessageSize
+
+	ctx, cancel := context.WithCancel(s.conn.Request().Context())
+	defer cancel()
+
+	defer func() {
+		for _, unsubscribe := range s.subscriptions {
+			unsubscribe()
+		}
+	}()
+
+	go h.pushEvents(ctx, s)
+
+	for {
+		if err := s.conn.SetReadDeadline(time.Now().Add(idleTimeout)); err != nil {
+			return
+		}
+
+		var msg []byte
+		if err := websocket.Message.Receive(s.conn, &msg); err != nil {
+			return // connection is closed, idle or message is too large
+		}
+
+		if err := s.send(h.handleMessage(ctx, s, msg)); err != nil {
+			return
+		}
+	}
+}
+
+// pushEvents sends events of subscribed topics to client until session is over
+func (h *Handler) pushEvents(ctx context.Context, s *session) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.events:
+			if err := s.send(event); err != nil {
+				s.conn.Close()
+				return
+			}
+		}
+	}
+}
+
+func (h *Handler) handleMessage(ctx context.Context, s *session, data []byte) actionResponse {
+	var msg clientMessage
+	if err := easyjson.Unmarshal(data, &msg); err != nil {
+		return actionResponse{Error: invalidMessage}
+	}
+
+	resp := actionResponse{Action: msg.Action, Topic: msg.Topic}
+
+	switch msg.Action {
+	case actionPing:
+		resp.Status = actionSuccess
+
+	case actionSubscribe:
+		if _, ok := s.subscriptions[msg.Topic]; ok {
+			resp.Status = actionSuccess
+			break
+		}
+		if len(s.subscriptions) >= maxSubscriptions {
+			resp.Error = tooManySubscription
+			break
+		}
+
+		unsubscribe, err := h.realtimeServices.Subscribe(ctx, msg.Topic, s.userID, s.events)
+		if err != nil {
+			resp.Error = h.subscribeErrorMessage(ctx, err)
+			break
+		}
+		s.subscriptions[msg.Topic] = unsubscribe
+		resp.Status = actionSuccess
+
+	case actionUnsubscribe:
+		if unsubscribe, ok := s.subscriptions[msg.Topic]; ok {
+			unsubscribe()
+			delete(s.subscriptions, msg.Topic)
+		}
+		resp.Status = actionSuccess
+
+	default:
+		resp.Error = unknownAction
+	}
+
+	return resp
+}
+
+func (h *Handler) subscribeErrorMessage(ctx context.Context, err error) string {
+	var errInvalidTopic *models.InvalidRealtimeTopicError
+	if errors.As(err, &errInvalidTopic) {
+		return invalidTopic
+	}
+
+	var errNoSuchPlaylist *models.NoSuchPlaylistError
+	if errors.As(err, &errNoSuchPlaylist) {
+		return topicNotFound
+	}
+
+	var errForbiddenUser *models.ForbiddenUserError
+	if errors.As(err, &errForbiddenUser) {
+		return topicNoRights
+	}
+
+	h.logger.ErrorfReqID(ctx, "realtime: %v", err)
+
+	return subscribeError
+}
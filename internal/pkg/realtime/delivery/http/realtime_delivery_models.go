@@ -0,0 +1,40 @@
+package http
+
+//go:generate easyjson -no_std_marshalers realtime_delivery_models.go
+
+// Actions of client's messages
+const (
+	actionSubscribe   = "subscribe"
+	actionUnsubscribe = "unsubscribe"
+	// actionPing only keeps idle connection alive
+	actionPing = "ping"
+)
+
+// Response messages
+const (
+	invalidMessage      = "invalid message"
+	unknownAction       = "unknown action"
+	invalidTopic        = "invalid topic"
+	topicNotFound       = "no such entity to listen"
+	topicNoRights       = "no rights to listen topic"
+	tooManySubscription = "too many subscriptions"
+	subscribeError      = "can't subscribe"
+
+	actionSuccess = "ok"
+)
+
+//easyjson:json
+type clientMessage struct {
+	Action string `json:"action"`
+	Topic  string `json:"topic"`
+}
+
+// actionResponse is sent to client for each of its messages
+//
+//easyjson:json
+type actionResponse struct {
+	Action string `json:"action"`
+	Topic  string `json:"topic,omitempty"`
+	Status string `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
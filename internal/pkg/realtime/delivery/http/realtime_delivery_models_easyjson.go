@@ -0,0 +1,151 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package http
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson3e412af3DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgRealtimeDeliveryHttp(in *jlexer.Lexer, out *clientMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			out.Action = string(in.String())
+		case "topic":
+			out.Topic = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3e412af3EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgRealtimeDeliveryHttp(out *jwriter.Writer, in clientMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"topic\":"
+		out.RawString(prefix)
+		out.String(string(in.Topic))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v clientMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3e412af3EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgRealtimeDeliveryHttp(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *clientMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3e412af3DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgRealtimeDeliveryHttp(l, v)
+}
+func easyjson3e412af3DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgRealtimeDeliveryHttp1(in *jlexer.Lexer, out *actionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "action":
+			out.Action = string(in.String())
+		case "topic":
+			out.Topic = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3e412af3EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgRealtimeDeliveryHttp1(out *jwriter.Writer, in actionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Action))
+	}
+	if in.Topic != "" {
+		const prefix string = ",\"topic\":"
+		out.RawString(prefix)
+		out.String(string(in.Topic))
+	}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v actionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3e412af3EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgRealtimeDeliveryHttp1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *actionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3e412af3DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgRealtimeDeliveryHttp1(l, v)
+}
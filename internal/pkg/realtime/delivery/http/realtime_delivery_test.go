@@ -0,0 +1,156 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	realtimeMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/mocks"
+)
+
+func TestRealtimeDeliveryHTTP_Connect(t *testing.T) {
+	// Init
+	c := gomock.NewController(t)
+
+	ru := realtimeMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(ru, l)
+
+	// Routing
+	user := &models.User{ID: 1}
+
+	r := chi.NewRouter()
+	r.Get("/api/realtime", func(w http.ResponseWriter, r *http.Request) {
+		h.Connect(w, commonTests.WrapRequestWithUserNotNil(r, user))
+	})
+
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/realtime"
+
+	// Test filling
+	playlistTopic := models.PlaylistTopic(2)
+	trackAdded := models.RealtimeEvent{
+		Topic:    playlistTopic,
+		Type:     models.RealtimeEventPlaylistTracksChanged,
+		EntityID: 3,
+	}
+
+	var unsubscribed atomic.Bool
+	sinks := make(chan chan<- models.RealtimeEvent, 1)
+
+	ru.EXPECT().Subscribe(gomock.Any(), playlistTopic, user.ID, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, topic string, userID uint32,
+			sink chan<- models.RealtimeEvent) (func(), error) {
+
+			sinks <- sink
+			return func() { unsubscribed.Store(true) }, nil
+		})
+	ru.EXPECT().Subscribe(gomock.Any(), "album:1", user.ID, gomock.Any()).
+		Return(nil, &models.InvalidRealtimeTopicError{Topic: "album:1"})
+	ru.EXPECT().Subscribe(gomock.Any(), models.NotificationsTopic(5), user.ID, gomock.Any()).
+		Return(nil, &models.ForbiddenUserError{})
+	ru.EXPECT().Subscribe(gomock.Any(), models.PlaylistTopic(9), user.ID, gomock.Any()).
+		Return(nil, errors.New(""))
+
+	ws, err := websocket.Dial(wsURL, "", server.URL)
+	require.NoError(t, err)
+
+	exchange := func(msg string) string {
+		t.Helper()
+
+		require.NoError(t, websocket.Message.Send(ws, msg))
+
+		var resp string
+		require.NoError(t, ws.SetReadDeadline(time.Now().Add(time.Second)))
+		require.NoError(t, websocket.Message.Receive(ws, &resp))
+
+		return resp
+	}
+
+	t.Run("Subscribe", func(t *testing.T) {
+		assert.JSONEq(t, `{"action": "subscribe", "topic": "playlist:2", "status": "ok"}`,
+			exchange(`{"action": "subscribe", "topic": "playlist:2"}`))
+	})
+
+	t.Run("Subscribe Again", func(t *testing.T) {
+		assert.JSONEq(t, `{"action": "subscribe", "topic": "playlist:2", "status": "ok"}`,
+			exchange(`{"action": "subscribe", "topic": "playlist:2"}`))
+	})
+
+	t.Run("Event", func(t *testing.T) {
+		sink := <-sinks
+		sink <- trackAdded
+
+		var event string
+		require.NoError(t, ws.SetReadDeadline(time.Now().Add(time.Second)))
+		require.NoError(t, websocket.Message.Receive(ws, &event))
+		assert.JSONEq(t, `{"topic": "playlist:2", "type": "playlistTracksChanged", "entityID": 3}`, event)
+	})
+
+	t.Run("Invalid Topic", func(t *testing.T) {
+		assert.JSONEq(t, `{"action": "subscribe", "topic": "album:1", "error": "`+invalidTopic+`"}`,
+			exchange(`{"action": "subscribe", "topic": "album:1"}`))
+	})
+
+	t.Run("Forbidden Topic", func(t *testing.T) {
+		assert.JSONEq(t, `{"action": "subscribe", "topic": "user:5:notifications", "error": "`+topicNoRights+`"}`,
+			exchange(`{"action": "subscribe", "topic": "user:5:notifications"}`))
+	})
+
+	t.Run("Server Error", func(t *testing.T) {
+		assert.JSONEq(t, `{"action": "subscribe", "topic": "playlist:9", "error": "`+subscribeError+`"}`,
+			exchange(`{"action": "subscribe", "topic": "playlist:9"}`))
+	})
+
+	t.Run("Ping", func(t *testing.T) {
+		assert.JSONEq(t, `{"action": "ping", "status": "ok"}`,
+			exchange(`{"action": "ping"}`))
+	})
+
+	t.Run("Unknown Action", func(t *testing.T) {
+		assert.JSONEq(t, `{"action": "jump", "error": "`+unknownAction+`"}`,
+			exchange(`{"action": "jump"}`))
+	})
+
+	t.Run("Invalid Message", func(t *testing.T) {
+		assert.JSONEq(t, `{"action": "", "error": "`+invalidMessage+`"}`,
+			exchange(`not json`))
+	})
+
+	t.Run("Unsubscribe On Close", func(t *testing.T) {
+		require.NoError(t, ws.Close())
+
+		assert.Eventually(t, unsubscribed.Load, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("Other Origin", func(t *testing.T) {
+		_, err := websocket.Dial(wsURL, "", "http://evil.example.com")
+		assert.Error(t, err)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		r := chi.NewRouter()
+		r.Get("/api/realtime", h.Connect)
+
+		commonTests.DeliveryTestGet(t, r, "/api/realtime", http.StatusUnauthorized,
+			commonTests.ErrorResponse(commonHTTP.UnathorizedUser), commonTests.NoWrapUserFunc())
+	})
+}
@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: realtime.go
+
+// Package mock_realtime is a generated GoMock package.
+package mock_realtime
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockUsecase) Subscribe(ctx context.Context, topic string, userID uint32, sink chan<- models.RealtimeEvent) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, topic, userID, sink)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockUsecaseMockRecorder) Subscribe(ctx, topic, userID, sink interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUsecase)(nil).Subscribe), ctx, topic, userID, sink)
+}
+
+// MockPublisher is a mock of Publisher interface.
+type MockPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublisherMockRecorder
+}
+
+// MockPublisherMockRecorder is the mock recorder for MockPublisher.
+type MockPublisherMockRecorder struct {
+	mock *MockPublisher
+}
+
+// NewMockPublisher creates a new mock instance.
+func NewMockPublisher(ctrl *gomock.Controller) *MockPublisher {
+	mock := &MockPublisher{ctrl: ctrl}
+	mock.recorder = &MockPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublisher) EXPECT() *MockPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockPublisher) Publish(ctx context.Context, event models.RealtimeEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockPublisherMockRecorder) Publish(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockPublisher)(nil).Publish), ctx, event)
+}
+
+// MockBroker is a mock of Broker interface.
+type MockBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBrokerMockRecorder
+}
+
+// MockBrokerMockRecorder is the mock recorder for MockBroker.
+type MockBrokerMockRecorder struct {
+	mock *MockBroker
+}
+
+// NewMockBroker creates a new mock instance.
+func NewMockBroker(ctrl *gomock.Controller) *MockBroker {
+	mock := &MockBroker{ctrl: ctrl}
+	mock.recorder = &MockBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroker) EXPECT() *MockBrokerMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockBroker) Publish(ctx context.Context, event models.RealtimeEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockBrokerMockRecorder) Publish(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockBroker)(nil).Publish), ctx, event)
+}
+
+// Subscribe mocks base method.
+func (m *MockBroker) Subscribe(topic string, sink chan<- models.RealtimeEvent) func() {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", topic, sink)
+	ret0, _ := ret[0].(func())
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockBrokerMockRecorder) Subscribe(topic, sink interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockBroker)(nil).Subscribe), topic, sink)
+}
@@ -0,0 +1,35 @@
+package realtime
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+//go:generate mockgen -source=realtime.go -destination=mocks/mock.go
+
+// Usecase includes bussiness logics methods to work with real-time subscriptions
+type Usecase interface {
+	// Subscribe checks if user can listen to topic and sends its events to sink until cancel is called.
+	// It returns models.InvalidRealtimeTopicError if topic has unknown format.
+	Subscribe(ctx context.Context, topic string, userID uint32,
+		sink chan<- models.RealtimeEvent) (cancel func(), err error)
+}
+
+// Publisher is used by other usecases to push events to subscribers of topics.
+// Events are pushed after changes are saved, so publish failures are logged
+// and never fail the caller.
+type Publisher interface {
+	Publish(ctx context.Context, event models.RealtimeEvent) error
+}
+
+// Broker delivers events published on topics to their subscribers.
+// It can be implemented in-process or backed by external message bus.
+type Broker interface {
+	Publisher
+
+	// Subscribe sends events on topic to sink until returned cancel is called.
+	// Events are dropped if sink isn't ready to receive them, so slow subscriber
+	// doesn't block publishers.
+	Subscribe(topic string, sink chan<- models.RealtimeEvent) (cancel func())
+}
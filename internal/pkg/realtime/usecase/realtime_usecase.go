@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime"
+)
+
+// Usecase implements realtime.Usecase
+type Usecase struct {
+	broker       realtime.Broker
+	playlistRepo playlist.Repository
+}
+
+func NewUsecase(b realtime.Broker, pr playlist.Repository) *Usecase {
+	return &Usecase{
+		broker:       b,
+		playlistRepo: pr,
+	}
+}
+
+func (u *Usecase) Subscribe(ctx context.Context, topic string, userID uint32,
+	sink chan<- models.RealtimeEvent) (func(), error) {
+
+	t, err := models.ParseRealtimeTopic(topic)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) %w", err)
+	}
+
+	switch t.Kind {
+	case models.RealtimeTopicPlaylist:
+		if err := u.playlistRepo.Check(ctx, t.ID); err != nil {
+			return nil, fmt.Errorf("(usecase) can't find playlist with id #%d: %w", t.ID, err)
+		}
+
+	case models.RealtimeTopicNotifications, models.RealtimeTopicPlayer:
+		if t.ID != userID {
+			return nil, fmt.Errorf("(usecase) %s of other user can't be listened: %w", t.Kind, &models.ForbiddenUserError{})
+		}
+	}
+
+	return u.broker.Subscribe(topic, sink), nil
+}
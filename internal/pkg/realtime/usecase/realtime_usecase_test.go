@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	playlistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/mocks"
+	realtimeMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/mocks"
+)
+
+var ctx = context.Background()
+
+func TestRealtimeUsecase_Subscribe(t *testing.T) {
+	type mockBehavior func(b *realtimeMocks.MockBroker, pr *playlistMocks.MockRepository, topic string)
+
+	c := gomock.NewController(t)
+
+	b := realtimeMocks.NewMockBroker(c)
+	pr := playlistMocks.NewMockRepository(c)
+
+	u := NewUsecase(b, pr)
+
+	const userID uint32 = 1
+	const playlistID uint32 = 2
+
+	sink := make(chan models.RealtimeEvent)
+	cancel := func() {}
+
+	testTable := []struct {
+		name          string
+		topic         string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name:  "Playlist",
+			topic: models.PlaylistTopic(playlistID),
+			mockBehavior: func(b *realtimeMocks.MockBroker, pr *playlistMocks.MockRepository, topic string) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				b.EXPECT().Subscribe(topic, gomock.Any()).Return(cancel)
+			},
+		},
+		{
+			name:  "Own Notifications",
+			topic: models.NotificationsTopic(userID),
+			mockBehavior: func(b *realtimeMocks.MockBroker, pr *playlistMocks.MockRepository, topic string) {
+				b.EXPECT().Subscribe(topic, gomock.Any()).Return(cancel)
+			},
+		},
+		{
+			name:          "Notifications Of Other User",
+			topic:         models.NotificationsTopic(userID + 1),
+			mockBehavior:  func(b *realtimeMocks.MockBroker, pr *playlistMocks.MockRepository, topic string) {},
+			expectError:   true,
+			expectedError: &models.ForbiddenUserError{},
+		},
+		{
+			name:  "Own Player",
+			topic: models.PlayerTopic(userID),
+			mockBehavior: func(b *realtimeMocks.MockBroker, pr *playlistMocks.MockRepository, topic string) {
+				b.EXPECT().Subscribe(topic, gomock.Any()).Return(cancel)
+			},
+		},
+		{
+			name:          "Player Of Other User",
+			topic:         models.PlayerTopic(userID + 1),
+			mockBehavior:  func(b *realtimeMocks.MockBroker, pr *playlistMocks.MockRepository, topic string) {},
+			expectError:   true,
+			expectedError: &models.ForbiddenUserError{},
+		},
+		{
+			name:          "Invalid Topic",
+			topic:         "album:1",
+			mockBehavior:  func(b *realtimeMocks.MockBroker, pr *playlistMocks.MockRepository, topic string) {},
+			expectError:   true,
+			expectedError: &models.InvalidRealtimeTopicError{Topic: "album:1"},
+		},
+		{
+			name:  "No Such Playlist",
+			topic: models.PlaylistTopic(playlistID),
+			mockBehavior: func(b *realtimeMocks.MockBroker, pr *playlistMocks.MockRepository, topic string) {
+				pr.EXPECT().Check(ctx, playlistID).Return(&models.NoSuchPlaylistError{PlaylistID: playlistID})
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchPlaylistError{PlaylistID: playlistID},
+		},
+		{
+			name:  "Repository Issue",
+			topic: models.PlaylistTopic(playlistID),
+			mockBehavior: func(b *realtimeMocks.MockBroker, pr *playlistMocks.MockRepository, topic string) {
+				pr.EXPECT().Check(ctx, playlistID).Return(errors.New("repo error"))
+			},
+			expectError:   true,
+			expectedError: errors.New("repo error"),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(b, pr, tc.topic)
+
+			unsubscribe, err := u.Subscribe(ctx, tc.topic, userID, sink)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+				assert.Nil(t, unsubscribe)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, unsubscribe)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+)
+
+type Handler struct {
+	recommendationServices recommendation.Usecase
+	trackServices          track.Usecase
+	artistServices         artist.Usecase
+	logger                 logger.Logger
+}
+
+func NewHandler(ru recommendation.Usecase, tu track.Usecase, au artist.Usecase, l logger.Logger) *Handler {
+	return &Handler{
+		recommendationServices: ru,
+		trackServices:          tu,
+		artistServices:         au,
+
+		logger: l,
+	}
+}
+
+// @Summary      Because You Liked
+// @Tags         Feed
+// @Description  Get tracks similar to each of the latest liked tracks of user
+// @Produce      json
+// @Success      200    {object}  	models.BecauseYouLikedTransfers	"Recommendations got"
+// @Failure      401    {object}  	http.Error  					"User unathorized"
+// @Failure      500    {object}  	http.Error  					"Server error"
+// @Router       /api/recommendations/because-you-liked [get]
+func (h *Handler) BecauseYouLiked(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		if errors.Is(err, commonHTTP.ErrUnauthorized) {
+			commonHTTP.ErrorResponse(w, r, commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger)
+			return
+		}
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			becauseYouLikedGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	groups, err := h.recommendationServices.GetBecauseYouLiked(r.Context(), user.ID)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			becauseYouLikedGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	bt, err := models.BecauseYouLikedTransferFromList(r.Context(), groups, user,
+		h.trackServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByTrack)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			becauseYouLikedGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	commonHTTP.SuccessResponse(w, r, bt, h.logger)
+}
+
+// @Summary      Recommended Tracks
+// @Tags         Feed
+// @Description  Get tracks recommended to user by likes and listens of similar users.
+// @Description  Charts are returned for unauthorized users and users without enough likes and listens.
+// @Produce      json
+// @Success      200    {object}  	recommendedTracksResponse	"Tracks got"
+// @Failure      500    {object}  	http.Error  				"Server error"
+// @Router       /api/recommendations/tracks [get]
+func (h *Handler) Tracks(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			feedGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	var userID uint32
+	if user != nil {
+		userID = user.ID
+	}
+
+	tracks, source, err := h.recommendationServices.GetFeed(r.Context(), userID)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			feedGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	tt, err := models.TrackTransferFromList(r.Context(), tracks, user,
+		h.trackServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByTrack)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			feedGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := recommendedTracksResponse{
+		Source: source,
+		Tracks: tt,
+	}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
+
+// @Summary      Radio
+// @Tags         Feed
+// @Description  Get page of endless radio queue built around track or artist:
+// @Description  tracks with similar listen patterns, of the same and co-liked artists.
+// @Description  Tracks recently played by user are skipped, so page may be shorter than limit.
+// @Produce      json
+// @Param		 seed	query		string	true	"Seed of radio: track:ID or artist:ID"
+// @Param		 limit	query		int		false	"Page size"
+// @Param		 offset	query		int		false	"Page offset"
+// @Success      200    {object}  	radioResponse	"Radio queue got"
+// @Failure		 400	{object}	http.Error		"Incorrect input"
+// @Failure      500    {object}  	http.Error  	"Server error"
+// @Router       /api/radio [get]
+func (h *Handler) Radio(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			radioGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	seed, err := models.ParseRadioSeed(r.URL.Query().Get(RadioSeedQueryParam))
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	limit, offset, err := commonHTTP.GetPaginationFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	var userID uint32
+	if user != nil {
+		userID = user.ID
+	}
+
+	tracks, nextOffset, err := h.recommendationServices.GetRadio(r.Context(), seed, userID, limit, offset)
+	if err != nil {
+		var errInvalidQuery *models.InvalidRadioQueryError
+		if errors.As(err, &errInvalidQuery) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errNoSuchTrack *models.NoSuchTrackError
+		var errNoSuchArtist *models.NoSuchArtistError
+		if errors.As(err, &errNoSuchTrack) || errors.As(err, &errNoSuchArtist) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				radioSeedNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			radioGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	tt, err := models.TrackTransferFromList(r.Context(), tracks, user,
+		h.trackServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByTrack)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			radioGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := radioResponse{
+		Tracks:     tt,
+		NextOffset: nextOffset,
+	}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
@@ -0,0 +1,34 @@
+package http
+
+import "github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+//go:generate easyjson -no_std_marshalers recommendation_delivery_models.go
+
+const (
+	becauseYouLikedGetServerError = "can't get recommendations"
+	feedGetServerError            = "can't get recommended tracks"
+	radioGetServerError           = "can't get radio"
+
+	radioSeedNotFound = "no such seed track or artist"
+)
+
+// RadioSeedQueryParam is track or artist which radio is built around, e.g. track:123 or artist:45
+const RadioSeedQueryParam = "seed"
+
+// Feed
+//
+//easyjson:json
+type recommendedTracksResponse struct {
+	// Source is personal or charts if there isn't enough data about user
+	Source string                `json:"source"`
+	Tracks models.TrackTransfers `json:"tracks"`
+}
+
+// Radio
+//
+//easyjson:json
+type radioResponse struct {
+	Tracks models.TrackTransfers `json:"tracks"`
+	// NextOffset is offset of the next page, queue is endless
+	NextOffset uint32 `json:"nextOffset"`
+}
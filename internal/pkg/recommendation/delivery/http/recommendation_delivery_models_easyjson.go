@@ -0,0 +1,137 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package http
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonDbfa3c51DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgRecommendationDeliveryHttp(in *jlexer.Lexer, out *recommendedTracksResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "source":
+			out.Source = string(in.String())
+		case "tracks":
+			(out.Tracks).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDbfa3c51EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgRecommendationDeliveryHttp(out *jwriter.Writer, in recommendedTracksResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"source\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		(in.Tracks).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v recommendedTracksResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDbfa3c51EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgRecommendationDeliveryHttp(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *recommendedTracksResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDbfa3c51DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgRecommendationDeliveryHttp(l, v)
+}
+func easyjsonDbfa3c51DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgRecommendationDeliveryHttp1(in *jlexer.Lexer, out *radioResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tracks":
+			(out.Tracks).UnmarshalEasyJSON(in)
+		case "nextOffset":
+			out.NextOffset = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDbfa3c51EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgRecommendationDeliveryHttp1(out *jwriter.Writer, in radioResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix[1:])
+		(in.Tracks).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nextOffset\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.NextOffset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v radioResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDbfa3c51EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgRecommendationDeliveryHttp1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *radioResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDbfa3c51DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgRecommendationDeliveryHttp1(l, v)
+}
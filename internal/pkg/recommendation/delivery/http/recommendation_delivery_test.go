@@ -0,0 +1,276 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	artistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/mocks"
+	recommendationMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation/mocks"
+	trackMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/mocks"
+)
+
+func TestRecommendationDeliveryHTTP_Tracks(t *testing.T) {
+	// Init
+	type mockBehavior func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+		au *artistMocks.MockUsecase, user *models.User)
+
+	c := gomock.NewController(t)
+
+	ru := recommendationMocks.NewMockUsecase(c)
+	tu := trackMocks.NewMockUsecase(c)
+	au := artistMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(ru, tu, au, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/recommendations/tracks", h.Tracks)
+
+	// Test filling
+	correctUser := &models.User{ID: 1}
+
+	tracks := []models.Track{
+		{
+			ID:        1,
+			Name:      "Где нас нет",
+			CoverSrc:  "/tracks/covers/where_we_are_not.png",
+			RecordSrc: "/tracks/records/where_we_are_not.wav",
+		},
+	}
+	artists := []models.Artist{
+		{
+			ID:        1,
+			Name:      "Oxxxymiron",
+			AvatarSrc: "/artists/avatars/oxxxymiron.png",
+		},
+	}
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Personal",
+			user: correctUser,
+			mockBehavior: func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+				au *artistMocks.MockUsecase, user *models.User) {
+
+				ru.EXPECT().GetFeed(gomock.Any(), user.ID).Return(tracks, models.RecommendationSourcePersonal, nil)
+				au.EXPECT().GetByTrack(gomock.Any(), tracks[0].ID).Return(artists, nil)
+				tu.EXPECT().IsLiked(gomock.Any(), tracks[0].ID, user.ID).Return(false, nil)
+				au.EXPECT().IsLiked(gomock.Any(), artists[0].ID, user.ID).Return(true, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: `{
+				"source": "personal",
+				"tracks": [
+					{
+						"id": 1,
+						"name": "Где нас нет",
+						"artists": [
+							{
+								"id": 1,
+								"name": "Oxxxymiron",
+								"isLiked": true,
+								"cover": "/artists/avatars/oxxxymiron.png"
+							}
+						],
+						"cover": "/tracks/covers/where_we_are_not.png",
+						"duration": 0,
+						"listens": 0,
+						"isLiked": false,
+						"recordSrc": "/tracks/records/where_we_are_not.wav"
+					}
+				]
+			}`,
+		},
+		{
+			name: "Unauthorized Charts",
+			user: nil,
+			mockBehavior: func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+				au *artistMocks.MockUsecase, user *models.User) {
+
+				ru.EXPECT().GetFeed(gomock.Any(), uint32(0)).Return([]models.Track{}, models.RecommendationSourceCharts, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"source": "charts", "tracks": []}`,
+		},
+		{
+			name: "Server Error",
+			user: correctUser,
+			mockBehavior: func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+				au *artistMocks.MockUsecase, user *models.User) {
+
+				ru.EXPECT().GetFeed(gomock.Any(), user.ID).Return(nil, "", errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(feedGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(ru, tu, au, tc.user)
+
+			commonTests.DeliveryTestGet(t, r, "/api/recommendations/tracks", tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestRecommendationDeliveryHTTP_Radio(t *testing.T) {
+	// Init
+	type mockBehavior func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+		au *artistMocks.MockUsecase, user *models.User)
+
+	c := gomock.NewController(t)
+
+	ru := recommendationMocks.NewMockUsecase(c)
+	tu := trackMocks.NewMockUsecase(c)
+	au := artistMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(ru, tu, au, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/radio", h.Radio)
+
+	// Test filling
+	correctUser := &models.User{ID: 1}
+	trackSeed := models.RadioSeed{Type: models.RadioSeedTrack, ID: 123}
+
+	tracks := []models.Track{
+		{
+			ID:        1,
+			Name:      "Где нас нет",
+			CoverSrc:  "/tracks/covers/where_we_are_not.png",
+			RecordSrc: "/tracks/records/where_we_are_not.wav",
+		},
+	}
+
+	testTable := []struct {
+		name             string
+		query            string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:  "Common",
+			query: "?seed=track:123&limit=10&offset=20",
+			user:  correctUser,
+			mockBehavior: func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+				au *artistMocks.MockUsecase, user *models.User) {
+
+				ru.EXPECT().GetRadio(gomock.Any(), trackSeed, user.ID, uint32(10), uint32(20)).
+					Return([]models.Track{}, uint32(30), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"tracks": [], "nextOffset": 30}`,
+		},
+		{
+			name:  "Short Page (recently played tracks are skipped)",
+			query: "?seed=track:123&limit=3&offset=6",
+			user:  correctUser,
+			mockBehavior: func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+				au *artistMocks.MockUsecase, user *models.User) {
+
+				ru.EXPECT().GetRadio(gomock.Any(), trackSeed, user.ID, uint32(3), uint32(6)).
+					Return(tracks, uint32(9), nil)
+				au.EXPECT().GetByTrack(gomock.Any(), tracks[0].ID).Return([]models.Artist{}, nil)
+				tu.EXPECT().IsLiked(gomock.Any(), tracks[0].ID, user.ID).Return(false, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: `{
+				"tracks": [
+					{
+						"id": 1,
+						"name": "Где нас нет",
+						"artists": [],
+						"cover": "/tracks/covers/where_we_are_not.png",
+						"duration": 0,
+						"listens": 0,
+						"isLiked": false,
+						"recordSrc": "/tracks/records/where_we_are_not.wav"
+					}
+				],
+				"nextOffset": 9
+			}`,
+		},
+		{
+			name:  "Queue Wrapped",
+			query: "?seed=track:123&limit=10&offset=95",
+			user:  nil,
+			mockBehavior: func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+				au *artistMocks.MockUsecase, user *models.User) {
+
+				ru.EXPECT().GetRadio(gomock.Any(), trackSeed, uint32(0), uint32(10), uint32(95)).
+					Return([]models.Track{}, uint32(5), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"tracks": [], "nextOffset": 5}`,
+		},
+		{
+			name:  "Invalid Seed",
+			query: "?seed=album:1",
+			user:  correctUser,
+			mockBehavior: func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+				au *artistMocks.MockUsecase, user *models.User) {
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
+		},
+		{
+			name:  "No Such Seed",
+			query: "?seed=track:123",
+			user:  nil,
+			mockBehavior: func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+				au *artistMocks.MockUsecase, user *models.User) {
+
+				ru.EXPECT().GetRadio(gomock.Any(), trackSeed, uint32(0), uint32(0), uint32(0)).
+					Return(nil, uint32(0), &models.NoSuchTrackError{TrackID: trackSeed.ID})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(radioSeedNotFound),
+		},
+		{
+			name:  "Server Error",
+			query: "?seed=track:123",
+			user:  correctUser,
+			mockBehavior: func(ru *recommendationMocks.MockUsecase, tu *trackMocks.MockUsecase,
+				au *artistMocks.MockUsecase, user *models.User) {
+
+				ru.EXPECT().GetRadio(gomock.Any(), trackSeed, user.ID, uint32(0), uint32(0)).
+					Return(nil, uint32(0), errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(radioGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(ru, tu, au, tc.user)
+
+			commonTests.DeliveryTestGet(t, r, "/api/radio"+tc.query, tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
@@ -0,0 +1,360 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recommendation.go
+
+// Package mock_recommendation is a generated GoMock package.
+package mock_recommendation
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetBecauseYouLiked mocks base method.
+func (m *MockUsecase) GetBecauseYouLiked(ctx context.Context, userID uint32) ([]models.BecauseYouLiked, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBecauseYouLiked", ctx, userID)
+	ret0, _ := ret[0].([]models.BecauseYouLiked)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBecauseYouLiked indicates an expected call of GetBecauseYouLiked.
+func (mr *MockUsecaseMockRecorder) GetBecauseYouLiked(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBecauseYouLiked", reflect.TypeOf((*MockUsecase)(nil).GetBecauseYouLiked), ctx, userID)
+}
+
+// GetFeed mocks base method.
+func (m *MockUsecase) GetFeed(ctx context.Context, userID uint32) ([]models.Track, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, userID)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockUsecaseMockRecorder) GetFeed(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockUsecase)(nil).GetFeed), ctx, userID)
+}
+
+// GetRadio mocks base method.
+func (m *MockUsecase) GetRadio(ctx context.Context, seed models.RadioSeed, userID, limit, offset uint32) ([]models.Track, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRadio", ctx, seed, userID, limit, offset)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRadio indicates an expected call of GetRadio.
+func (mr *MockUsecaseMockRecorder) GetRadio(ctx, seed, userID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRadio", reflect.TypeOf((*MockUsecase)(nil).GetRadio), ctx, seed, userID, limit, offset)
+}
+
+// RecomputeNeighbors mocks base method.
+func (m *MockUsecase) RecomputeNeighbors(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeNeighbors", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecomputeNeighbors indicates an expected call of RecomputeNeighbors.
+func (mr *MockUsecaseMockRecorder) RecomputeNeighbors(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeNeighbors", reflect.TypeOf((*MockUsecase)(nil).RecomputeNeighbors), ctx)
+}
+
+// RecomputeRelatedArtists mocks base method.
+func (m *MockUsecase) RecomputeRelatedArtists(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeRelatedArtists", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecomputeRelatedArtists indicates an expected call of RecomputeRelatedArtists.
+func (mr *MockUsecaseMockRecorder) RecomputeRelatedArtists(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeRelatedArtists", reflect.TypeOf((*MockUsecase)(nil).RecomputeRelatedArtists), ctx)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetCharts mocks base method.
+func (m *MockRepository) GetCharts(ctx context.Context, since time.Time, limit uint32) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharts", ctx, since, limit)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharts indicates an expected call of GetCharts.
+func (mr *MockRepositoryMockRecorder) GetCharts(ctx, since, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharts", reflect.TypeOf((*MockRepository)(nil).GetCharts), ctx, since, limit)
+}
+
+// GetForUser mocks base method.
+func (m *MockRepository) GetForUser(ctx context.Context, userID, limit uint32) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUser", ctx, userID, limit)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUser indicates an expected call of GetForUser.
+func (mr *MockRepositoryMockRecorder) GetForUser(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUser", reflect.TypeOf((*MockRepository)(nil).GetForUser), ctx, userID, limit)
+}
+
+// GetLastLiked mocks base method.
+func (m *MockRepository) GetLastLiked(ctx context.Context, userID, limit uint32) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastLiked", ctx, userID, limit)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastLiked indicates an expected call of GetLastLiked.
+func (mr *MockRepositoryMockRecorder) GetLastLiked(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastLiked", reflect.TypeOf((*MockRepository)(nil).GetLastLiked), ctx, userID, limit)
+}
+
+// GetNeighbors mocks base method.
+func (m *MockRepository) GetNeighbors(ctx context.Context, trackID, userID, limit uint32) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNeighbors", ctx, trackID, userID, limit)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNeighbors indicates an expected call of GetNeighbors.
+func (mr *MockRepositoryMockRecorder) GetNeighbors(ctx, trackID, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNeighbors", reflect.TypeOf((*MockRepository)(nil).GetNeighbors), ctx, trackID, userID, limit)
+}
+
+// GetRadio mocks base method.
+func (m *MockRepository) GetRadio(ctx context.Context, seed models.RadioSeed, userID, limit, offset uint32) ([]models.Track, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRadio", ctx, seed, userID, limit, offset)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRadio indicates an expected call of GetRadio.
+func (mr *MockRepositoryMockRecorder) GetRadio(ctx, seed, userID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRadio", reflect.TypeOf((*MockRepository)(nil).GetRadio), ctx, seed, userID, limit, offset)
+}
+
+// RecomputeNeighbors mocks base method.
+func (m *MockRepository) RecomputeNeighbors(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeNeighbors", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecomputeNeighbors indicates an expected call of RecomputeNeighbors.
+func (mr *MockRepositoryMockRecorder) RecomputeNeighbors(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeNeighbors", reflect.TypeOf((*MockRepository)(nil).RecomputeNeighbors), ctx)
+}
+
+// RecomputeRelatedArtists mocks base method.
+func (m *MockRepository) RecomputeRelatedArtists(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecomputeRelatedArtists", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecomputeRelatedArtists indicates an expected call of RecomputeRelatedArtists.
+func (mr *MockRepositoryMockRecorder) RecomputeRelatedArtists(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecomputeRelatedArtists", reflect.TypeOf((*MockRepository)(nil).RecomputeRelatedArtists), ctx)
+}
+
+// MockTables is a mock of Tables interface.
+type MockTables struct {
+	ctrl     *gomock.Controller
+	recorder *MockTablesMockRecorder
+}
+
+// MockTablesMockRecorder is the mock recorder for MockTables.
+type MockTablesMockRecorder struct {
+	mock *MockTables
+}
+
+// NewMockTables creates a new mock instance.
+func NewMockTables(ctrl *gomock.Controller) *MockTables {
+	mock := &MockTables{ctrl: ctrl}
+	mock.recorder = &MockTablesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTables) EXPECT() *MockTablesMockRecorder {
+	return m.recorder
+}
+
+// ArtistsTracks mocks base method.
+func (m *MockTables) ArtistsTracks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistsTracks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ArtistsTracks indicates an expected call of ArtistsTracks.
+func (mr *MockTablesMockRecorder) ArtistsTracks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistsTracks", reflect.TypeOf((*MockTables)(nil).ArtistsTracks))
+}
+
+// LikedArtists mocks base method.
+func (m *MockTables) LikedArtists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedArtists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedArtists indicates an expected call of LikedArtists.
+func (mr *MockTablesMockRecorder) LikedArtists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedArtists", reflect.TypeOf((*MockTables)(nil).LikedArtists))
+}
+
+// LikedTracks mocks base method.
+func (m *MockTables) LikedTracks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedTracks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedTracks indicates an expected call of LikedTracks.
+func (mr *MockTablesMockRecorder) LikedTracks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedTracks", reflect.TypeOf((*MockTables)(nil).LikedTracks))
+}
+
+// Listens mocks base method.
+func (m *MockTables) Listens() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Listens")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Listens indicates an expected call of Listens.
+func (mr *MockTablesMockRecorder) Listens() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Listens", reflect.TypeOf((*MockTables)(nil).Listens))
+}
+
+// RelatedArtists mocks base method.
+func (m *MockTables) RelatedArtists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedArtists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// RelatedArtists indicates an expected call of RelatedArtists.
+func (mr *MockTablesMockRecorder) RelatedArtists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedArtists", reflect.TypeOf((*MockTables)(nil).RelatedArtists))
+}
+
+// TrackNeighbors mocks base method.
+func (m *MockTables) TrackNeighbors() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackNeighbors")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// TrackNeighbors indicates an expected call of TrackNeighbors.
+func (mr *MockTablesMockRecorder) TrackNeighbors() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackNeighbors", reflect.TypeOf((*MockTables)(nil).TrackNeighbors))
+}
+
+// Tracks mocks base method.
+func (m *MockTables) Tracks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tracks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Tracks indicates an expected call of Tracks.
+func (mr *MockTablesMockRecorder) Tracks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tracks", reflect.TypeOf((*MockTables)(nil).Tracks))
+}
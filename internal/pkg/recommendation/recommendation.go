@@ -0,0 +1,150 @@
+package recommendation
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+//go:generate mockgen -source=recommendation.go -destination=mocks/mock.go
+
+// Usecase includes bussiness logics methods to work with recommendations
+type Usecase interface {
+	// RecomputeNeighbors rebuilds similarity of tracks, it's meant to be run by offline batch job
+	RecomputeNeighbors(ctx context.Context) error
+
+	// RecomputeRelatedArtists rebuilds related artists, it's meant to be run by offline batch job
+	RecomputeRelatedArtists(ctx context.Context) error
+
+	// GetBecauseYouLiked returns recommendations based on each of the latest liked tracks of user
+	GetBecauseYouLiked(ctx context.Context, userID uint32) ([]models.BecauseYouLiked, error)
+
+	// GetFeed returns personal tracks recommendations and their source:
+	// charts are returned if there isn't enough data about user
+	GetFeed(ctx context.Context, userID uint32) ([]models.Track, string, error)
+
+	// GetRadio returns page of endless radio queue around seed: pages wrap
+	// to the start of queue when it's over. Tracks recently played by user are skipped
+	// in returned page, so it may be shorter than limit and offset of the next page is returned too.
+	GetRadio(ctx context.Context, seed models.RadioSeed,
+		userID uint32, limit, offset uint32) ([]models.Track, uint32, error)
+}
+
+// Repository includes DBMS-relatable methods to work with recommendations
+type Repository interface {
+	// RecomputeNeighbors replaces stored neighbors of tracks by the most similar tracks
+	// according to likes and listens co-occurrence
+	RecomputeNeighbors(ctx context.Context) error
+
+	// RecomputeRelatedArtists replaces stored related artists by the most similar artists
+	// according to shared listeners, shared likes and collaborations on tracks
+	RecomputeRelatedArtists(ctx context.Context) error
+
+	// GetNeighbors returns the most similar tracks to given one excluding liked by user
+	GetNeighbors(ctx context.Context, trackID, userID uint32, limit uint32) ([]models.Track, error)
+
+	// GetLastLiked returns the latest liked tracks of user which have neighbors
+	GetLastLiked(ctx context.Context, userID uint32, limit uint32) ([]models.Track, error)
+
+	// GetForUser returns tracks most similar to liked and listened by user in total,
+	// excluding these ones
+	GetForUser(ctx context.Context, userID uint32, limit uint32) ([]models.Track, error)
+
+	// GetCharts returns the most listened tracks since given time
+	GetCharts(ctx context.Context, since time.Time, limit uint32) ([]models.Track, error)
+
+	// GetRadio returns page of radio queue around seed: tracks with similar listen patterns,
+	// tracks of the same artists and of artists co-liked with them, then the rest by popularity.
+	// Tracks recently played by user are skipped within page, so positions in queue
+	// don't depend on listens. Total length of queue is returned too.
+	GetRadio(ctx context.Context, seed models.RadioSeed,
+		userID uint32, limit, offset uint32) ([]models.Track, uint32, error)
+}
+
+// Config includes tunable parameters of recommendations
+type Config struct {
+	// NeighborsPerTrack is amount of the most similar tracks stored for each track
+	NeighborsPerTrack uint32
+	// ListensWindow is period of listens taken into account
+	ListensWindow time.Duration
+	// InteractionsPerUser is amount of the latest interactions of each user taken into account
+	// in similarity of tracks: pairs of them are built, so it bounds cost of heavy users' history
+	InteractionsPerUser uint32
+	// Weights of user's interactions with track
+	Weights InteractionWeights
+	// Radio is how radio queue is built
+	Radio RadioConfig
+	// RelatedArtists is how related artists are computed
+	RelatedArtists RelatedArtistsConfig
+}
+
+// InteractionWeights are weights of user's interactions with track in similarity computation
+type InteractionWeights struct {
+	// Like is added if user liked track
+	Like float64
+	// Listens is multiplied by natural logarithm of amount of user's listens of track
+	Listens float64
+}
+
+// RadioConfig includes tunable parameters of radio queue
+type RadioConfig struct {
+	// Weights of sources of tracks in radio queue.
+	// Similar is multiplied by similarity of listen patterns, which is between 0 and 1;
+	// CoLikedArtist is multiplied by share of co-likes relatively to the most co-liked artist.
+	Similar       float64
+	SameArtist    float64
+	CoLikedArtist float64
+	// CoLikedArtists is amount of the most co-liked artists taken into account
+	CoLikedArtists uint32
+	// RecentListens is amount of the latest listens of user skipped in queue pages
+	RecentListens uint32
+}
+
+// RelatedArtistsConfig includes tunable parameters of related artists
+type RelatedArtistsConfig struct {
+	// Weights of similarity sources, each of them is multiplied by cosine
+	// of artists' sets: listeners, users who liked them and tracks they appear on together
+	Listeners      float64
+	Likes          float64
+	Collaborations float64
+	// PerArtist is amount of the most related artists stored for each artist
+	PerArtist uint32
+}
+
+func DefaultConfig() Config {
+	return Config{
+		NeighborsPerTrack:   50,
+		ListensWindow:       90 * 24 * time.Hour,
+		InteractionsPerUser: 500,
+		Weights: InteractionWeights{
+			Like:    1,
+			Listens: 0.5,
+		},
+		Radio: RadioConfig{
+			Similar:        1,
+			SameArtist:     0.5,
+			CoLikedArtist:  0.3,
+			CoLikedArtists: 20,
+			RecentListens:  100,
+		},
+		RelatedArtists: RelatedArtistsConfig{
+			Listeners:      1,
+			Likes:          1,
+			Collaborations: 0.5,
+			PerArtist:      20,
+		},
+	}
+}
+
+// Tables includes methods which return needed tables
+// to work with recommendations on repository layer
+type Tables interface {
+	Tracks() string
+	LikedTracks() string
+	Listens() string
+	ArtistsTracks() string
+	LikedArtists() string
+	TrackNeighbors() string
+	RelatedArtists() string
+}
@@ -0,0 +1,423 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation"
+
+	commonSQL "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/db"
+)
+
+// PostgreSQL implements recommendation.Repository
+type PostgreSQL struct {
+	db     *sqlx.DB
+	tables recommendation.Tables
+	cfg    recommendation.Config
+}
+
+func NewPostgreSQL(db *sqlx.DB, t recommendation.Tables, cfg recommendation.Config) *PostgreSQL {
+	return &PostgreSQL{
+		db:     db,
+		tables: t,
+		cfg:    cfg,
+	}
+}
+
+const trackColumns = "t.id, t.name, t.album_id, t.cover_src, t.record_src, t.listens, t.duration"
+
+// interactions returns CTE of weights of users' interactions with tracks:
+// likes and listens since $1 weighted by $2 and $3 accordingly, with time of the latest of them.
+// If userFilter isn't empty, only interactions of users satisfying it are selected.
+func (p *PostgreSQL) interactions(userFilter string) string {
+	listensFilter := "TRUE"
+	if userFilter != "" {
+		listensFilter = "l.user_id " + userFilter
+		userFilter = "WHERE user_id " + userFilter
+	}
+
+	return fmt.Sprintf(
+		`listened AS (
+			SELECT l.user_id, l.track_id, COUNT(*) AS listens, MAX(l.commited_at) AS listened_at
+			FROM %s l
+			WHERE l.user_id IS NOT NULL AND l.commited_at >= $1 AND %s
+			GROUP BY l.user_id, l.track_id
+		),
+		liked AS (
+			SELECT user_id, track_id, liked_at
+			FROM %s
+			%s
+		),
+		interactions AS (
+			SELECT COALESCE(lt.user_id, l.user_id) AS user_id,
+				COALESCE(lt.track_id, l.track_id) AS track_id,
+				CASE WHEN lt.user_id IS NULL THEN 0 ELSE $2::FLOAT8 END
+					+ $3::FLOAT8 * LN(1 + COALESCE(l.listens, 0)) AS weight,
+				GREATEST(lt.liked_at, l.listened_at) AS active_at
+			FROM liked lt
+				FULL OUTER JOIN listened l ON lt.user_id = l.user_id AND lt.track_id = l.track_id
+		)`,
+		p.tables.Listens(), listensFilter, p.tables.LikedTracks(), userFilter)
+}
+
+func (p *PostgreSQL) interactionsArgs() []interface{} {
+	return []interface{}{
+		time.Now().Add(-p.cfg.ListensWindow), p.cfg.Weights.Like, p.cfg.Weights.Listens,
+	}
+}
+
+func (p *PostgreSQL) RecomputeNeighbors(ctx context.Context) (repoErr error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	deleteQuery := fmt.Sprintf(
+		`DELETE FROM %s;`,
+		p.tables.TrackNeighbors())
+	if _, err := tx.ExecContext(ctx, deleteQuery); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	// Similarity of tracks is cosine of their vectors of users' interactions.
+	// Every interaction of user is paired with each other one, so only the latest are taken.
+	insertQuery := fmt.Sprintf(
+		`WITH %s,
+		latest AS (
+			SELECT user_id, track_id, weight
+			FROM (
+				SELECT user_id, track_id, weight,
+					ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY active_at DESC, track_id) AS position
+				FROM interactions
+			) i
+			WHERE position <= $5
+		),
+		norms AS (
+			SELECT track_id, SQRT(SUM(weight * weight)) AS norm
+			FROM latest
+			GROUP BY track_id
+		),
+		pairs AS (
+			SELECT a.track_id, b.track_id AS neighbor_id, SUM(a.weight * b.weight) AS dot
+			FROM latest a
+				INNER JOIN latest b ON a.user_id = b.user_id AND a.track_id <> b.track_id
+			GROUP BY a.track_id, b.track_id
+		),
+		scored AS (
+			SELECT p.track_id, p.neighbor_id, p.dot / (na.norm * nb.norm) AS score,
+				ROW_NUMBER() OVER (
+					PARTITION BY p.track_id
+					ORDER BY p.dot / (na.norm * nb.norm) DESC, p.neighbor_id
+				) AS position
+			FROM pairs p
+				INNER JOIN norms na ON na.track_id = p.track_id
+				INNER JOIN norms nb ON nb.track_id = p.neighbor_id
+			WHERE p.dot > 0
+		)
+		INSERT INTO %s (track_id, neighbor_id, score)
+		SELECT track_id, neighbor_id, score
+		FROM scored
+		WHERE position <= $4;`,
+		p.interactions(""), p.tables.TrackNeighbors())
+
+	args := append(p.interactionsArgs(), p.cfg.NeighborsPerTrack, p.cfg.InteractionsPerUser)
+	if _, err := tx.ExecContext(ctx, insertQuery, args...); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) RecomputeRelatedArtists(ctx context.Context) (repoErr error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	deleteQuery := fmt.Sprintf(
+		`DELETE FROM %s;`,
+		p.tables.RelatedArtists())
+	if _, err := tx.ExecContext(ctx, deleteQuery); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	// Each signal is set of items of artist: listeners, users who liked artist
+	// and tracks (artists appearing on the same track are collaborators).
+	// Similarity by signal is cosine of artists' sets, score is weighted sum of them.
+	insertQuery := fmt.Sprintf(
+		`WITH sets AS (
+			SELECT DISTINCT 'listeners' AS signal, at.artist_id, l.user_id AS item
+			FROM %s l
+				INNER JOIN %s at ON at.track_id = l.track_id
+			WHERE l.user_id IS NOT NULL AND l.commited_at >= $1
+			UNION ALL
+			SELECT 'likes', artist_id, user_id
+			FROM %s
+			UNION ALL
+			SELECT 'collaborations', artist_id, track_id
+			FROM %s
+		),
+		weights (signal, weight) AS (
+			VALUES ('listeners', $2::FLOAT8), ('likes', $3::FLOAT8), ('collaborations', $4::FLOAT8)
+		),
+		sizes AS (
+			SELECT signal, artist_id, COUNT(*) AS size
+			FROM sets
+			GROUP BY signal, artist_id
+		),
+		shared AS (
+			SELECT a.signal, a.artist_id, b.artist_id AS related_id, COUNT(*) AS amount
+			FROM sets a
+				INNER JOIN sets b ON a.signal = b.signal AND a.item = b.item AND a.artist_id <> b.artist_id
+			GROUP BY a.signal, a.artist_id, b.artist_id
+		),
+		scores AS (
+			SELECT s.artist_id, s.related_id,
+				SUM(w.weight * s.amount / SQRT(sa.size * sb.size)) AS score
+			FROM shared s
+				INNER JOIN weights w ON w.signal = s.signal
+				INNER JOIN sizes sa ON sa.signal = s.signal AND sa.artist_id = s.artist_id
+				INNER JOIN sizes sb ON sb.signal = s.signal AND sb.artist_id = s.related_id
+			GROUP BY s.artist_id, s.related_id
+		),
+		ranked AS (
+			SELECT artist_id, related_id, score,
+				ROW_NUMBER() OVER (PARTITION BY artist_id ORDER BY score DESC, related_id) AS position
+			FROM scores
+			WHERE score > 0
+		)
+		INSERT INTO %s (artist_id, related_id, score)
+		SELECT artist_id, related_id, score
+		FROM ranked
+		WHERE position <= $5;`,
+		p.tables.Listens(), p.tables.ArtistsTracks(), p.tables.LikedArtists(),
+		p.tables.ArtistsTracks(), p.tables.RelatedArtists())
+
+	cfg := p.cfg.RelatedArtists
+	args := []interface{}{
+		time.Now().Add(-p.cfg.ListensWindow), cfg.Listeners, cfg.Likes, cfg.Collaborations, cfg.PerArtist,
+	}
+	if _, err := tx.ExecContext(ctx, insertQuery, args...); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) GetNeighbors(ctx context.Context,
+	trackID, userID uint32, limit uint32) ([]models.Track, error) {
+
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s n
+			INNER JOIN %s t ON t.id = n.neighbor_id
+		WHERE n.track_id = $1 AND NOT EXISTS (
+			SELECT track_id
+			FROM %s
+			WHERE user_id = $2 AND track_id = n.neighbor_id
+		)
+		ORDER BY n.score DESC, t.id
+		LIMIT $3;`,
+		trackColumns, p.tables.TrackNeighbors(), p.tables.Tracks(), p.tables.LikedTracks())
+
+	tracks := []models.Track{}
+	if err := p.db.SelectContext(ctx, &tracks, query, trackID, userID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return tracks, nil
+}
+
+func (p *PostgreSQL) GetLastLiked(ctx context.Context, userID uint32, limit uint32) ([]models.Track, error) {
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s t
+			INNER JOIN %s lt ON t.id = lt.track_id
+		WHERE lt.user_id = $1 AND EXISTS (
+			SELECT track_id
+			FROM %s
+			WHERE track_id = t.id
+		)
+		ORDER BY lt.liked_at DESC, t.id
+		LIMIT $2;`,
+		trackColumns, p.tables.Tracks(), p.tables.LikedTracks(), p.tables.TrackNeighbors())
+
+	tracks := []models.Track{}
+	if err := p.db.SelectContext(ctx, &tracks, query, userID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return tracks, nil
+}
+
+func (p *PostgreSQL) GetForUser(ctx context.Context, userID uint32, limit uint32) ([]models.Track, error) {
+	query := fmt.Sprintf(
+		`WITH %s,
+		candidates AS (
+			SELECT n.neighbor_id AS track_id, SUM(i.weight * n.score) AS score
+			FROM interactions i
+				INNER JOIN %s n ON n.track_id = i.track_id
+			WHERE NOT EXISTS (
+				SELECT track_id
+				FROM interactions
+				WHERE track_id = n.neighbor_id
+			)
+			GROUP BY n.neighbor_id
+		)
+		SELECT %s
+		FROM candidates c
+			INNER JOIN %s t ON t.id = c.track_id
+		ORDER BY c.score DESC, t.id
+		LIMIT $5;`,
+		p.interactions("= $4"), p.tables.TrackNeighbors(), trackColumns, p.tables.Tracks())
+
+	args := append(p.interactionsArgs(), userID, limit)
+
+	tracks := []models.Track{}
+	if err := p.db.SelectContext(ctx, &tracks, query, args...); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return tracks, nil
+}
+
+func (p *PostgreSQL) GetCharts(ctx context.Context, since time.Time, limit uint32) ([]models.Track, error) {
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s t
+			LEFT JOIN (
+				SELECT track_id, COUNT(*) AS listens
+				FROM %s
+				WHERE commited_at >= $1
+				GROUP BY track_id
+			) l ON t.id = l.track_id
+		ORDER BY COALESCE(l.listens, 0) DESC, t.listens DESC, t.id
+		LIMIT $2;`,
+		trackColumns, p.tables.Tracks(), p.tables.Listens())
+
+	tracks := []models.Track{}
+	if err := p.db.SelectContext(ctx, &tracks, query, since, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return tracks, nil
+}
+
+// radioCandidates returns CTE of tracks of radio queue around seed with ID in $1
+// with their scores. Queue doesn't depend on user, so its positions don't shift between pages.
+func (p *PostgreSQL) radioCandidates(seed models.RadioSeed) string {
+	seedTracks := fmt.Sprintf("SELECT track_id FROM %s WHERE artist_id = $1", p.tables.ArtistsTracks())
+	seedArtists := "SELECT $1::INT AS artist_id"
+	excludeSeed := "TRUE"
+	if seed.Type == models.RadioSeedTrack {
+		seedTracks = "SELECT $1::INT AS track_id"
+		seedArtists = fmt.Sprintf("SELECT artist_id FROM %s WHERE track_id = $1", p.tables.ArtistsTracks())
+		excludeSeed = "t.id <> $1"
+	}
+
+	return fmt.Sprintf(
+		`seed_tracks AS (
+			%s
+		),
+		seed_artists AS (
+			%s
+		),
+		similar AS (
+			SELECT n.neighbor_id AS track_id, SUM(n.score) AS score
+			FROM %s n
+			WHERE n.track_id IN (SELECT track_id FROM seed_tracks)
+			GROUP BY n.neighbor_id
+		),
+		co_liked AS (
+			SELECT la.artist_id, COUNT(*)::FLOAT8 / MAX(COUNT(*)) OVER () AS score
+			FROM %[4]s sl
+				INNER JOIN %[4]s la ON la.user_id = sl.user_id
+			WHERE sl.artist_id IN (SELECT artist_id FROM seed_artists)
+				AND la.artist_id NOT IN (SELECT artist_id FROM seed_artists)
+			GROUP BY la.artist_id
+			ORDER BY score DESC, la.artist_id
+			LIMIT $5
+		),
+		artists_scores AS (
+			SELECT at.track_id, MAX(
+				CASE WHEN cl.artist_id IS NULL THEN $3::FLOAT8 ELSE $4::FLOAT8 * cl.score END
+			) AS score
+			FROM %[5]s at
+				LEFT JOIN co_liked cl ON cl.artist_id = at.artist_id
+			WHERE at.artist_id IN (SELECT artist_id FROM seed_artists) OR cl.artist_id IS NOT NULL
+			GROUP BY at.track_id
+		),
+		candidates AS (
+			SELECT t.id, $2::FLOAT8 * COALESCE(s.score, 0) + COALESCE(a.score, 0) AS score
+			FROM %[6]s t
+				LEFT JOIN similar s ON s.track_id = t.id
+				LEFT JOIN artists_scores a ON a.track_id = t.id
+			WHERE %[7]s
+		)`,
+		seedTracks, seedArtists, p.tables.TrackNeighbors(), p.tables.LikedArtists(),
+		p.tables.ArtistsTracks(), p.tables.Tracks(), excludeSeed)
+}
+
+func (p *PostgreSQL) radioArgs(seed models.RadioSeed) []interface{} {
+	return []interface{}{
+		seed.ID,
+		p.cfg.Radio.Similar, p.cfg.Radio.SameArtist, p.cfg.Radio.CoLikedArtist,
+		p.cfg.Radio.CoLikedArtists,
+	}
+}
+
+func (p *PostgreSQL) GetRadio(ctx context.Context, seed models.RadioSeed,
+	userID uint32, limit, offset uint32) ([]models.Track, uint32, error) {
+
+	countQuery := fmt.Sprintf(
+		`WITH %s
+		SELECT COUNT(*)
+		FROM candidates;`,
+		p.radioCandidates(seed))
+
+	var total uint32
+	if err := p.db.GetContext(ctx, &total, countQuery, p.radioArgs(seed)...); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	// Tracks with equal scores, e.g. the rest of catalog, are ordered by popularity.
+	// Recently played tracks are skipped only after page is cut, otherwise
+	// every listen would shift the rest of queue and next pages would miss tracks.
+	query := fmt.Sprintf(
+		`WITH %s,
+		page AS (
+			SELECT c.id, ROW_NUMBER() OVER (ORDER BY c.score DESC, t.listens DESC, t.id) AS position
+			FROM candidates c
+				INNER JOIN %[2]s t ON t.id = c.id
+			ORDER BY position
+			LIMIT $8 OFFSET $9
+		),
+		recent AS (
+			SELECT track_id
+			FROM %[3]s
+			WHERE user_id = $6
+			ORDER BY commited_at DESC
+			LIMIT $7
+		)
+		SELECT %[4]s
+		FROM page pg
+			INNER JOIN %[2]s t ON t.id = pg.id
+		WHERE t.id NOT IN (SELECT track_id FROM recent)
+		ORDER BY pg.position;`,
+		p.radioCandidates(seed), p.tables.Tracks(), p.tables.Listens(), trackColumns)
+
+	args := append(p.radioArgs(seed), userID, p.cfg.Radio.RecentListens, limit, offset)
+
+	tracks := []models.Track{}
+	if err := p.db.SelectContext(ctx, &tracks, query, args...); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return tracks, total, nil
+}
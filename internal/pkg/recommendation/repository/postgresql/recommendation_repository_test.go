@@ -0,0 +1,656 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation"
+
+	recommendationMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation/mocks"
+)
+
+var ctx = context.Background()
+
+const tracksTable = "Tracks"
+const likedTracksTable = "Liked_tracks"
+const listensTable = "Listens"
+const trackNeighborsTable = "Track_neighbors"
+const artistsTracksTable = "Artists_Tracks"
+const likedArtistsTable = "Liked_artists"
+const relatedArtistsTable = "Related_artists"
+
+var errPqInternal = errors.New("postgres is dead")
+
+var defaultAlbumID uint32 = 1
+var defaultTracks = []models.Track{
+	{
+		ID:        1,
+		Name:      "Lagg Out",
+		AlbumID:   &defaultAlbumID,
+		CoverSrc:  "/tracks/covers/laggout.png",
+		RecordSrc: "/tracks/records/laggout.wav",
+		Listens:   9999999,
+		Duration:  180,
+	},
+	{
+		ID:        2,
+		Name:      "Накануне",
+		AlbumID:   &defaultAlbumID,
+		CoverSrc:  "/tracks/covers/nakanune.png",
+		RecordSrc: "/tracks/records/nakanune.wav",
+		Listens:   10000000,
+		Duration:  180,
+	},
+}
+
+var trackRowsColumns = []string{"id", "name", "album_id", "cover_src", "record_src", "listens", "duration"}
+
+func trackRows(sqlxMock sqlmock.Sqlmock, tracks []models.Track) *sqlmock.Rows {
+	rows := sqlxMock.NewRows(trackRowsColumns)
+	for _, t := range tracks {
+		rows.AddRow(t.ID, t.Name, t.AlbumID, t.CoverSrc, t.RecordSrc, t.Listens, t.Duration)
+	}
+
+	return rows
+}
+
+func TestRecommendationRepositoryPostgreSQL_RecomputeNeighbors(t *testing.T) {
+	// Init
+	type mockBehavior func(cfg recommendation.Config)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := recommendationMocks.NewMockTables(c)
+
+	cfg := recommendation.DefaultConfig()
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, cfg)
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(cfg recommendation.Config) {
+				tablesMock.EXPECT().TrackNeighbors().Return(trackNeighborsTable).Times(2)
+				tablesMock.EXPECT().Listens().Return(listensTable)
+				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectExec("DELETE FROM " + trackNeighborsTable).
+					WillReturnResult(driver.RowsAffected(100))
+
+				sqlxMock.ExpectExec("WITH (.+)"+
+					"latest AS (.+)PARTITION BY user_id ORDER BY active_at DESC(.+)WHERE position <= \\$5"+
+					"(.+)FROM latest a(.+)INNER JOIN latest b ON a.user_id = b.user_id"+
+					"(.+)INSERT INTO "+trackNeighborsTable).
+					WithArgs(sqlmock.AnyArg(), cfg.Weights.Like, cfg.Weights.Listens,
+						cfg.NeighborsPerTrack, cfg.InteractionsPerUser).
+					WillReturnResult(driver.RowsAffected(100))
+
+				sqlxMock.ExpectCommit()
+			},
+		},
+		{
+			name: "Insert Issue",
+			mockBehavior: func(cfg recommendation.Config) {
+				tablesMock.EXPECT().TrackNeighbors().Return(trackNeighborsTable).Times(2)
+				tablesMock.EXPECT().Listens().Return(listensTable)
+				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectExec("DELETE FROM " + trackNeighborsTable).
+					WillReturnResult(driver.RowsAffected(100))
+
+				sqlxMock.ExpectExec("INSERT INTO "+trackNeighborsTable).
+					WithArgs(sqlmock.AnyArg(), cfg.Weights.Like, cfg.Weights.Listens,
+						cfg.NeighborsPerTrack, cfg.InteractionsPerUser).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+		{
+			name: "Delete Issue",
+			mockBehavior: func(cfg recommendation.Config) {
+				tablesMock.EXPECT().TrackNeighbors().Return(trackNeighborsTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectExec("DELETE FROM " + trackNeighborsTable).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(cfg)
+
+			err := repo.RecomputeNeighbors(ctx)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecommendationRepositoryPostgreSQL_RecomputeRelatedArtists(t *testing.T) {
+	// Init
+	type mockBehavior func(cfg recommendation.RelatedArtistsConfig)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := recommendationMocks.NewMockTables(c)
+
+	cfg := recommendation.DefaultConfig()
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, cfg)
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(cfg recommendation.RelatedArtistsConfig) {
+				tablesMock.EXPECT().RelatedArtists().Return(relatedArtistsTable).Times(2)
+				tablesMock.EXPECT().Listens().Return(listensTable)
+				tablesMock.EXPECT().ArtistsTracks().Return(artistsTracksTable).Times(2)
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectExec("DELETE FROM " + relatedArtistsTable).
+					WillReturnResult(driver.RowsAffected(100))
+
+				sqlxMock.ExpectExec("WITH sets AS (.+)FROM "+listensTable+
+					"(.+)FROM "+likedArtistsTable+"(.+)FROM "+artistsTracksTable+
+					"(.+)a.artist_id <> b.artist_id(.+)WHERE position <= \\$5").
+					WithArgs(sqlmock.AnyArg(), cfg.Listeners, cfg.Likes, cfg.Collaborations, cfg.PerArtist).
+					WillReturnResult(driver.RowsAffected(100))
+
+				sqlxMock.ExpectCommit()
+			},
+		},
+		{
+			name: "Insert Issue",
+			mockBehavior: func(cfg recommendation.RelatedArtistsConfig) {
+				tablesMock.EXPECT().RelatedArtists().Return(relatedArtistsTable).Times(2)
+				tablesMock.EXPECT().Listens().Return(listensTable)
+				tablesMock.EXPECT().ArtistsTracks().Return(artistsTracksTable).Times(2)
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectExec("DELETE FROM " + relatedArtistsTable).
+					WillReturnResult(driver.RowsAffected(100))
+
+				sqlxMock.ExpectExec("INSERT INTO "+relatedArtistsTable).
+					WithArgs(sqlmock.AnyArg(), cfg.Listeners, cfg.Likes, cfg.Collaborations, cfg.PerArtist).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+		{
+			name: "Delete Issue",
+			mockBehavior: func(cfg recommendation.RelatedArtistsConfig) {
+				tablesMock.EXPECT().RelatedArtists().Return(relatedArtistsTable)
+
+				sqlxMock.ExpectBegin()
+
+				sqlxMock.ExpectExec("DELETE FROM " + relatedArtistsTable).
+					WillReturnError(errPqInternal)
+
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(cfg.RelatedArtists)
+
+			err := repo.RecomputeRelatedArtists(ctx)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecommendationRepositoryPostgreSQL_GetNeighbors(t *testing.T) {
+	// Init
+	type mockBehavior func(trackID, userID, limit uint32, tracks []models.Track)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := recommendationMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, recommendation.DefaultConfig())
+
+	// Test filling
+	const trackID uint32 = 3
+	const userID uint32 = 1
+	const limit uint32 = 10
+
+	testTable := []struct {
+		name           string
+		mockBehavior   mockBehavior
+		expectedTracks []models.Track
+		expectError    bool
+		expectedError  error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(trackID, userID, limit uint32, tracks []models.Track) {
+				tablesMock.EXPECT().TrackNeighbors().Return(trackNeighborsTable)
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+trackNeighborsTable+" n"+
+					"(.+)NOT EXISTS(.+)"+likedTracksTable+"(.+)ORDER BY n.score DESC").
+					WithArgs(trackID, userID, limit).
+					WillReturnRows(trackRows(sqlxMock, tracks))
+			},
+			expectedTracks: defaultTracks,
+		},
+		{
+			name: "No Neighbors",
+			mockBehavior: func(trackID, userID, limit uint32, tracks []models.Track) {
+				tablesMock.EXPECT().TrackNeighbors().Return(trackNeighborsTable)
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+trackNeighborsTable).
+					WithArgs(trackID, userID, limit).
+					WillReturnRows(trackRows(sqlxMock, tracks))
+			},
+			expectedTracks: []models.Track{},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(trackID, userID, limit uint32, tracks []models.Track) {
+				tablesMock.EXPECT().TrackNeighbors().Return(trackNeighborsTable)
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+trackNeighborsTable).
+					WithArgs(trackID, userID, limit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(trackID, userID, limit, tc.expectedTracks)
+
+			tracks, err := repo.GetNeighbors(ctx, trackID, userID, limit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTracks, tracks)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecommendationRepositoryPostgreSQL_GetForUser(t *testing.T) {
+	// Init
+	type mockBehavior func(userID, limit uint32, tracks []models.Track)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := recommendationMocks.NewMockTables(c)
+
+	cfg := recommendation.DefaultConfig()
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, cfg)
+
+	// Test filling
+	const userID uint32 = 1
+	const limit uint32 = 10
+
+	expectTables := func() {
+		tablesMock.EXPECT().Listens().Return(listensTable)
+		tablesMock.EXPECT().LikedTracks().Return(likedTracksTable)
+		tablesMock.EXPECT().TrackNeighbors().Return(trackNeighborsTable)
+		tablesMock.EXPECT().Tracks().Return(tracksTable)
+	}
+
+	testTable := []struct {
+		name           string
+		mockBehavior   mockBehavior
+		expectedTracks []models.Track
+		expectError    bool
+		expectedError  error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID, limit uint32, tracks []models.Track) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("WITH (.+)l.user_id = \\$4(.+)WHERE user_id = \\$4"+
+					"(.+)candidates AS (.+)INNER JOIN "+trackNeighborsTable+" n"+
+					"(.+)ORDER BY c.score DESC(.+)LIMIT \\$5").
+					WithArgs(sqlmock.AnyArg(), cfg.Weights.Like, cfg.Weights.Listens, userID, limit).
+					WillReturnRows(trackRows(sqlxMock, tracks))
+			},
+			expectedTracks: defaultTracks,
+		},
+		{
+			name: "Not Enough Data",
+			mockBehavior: func(userID, limit uint32, tracks []models.Track) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("WITH (.+)candidates AS").
+					WithArgs(sqlmock.AnyArg(), cfg.Weights.Like, cfg.Weights.Listens, userID, limit).
+					WillReturnRows(trackRows(sqlxMock, tracks))
+			},
+			expectedTracks: []models.Track{},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID, limit uint32, tracks []models.Track) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("WITH (.+)candidates AS").
+					WithArgs(sqlmock.AnyArg(), cfg.Weights.Like, cfg.Weights.Listens, userID, limit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(userID, limit, tc.expectedTracks)
+
+			tracks, err := repo.GetForUser(ctx, userID, limit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTracks, tracks)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecommendationRepositoryPostgreSQL_GetCharts(t *testing.T) {
+	// Init
+	type mockBehavior func(since time.Time, limit uint32, tracks []models.Track)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := recommendationMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, recommendation.DefaultConfig())
+
+	// Test filling
+	since := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+	const limit uint32 = 10
+
+	testTable := []struct {
+		name           string
+		mockBehavior   mockBehavior
+		expectedTracks []models.Track
+		expectError    bool
+		expectedError  error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(since time.Time, limit uint32, tracks []models.Track) {
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+				tablesMock.EXPECT().Listens().Return(listensTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+tracksTable+" t(.+)LEFT JOIN(.+)FROM "+listensTable+
+					"(.+)ORDER BY COALESCE\\(l.listens, 0\\) DESC, t.listens DESC").
+					WithArgs(since, limit).
+					WillReturnRows(trackRows(sqlxMock, tracks))
+			},
+			expectedTracks: defaultTracks,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(since time.Time, limit uint32, tracks []models.Track) {
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+				tablesMock.EXPECT().Listens().Return(listensTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+tracksTable).
+					WithArgs(since, limit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(since, limit, tc.expectedTracks)
+
+			tracks, err := repo.GetCharts(ctx, since, limit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTracks, tracks)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecommendationRepositoryPostgreSQL_GetRadio(t *testing.T) {
+	// Init
+	type mockBehavior func(seed models.RadioSeed, userID, limit, offset uint32, tracks []models.Track)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := recommendationMocks.NewMockTables(c)
+
+	cfg := recommendation.DefaultConfig()
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, cfg)
+
+	// Test filling
+	const userID uint32 = 1
+	const limit uint32 = 3
+	const offset uint32 = 6
+	const total uint32 = 50
+
+	trackSeed := models.RadioSeed{Type: models.RadioSeedTrack, ID: 10}
+	artistSeed := models.RadioSeed{Type: models.RadioSeedArtist, ID: 20}
+
+	// Artists of seed track are found in Artists_Tracks too
+	expectTables := func(seed models.RadioSeed) {
+		artistsTracksTimes := 4
+		if seed.Type == models.RadioSeedTrack {
+			artistsTracksTimes = 6
+		}
+		tablesMock.EXPECT().ArtistsTracks().Return(artistsTracksTable).Times(artistsTracksTimes)
+		tablesMock.EXPECT().TrackNeighbors().Return(trackNeighborsTable).Times(2)
+		tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable).Times(2)
+		tablesMock.EXPECT().Tracks().Return(tracksTable).Times(3)
+		tablesMock.EXPECT().Listens().Return(listensTable)
+	}
+	radioArgs := func(seed models.RadioSeed) []driver.Value {
+		return []driver.Value{
+			seed.ID, cfg.Radio.Similar, cfg.Radio.SameArtist, cfg.Radio.CoLikedArtist, cfg.Radio.CoLikedArtists,
+		}
+	}
+
+	testTable := []struct {
+		name           string
+		seed           models.RadioSeed
+		mockBehavior   mockBehavior
+		expectedTracks []models.Track
+		expectedTotal  uint32
+		expectError    bool
+		expectedError  error
+	}{
+		{
+			name: "Track Seed (recently played are skipped within page)",
+			seed: trackSeed,
+			mockBehavior: func(seed models.RadioSeed, userID, limit, offset uint32, tracks []models.Track) {
+				expectTables(seed)
+
+				countRow := sqlxMock.NewRows([]string{"count"}).AddRow(total)
+				sqlxMock.ExpectQuery("WITH seed_tracks AS (.+)SELECT \\$1::INT AS track_id" +
+					"(.+)WHERE t.id <> \\$1(.+)SELECT COUNT(.+)FROM candidates").
+					WithArgs(radioArgs(seed)...).
+					WillReturnRows(countRow)
+
+				sqlxMock.ExpectQuery("WITH seed_tracks AS (.+)page AS (.+)LIMIT \\$8 OFFSET \\$9" +
+					"(.+)recent AS (.+)WHERE t.id NOT IN \\(SELECT track_id FROM recent\\)" +
+					"(.+)ORDER BY pg.position").
+					WithArgs(append(radioArgs(seed), userID, cfg.Radio.RecentListens, limit, offset)...).
+					WillReturnRows(trackRows(sqlxMock, tracks))
+			},
+			expectedTracks: defaultTracks,
+			expectedTotal:  total,
+		},
+		{
+			name: "Artist Seed",
+			seed: artistSeed,
+			mockBehavior: func(seed models.RadioSeed, userID, limit, offset uint32, tracks []models.Track) {
+				expectTables(seed)
+
+				countRow := sqlxMock.NewRows([]string{"count"}).AddRow(total)
+				sqlxMock.ExpectQuery("WITH seed_tracks AS (.+)FROM " + artistsTracksTable + " WHERE artist_id = \\$1" +
+					"(.+)SELECT \\$1::INT AS artist_id(.+)SELECT COUNT").
+					WithArgs(radioArgs(seed)...).
+					WillReturnRows(countRow)
+
+				sqlxMock.ExpectQuery("WITH seed_tracks AS (.+)page AS").
+					WithArgs(append(radioArgs(seed), userID, cfg.Radio.RecentListens, limit, offset)...).
+					WillReturnRows(trackRows(sqlxMock, tracks))
+			},
+			expectedTracks: defaultTracks[:1],
+			expectedTotal:  total,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			seed: trackSeed,
+			mockBehavior: func(seed models.RadioSeed, userID, limit, offset uint32, tracks []models.Track) {
+				tablesMock.EXPECT().ArtistsTracks().Return(artistsTracksTable).Times(3)
+				tablesMock.EXPECT().TrackNeighbors().Return(trackNeighborsTable)
+				tablesMock.EXPECT().LikedArtists().Return(likedArtistsTable)
+				tablesMock.EXPECT().Tracks().Return(tracksTable)
+
+				sqlxMock.ExpectQuery("SELECT COUNT").
+					WithArgs(radioArgs(seed)...).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tc.seed, userID, limit, offset, tc.expectedTracks)
+
+			tracks, total, err := repo.GetRadio(ctx, tc.seed, userID, limit, offset)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTracks, tracks)
+				assert.Equal(t, tc.expectedTotal, total)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
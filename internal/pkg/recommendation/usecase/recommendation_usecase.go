@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track"
+)
+
+const (
+	becauseYouLikedGroupsLimit uint32 = 5
+	becauseYouLikedTracksLimit uint32 = 10
+
+	feedTracksAmountLimit uint32 = 50
+
+	chartsPeriod = 7 * 24 * time.Hour
+)
+
+// Usecase implements recommendation.Usecase
+type Usecase struct {
+	repo       recommendation.Repository
+	trackRepo  track.Repository
+	artistRepo artist.Repository
+}
+
+func NewUsecase(r recommendation.Repository, tr track.Repository, arr artist.Repository) *Usecase {
+	return &Usecase{
+		repo:       r,
+		trackRepo:  tr,
+		artistRepo: arr,
+	}
+}
+
+func (u *Usecase) RecomputeNeighbors(ctx context.Context) error {
+	if err := u.repo.RecomputeNeighbors(ctx); err != nil {
+		return fmt.Errorf("(usecase) can't recompute neighbors of tracks: %w", err)
+	}
+
+	return nil
+}
+
+func (u *Usecase) RecomputeRelatedArtists(ctx context.Context) error {
+	if err := u.repo.RecomputeRelatedArtists(ctx); err != nil {
+		return fmt.Errorf("(usecase) can't recompute related artists: %w", err)
+	}
+
+	return nil
+}
+
+func (u *Usecase) GetBecauseYouLiked(ctx context.Context, userID uint32) ([]models.BecauseYouLiked, error) {
+	liked, err := u.repo.GetLastLiked(ctx, userID, becauseYouLikedGroupsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get liked tracks from repository: %w", err)
+	}
+
+	groups := make([]models.BecauseYouLiked, 0, len(liked))
+	for _, track := range liked {
+		neighbors, err := u.repo.GetNeighbors(ctx, track.ID, userID, becauseYouLikedTracksLimit)
+		if err != nil {
+			return nil, fmt.Errorf("(usecase) can't get neighbors of track #%d: %w", track.ID, err)
+		}
+		// All neighbors can be already liked
+		if len(neighbors) == 0 {
+			continue
+		}
+
+		groups = append(groups, models.BecauseYouLiked{
+			Track:           track,
+			Recommendations: neighbors,
+		})
+	}
+
+	return groups, nil
+}
+
+func (u *Usecase) GetFeed(ctx context.Context, userID uint32) ([]models.Track, string, error) {
+	if userID != 0 {
+		tracks, err := u.repo.GetForUser(ctx, userID, feedTracksAmountLimit)
+		if err != nil {
+			return nil, "", fmt.Errorf("(usecase) can't get recommended tracks from repository: %w", err)
+		}
+		if len(tracks) > 0 {
+			return tracks, models.RecommendationSourcePersonal, nil
+		}
+	}
+
+	tracks, err := u.repo.GetCharts(ctx, time.Now().Add(-chartsPeriod), feedTracksAmountLimit)
+	if err != nil {
+		return nil, "", fmt.Errorf("(usecase) can't get charts from repository: %w", err)
+	}
+
+	return tracks, models.RecommendationSourceCharts, nil
+}
+
+func (u *Usecase) GetRadio(ctx context.Context,
+	seed models.RadioSeed, userID uint32, limit, offset uint32) ([]models.Track, uint32, error) {
+
+	if limit == 0 {
+		limit = models.RadioDefaultLimit
+	}
+	if limit > models.RadioMaxLimit {
+		return nil, 0, fmt.Errorf("(usecase) %w", &models.InvalidRadioQueryError{Reason: "limit is too big"})
+	}
+
+	switch seed.Type {
+	case models.RadioSeedTrack:
+		if err := u.trackRepo.Check(ctx, seed.ID); err != nil {
+			return nil, 0, fmt.Errorf("(usecase) can't find track with id #%d: %w", seed.ID, err)
+		}
+	case models.RadioSeedArtist:
+		if err := u.artistRepo.Check(ctx, seed.ID); err != nil {
+			return nil, 0, fmt.Errorf("(usecase) can't find artist with id #%d: %w", seed.ID, err)
+		}
+	default:
+		return nil, 0, fmt.Errorf("(usecase) %w", &models.InvalidRadioQueryError{Reason: "unknown seed type"})
+	}
+
+	tracks, total, err := u.repo.GetRadio(ctx, seed, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("(usecase) can't get radio queue from repository: %w", err)
+	}
+	if total == 0 {
+		return tracks, 0, nil
+	}
+
+	// Queue is endless: pages beyond its end start it over
+	if offset >= total {
+		offset %= total
+		tracks, _, err = u.repo.GetRadio(ctx, seed, userID, limit, offset)
+		if err != nil {
+			return nil, 0, fmt.Errorf("(usecase) can't get radio queue from repository: %w", err)
+		}
+	}
+	// Page length can't tell where queue ends: recently played tracks are skipped in it
+	if end := offset + limit; end > total && offset > 0 {
+		missing := end - total
+		if missing > offset {
+			missing = offset
+		}
+		head, _, err := u.repo.GetRadio(ctx, seed, userID, missing, 0)
+		if err != nil {
+			return nil, 0, fmt.Errorf("(usecase) can't get radio queue from repository: %w", err)
+		}
+		tracks = append(tracks, head...)
+	}
+
+	// Page covers limit positions of queue, but not more than whole queue
+	next := offset + limit
+	if next > offset+total {
+		next = offset + total
+	}
+
+	return tracks, next % total, nil
+}
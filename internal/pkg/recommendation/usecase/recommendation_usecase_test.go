@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	artistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/mocks"
+	recommendationMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/recommendation/mocks"
+	trackMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/mocks"
+)
+
+var ctx = context.Background()
+
+func TestRecommendationUsecase_GetFeed(t *testing.T) {
+	type mockBehavior func(r *recommendationMocks.MockRepository, userID uint32)
+
+	c := gomock.NewController(t)
+
+	r := recommendationMocks.NewMockRepository(c)
+
+	u := NewUsecase(r, trackMocks.NewMockRepository(c), artistMocks.NewMockRepository(c))
+
+	const correctUserID uint32 = 1
+
+	personal := []models.Track{{ID: 1, Name: "Где нас нет"}}
+	charts := []models.Track{{ID: 2, Name: "Город под подошвой"}}
+
+	testTable := []struct {
+		name             string
+		userID           uint32
+		mockBehavior     mockBehavior
+		expectedTracks   []models.Track
+		expectedSource   string
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:   "Personal",
+			userID: correctUserID,
+			mockBehavior: func(r *recommendationMocks.MockRepository, userID uint32) {
+				r.EXPECT().GetForUser(ctx, userID, feedTracksAmountLimit).Return(personal, nil)
+			},
+			expectedTracks: personal,
+			expectedSource: models.RecommendationSourcePersonal,
+		},
+		{
+			name:   "Cold Start",
+			userID: correctUserID,
+			mockBehavior: func(r *recommendationMocks.MockRepository, userID uint32) {
+				r.EXPECT().GetForUser(ctx, userID, feedTracksAmountLimit).Return([]models.Track{}, nil)
+				r.EXPECT().GetCharts(ctx, gomock.Any(), feedTracksAmountLimit).Return(charts, nil)
+			},
+			expectedTracks: charts,
+			expectedSource: models.RecommendationSourceCharts,
+		},
+		{
+			name:   "Unauthorized",
+			userID: 0,
+			mockBehavior: func(r *recommendationMocks.MockRepository, userID uint32) {
+				r.EXPECT().GetCharts(ctx, gomock.Any(), feedTracksAmountLimit).Return(charts, nil)
+			},
+			expectedTracks: charts,
+			expectedSource: models.RecommendationSourceCharts,
+		},
+		{
+			name:   "Recommendations Issue",
+			userID: correctUserID,
+			mockBehavior: func(r *recommendationMocks.MockRepository, userID uint32) {
+				r.EXPECT().GetForUser(ctx, userID, feedTracksAmountLimit).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get recommended tracks",
+		},
+		{
+			name:   "Charts Issue",
+			userID: 0,
+			mockBehavior: func(r *recommendationMocks.MockRepository, userID uint32) {
+				r.EXPECT().GetCharts(ctx, gomock.Any(), feedTracksAmountLimit).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get charts",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(r, tc.userID)
+
+			tracks, source, err := u.GetFeed(ctx, tc.userID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTracks, tracks)
+				assert.Equal(t, tc.expectedSource, source)
+			}
+		})
+	}
+}
+
+func TestRecommendationUsecase_GetBecauseYouLiked(t *testing.T) {
+	type mockBehavior func(r *recommendationMocks.MockRepository, userID uint32)
+
+	c := gomock.NewController(t)
+
+	r := recommendationMocks.NewMockRepository(c)
+
+	u := NewUsecase(r, trackMocks.NewMockRepository(c), artistMocks.NewMockRepository(c))
+
+	const userID uint32 = 1
+
+	liked := []models.Track{{ID: 1, Name: "Где нас нет"}, {ID: 2, Name: "Город под подошвой"}}
+	neighbors := []models.Track{{ID: 3, Name: "Переплетено"}}
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectedGroups   []models.BecauseYouLiked
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(r *recommendationMocks.MockRepository, userID uint32) {
+				r.EXPECT().GetLastLiked(ctx, userID, becauseYouLikedGroupsLimit).Return(liked, nil)
+				r.EXPECT().GetNeighbors(ctx, liked[0].ID, userID, becauseYouLikedTracksLimit).Return(neighbors, nil)
+				// all neighbors are liked
+				r.EXPECT().GetNeighbors(ctx, liked[1].ID, userID, becauseYouLikedTracksLimit).Return([]models.Track{}, nil)
+			},
+			expectedGroups: []models.BecauseYouLiked{
+				{Track: liked[0], Recommendations: neighbors},
+			},
+		},
+		{
+			name: "Liked Issue",
+			mockBehavior: func(r *recommendationMocks.MockRepository, userID uint32) {
+				r.EXPECT().GetLastLiked(ctx, userID, becauseYouLikedGroupsLimit).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get liked tracks",
+		},
+		{
+			name: "Neighbors Issue",
+			mockBehavior: func(r *recommendationMocks.MockRepository, userID uint32) {
+				r.EXPECT().GetLastLiked(ctx, userID, becauseYouLikedGroupsLimit).Return(liked, nil)
+				r.EXPECT().GetNeighbors(ctx, liked[0].ID, userID, becauseYouLikedTracksLimit).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get neighbors",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(r, userID)
+
+			groups, err := u.GetBecauseYouLiked(ctx, userID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedGroups, groups)
+			}
+		})
+	}
+}
+
+func TestRecommendationUsecase_GetRadio(t *testing.T) {
+	type mockBehavior func(r *recommendationMocks.MockRepository, tr *trackMocks.MockRepository,
+		arr *artistMocks.MockRepository, seed models.RadioSeed)
+
+	c := gomock.NewController(t)
+
+	r := recommendationMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+	arr := artistMocks.NewMockRepository(c)
+
+	u := NewUsecase(r, tr, arr)
+
+	const userID uint32 = 1
+	const total uint32 = 5
+
+	trackSeed := models.RadioSeed{Type: models.RadioSeedTrack, ID: 1}
+	artistSeed := models.RadioSeed{Type: models.RadioSeedArtist, ID: 2}
+
+	queue := []models.Track{{ID: 10}, {ID: 11}, {ID: 12}, {ID: 13}, {ID: 14}}
+
+	testTable := []struct {
+		name             string
+		seed             models.RadioSeed
+		limit            uint32
+		offset           uint32
+		mockBehavior     mockBehavior
+		expectedTracks   []models.Track
+		expectedNext     uint32
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:   "Common",
+			seed:   trackSeed,
+			limit:  2,
+			offset: 1,
+			mockBehavior: func(r *recommendationMocks.MockRepository, tr *trackMocks.MockRepository,
+				arr *artistMocks.MockRepository, seed models.RadioSeed) {
+
+				tr.EXPECT().Check(ctx, seed.ID).Return(nil)
+				r.EXPECT().GetRadio(ctx, seed, userID, uint32(2), uint32(1)).Return(queue[1:3], total, nil)
+			},
+			expectedTracks: queue[1:3],
+			expectedNext:   3,
+		},
+		{
+			name:   "Recent Tracks Skipped In Page",
+			seed:   trackSeed,
+			limit:  2,
+			offset: 2,
+			mockBehavior: func(r *recommendationMocks.MockRepository, tr *trackMocks.MockRepository,
+				arr *artistMocks.MockRepository, seed models.RadioSeed) {
+
+				tr.EXPECT().Check(ctx, seed.ID).Return(nil)
+				r.EXPECT().GetRadio(ctx, seed, userID, uint32(2), uint32(2)).Return(queue[3:4], total, nil)
+			},
+			expectedTracks: queue[3:4],
+			expectedNext:   4,
+		},
+		{
+			name:   "End Of Queue",
+			seed:   artistSeed,
+			limit:  4,
+			offset: 3,
+			mockBehavior: func(r *recommendationMocks.MockRepository, tr *trackMocks.MockRepository,
+				arr *artistMocks.MockRepository, seed models.RadioSeed) {
+
+				arr.EXPECT().Check(ctx, seed.ID).Return(nil)
+				r.EXPECT().GetRadio(ctx, seed, userID, uint32(4), uint32(3)).Return(queue[3:], total, nil)
+				r.EXPECT().GetRadio(ctx, seed, userID, uint32(2), uint32(0)).Return(queue[:2], total, nil)
+			},
+			expectedTracks: append(append([]models.Track{}, queue[3:]...), queue[:2]...),
+			expectedNext:   2,
+		},
+		{
+			name:   "Beyond End Of Queue",
+			seed:   trackSeed,
+			limit:  2,
+			offset: 11,
+			mockBehavior: func(r *recommendationMocks.MockRepository, tr *trackMocks.MockRepository,
+				arr *artistMocks.MockRepository, seed models.RadioSeed) {
+
+				tr.EXPECT().Check(ctx, seed.ID).Return(nil)
+				r.EXPECT().GetRadio(ctx, seed, userID, uint32(2), uint32(11)).Return([]models.Track{}, total, nil)
+				r.EXPECT().GetRadio(ctx, seed, userID, uint32(2), uint32(1)).Return(queue[1:3], total, nil)
+			},
+			expectedTracks: queue[1:3],
+			expectedNext:   3,
+		},
+		{
+			name: "Default Limit",
+			seed: trackSeed,
+			mockBehavior: func(r *recommendationMocks.MockRepository, tr *trackMocks.MockRepository,
+				arr *artistMocks.MockRepository, seed models.RadioSeed) {
+
+				tr.EXPECT().Check(ctx, seed.ID).Return(nil)
+				r.EXPECT().GetRadio(ctx, seed, userID, models.RadioDefaultLimit, uint32(0)).Return(queue, total, nil)
+			},
+			expectedTracks: queue,
+			expectedNext:   0,
+		},
+		{
+			name:  "Too Big Limit",
+			seed:  trackSeed,
+			limit: models.RadioMaxLimit + 1,
+			mockBehavior: func(r *recommendationMocks.MockRepository, tr *trackMocks.MockRepository,
+				arr *artistMocks.MockRepository, seed models.RadioSeed) {
+			},
+			expectError:      true,
+			expectedErrorMsg: "limit is too big",
+		},
+		{
+			name:  "No Such Track",
+			seed:  trackSeed,
+			limit: 2,
+			mockBehavior: func(r *recommendationMocks.MockRepository, tr *trackMocks.MockRepository,
+				arr *artistMocks.MockRepository, seed models.RadioSeed) {
+
+				tr.EXPECT().Check(ctx, seed.ID).Return(&models.NoSuchTrackError{TrackID: seed.ID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't find track",
+		},
+		{
+			name:  "Repository Issue",
+			seed:  artistSeed,
+			limit: 2,
+			mockBehavior: func(r *recommendationMocks.MockRepository, tr *trackMocks.MockRepository,
+				arr *artistMocks.MockRepository, seed models.RadioSeed) {
+
+				arr.EXPECT().Check(ctx, seed.ID).Return(nil)
+				r.EXPECT().GetRadio(ctx, seed, userID, uint32(2), uint32(0)).Return(nil, uint32(0), errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get radio queue",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(r, tr, arr, tc.seed)
+
+			tracks, next, err := u.GetRadio(ctx, tc.seed, userID, tc.limit, tc.offset)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTracks, tracks)
+				assert.Equal(t, tc.expectedNext, next)
+			}
+		})
+	}
+}
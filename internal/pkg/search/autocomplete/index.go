@@ -0,0 +1,148 @@
+package autocomplete
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+// entry is one of keys by which suggestion can be found.
+// Every word of name starts its own key, so "puppets" finds "Master of Puppets".
+type entry struct {
+	key        string
+	wordPos    int
+	suggestion models.SearchSuggestion
+
+	boosted bool // set only while finding
+}
+
+type suggestionKey struct {
+	entityType string
+	id         uint32
+}
+
+// Index is concurrency-safe in-memory prefix index of entities' names
+type Index struct {
+	mu      sync.RWMutex
+	entries []entry // sorted by key
+}
+
+func NewIndex() *Index {
+	return &Index{}
+}
+
+// Replace rebuilds index from scratch
+func (i *Index) Replace(suggestions []models.SearchSuggestion) {
+	entries := entriesOf(suggestions)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.entries = entries
+}
+
+// Add inserts new suggestions into index
+func (i *Index) Add(suggestions []models.SearchSuggestion) {
+	if len(suggestions) == 0 {
+		return
+	}
+	added := entriesOf(suggestions)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	merged := make([]entry, 0, len(i.entries)+len(added))
+	old := i.entries
+	for len(old) > 0 && len(added) > 0 {
+		if old[0].key <= added[0].key {
+			merged = append(merged, old[0])
+			old = old[1:]
+		} else {
+			merged = append(merged, added[0])
+			added = added[1:]
+		}
+	}
+	merged = append(merged, old...)
+	merged = append(merged, added...)
+
+	i.entries = merged
+}
+
+// Find returns up to limit suggestions which name or one of its words starts with prefix.
+// Boosted suggestions go first, then names starting with prefix. Nil boosted boosts nothing.
+func (i *Index) Find(prefix string, limit int, boosted func(models.SearchSuggestion) bool) []models.SearchSuggestion {
+	prefix = normalize(prefix)
+	if prefix == "" || limit <= 0 {
+		return nil
+	}
+
+	i.mu.RLock()
+	start := sort.Search(len(i.entries), func(ind int) bool {
+		return i.entries[ind].key >= prefix
+	})
+	var matched []entry
+	for ind := start; ind < len(i.entries) && strings.HasPrefix(i.entries[ind].key, prefix); ind++ {
+		matched = append(matched, i.entries[ind])
+	}
+	i.mu.RUnlock()
+
+	if boosted != nil {
+		for ind := range matched {
+			matched[ind].boosted = boosted(matched[ind].suggestion)
+		}
+	}
+
+	sort.SliceStable(matched, func(a, b int) bool {
+		if matched[a].boosted != matched[b].boosted {
+			return matched[a].boosted
+		}
+		if matched[a].wordPos != matched[b].wordPos {
+			return matched[a].wordPos < matched[b].wordPos
+		}
+		return len(matched[a].suggestion.Name) < len(matched[b].suggestion.Name)
+	})
+
+	seen := make(map[suggestionKey]struct{}, limit)
+	suggestions := make([]models.SearchSuggestion, 0, limit)
+	for _, e := range matched {
+		if len(suggestions) == limit {
+			break
+		}
+
+		k := suggestionKey{entityType: e.suggestion.Type, id: e.suggestion.ID}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+
+		suggestions = append(suggestions, e.suggestion)
+	}
+
+	return suggestions
+}
+
+func entriesOf(suggestions []models.SearchSuggestion) []entry {
+	entries := make([]entry, 0, len(suggestions))
+	for _, s := range suggestions {
+		words := strings.Fields(normalize(s.Name))
+		for pos := range words {
+			entries = append(entries, entry{
+				key:        strings.Join(words[pos:], " "),
+				wordPos:    pos,
+				suggestion: s,
+			})
+		}
+	}
+
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].key < entries[b].key
+	})
+
+	return entries
+}
+
+func normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
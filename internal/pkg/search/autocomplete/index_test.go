@@ -0,0 +1,77 @@
+package autocomplete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+func TestIndex_Find(t *testing.T) {
+	metallica := models.SearchSuggestion{Type: models.SearchTypeArtist, ID: 1, Name: "Metallica"}
+	master := models.SearchSuggestion{Type: models.SearchTypeTrack, ID: 1, Name: "Master of Puppets"}
+	masterAlbum := models.SearchSuggestion{Type: models.SearchTypeAlbum, ID: 1, Name: "Master of Puppets"}
+	puppet := models.SearchSuggestion{Type: models.SearchTypeTrack, ID: 2, Name: "Puppet Master"}
+	oxxxymiron := models.SearchSuggestion{Type: models.SearchTypeArtist, ID: 2, Name: "Oxxxymiron"}
+
+	index := NewIndex()
+	index.Replace([]models.SearchSuggestion{metallica, master, puppet})
+	index.Add([]models.SearchSuggestion{masterAlbum, oxxxymiron})
+
+	testTable := []struct {
+		name                string
+		prefix              string
+		limit               int
+		boosted             func(models.SearchSuggestion) bool
+		expectedSuggestions []models.SearchSuggestion
+	}{
+		{
+			name:                "Name Prefix",
+			prefix:              "met",
+			limit:               10,
+			expectedSuggestions: []models.SearchSuggestion{metallica},
+		},
+		{
+			name:                "Names Before Words",
+			prefix:              "  MASTER ",
+			limit:               10,
+			expectedSuggestions: []models.SearchSuggestion{master, masterAlbum, puppet},
+		},
+		{
+			name:   "Boosted First",
+			prefix: "master",
+			limit:  10,
+			boosted: func(s models.SearchSuggestion) bool {
+				return s == puppet
+			},
+			expectedSuggestions: []models.SearchSuggestion{puppet, master, masterAlbum},
+		},
+		{
+			name:                "Limit",
+			prefix:              "pup",
+			limit:               1,
+			expectedSuggestions: []models.SearchSuggestion{puppet},
+		},
+		{
+			name:                "Added Incrementally",
+			prefix:              "oxx",
+			limit:               10,
+			expectedSuggestions: []models.SearchSuggestion{oxxxymiron},
+		},
+		{
+			name:                "Nothing Found",
+			prefix:              "saluki",
+			limit:               10,
+			expectedSuggestions: []models.SearchSuggestion{},
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			suggestions := index.Find(tc.prefix, tc.limit, tc.boosted)
+
+			assert.Equal(t, tc.expectedSuggestions, suggestions)
+		})
+	}
+}
@@ -3,8 +3,13 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
 	proto "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/microservices/search/proto/generated"
 )
@@ -19,10 +24,14 @@ func NewAuthAgent(c proto.SearchClient) *SearchAgent {
 	}
 }
 
-func (s *SearchAgent) FindAlbums(ctx context.Context, query string, amount uint32) ([]models.Album, error) {
+func (s *SearchAgent) FindAlbums(ctx context.Context, query string,
+	amount uint32, userID uint32, f models.SearchFilters) ([]models.Album, error) {
+
 	msg := &proto.SearchMsg{
-		Query:  query,
-		Amount: amount,
+		Query:   query,
+		Amount:  amount,
+		UserID:  userID,
+		Filters: filtersToProto(f),
 	}
 
 	grpcCtx, cancel := context.WithCancel(ctx)
@@ -43,23 +52,20 @@ func (s *SearchAgent) FindAlbums(ctx context.Context, query string, amount uint3
 			return nil, err
 		}
 
-		album := models.Album{
-			ID:          albumProto.Id,
-			Name:        albumProto.Name,
-			CoverSrc:    albumProto.CoverSrc,
-			Description: nilConvertString(albumProto.Description),
-		}
-
-		albums = append(albums, album)
+		albums = append(albums, albumFromProto(albumProto))
 	}
 
 	return albums, nil
 }
 
-func (s *SearchAgent) FindArtists(ctx context.Context, query string, amount uint32) ([]models.Artist, error) {
+func (s *SearchAgent) FindArtists(ctx context.Context, query string,
+	amount uint32, userID uint32, f models.SearchFilters) ([]models.Artist, error) {
+
 	msg := &proto.SearchMsg{
-		Query:  query,
-		Amount: amount,
+		Query:   query,
+		Amount:  amount,
+		UserID:  userID,
+		Filters: filtersToProto(f),
 	}
 
 	grpcCtx, cancel := context.WithCancel(ctx)
@@ -80,23 +86,20 @@ func (s *SearchAgent) FindArtists(ctx context.Context, query string, amount uint
 			return nil, err
 		}
 
-		artist := models.Artist{
-			ID:        artistProto.Id,
-			UserID:    nilConvertUint32(artistProto.UserID),
-			Name:      artistProto.Name,
-			AvatarSrc: artistProto.AvatarSrc,
-		}
-
-		artists = append(artists, artist)
+		artists = append(artists, artistFromProto(artistProto))
 	}
 
 	return artists, nil
 }
 
-func (s *SearchAgent) FindTracks(ctx context.Context, query string, amount uint32) ([]models.Track, error) {
+func (s *SearchAgent) FindTracks(ctx context.Context, query string,
+	amount uint32, userID uint32, f models.SearchFilters) ([]models.Track, error) {
+
 	msg := &proto.SearchMsg{
-		Query:  query,
-		Amount: amount,
+		Query:   query,
+		Amount:  amount,
+		UserID:  userID,
+		Filters: filtersToProto(f),
 	}
 
 	grpcCtx, cancel := context.WithCancel(ctx)
@@ -117,27 +120,20 @@ func (s *SearchAgent) FindTracks(ctx context.Context, query string, amount uint3
 			return nil, err
 		}
 
-		track := models.Track{
-			ID:            trackProto.Id,
-			Name:          trackProto.Name,
-			AlbumID:       nilConvertUint32(trackProto.AlbumID),
-			AlbumPosition: nilConvertUint32(trackProto.AlbumPosition),
-			CoverSrc:      trackProto.CoverSrc,
-			RecordSrc:     trackProto.CoverSrc,
-			Duration:      trackProto.Duration,
-			Listens:       trackProto.Listens,
-		}
-
-		tracks = append(tracks, track)
+		tracks = append(tracks, trackFromProto(trackProto))
 	}
 
 	return tracks, nil
 }
 
-func (s *SearchAgent) FindPlaylists(ctx context.Context, query string, amount uint32) ([]models.Playlist, error) {
+func (s *SearchAgent) FindPlaylists(ctx context.Context, query string,
+	amount uint32, userID uint32, f models.SearchFilters) ([]models.Playlist, error) {
+
 	msg := &proto.SearchMsg{
-		Query:  query,
-		Amount: amount,
+		Query:   query,
+		Amount:  amount,
+		UserID:  userID,
+		Filters: filtersToProto(f),
 	}
 
 	grpcCtx, cancel := context.WithCancel(ctx)
@@ -158,17 +154,253 @@ func (s *SearchAgent) FindPlaylists(ctx context.Context, query string, amount ui
 			return nil, err
 		}
 
-		playlist := models.Playlist{
-			ID:          playlistProto.Id,
-			Name:        playlistProto.Name,
-			Description: nilConvertString(playlistProto.Description),
-			CoverSrc:    playlistProto.CoverSrc,
+		playlists = append(playlists, playlistFromProto(playlistProto))
+	}
+
+	return playlists, nil
+}
+
+func (s *SearchAgent) Search(ctx context.Context, query string,
+	amount uint32, userID uint32, f models.SearchFilters) (*models.SearchResult, error) {
+
+	msg := &proto.SearchMsg{
+		Query:   query,
+		Amount:  amount,
+		UserID:  userID,
+		Filters: filtersToProto(f),
+	}
+
+	resp, err := s.client.Search(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.SearchResult{
+		Albums:    make([]models.Album, 0, len(resp.Albums)),
+		Artists:   make([]models.Artist, 0, len(resp.Artists)),
+		Tracks:    make([]models.Track, 0, len(resp.Tracks)),
+		Playlists: make([]models.Playlist, 0, len(resp.Playlists)),
+	}
+	for _, albumProto := range resp.Albums {
+		result.Albums = append(result.Albums, albumFromProto(albumProto))
+	}
+	for _, artistProto := range resp.Artists {
+		result.Artists = append(result.Artists, artistFromProto(artistProto))
+	}
+	for _, trackProto := range resp.Tracks {
+		result.Tracks = append(result.Tracks, trackFromProto(trackProto))
+	}
+	for _, playlistProto := range resp.Playlists {
+		result.Playlists = append(result.Playlists, playlistFromProto(playlistProto))
+	}
+	if resp.Top != nil {
+		result.Top = &models.SearchTopResult{
+			Type: resp.Top.Type,
+			ID:   resp.Top.Id,
 		}
+	}
+	if resp.Facets != nil {
+		result.Facets = facetsFromProto(resp.Facets)
+	}
+
+	return result, nil
+}
+
+func (s *SearchAgent) Suggest(ctx context.Context,
+	prefix string, amount uint32, userID uint32) ([]models.SearchSuggestion, error) {
 
-		playlists = append(playlists, playlist)
+	msg := &proto.SearchMsg{
+		Query:  prefix,
+		Amount: amount,
+		UserID: userID,
 	}
 
-	return playlists, nil
+	resp, err := s.client.Suggest(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]models.SearchSuggestion, 0, len(resp.Suggestions))
+	for _, suggestionProto := range resp.Suggestions {
+		suggestions = append(suggestions, models.SearchSuggestion{
+			Type: suggestionProto.Type,
+			ID:   suggestionProto.Id,
+			Name: suggestionProto.Name,
+		})
+	}
+
+	return suggestions, nil
+}
+
+func (s *SearchAgent) AddRecentSearch(ctx context.Context, userID uint32, recent models.RecentSearch) error {
+	msg := &proto.AddRecentSearchMsg{
+		UserID: userID,
+		Recent: &proto.RecentSearch{
+			Query:      recent.Query,
+			EntityType: nilCheckString(recent.EntityType),
+			EntityID:   nilCheckUint32(recent.EntityID),
+		},
+	}
+
+	_, err := s.client.AddRecentSearch(ctx, msg)
+
+	return err
+}
+
+func (s *SearchAgent) GetRecentSearches(ctx context.Context,
+	userID uint32, limit uint32) ([]models.RecentSearch, error) {
+
+	msg := &proto.GetRecentSearchesMsg{
+		UserID: userID,
+		Limit:  limit,
+	}
+
+	resp, err := s.client.GetRecentSearches(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	recents := make([]models.RecentSearch, 0, len(resp.Recents))
+	for _, recentProto := range resp.Recents {
+		recents = append(recents, recentSearchFromProto(recentProto))
+	}
+
+	return recents, nil
+}
+
+func (s *SearchAgent) DeleteRecentSearch(ctx context.Context, recentSearchID uint32, userID uint32) error {
+	msg := &proto.DeleteRecentSearchMsg{
+		RecentSearchID: recentSearchID,
+		UserID:         userID,
+	}
+
+	if _, err := s.client.DeleteRecentSearch(ctx, msg); err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return fmt.Errorf("%w: %v", &models.NoSuchRecentSearchError{RecentSearchID: recentSearchID}, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *SearchAgent) ClearRecentSearches(ctx context.Context, userID uint32) error {
+	_, err := s.client.ClearRecentSearches(ctx, &proto.ClearRecentSearchesMsg{UserID: userID})
+
+	return err
+}
+
+func albumFromProto(albumProto *proto.AlbumResponse) models.Album {
+	return models.Album{
+		ID:          albumProto.Id,
+		Name:        albumProto.Name,
+		CoverSrc:    albumProto.CoverSrc,
+		Description: nilConvertString(albumProto.Description),
+		Highlight:   albumProto.Highlight,
+	}
+}
+
+func artistFromProto(artistProto *proto.ArtistResponse) models.Artist {
+	return models.Artist{
+		ID:        artistProto.Id,
+		UserID:    nilConvertUint32(artistProto.UserID),
+		Name:      artistProto.Name,
+		AvatarSrc: artistProto.AvatarSrc,
+		Highlight: artistProto.Highlight,
+	}
+}
+
+func trackFromProto(trackProto *proto.TrackResponse) models.Track {
+	return models.Track{
+		ID:            trackProto.Id,
+		Name:          trackProto.Name,
+		AlbumID:       nilConvertUint32(trackProto.AlbumID),
+		AlbumPosition: nilConvertUint32(trackProto.AlbumPosition),
+		CoverSrc:      trackProto.CoverSrc,
+		RecordSrc:     trackProto.RecordSrc,
+		Duration:      trackProto.Duration,
+		Listens:       trackProto.Listens,
+		Highlight:     trackProto.Highlight,
+		LyricsSnippet: trackProto.LyricsSnippet,
+	}
+}
+
+func playlistFromProto(playlistProto *proto.PlaylistResponse) models.Playlist {
+	return models.Playlist{
+		ID:          playlistProto.Id,
+		Name:        playlistProto.Name,
+		Description: nilConvertString(playlistProto.Description),
+		CoverSrc:    playlistProto.CoverSrc,
+		Highlight:   playlistProto.Highlight,
+	}
+}
+
+func filtersToProto(f models.SearchFilters) *proto.SearchFilters {
+	filtersProto := &proto.SearchFilters{
+		MinDuration: f.MinDuration,
+		MaxDuration: f.MaxDuration,
+		TrackKind:   f.TrackKind,
+		ArtistID:    f.ArtistID,
+		OnlyLiked:   f.OnlyLiked,
+	}
+	if f.AddedAfter != nil {
+		filtersProto.AddedAfter = timestamppb.New(*f.AddedAfter)
+	}
+
+	return filtersProto
+}
+
+func facetsFromProto(facetsProto *proto.SearchFacets) *models.SearchFacets {
+	facets := &models.SearchFacets{
+		Artists: make([]models.SearchArtistFacet, 0, len(facetsProto.Artists)),
+	}
+	if counts := facetsProto.Counts; counts != nil {
+		facets.Counts = models.SearchCounts{
+			Albums:    counts.Albums,
+			Artists:   counts.Artists,
+			Tracks:    counts.Tracks,
+			Playlists: counts.Playlists,
+		}
+	}
+	for _, artistProto := range facetsProto.Artists {
+		facets.Artists = append(facets.Artists, models.SearchArtistFacet{
+			ArtistID: artistProto.ArtistID,
+			Name:     artistProto.Name,
+			Count:    artistProto.Count,
+		})
+	}
+
+	return facets
+}
+
+func recentSearchFromProto(recentProto *proto.RecentSearch) models.RecentSearch {
+	recent := models.RecentSearch{
+		ID:         recentProto.Id,
+		Query:      recentProto.Query,
+		EntityName: nilConvertString(recentProto.EntityName),
+		SearchedAt: recentProto.SearchedAt.AsTime(),
+	}
+	if recentProto.EntityType != "" {
+		entityType, entityID := recentProto.EntityType, recentProto.EntityID
+		recent.EntityType = &entityType
+		recent.EntityID = &entityID
+	}
+
+	return recent
+}
+
+func nilCheckString(val *string) string {
+	if val == nil {
+		return ""
+	}
+	return *val
+}
+
+func nilCheckUint32(val *uint32) uint32 {
+	if val == nil {
+		return 0
+	}
+	return *val
 }
 
 func nilConvertString(s string) *string {
@@ -1,6 +1,7 @@
 package delivery
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -50,7 +51,7 @@ func NewHandler(su search.Usecase, alu album.Usecase, aru artist.Usecase,
 // @Param		query	body		searchRequest    	true "Query for search"
 // @Success		200		{object}	searchAlbumsResponse	 "Albums found"
 // @Failure		400		{object}	http.Error				 "Incorrect body"
-// @Failure		401		{object}	http.Error  			 "User unathorized"
+// @Failure		401		{object}	http.Error  			 "User unathorized (filtering by likes)"
 // @Failure		500		{object}	http.Error				 "Server error"
 // @Router		/api/albums/search [post]
 func (h *Handler) FindAlbums(w http.ResponseWriter, r *http.Request) {
@@ -73,13 +74,18 @@ func (h *Handler) FindAlbums(w http.ResponseWriter, r *http.Request) {
 			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
 		return
 	}
+	if sr.filters().OnlyLiked && user == nil {
+		commonHTTP.ErrorResponse(w, r, commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger)
+		return
+	}
 
-	albums, err := h.searchServices.FindAlbums(r.Context(), sr.Query, sr.Amount)
+	albums, err := h.searchServices.FindAlbums(r.Context(), sr.Query, sr.Amount, userIDOf(user), sr.filters())
 	if err != nil {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			albumsFindServerError, http.StatusInternalServerError, h.logger, err)
 		return
 	}
+	h.rememberSearch(r, user, sr.recentSearch())
 
 	at, err := models.AlbumTransferFromList(r.Context(),
 		albums, user, h.albumServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByAlbum)
@@ -102,7 +108,7 @@ func (h *Handler) FindAlbums(w http.ResponseWriter, r *http.Request) {
 // @Param		query	body		searchRequest    	true "Query for search"
 // @Success		200		{object}	searchArtistsResponse	 "Artists found"
 // @Failure		400		{object}	http.Error				 "Incorrect body"
-// @Failure		401		{object}	http.Error  			 "User unathorized"
+// @Failure		401		{object}	http.Error  			 "User unathorized (filtering by likes)"
 // @Failure		500		{object}	http.Error				 "Server error"
 // @Router		/api/artists/search [post]
 func (h *Handler) FindArtists(w http.ResponseWriter, r *http.Request) {
@@ -125,13 +131,18 @@ func (h *Handler) FindArtists(w http.ResponseWriter, r *http.Request) {
 			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
 		return
 	}
+	if sr.filters().OnlyLiked && user == nil {
+		commonHTTP.ErrorResponse(w, r, commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger)
+		return
+	}
 
-	artists, err := h.searchServices.FindArtists(r.Context(), sr.Query, sr.Amount)
+	artists, err := h.searchServices.FindArtists(r.Context(), sr.Query, sr.Amount, userIDOf(user), sr.filters())
 	if err != nil {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			artistsFindServerError, http.StatusInternalServerError, h.logger, err)
 		return
 	}
+	h.rememberSearch(r, user, sr.recentSearch())
 
 	at, err := models.ArtistTransferFromList(r.Context(), artists, user, h.artistServices.IsLiked)
 	if err != nil {
@@ -153,7 +164,7 @@ func (h *Handler) FindArtists(w http.ResponseWriter, r *http.Request) {
 // @Param		query	body		searchRequest    	true "Query for search"
 // @Success		200		{object}	searchTracksResponse	 "Tracks found"
 // @Failure		400		{object}	http.Error				 "Incorrect body"
-// @Failure		401		{object}	http.Error  			 "User unathorized"
+// @Failure		401		{object}	http.Error  			 "User unathorized (filtering by likes)"
 // @Failure		500		{object}	http.Error				 "Server error"
 // @Router		/api/tracks/search [post]
 func (h *Handler) FindTracks(w http.ResponseWriter, r *http.Request) {
@@ -176,13 +187,18 @@ func (h *Handler) FindTracks(w http.ResponseWriter, r *http.Request) {
 			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
 		return
 	}
+	if sr.filters().OnlyLiked && user == nil {
+		commonHTTP.ErrorResponse(w, r, commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger)
+		return
+	}
 
-	tracks, err := h.searchServices.FindTracks(r.Context(), sr.Query, sr.Amount)
+	tracks, err := h.searchServices.FindTracks(r.Context(), sr.Query, sr.Amount, userIDOf(user), sr.filters())
 	if err != nil {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			tracksFindServerError, http.StatusInternalServerError, h.logger, err)
 		return
 	}
+	h.rememberSearch(r, user, sr.recentSearch())
 
 	tt, err := models.TrackTransferFromList(r.Context(),
 		tracks, user, h.trackServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByTrack)
@@ -205,7 +221,7 @@ func (h *Handler) FindTracks(w http.ResponseWriter, r *http.Request) {
 // @Param		query	body		searchRequest    	true "Query for search"
 // @Success		200		{object}	searchPlaylistsResponse	 "Playlists found"
 // @Failure		400		{object}	http.Error				 "Incorrect body"
-// @Failure		401		{object}	http.Error  			 "User unathorized"
+// @Failure		401		{object}	http.Error  			 "User unathorized (filtering by likes)"
 // @Failure		500		{object}	http.Error				 "Server error"
 // @Router		/api/playlists/search [post]
 func (h *Handler) FindPlaylists(w http.ResponseWriter, r *http.Request) {
@@ -228,13 +244,18 @@ func (h *Handler) FindPlaylists(w http.ResponseWriter, r *http.Request) {
 			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
 		return
 	}
+	if sr.filters().OnlyLiked && user == nil {
+		commonHTTP.ErrorResponse(w, r, commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger)
+		return
+	}
 
-	playlists, err := h.searchServices.FindPlaylists(r.Context(), sr.Query, sr.Amount)
+	playlists, err := h.searchServices.FindPlaylists(r.Context(), sr.Query, sr.Amount, userIDOf(user), sr.filters())
 	if err != nil {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			albumsFindServerError, http.StatusInternalServerError, h.logger, err)
 		return
 	}
+	h.rememberSearch(r, user, sr.recentSearch())
 
 	pt, err := models.PlaylistTransferFromList(r.Context(),
 		playlists, user, h.playlistServices.IsLiked, h.userServices.GetByPlaylist)
@@ -248,3 +269,304 @@ func (h *Handler) FindPlaylists(w http.ResponseWriter, r *http.Request) {
 
 	commonHTTP.SuccessResponse(w, r, resp, h.logger)
 }
+
+// @Summary		Search
+// @Tags		Search
+// @Description	Find amount of albums, artists, tracks and playlists by search-query, pick the most relevant of them and count facets
+// @Accept      json
+// @Produce		json
+// @Param		query	body		searchRequest	true "Query for search"
+// @Success		200		{object}	searchResponse		 "Entities found"
+// @Failure		400		{object}	http.Error			 "Incorrect body"
+// @Failure		401		{object}	http.Error  		 "User unathorized (filtering by likes)"
+// @Failure		500		{object}	http.Error			 "Server error"
+// @Router		/api/search [post]
+func (h *Handler) Search(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			searchServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	var sr searchRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &sr); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := sr.validate(); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+	if sr.filters().OnlyLiked && user == nil {
+		commonHTTP.ErrorResponse(w, r, commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger)
+		return
+	}
+
+	result, err := h.searchServices.Search(r.Context(), sr.Query, sr.Amount, userIDOf(user), sr.filters())
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			searchServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+	h.rememberSearch(r, user, sr.recentSearch())
+
+	resp, err := h.searchResponseFromResult(r.Context(), result, user)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			searchServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
+
+func (h *Handler) searchResponseFromResult(ctx context.Context,
+	result *models.SearchResult, user *models.User) (searchResponse, error) {
+
+	var resp searchResponse
+	var err error
+
+	resp.Albums, err = models.AlbumTransferFromList(ctx,
+		result.Albums, user, h.albumServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByAlbum)
+	if err != nil {
+		return searchResponse{}, err
+	}
+
+	resp.Artists, err = models.ArtistTransferFromList(ctx, result.Artists, user, h.artistServices.IsLiked)
+	if err != nil {
+		return searchResponse{}, err
+	}
+
+	resp.Tracks, err = models.TrackTransferFromList(ctx,
+		result.Tracks, user, h.trackServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByTrack)
+	if err != nil {
+		return searchResponse{}, err
+	}
+
+	resp.Playlists, err = models.PlaylistTransferFromList(ctx,
+		result.Playlists, user, h.playlistServices.IsLiked, h.userServices.GetByPlaylist)
+	if err != nil {
+		return searchResponse{}, err
+	}
+
+	if result.Top != nil {
+		resp.Top = &searchTopResult{
+			Type: result.Top.Type,
+			ID:   result.Top.ID,
+		}
+	}
+	resp.Facets = searchFacetsFromModel(result.Facets)
+
+	return resp, nil
+}
+
+// rememberSearch adds query to recent searches of authorized user.
+// Failure isn't reported to user, as search itself succeeded.
+func (h *Handler) rememberSearch(r *http.Request, user *models.User, recent models.RecentSearch) {
+	if user == nil {
+		return
+	}
+
+	if err := h.searchServices.AddRecentSearch(r.Context(), user.ID, recent); err != nil {
+		h.logger.ErrorfReqID(r.Context(), "can't remember search of user #%d: %v", user.ID, err)
+	}
+}
+
+// @Summary		Suggest
+// @Tags		Search
+// @Description	Get names of artists, tracks and albums starting with typed prefix, ones clicked by user before go first
+// @Produce		json
+// @Param		q		query		string					true	"Typed prefix"
+// @Param		limit	query		int						false	"Amount of suggestions"
+// @Success		200		{object}	searchSuggestResponse	"Suggestions got"
+// @Failure		400		{object}	http.Error				"Incorrect query"
+// @Failure		500		{object}	http.Error				"Server error"
+// @Router		/api/search/suggest [get]
+func (h *Handler) Suggest(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			suggestServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	prefix, amount, err := suggestInputFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	suggestions, err := h.searchServices.Suggest(r.Context(), prefix, amount, userIDOf(user))
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			suggestServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := searchSuggestResponse{
+		Suggestions: make([]searchSuggestion, 0, len(suggestions)),
+	}
+	for _, s := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, searchSuggestion{
+			Type: s.Type,
+			ID:   s.ID,
+			Name: s.Name,
+		})
+	}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
+
+// @Summary		Recent Searches
+// @Tags		Search
+// @Description	Get user's recent search queries and clicked results, the latest first
+// @Produce		json
+// @Param		limit	query		int						false	"Amount of recent searches"
+// @Success		200		{object}	recentSearchesResponse	"Recent searches got"
+// @Failure		400		{object}	http.Error				"Incorrect query"
+// @Failure		401		{object}	http.Error				"User unathorized"
+// @Failure		500		{object}	http.Error				"Server error"
+// @Router		/api/search/recent [get]
+func (h *Handler) RecentSearches(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	limit, err := recentSearchesLimitFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	recents, err := h.searchServices.GetRecentSearches(r.Context(), user.ID, limit)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			recentSearchesGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := recentSearchesResponseFromModels(recents)
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
+
+// @Summary		Add Recent Search
+// @Tags		Search
+// @Description	Remember search result clicked by user
+// @Accept      json
+// @Produce		json
+// @Param		recent	body		recentSearchRequest	true	"Query and clicked result"
+// @Success		200		{object}	defaultResponse		"Recent search added"
+// @Failure		400		{object}	http.Error			"Incorrect body"
+// @Failure		401		{object}	http.Error			"User unathorized"
+// @Failure		500		{object}	http.Error			"Server error"
+// @Router		/api/search/recent [post]
+func (h *Handler) AddRecentSearch(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	var rsr recentSearchRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &rsr); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := rsr.validate(); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := h.searchServices.AddRecentSearch(r.Context(), user.ID, rsr.recentSearch()); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			recentSearchAddServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := defaultResponse{Status: recentSearchAddedSuccessfully}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
+
+// @Summary		Delete Recent Search
+// @Tags		Search
+// @Description	Delete one of user's recent searches
+// @Produce		json
+// @Success		200		{object}	defaultResponse	"Recent search deleted"
+// @Failure		400		{object}	http.Error		"Client error"
+// @Failure		401		{object}	http.Error		"User unathorized"
+// @Failure		500		{object}	http.Error		"Server error"
+// @Router		/api/search/recent/{recentSearchID} [delete]
+func (h *Handler) DeleteRecentSearch(w http.ResponseWriter, r *http.Request) {
+	recentSearchID, err := commonHTTP.GetRecentSearchIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	if err := h.searchServices.DeleteRecentSearch(r.Context(), recentSearchID, user.ID); err != nil {
+		var errNoSuchRecentSearch *models.NoSuchRecentSearchError
+		if errors.As(err, &errNoSuchRecentSearch) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				recentSearchNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			recentSearchDeleteServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := defaultResponse{Status: recentSearchDeletedSuccessfully}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
+
+// @Summary		Clear Recent Searches
+// @Tags		Search
+// @Description	Delete all of user's recent searches
+// @Produce		json
+// @Success		200		{object}	defaultResponse	"Recent searches cleared"
+// @Failure		401		{object}	http.Error		"User unathorized"
+// @Failure		500		{object}	http.Error		"Server error"
+// @Router		/api/search/recent [delete]
+func (h *Handler) ClearRecentSearches(w http.ResponseWriter, r *http.Request) {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	if err := h.searchServices.ClearRecentSearches(r.Context(), user.ID); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			recentSearchesClearServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := defaultResponse{Status: recentSearchesClearedSuccessfully}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
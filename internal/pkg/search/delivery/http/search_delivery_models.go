@@ -1,8 +1,17 @@
 package delivery
 
 import (
+	"errors"
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
 	valid "github.com/asaskevich/govalidator"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
 )
 
 //go:generate easyjson -no_std_marshalers search_delivery_models.go
@@ -13,17 +22,71 @@ const (
 	artistsFindServerError   = "can't find artists"
 	tracksFindServerError    = "can't find tracks"
 	playlistsFindServerError = "can't find playlists"
+	searchServerError        = "can't search"
+	suggestServerError       = "can't get suggestions"
+
+	recentSearchesGetServerError   = "can't get recent searches"
+	recentSearchAddServerError     = "can't remember search"
+	recentSearchDeleteServerError  = "can't delete recent search"
+	recentSearchesClearServerError = "can't clear recent searches"
+	recentSearchNotFound           = "no such recent search"
+
+	recentSearchAddedSuccessfully     = "ok"
+	recentSearchDeletedSuccessfully   = "ok"
+	recentSearchesClearedSuccessfully = "ok"
 )
 
+//easyjson:json
+type searchFilters struct {
+	MinDuration uint32     `json:"minDuration"`
+	MaxDuration uint32     `json:"maxDuration"`
+	TrackKind   string     `json:"trackKind"`
+	ArtistID    uint32     `json:"artistID"`
+	OnlyLiked   bool       `json:"onlyLiked"`
+	AddedAfter  *time.Time `json:"addedAfter"`
+}
+
 //easyjson:json
 type searchRequest struct {
-	Query  string `json:"query" valid:"required"`
-	Amount uint32 `json:"amount" valid:"required,range(1|100)"`
+	Query   string         `json:"query" valid:"required,stringlength(1|200)"`
+	Amount  uint32         `json:"amount" valid:"required,range(1|100)"`
+	Filters *searchFilters `json:"filters,omitempty"`
 }
 
 func (sr *searchRequest) validate() error {
-	_, err := valid.ValidateStruct(sr)
-	return err
+	if _, err := valid.ValidateStruct(sr); err != nil {
+		return err
+	}
+
+	return sr.filters().Validate()
+}
+
+// recentSearch is query to remember in recent searches of user
+func (sr *searchRequest) recentSearch() models.RecentSearch {
+	return models.RecentSearch{Query: html.EscapeString(sr.Query)}
+}
+
+func (sr *searchRequest) filters() models.SearchFilters {
+	if sr.Filters == nil {
+		return models.SearchFilters{}
+	}
+
+	return models.SearchFilters{
+		MinDuration: sr.Filters.MinDuration,
+		MaxDuration: sr.Filters.MaxDuration,
+		TrackKind:   sr.Filters.TrackKind,
+		ArtistID:    sr.Filters.ArtistID,
+		OnlyLiked:   sr.Filters.OnlyLiked,
+		AddedAfter:  sr.Filters.AddedAfter,
+	}
+}
+
+// userIDOf returns ID of user to personalize search for, 0 if user is unauthorized
+func userIDOf(user *models.User) uint32 {
+	if user == nil {
+		return 0
+	}
+	return user.ID
 }
 
 //easyjson:json
@@ -45,3 +108,190 @@ type searchTracksResponse struct {
 type searchPlaylistsResponse struct {
 	Playlists models.PlaylistTransfers `json:"playlists"`
 }
+
+//easyjson:json
+type searchTopResult struct {
+	Type string `json:"type"`
+	ID   uint32 `json:"id"`
+}
+
+//easyjson:json
+type searchCounts struct {
+	Albums    uint32 `json:"albums"`
+	Artists   uint32 `json:"artists"`
+	Tracks    uint32 `json:"tracks"`
+	Playlists uint32 `json:"playlists"`
+}
+
+//easyjson:json
+type searchArtistFacet struct {
+	ArtistID uint32 `json:"artistID"`
+	Name     string `json:"name"`
+	Count    uint32 `json:"count"`
+}
+
+//easyjson:json
+type searchFacets struct {
+	Counts  searchCounts        `json:"counts"`
+	Artists []searchArtistFacet `json:"artists"`
+}
+
+func searchFacetsFromModel(facets *models.SearchFacets) *searchFacets {
+	if facets == nil {
+		return nil
+	}
+
+	sf := &searchFacets{
+		Counts:  searchCounts(facets.Counts),
+		Artists: make([]searchArtistFacet, 0, len(facets.Artists)),
+	}
+	for _, artist := range facets.Artists {
+		sf.Artists = append(sf.Artists, searchArtistFacet(artist))
+	}
+
+	return sf
+}
+
+//easyjson:json
+type searchResponse struct {
+	Top       *searchTopResult         `json:"top,omitempty"`
+	Albums    models.AlbumTransfers    `json:"albums"`
+	Artists   models.ArtistTransfers   `json:"artists"`
+	Tracks    models.TrackTransfers    `json:"tracks"`
+	Playlists models.PlaylistTransfers `json:"playlists"`
+	Facets    *searchFacets            `json:"facets,omitempty"`
+}
+
+const (
+	suggestDefaultAmount = 10
+	suggestMaxAmount     = 20
+)
+
+// suggestInputFromRequest returns prefix typed by user and amount of suggestions
+func suggestInputFromRequest(r *http.Request) (string, uint32, error) {
+	values := r.URL.Query()
+
+	prefix := strings.TrimSpace(values.Get(commonHTTP.FilterQueryParam))
+	if prefix == "" {
+		return "", 0, errors.New("empty prefix")
+	}
+
+	amount := uint64(suggestDefaultAmount)
+	if limit := values.Get(commonHTTP.LimitQueryParam); limit != "" {
+		var err error
+		if amount, err = strconv.ParseUint(limit, 10, 32); err != nil {
+			return "", 0, err
+		}
+		if amount == 0 || amount > suggestMaxAmount {
+			return "", 0, errors.New("invalid amount of suggestions")
+		}
+	}
+
+	return prefix, uint32(amount), nil
+}
+
+//easyjson:json
+type searchSuggestion struct {
+	Type string `json:"type"`
+	ID   uint32 `json:"id"`
+	Name string `json:"name"`
+}
+
+//easyjson:json
+type searchSuggestResponse struct {
+	Suggestions []searchSuggestion `json:"suggestions"`
+}
+
+//easyjson:json
+type defaultResponse struct {
+	Status string `json:"status"`
+}
+
+const (
+	recentSearchesDefaultLimit = 10
+	recentSearchesMaxLimit     = 50
+)
+
+// recentSearchesLimitFromRequest returns amount of recent searches to get
+func recentSearchesLimitFromRequest(r *http.Request) (uint32, error) {
+	limit := uint64(recentSearchesDefaultLimit)
+	if l := r.URL.Query().Get(commonHTTP.LimitQueryParam); l != "" {
+		var err error
+		if limit, err = strconv.ParseUint(l, 10, 32); err != nil {
+			return 0, err
+		}
+		if limit == 0 || limit > recentSearchesMaxLimit {
+			return 0, errors.New("invalid amount of recent searches")
+		}
+	}
+
+	return uint32(limit), nil
+}
+
+// recentSearchRequest is search result clicked by user
+//
+//easyjson:json
+type recentSearchRequest struct {
+	Query string `json:"query" valid:"required,stringlength(1|200)"`
+	Type  string `json:"type" valid:"required,in(album|artist|track|playlist)"`
+	ID    uint32 `json:"id" valid:"required"`
+}
+
+func (rsr *recentSearchRequest) validate() error {
+	_, err := valid.ValidateStruct(rsr)
+	return err
+}
+
+func (rsr *recentSearchRequest) recentSearch() models.RecentSearch {
+	return models.RecentSearch{
+		Query:      html.EscapeString(rsr.Query),
+		EntityType: &rsr.Type,
+		EntityID:   &rsr.ID,
+	}
+}
+
+//easyjson:json
+type recentSearchEntity struct {
+	Type string `json:"type"`
+	ID   uint32 `json:"id"`
+	// Name is empty if entity was deleted
+	Name string `json:"name,omitempty"`
+}
+
+//easyjson:json
+type recentSearch struct {
+	ID         uint32              `json:"id"`
+	Query      string              `json:"query"`
+	Entity     *recentSearchEntity `json:"entity,omitempty"`
+	SearchedAt time.Time           `json:"searchedAt"`
+}
+
+//easyjson:json
+type recentSearchesResponse struct {
+	Recents []recentSearch `json:"recents"`
+}
+
+func recentSearchesResponseFromModels(recents []models.RecentSearch) recentSearchesResponse {
+	resp := recentSearchesResponse{
+		Recents: make([]recentSearch, 0, len(recents)),
+	}
+	for _, recent := range recents {
+		rs := recentSearch{
+			ID:         recent.ID,
+			Query:      recent.Query,
+			SearchedAt: recent.SearchedAt,
+		}
+		if recent.EntityType != nil && recent.EntityID != nil {
+			rs.Entity = &recentSearchEntity{
+				Type: *recent.EntityType,
+				ID:   *recent.EntityID,
+			}
+			if recent.EntityName != nil {
+				rs.Entity.Name = *recent.EntityName
+			}
+		}
+		resp.Recents = append(resp.Recents, rs)
+	}
+
+	return resp
+}
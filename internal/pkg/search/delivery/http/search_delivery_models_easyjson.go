@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -69,7 +70,325 @@ func (v searchTracksResponse) MarshalEasyJSON(w *jwriter.Writer) {
 func (v *searchTracksResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp(l, v)
 }
-func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp1(in *jlexer.Lexer, out *searchRequest) {
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp1(in *jlexer.Lexer, out *searchTopResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp1(out *jwriter.Writer, in searchTopResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v searchTopResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp1(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *searchTopResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp1(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp2(in *jlexer.Lexer, out *searchSuggestion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp2(out *jwriter.Writer, in searchSuggestion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v searchSuggestion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp2(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *searchSuggestion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp2(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp3(in *jlexer.Lexer, out *searchSuggestResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "suggestions":
+			if in.IsNull() {
+				in.Skip()
+				out.Suggestions = nil
+			} else {
+				in.Delim('[')
+				if out.Suggestions == nil {
+					if !in.IsDelim(']') {
+						out.Suggestions = make([]searchSuggestion, 0, 1)
+					} else {
+						out.Suggestions = []searchSuggestion{}
+					}
+				} else {
+					out.Suggestions = (out.Suggestions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 searchSuggestion
+					(v1).UnmarshalEasyJSON(in)
+					out.Suggestions = append(out.Suggestions, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp3(out *jwriter.Writer, in searchSuggestResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"suggestions\":"
+		out.RawString(prefix[1:])
+		if in.Suggestions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Suggestions {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v searchSuggestResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp3(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *searchSuggestResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp3(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp4(in *jlexer.Lexer, out *searchResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "top":
+			if in.IsNull() {
+				in.Skip()
+				out.Top = nil
+			} else {
+				if out.Top == nil {
+					out.Top = new(searchTopResult)
+				}
+				(*out.Top).UnmarshalEasyJSON(in)
+			}
+		case "albums":
+			(out.Albums).UnmarshalEasyJSON(in)
+		case "artists":
+			(out.Artists).UnmarshalEasyJSON(in)
+		case "tracks":
+			(out.Tracks).UnmarshalEasyJSON(in)
+		case "playlists":
+			(out.Playlists).UnmarshalEasyJSON(in)
+		case "facets":
+			if in.IsNull() {
+				in.Skip()
+				out.Facets = nil
+			} else {
+				if out.Facets == nil {
+					out.Facets = new(searchFacets)
+				}
+				(*out.Facets).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp4(out *jwriter.Writer, in searchResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Top != nil {
+		const prefix string = ",\"top\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Top).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"albums\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.Albums).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		(in.Artists).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		(in.Tracks).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"playlists\":"
+		out.RawString(prefix)
+		(in.Playlists).MarshalEasyJSON(out)
+	}
+	if in.Facets != nil {
+		const prefix string = ",\"facets\":"
+		out.RawString(prefix)
+		(*in.Facets).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v searchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp4(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *searchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp4(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp5(in *jlexer.Lexer, out *searchRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -92,6 +411,16 @@ func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearch
 			out.Query = string(in.String())
 		case "amount":
 			out.Amount = uint32(in.Uint32())
+		case "filters":
+			if in.IsNull() {
+				in.Skip()
+				out.Filters = nil
+			} else {
+				if out.Filters == nil {
+					out.Filters = new(searchFilters)
+				}
+				(*out.Filters).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -102,7 +431,7 @@ func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearch
 		in.Consumed()
 	}
 }
-func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp1(out *jwriter.Writer, in searchRequest) {
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp5(out *jwriter.Writer, in searchRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -116,19 +445,24 @@ func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearch
 		out.RawString(prefix)
 		out.Uint32(uint32(in.Amount))
 	}
+	if in.Filters != nil {
+		const prefix string = ",\"filters\":"
+		out.RawString(prefix)
+		(*in.Filters).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v searchRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp1(w, v)
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp5(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *searchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp1(l, v)
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp5(l, v)
 }
-func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp2(in *jlexer.Lexer, out *searchPlaylistsResponse) {
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp6(in *jlexer.Lexer, out *searchPlaylistsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -159,7 +493,7 @@ func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearch
 		in.Consumed()
 	}
 }
-func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp2(out *jwriter.Writer, in searchPlaylistsResponse) {
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp6(out *jwriter.Writer, in searchPlaylistsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -173,14 +507,14 @@ func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearch
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v searchPlaylistsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp2(w, v)
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp6(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *searchPlaylistsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp2(l, v)
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp6(l, v)
 }
-func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp3(in *jlexer.Lexer, out *searchArtistsResponse) {
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp7(in *jlexer.Lexer, out *searchFilters) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -199,8 +533,28 @@ func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearch
 			continue
 		}
 		switch key {
-		case "artists":
-			(out.Artists).UnmarshalEasyJSON(in)
+		case "minDuration":
+			out.MinDuration = uint32(in.Uint32())
+		case "maxDuration":
+			out.MaxDuration = uint32(in.Uint32())
+		case "trackKind":
+			out.TrackKind = string(in.String())
+		case "artistID":
+			out.ArtistID = uint32(in.Uint32())
+		case "onlyLiked":
+			out.OnlyLiked = bool(in.Bool())
+		case "addedAfter":
+			if in.IsNull() {
+				in.Skip()
+				out.AddedAfter = nil
+			} else {
+				if out.AddedAfter == nil {
+					out.AddedAfter = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.AddedAfter).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -211,28 +565,57 @@ func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearch
 		in.Consumed()
 	}
 }
-func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp3(out *jwriter.Writer, in searchArtistsResponse) {
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp7(out *jwriter.Writer, in searchFilters) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"artists\":"
+		const prefix string = ",\"minDuration\":"
 		out.RawString(prefix[1:])
-		(in.Artists).MarshalEasyJSON(out)
+		out.Uint32(uint32(in.MinDuration))
+	}
+	{
+		const prefix string = ",\"maxDuration\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.MaxDuration))
+	}
+	{
+		const prefix string = ",\"trackKind\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackKind))
+	}
+	{
+		const prefix string = ",\"artistID\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.ArtistID))
+	}
+	{
+		const prefix string = ",\"onlyLiked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.OnlyLiked))
+	}
+	{
+		const prefix string = ",\"addedAfter\":"
+		out.RawString(prefix)
+		if in.AddedAfter == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.AddedAfter).MarshalJSON())
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v searchArtistsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp3(w, v)
+func (v searchFilters) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp7(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *searchArtistsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp3(l, v)
+func (v *searchFilters) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp7(l, v)
 }
-func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp4(in *jlexer.Lexer, out *searchAlbumsResponse) {
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp8(in *jlexer.Lexer, out *searchFacets) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -251,8 +634,31 @@ func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearch
 			continue
 		}
 		switch key {
-		case "albums":
-			(out.Albums).UnmarshalEasyJSON(in)
+		case "counts":
+			(out.Counts).UnmarshalEasyJSON(in)
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]searchArtistFacet, 0, 2)
+					} else {
+						out.Artists = []searchArtistFacet{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 searchArtistFacet
+					(v4).UnmarshalEasyJSON(in)
+					out.Artists = append(out.Artists, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -263,24 +669,634 @@ func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearch
 		in.Consumed()
 	}
 }
-func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp4(out *jwriter.Writer, in searchAlbumsResponse) {
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp8(out *jwriter.Writer, in searchFacets) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"albums\":"
+		const prefix string = ",\"counts\":"
 		out.RawString(prefix[1:])
-		(in.Albums).MarshalEasyJSON(out)
+		(in.Counts).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Artists {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v searchAlbumsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp4(w, v)
+func (v searchFacets) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp8(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *searchFacets) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp8(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp9(in *jlexer.Lexer, out *searchCounts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "albums":
+			out.Albums = uint32(in.Uint32())
+		case "artists":
+			out.Artists = uint32(in.Uint32())
+		case "tracks":
+			out.Tracks = uint32(in.Uint32())
+		case "playlists":
+			out.Playlists = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp9(out *jwriter.Writer, in searchCounts) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.Albums))
+	}
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Artists))
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Tracks))
+	}
+	{
+		const prefix string = ",\"playlists\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Playlists))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v searchCounts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp9(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *searchCounts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp9(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp10(in *jlexer.Lexer, out *searchArtistsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "artists":
+			(out.Artists).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp10(out *jwriter.Writer, in searchArtistsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix[1:])
+		(in.Artists).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v searchArtistsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp10(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *searchArtistsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp10(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp11(in *jlexer.Lexer, out *searchArtistFacet) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "artistID":
+			out.ArtistID = uint32(in.Uint32())
+		case "name":
+			out.Name = string(in.String())
+		case "count":
+			out.Count = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp11(out *jwriter.Writer, in searchArtistFacet) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"artistID\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ArtistID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v searchArtistFacet) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp11(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *searchArtistFacet) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp11(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp12(in *jlexer.Lexer, out *searchAlbumsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "albums":
+			(out.Albums).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp12(out *jwriter.Writer, in searchAlbumsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix[1:])
+		(in.Albums).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v searchAlbumsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp12(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *searchAlbumsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp4(l, v)
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp12(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp13(in *jlexer.Lexer, out *recentSearchesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recents":
+			if in.IsNull() {
+				in.Skip()
+				out.Recents = nil
+			} else {
+				in.Delim('[')
+				if out.Recents == nil {
+					if !in.IsDelim(']') {
+						out.Recents = make([]recentSearch, 0, 1)
+					} else {
+						out.Recents = []recentSearch{}
+					}
+				} else {
+					out.Recents = (out.Recents)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 recentSearch
+					(v7).UnmarshalEasyJSON(in)
+					out.Recents = append(out.Recents, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp13(out *jwriter.Writer, in recentSearchesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recents\":"
+		out.RawString(prefix[1:])
+		if in.Recents == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Recents {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v recentSearchesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp13(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *recentSearchesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp13(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp14(in *jlexer.Lexer, out *recentSearchRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "query":
+			out.Query = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp14(out *jwriter.Writer, in recentSearchRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v recentSearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp14(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *recentSearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp14(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp15(in *jlexer.Lexer, out *recentSearchEntity) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp15(out *jwriter.Writer, in recentSearchEntity) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v recentSearchEntity) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp15(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *recentSearchEntity) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp15(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp16(in *jlexer.Lexer, out *recentSearch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint32(in.Uint32())
+		case "query":
+			out.Query = string(in.String())
+		case "entity":
+			if in.IsNull() {
+				in.Skip()
+				out.Entity = nil
+			} else {
+				if out.Entity == nil {
+					out.Entity = new(recentSearchEntity)
+				}
+				(*out.Entity).UnmarshalEasyJSON(in)
+			}
+		case "searchedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.SearchedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp16(out *jwriter.Writer, in recentSearch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.ID))
+	}
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
+	if in.Entity != nil {
+		const prefix string = ",\"entity\":"
+		out.RawString(prefix)
+		(*in.Entity).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"searchedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.SearchedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v recentSearch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp16(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *recentSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp16(l, v)
+}
+func easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp17(in *jlexer.Lexer, out *defaultResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp17(out *jwriter.Writer, in defaultResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v defaultResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonE86c8d54EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp17(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *defaultResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonE86c8d54DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgSearchDeliveryHttp17(l, v)
 }
@@ -1 +1,509 @@
 package delivery
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	albumMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/mocks"
+	artistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/mocks"
+	playlistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/mocks"
+	searchMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search/mocks"
+	trackMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/mocks"
+	userMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/mocks"
+)
+
+var correctUser = models.User{
+	ID: 1,
+}
+
+func newTestHandler(c *gomock.Controller, su *searchMocks.MockUsecase) *Handler {
+	return NewHandler(su,
+		albumMocks.NewMockUsecase(c),
+		artistMocks.NewMockUsecase(c),
+		trackMocks.NewMockUsecase(c),
+		playlistMocks.NewMockUsecase(c),
+		userMocks.NewMockUsecase(c),
+		commonTests.MockLogger(c))
+}
+
+func TestSearchDeliveryHTTP_FindArtists(t *testing.T) {
+	// Init
+	type mockBehavior func(su *searchMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	su := searchMocks.NewMockUsecase(c)
+
+	h := newTestHandler(c, su)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Post("/api/artists/search", h.FindArtists)
+
+	// Test filling
+	const correctQuery = "<b>yarik</b>"
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		requestBody      string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:        "Common (escaped query remembered)",
+			user:        &correctUser,
+			requestBody: `{"query": "` + correctQuery + `", "amount": 10}`,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().FindArtists(gomock.Any(), correctQuery, uint32(10), correctUser.ID,
+					models.SearchFilters{}).Return([]models.Artist{}, nil)
+				su.EXPECT().AddRecentSearch(gomock.Any(), correctUser.ID,
+					models.RecentSearch{Query: "&lt;b&gt;yarik&lt;/b&gt;"}).Return(nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"artists": []}`,
+		},
+		{
+			name:        "No User (nothing remembered)",
+			user:        nil,
+			requestBody: `{"query": "` + correctQuery + `", "amount": 10}`,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().FindArtists(gomock.Any(), correctQuery, uint32(10), uint32(0),
+					models.SearchFilters{}).Return([]models.Artist{}, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"artists": []}`,
+		},
+		{
+			name:        "Remember Search Issue",
+			user:        &correctUser,
+			requestBody: `{"query": "yarik", "amount": 10}`,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().FindArtists(gomock.Any(), "yarik", uint32(10), correctUser.ID,
+					models.SearchFilters{}).Return([]models.Artist{}, nil)
+				su.EXPECT().AddRecentSearch(gomock.Any(), correctUser.ID,
+					models.RecentSearch{Query: "yarik"}).Return(errors.New(""))
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: `{"artists": []}`,
+		},
+		{
+			name:             "Too Long Query",
+			user:             &correctUser,
+			requestBody:      `{"query": "` + strings.Repeat("я", 201) + `", "amount": 10}`,
+			mockBehavior:     func(su *searchMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:             "Empty Query",
+			user:             &correctUser,
+			requestBody:      `{"query": "", "amount": 10}`,
+			mockBehavior:     func(su *searchMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:        "Server Error",
+			user:        &correctUser,
+			requestBody: `{"query": "yarik", "amount": 10}`,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().FindArtists(gomock.Any(), "yarik", uint32(10), correctUser.ID,
+					models.SearchFilters{}).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(artistsFindServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(su)
+
+			commonTests.DeliveryTestPost(t, r, "/api/artists/search", tc.requestBody,
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestSearchDeliveryHTTP_RecentSearches(t *testing.T) {
+	// Init
+	type mockBehavior func(su *searchMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	su := searchMocks.NewMockUsecase(c)
+
+	h := newTestHandler(c, su)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/search/recent", h.RecentSearches)
+
+	// Test filling
+	searchedAt := time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+	entityType := "artist"
+	entityID := uint32(2)
+	entityName := "YARIK"
+
+	recents := []models.RecentSearch{
+		{
+			ID:         2,
+			Query:      "yar",
+			EntityType: &entityType,
+			EntityID:   &entityID,
+			EntityName: &entityName,
+			SearchedAt: searchedAt,
+		},
+		{
+			ID:         1,
+			Query:      "&lt;yarik&gt;",
+			SearchedAt: searchedAt,
+		},
+	}
+
+	correctResponse := `{"recents": [
+		{
+			"id": 2,
+			"query": "yar",
+			"entity": {"type": "artist", "id": 2, "name": "YARIK"},
+			"searchedAt": "2023-05-01T12:00:00Z"
+		},
+		{
+			"id": 1,
+			"query": "&lt;yarik&gt;",
+			"searchedAt": "2023-05-01T12:00:00Z"
+		}
+	]}`
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		query            string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Common",
+			user: &correctUser,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().GetRecentSearches(gomock.Any(), correctUser.ID,
+					uint32(recentSearchesDefaultLimit)).Return(recents, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:  "With Limit",
+			user:  &correctUser,
+			query: "?limit=2",
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().GetRecentSearches(gomock.Any(), correctUser.ID, uint32(2)).Return(recents, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:             "No User",
+			user:             nil,
+			mockBehavior:     func(su *searchMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:             "Too Big Limit",
+			user:             &correctUser,
+			query:            "?limit=51",
+			mockBehavior:     func(su *searchMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
+		},
+		{
+			name: "Server Error",
+			user: &correctUser,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().GetRecentSearches(gomock.Any(), correctUser.ID,
+					uint32(recentSearchesDefaultLimit)).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(recentSearchesGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(su)
+
+			commonTests.DeliveryTestGet(t, r, "/api/search/recent"+tc.query,
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestSearchDeliveryHTTP_AddRecentSearch(t *testing.T) {
+	// Init
+	type mockBehavior func(su *searchMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	su := searchMocks.NewMockUsecase(c)
+
+	h := newTestHandler(c, su)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Post("/api/search/recent", h.AddRecentSearch)
+
+	// Test filling
+	correctRequestBody := `{
+		"query": "<yarik>",
+		"type": "artist",
+		"id": 2
+	}`
+
+	entityType := "artist"
+	entityID := uint32(2)
+	expectedRecent := models.RecentSearch{
+		Query:      "&lt;yarik&gt;",
+		EntityType: &entityType,
+		EntityID:   &entityID,
+	}
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		requestBody      string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:        "Common",
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().AddRecentSearch(gomock.Any(), correctUser.ID, expectedRecent).Return(nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(recentSearchAddedSuccessfully),
+		},
+		{
+			name:             "No User",
+			user:             nil,
+			requestBody:      correctRequestBody,
+			mockBehavior:     func(su *searchMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name: "Too Long Query",
+			user: &correctUser,
+			requestBody: `{
+				"query": "` + strings.Repeat("я", 201) + `",
+				"type": "artist",
+				"id": 2
+			}`,
+			mockBehavior:     func(su *searchMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name: "Incorrect Type",
+			user: &correctUser,
+			requestBody: `{
+				"query": "yarik",
+				"type": "user",
+				"id": 2
+			}`,
+			mockBehavior:     func(su *searchMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:        "Server Error",
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().AddRecentSearch(gomock.Any(), correctUser.ID, expectedRecent).Return(errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(recentSearchAddServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(su)
+
+			commonTests.DeliveryTestPost(t, r, "/api/search/recent", tc.requestBody,
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestSearchDeliveryHTTP_DeleteRecentSearch(t *testing.T) {
+	// Init
+	type mockBehavior func(su *searchMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	su := searchMocks.NewMockUsecase(c)
+
+	h := newTestHandler(c, su)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Delete("/api/search/recent/{recentSearchID}", h.DeleteRecentSearch)
+
+	// Test filling
+	const correctRecentSearchID uint32 = 1
+	correctRecentSearchIDPath := "1"
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		recentSearchID   string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:           "Common",
+			user:           &correctUser,
+			recentSearchID: correctRecentSearchIDPath,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().DeleteRecentSearch(gomock.Any(), correctRecentSearchID, correctUser.ID).Return(nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(recentSearchDeletedSuccessfully),
+		},
+		{
+			name:             "Incorrect ID In Path",
+			user:             &correctUser,
+			recentSearchID:   "-5",
+			mockBehavior:     func(su *searchMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidURLParameter),
+		},
+		{
+			name:             "No User",
+			user:             nil,
+			recentSearchID:   correctRecentSearchIDPath,
+			mockBehavior:     func(su *searchMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:           "No Recent Search To Delete",
+			user:           &correctUser,
+			recentSearchID: correctRecentSearchIDPath,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().DeleteRecentSearch(gomock.Any(), correctRecentSearchID, correctUser.ID).
+					Return(&models.NoSuchRecentSearchError{RecentSearchID: correctRecentSearchID})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(recentSearchNotFound),
+		},
+		{
+			name:           "Server Error",
+			user:           &correctUser,
+			recentSearchID: correctRecentSearchIDPath,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().DeleteRecentSearch(gomock.Any(), correctRecentSearchID, correctUser.ID).
+					Return(errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(recentSearchDeleteServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(su)
+
+			commonTests.DeliveryTestDelete(t, r, "/api/search/recent/"+tc.recentSearchID,
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestSearchDeliveryHTTP_ClearRecentSearches(t *testing.T) {
+	// Init
+	type mockBehavior func(su *searchMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	su := searchMocks.NewMockUsecase(c)
+
+	h := newTestHandler(c, su)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Delete("/api/search/recent", h.ClearRecentSearches)
+
+	// Test filling
+	testTable := []struct {
+		name             string
+		user             *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Common",
+			user: &correctUser,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().ClearRecentSearches(gomock.Any(), correctUser.ID).Return(nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(recentSearchesClearedSuccessfully),
+		},
+		{
+			name:             "No User",
+			user:             nil,
+			mockBehavior:     func(su *searchMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name: "Server Error",
+			user: &correctUser,
+			mockBehavior: func(su *searchMocks.MockUsecase) {
+				su.EXPECT().ClearRecentSearches(gomock.Any(), correctUser.ID).Return(errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(recentSearchesClearServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(su)
+
+			commonTests.DeliveryTestDelete(t, r, "/api/search/recent",
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
@@ -35,64 +35,151 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AddRecentSearch mocks base method.
+func (m *MockUsecase) AddRecentSearch(ctx context.Context, userID uint32, recent models.RecentSearch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRecentSearch", ctx, userID, recent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRecentSearch indicates an expected call of AddRecentSearch.
+func (mr *MockUsecaseMockRecorder) AddRecentSearch(ctx, userID, recent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecentSearch", reflect.TypeOf((*MockUsecase)(nil).AddRecentSearch), ctx, userID, recent)
+}
+
+// ClearRecentSearches mocks base method.
+func (m *MockUsecase) ClearRecentSearches(ctx context.Context, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearRecentSearches", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearRecentSearches indicates an expected call of ClearRecentSearches.
+func (mr *MockUsecaseMockRecorder) ClearRecentSearches(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRecentSearches", reflect.TypeOf((*MockUsecase)(nil).ClearRecentSearches), ctx, userID)
+}
+
+// DeleteRecentSearch mocks base method.
+func (m *MockUsecase) DeleteRecentSearch(ctx context.Context, recentSearchID, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecentSearch", ctx, recentSearchID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecentSearch indicates an expected call of DeleteRecentSearch.
+func (mr *MockUsecaseMockRecorder) DeleteRecentSearch(ctx, recentSearchID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecentSearch", reflect.TypeOf((*MockUsecase)(nil).DeleteRecentSearch), ctx, recentSearchID, userID)
+}
+
 // FindAlbums mocks base method.
-func (m *MockUsecase) FindAlbums(ctx context.Context, query string, amount uint32) ([]models.Album, error) {
+func (m *MockUsecase) FindAlbums(ctx context.Context, query string, amount, userID uint32, f models.SearchFilters) ([]models.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAlbums", ctx, query, amount)
+	ret := m.ctrl.Call(m, "FindAlbums", ctx, query, amount, userID, f)
 	ret0, _ := ret[0].([]models.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAlbums indicates an expected call of FindAlbums.
-func (mr *MockUsecaseMockRecorder) FindAlbums(ctx, query, amount interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) FindAlbums(ctx, query, amount, userID, f interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAlbums", reflect.TypeOf((*MockUsecase)(nil).FindAlbums), ctx, query, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAlbums", reflect.TypeOf((*MockUsecase)(nil).FindAlbums), ctx, query, amount, userID, f)
 }
 
 // FindArtists mocks base method.
-func (m *MockUsecase) FindArtists(ctx context.Context, query string, amount uint32) ([]models.Artist, error) {
+func (m *MockUsecase) FindArtists(ctx context.Context, query string, amount, userID uint32, f models.SearchFilters) ([]models.Artist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindArtists", ctx, query, amount)
+	ret := m.ctrl.Call(m, "FindArtists", ctx, query, amount, userID, f)
 	ret0, _ := ret[0].([]models.Artist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindArtists indicates an expected call of FindArtists.
-func (mr *MockUsecaseMockRecorder) FindArtists(ctx, query, amount interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) FindArtists(ctx, query, amount, userID, f interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindArtists", reflect.TypeOf((*MockUsecase)(nil).FindArtists), ctx, query, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindArtists", reflect.TypeOf((*MockUsecase)(nil).FindArtists), ctx, query, amount, userID, f)
 }
 
 // FindPlaylists mocks base method.
-func (m *MockUsecase) FindPlaylists(ctx context.Context, query string, amount uint32) ([]models.Playlist, error) {
+func (m *MockUsecase) FindPlaylists(ctx context.Context, query string, amount, userID uint32, f models.SearchFilters) ([]models.Playlist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPlaylists", ctx, query, amount)
+	ret := m.ctrl.Call(m, "FindPlaylists", ctx, query, amount, userID, f)
 	ret0, _ := ret[0].([]models.Playlist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindPlaylists indicates an expected call of FindPlaylists.
-func (mr *MockUsecaseMockRecorder) FindPlaylists(ctx, query, amount interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) FindPlaylists(ctx, query, amount, userID, f interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPlaylists", reflect.TypeOf((*MockUsecase)(nil).FindPlaylists), ctx, query, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPlaylists", reflect.TypeOf((*MockUsecase)(nil).FindPlaylists), ctx, query, amount, userID, f)
 }
 
 // FindTracks mocks base method.
-func (m *MockUsecase) FindTracks(ctx context.Context, query string, amount uint32) ([]models.Track, error) {
+func (m *MockUsecase) FindTracks(ctx context.Context, query string, amount, userID uint32, f models.SearchFilters) ([]models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindTracks", ctx, query, amount)
+	ret := m.ctrl.Call(m, "FindTracks", ctx, query, amount, userID, f)
 	ret0, _ := ret[0].([]models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindTracks indicates an expected call of FindTracks.
-func (mr *MockUsecaseMockRecorder) FindTracks(ctx, query, amount interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) FindTracks(ctx, query, amount, userID, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTracks", reflect.TypeOf((*MockUsecase)(nil).FindTracks), ctx, query, amount, userID, f)
+}
+
+// GetRecentSearches mocks base method.
+func (m *MockUsecase) GetRecentSearches(ctx context.Context, userID, limit uint32) ([]models.RecentSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentSearches", ctx, userID, limit)
+	ret0, _ := ret[0].([]models.RecentSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentSearches indicates an expected call of GetRecentSearches.
+func (mr *MockUsecaseMockRecorder) GetRecentSearches(ctx, userID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTracks", reflect.TypeOf((*MockUsecase)(nil).FindTracks), ctx, query, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentSearches", reflect.TypeOf((*MockUsecase)(nil).GetRecentSearches), ctx, userID, limit)
+}
+
+// Search mocks base method.
+func (m *MockUsecase) Search(ctx context.Context, query string, amount, userID uint32, f models.SearchFilters) (*models.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, amount, userID, f)
+	ret0, _ := ret[0].(*models.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockUsecaseMockRecorder) Search(ctx, query, amount, userID, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUsecase)(nil).Search), ctx, query, amount, userID, f)
+}
+
+// Suggest mocks base method.
+func (m *MockUsecase) Suggest(ctx context.Context, prefix string, amount, userID uint32) ([]models.SearchSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, prefix, amount, userID)
+	ret0, _ := ret[0].([]models.SearchSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockUsecaseMockRecorder) Suggest(ctx, prefix, amount, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockUsecase)(nil).Suggest), ctx, prefix, amount, userID)
 }
 
 // MockRepository is a mock of Repository interface.
@@ -118,64 +205,501 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddRecentSearch mocks base method.
+func (m *MockRepository) AddRecentSearch(ctx context.Context, userID uint32, recent models.RecentSearch, keep uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRecentSearch", ctx, userID, recent, keep)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRecentSearch indicates an expected call of AddRecentSearch.
+func (mr *MockRepositoryMockRecorder) AddRecentSearch(ctx, userID, recent, keep interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecentSearch", reflect.TypeOf((*MockRepository)(nil).AddRecentSearch), ctx, userID, recent, keep)
+}
+
+// ClearRecentSearches mocks base method.
+func (m *MockRepository) ClearRecentSearches(ctx context.Context, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearRecentSearches", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearRecentSearches indicates an expected call of ClearRecentSearches.
+func (mr *MockRepositoryMockRecorder) ClearRecentSearches(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRecentSearches", reflect.TypeOf((*MockRepository)(nil).ClearRecentSearches), ctx, userID)
+}
+
+// DeleteRecentSearch mocks base method.
+func (m *MockRepository) DeleteRecentSearch(ctx context.Context, recentSearchID, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecentSearch", ctx, recentSearchID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecentSearch indicates an expected call of DeleteRecentSearch.
+func (mr *MockRepositoryMockRecorder) DeleteRecentSearch(ctx, recentSearchID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecentSearch", reflect.TypeOf((*MockRepository)(nil).DeleteRecentSearch), ctx, recentSearchID, userID)
+}
+
 // FullTextSearchAlbums mocks base method.
-func (m *MockRepository) FullTextSearchAlbums(ctx context.Context, query string, limit uint32) ([]models.Album, error) {
+func (m *MockRepository) FullTextSearchAlbums(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters) ([]models.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FullTextSearchAlbums", ctx, query, limit)
+	ret := m.ctrl.Call(m, "FullTextSearchAlbums", ctx, query, limit, userID, f)
 	ret0, _ := ret[0].([]models.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FullTextSearchAlbums indicates an expected call of FullTextSearchAlbums.
-func (mr *MockRepositoryMockRecorder) FullTextSearchAlbums(ctx, query, limit interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) FullTextSearchAlbums(ctx, query, limit, userID, f interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchAlbums", reflect.TypeOf((*MockRepository)(nil).FullTextSearchAlbums), ctx, query, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchAlbums", reflect.TypeOf((*MockRepository)(nil).FullTextSearchAlbums), ctx, query, limit, userID, f)
 }
 
 // FullTextSearchArtists mocks base method.
-func (m *MockRepository) FullTextSearchArtists(ctx context.Context, query string, limit uint32) ([]models.Artist, error) {
+func (m *MockRepository) FullTextSearchArtists(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters) ([]models.Artist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FullTextSearchArtists", ctx, query, limit)
+	ret := m.ctrl.Call(m, "FullTextSearchArtists", ctx, query, limit, userID, f)
 	ret0, _ := ret[0].([]models.Artist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FullTextSearchArtists indicates an expected call of FullTextSearchArtists.
-func (mr *MockRepositoryMockRecorder) FullTextSearchArtists(ctx, query, limit interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) FullTextSearchArtists(ctx, query, limit, userID, f interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchArtists", reflect.TypeOf((*MockRepository)(nil).FullTextSearchArtists), ctx, query, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchArtists", reflect.TypeOf((*MockRepository)(nil).FullTextSearchArtists), ctx, query, limit, userID, f)
 }
 
 // FullTextSearchPlaylists mocks base method.
-func (m *MockRepository) FullTextSearchPlaylists(ctx context.Context, query string, limit uint32) ([]models.Playlist, error) {
+func (m *MockRepository) FullTextSearchPlaylists(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters) ([]models.Playlist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FullTextSearchPlaylists", ctx, query, limit)
+	ret := m.ctrl.Call(m, "FullTextSearchPlaylists", ctx, query, limit, userID, f)
 	ret0, _ := ret[0].([]models.Playlist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FullTextSearchPlaylists indicates an expected call of FullTextSearchPlaylists.
-func (mr *MockRepositoryMockRecorder) FullTextSearchPlaylists(ctx, query, limit interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) FullTextSearchPlaylists(ctx, query, limit, userID, f interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchPlaylists", reflect.TypeOf((*MockRepository)(nil).FullTextSearchPlaylists), ctx, query, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchPlaylists", reflect.TypeOf((*MockRepository)(nil).FullTextSearchPlaylists), ctx, query, limit, userID, f)
 }
 
 // FullTextSearchTracks mocks base method.
-func (m *MockRepository) FullTextSearchTracks(ctx context.Context, query string, limit uint32) ([]models.Track, error) {
+func (m *MockRepository) FullTextSearchTracks(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters) ([]models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FullTextSearchTracks", ctx, query, limit)
+	ret := m.ctrl.Call(m, "FullTextSearchTracks", ctx, query, limit, userID, f)
 	ret0, _ := ret[0].([]models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FullTextSearchTracks indicates an expected call of FullTextSearchTracks.
-func (mr *MockRepositoryMockRecorder) FullTextSearchTracks(ctx, query, limit interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) FullTextSearchTracks(ctx, query, limit, userID, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchTracks", reflect.TypeOf((*MockRepository)(nil).FullTextSearchTracks), ctx, query, limit, userID, f)
+}
+
+// FuzzySearchAlbums mocks base method.
+func (m *MockRepository) FuzzySearchAlbums(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters, threshold float64) ([]models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuzzySearchAlbums", ctx, query, limit, userID, f, threshold)
+	ret0, _ := ret[0].([]models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FuzzySearchAlbums indicates an expected call of FuzzySearchAlbums.
+func (mr *MockRepositoryMockRecorder) FuzzySearchAlbums(ctx, query, limit, userID, f, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzySearchAlbums", reflect.TypeOf((*MockRepository)(nil).FuzzySearchAlbums), ctx, query, limit, userID, f, threshold)
+}
+
+// FuzzySearchArtists mocks base method.
+func (m *MockRepository) FuzzySearchArtists(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters, threshold float64) ([]models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuzzySearchArtists", ctx, query, limit, userID, f, threshold)
+	ret0, _ := ret[0].([]models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FuzzySearchArtists indicates an expected call of FuzzySearchArtists.
+func (mr *MockRepositoryMockRecorder) FuzzySearchArtists(ctx, query, limit, userID, f, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzySearchArtists", reflect.TypeOf((*MockRepository)(nil).FuzzySearchArtists), ctx, query, limit, userID, f, threshold)
+}
+
+// FuzzySearchPlaylists mocks base method.
+func (m *MockRepository) FuzzySearchPlaylists(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters, threshold float64) ([]models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuzzySearchPlaylists", ctx, query, limit, userID, f, threshold)
+	ret0, _ := ret[0].([]models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FuzzySearchPlaylists indicates an expected call of FuzzySearchPlaylists.
+func (mr *MockRepositoryMockRecorder) FuzzySearchPlaylists(ctx, query, limit, userID, f, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzySearchPlaylists", reflect.TypeOf((*MockRepository)(nil).FuzzySearchPlaylists), ctx, query, limit, userID, f, threshold)
+}
+
+// FuzzySearchTracks mocks base method.
+func (m *MockRepository) FuzzySearchTracks(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters, threshold float64) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuzzySearchTracks", ctx, query, limit, userID, f, threshold)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FuzzySearchTracks indicates an expected call of FuzzySearchTracks.
+func (mr *MockRepositoryMockRecorder) FuzzySearchTracks(ctx, query, limit, userID, f, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzySearchTracks", reflect.TypeOf((*MockRepository)(nil).FuzzySearchTracks), ctx, query, limit, userID, f, threshold)
+}
+
+// GetClickedEntities mocks base method.
+func (m *MockRepository) GetClickedEntities(ctx context.Context, userID uint32) ([]models.SearchEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClickedEntities", ctx, userID)
+	ret0, _ := ret[0].([]models.SearchEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClickedEntities indicates an expected call of GetClickedEntities.
+func (mr *MockRepositoryMockRecorder) GetClickedEntities(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClickedEntities", reflect.TypeOf((*MockRepository)(nil).GetClickedEntities), ctx, userID)
+}
+
+// GetFacets mocks base method.
+func (m *MockRepository) GetFacets(ctx context.Context, query string, userID uint32, f models.SearchFilters, artistsLimit uint32) (*models.SearchFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFacets", ctx, query, userID, f, artistsLimit)
+	ret0, _ := ret[0].(*models.SearchFacets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFacets indicates an expected call of GetFacets.
+func (mr *MockRepositoryMockRecorder) GetFacets(ctx, query, userID, f, artistsLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacets", reflect.TypeOf((*MockRepository)(nil).GetFacets), ctx, query, userID, f, artistsLimit)
+}
+
+// GetRecentSearches mocks base method.
+func (m *MockRepository) GetRecentSearches(ctx context.Context, userID, limit uint32) ([]models.RecentSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentSearches", ctx, userID, limit)
+	ret0, _ := ret[0].([]models.RecentSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentSearches indicates an expected call of GetRecentSearches.
+func (mr *MockRepositoryMockRecorder) GetRecentSearches(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentSearches", reflect.TypeOf((*MockRepository)(nil).GetRecentSearches), ctx, userID, limit)
+}
+
+// GetSuggestions mocks base method.
+func (m *MockRepository) GetSuggestions(ctx context.Context, entityType string, afterID uint32) ([]models.SearchSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuggestions", ctx, entityType, afterID)
+	ret0, _ := ret[0].([]models.SearchSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuggestions indicates an expected call of GetSuggestions.
+func (mr *MockRepositoryMockRecorder) GetSuggestions(ctx, entityType, afterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuggestions", reflect.TypeOf((*MockRepository)(nil).GetSuggestions), ctx, entityType, afterID)
+}
+
+// MockIndexSource is a mock of IndexSource interface.
+type MockIndexSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexSourceMockRecorder
+}
+
+// MockIndexSourceMockRecorder is the mock recorder for MockIndexSource.
+type MockIndexSourceMockRecorder struct {
+	mock *MockIndexSource
+}
+
+// NewMockIndexSource creates a new mock instance.
+func NewMockIndexSource(ctrl *gomock.Controller) *MockIndexSource {
+	mock := &MockIndexSource{ctrl: ctrl}
+	mock.recorder = &MockIndexSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIndexSource) EXPECT() *MockIndexSourceMockRecorder {
+	return m.recorder
+}
+
+// AddRecentSearch mocks base method.
+func (m *MockIndexSource) AddRecentSearch(ctx context.Context, userID uint32, recent models.RecentSearch, keep uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRecentSearch", ctx, userID, recent, keep)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRecentSearch indicates an expected call of AddRecentSearch.
+func (mr *MockIndexSourceMockRecorder) AddRecentSearch(ctx, userID, recent, keep interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRecentSearch", reflect.TypeOf((*MockIndexSource)(nil).AddRecentSearch), ctx, userID, recent, keep)
+}
+
+// ClearRecentSearches mocks base method.
+func (m *MockIndexSource) ClearRecentSearches(ctx context.Context, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearRecentSearches", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearRecentSearches indicates an expected call of ClearRecentSearches.
+func (mr *MockIndexSourceMockRecorder) ClearRecentSearches(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRecentSearches", reflect.TypeOf((*MockIndexSource)(nil).ClearRecentSearches), ctx, userID)
+}
+
+// DeleteRecentSearch mocks base method.
+func (m *MockIndexSource) DeleteRecentSearch(ctx context.Context, recentSearchID, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecentSearch", ctx, recentSearchID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecentSearch indicates an expected call of DeleteRecentSearch.
+func (mr *MockIndexSourceMockRecorder) DeleteRecentSearch(ctx, recentSearchID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchTracks", reflect.TypeOf((*MockRepository)(nil).FullTextSearchTracks), ctx, query, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecentSearch", reflect.TypeOf((*MockIndexSource)(nil).DeleteRecentSearch), ctx, recentSearchID, userID)
+}
+
+// FullTextSearchAlbums mocks base method.
+func (m *MockIndexSource) FullTextSearchAlbums(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters) ([]models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullTextSearchAlbums", ctx, query, limit, userID, f)
+	ret0, _ := ret[0].([]models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FullTextSearchAlbums indicates an expected call of FullTextSearchAlbums.
+func (mr *MockIndexSourceMockRecorder) FullTextSearchAlbums(ctx, query, limit, userID, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchAlbums", reflect.TypeOf((*MockIndexSource)(nil).FullTextSearchAlbums), ctx, query, limit, userID, f)
+}
+
+// FullTextSearchArtists mocks base method.
+func (m *MockIndexSource) FullTextSearchArtists(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters) ([]models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullTextSearchArtists", ctx, query, limit, userID, f)
+	ret0, _ := ret[0].([]models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FullTextSearchArtists indicates an expected call of FullTextSearchArtists.
+func (mr *MockIndexSourceMockRecorder) FullTextSearchArtists(ctx, query, limit, userID, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchArtists", reflect.TypeOf((*MockIndexSource)(nil).FullTextSearchArtists), ctx, query, limit, userID, f)
+}
+
+// FullTextSearchPlaylists mocks base method.
+func (m *MockIndexSource) FullTextSearchPlaylists(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters) ([]models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullTextSearchPlaylists", ctx, query, limit, userID, f)
+	ret0, _ := ret[0].([]models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FullTextSearchPlaylists indicates an expected call of FullTextSearchPlaylists.
+func (mr *MockIndexSourceMockRecorder) FullTextSearchPlaylists(ctx, query, limit, userID, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchPlaylists", reflect.TypeOf((*MockIndexSource)(nil).FullTextSearchPlaylists), ctx, query, limit, userID, f)
+}
+
+// FullTextSearchTracks mocks base method.
+func (m *MockIndexSource) FullTextSearchTracks(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullTextSearchTracks", ctx, query, limit, userID, f)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FullTextSearchTracks indicates an expected call of FullTextSearchTracks.
+func (mr *MockIndexSourceMockRecorder) FullTextSearchTracks(ctx, query, limit, userID, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullTextSearchTracks", reflect.TypeOf((*MockIndexSource)(nil).FullTextSearchTracks), ctx, query, limit, userID, f)
+}
+
+// FuzzySearchAlbums mocks base method.
+func (m *MockIndexSource) FuzzySearchAlbums(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters, threshold float64) ([]models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuzzySearchAlbums", ctx, query, limit, userID, f, threshold)
+	ret0, _ := ret[0].([]models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FuzzySearchAlbums indicates an expected call of FuzzySearchAlbums.
+func (mr *MockIndexSourceMockRecorder) FuzzySearchAlbums(ctx, query, limit, userID, f, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzySearchAlbums", reflect.TypeOf((*MockIndexSource)(nil).FuzzySearchAlbums), ctx, query, limit, userID, f, threshold)
+}
+
+// FuzzySearchArtists mocks base method.
+func (m *MockIndexSource) FuzzySearchArtists(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters, threshold float64) ([]models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuzzySearchArtists", ctx, query, limit, userID, f, threshold)
+	ret0, _ := ret[0].([]models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FuzzySearchArtists indicates an expected call of FuzzySearchArtists.
+func (mr *MockIndexSourceMockRecorder) FuzzySearchArtists(ctx, query, limit, userID, f, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzySearchArtists", reflect.TypeOf((*MockIndexSource)(nil).FuzzySearchArtists), ctx, query, limit, userID, f, threshold)
+}
+
+// FuzzySearchPlaylists mocks base method.
+func (m *MockIndexSource) FuzzySearchPlaylists(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters, threshold float64) ([]models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuzzySearchPlaylists", ctx, query, limit, userID, f, threshold)
+	ret0, _ := ret[0].([]models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FuzzySearchPlaylists indicates an expected call of FuzzySearchPlaylists.
+func (mr *MockIndexSourceMockRecorder) FuzzySearchPlaylists(ctx, query, limit, userID, f, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzySearchPlaylists", reflect.TypeOf((*MockIndexSource)(nil).FuzzySearchPlaylists), ctx, query, limit, userID, f, threshold)
+}
+
+// FuzzySearchTracks mocks base method.
+func (m *MockIndexSource) FuzzySearchTracks(ctx context.Context, query string, limit, userID uint32, f models.SearchFilters, threshold float64) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FuzzySearchTracks", ctx, query, limit, userID, f, threshold)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FuzzySearchTracks indicates an expected call of FuzzySearchTracks.
+func (mr *MockIndexSourceMockRecorder) FuzzySearchTracks(ctx, query, limit, userID, f, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FuzzySearchTracks", reflect.TypeOf((*MockIndexSource)(nil).FuzzySearchTracks), ctx, query, limit, userID, f, threshold)
+}
+
+// GetClickedEntities mocks base method.
+func (m *MockIndexSource) GetClickedEntities(ctx context.Context, userID uint32) ([]models.SearchEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClickedEntities", ctx, userID)
+	ret0, _ := ret[0].([]models.SearchEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClickedEntities indicates an expected call of GetClickedEntities.
+func (mr *MockIndexSourceMockRecorder) GetClickedEntities(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClickedEntities", reflect.TypeOf((*MockIndexSource)(nil).GetClickedEntities), ctx, userID)
+}
+
+// GetFacets mocks base method.
+func (m *MockIndexSource) GetFacets(ctx context.Context, query string, userID uint32, f models.SearchFilters, artistsLimit uint32) (*models.SearchFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFacets", ctx, query, userID, f, artistsLimit)
+	ret0, _ := ret[0].(*models.SearchFacets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFacets indicates an expected call of GetFacets.
+func (mr *MockIndexSourceMockRecorder) GetFacets(ctx, query, userID, f, artistsLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacets", reflect.TypeOf((*MockIndexSource)(nil).GetFacets), ctx, query, userID, f, artistsLimit)
+}
+
+// GetLikedIDs mocks base method.
+func (m *MockIndexSource) GetLikedIDs(ctx context.Context, entityType string, userID uint32) ([]uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLikedIDs", ctx, entityType, userID)
+	ret0, _ := ret[0].([]uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLikedIDs indicates an expected call of GetLikedIDs.
+func (mr *MockIndexSourceMockRecorder) GetLikedIDs(ctx, entityType, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedIDs", reflect.TypeOf((*MockIndexSource)(nil).GetLikedIDs), ctx, entityType, userID)
+}
+
+// GetRecentSearches mocks base method.
+func (m *MockIndexSource) GetRecentSearches(ctx context.Context, userID, limit uint32) ([]models.RecentSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentSearches", ctx, userID, limit)
+	ret0, _ := ret[0].([]models.RecentSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentSearches indicates an expected call of GetRecentSearches.
+func (mr *MockIndexSourceMockRecorder) GetRecentSearches(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentSearches", reflect.TypeOf((*MockIndexSource)(nil).GetRecentSearches), ctx, userID, limit)
+}
+
+// GetSearchDocuments mocks base method.
+func (m *MockIndexSource) GetSearchDocuments(ctx context.Context, entityType string, afterID uint32) ([]models.SearchDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchDocuments", ctx, entityType, afterID)
+	ret0, _ := ret[0].([]models.SearchDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchDocuments indicates an expected call of GetSearchDocuments.
+func (mr *MockIndexSourceMockRecorder) GetSearchDocuments(ctx, entityType, afterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchDocuments", reflect.TypeOf((*MockIndexSource)(nil).GetSearchDocuments), ctx, entityType, afterID)
+}
+
+// GetSuggestions mocks base method.
+func (m *MockIndexSource) GetSuggestions(ctx context.Context, entityType string, afterID uint32) ([]models.SearchSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuggestions", ctx, entityType, afterID)
+	ret0, _ := ret[0].([]models.SearchSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuggestions indicates an expected call of GetSuggestions.
+func (mr *MockIndexSourceMockRecorder) GetSuggestions(ctx, entityType, afterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuggestions", reflect.TypeOf((*MockIndexSource)(nil).GetSuggestions), ctx, entityType, afterID)
 }
 
 // MockTables is a mock of Tables interface.
@@ -229,6 +753,104 @@ func (mr *MockTablesMockRecorder) Artists() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Artists", reflect.TypeOf((*MockTables)(nil).Artists))
 }
 
+// ArtistsAlbums mocks base method.
+func (m *MockTables) ArtistsAlbums() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistsAlbums")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ArtistsAlbums indicates an expected call of ArtistsAlbums.
+func (mr *MockTablesMockRecorder) ArtistsAlbums() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistsAlbums", reflect.TypeOf((*MockTables)(nil).ArtistsAlbums))
+}
+
+// ArtistsTracks mocks base method.
+func (m *MockTables) ArtistsTracks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArtistsTracks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ArtistsTracks indicates an expected call of ArtistsTracks.
+func (mr *MockTablesMockRecorder) ArtistsTracks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArtistsTracks", reflect.TypeOf((*MockTables)(nil).ArtistsTracks))
+}
+
+// LikedAlbums mocks base method.
+func (m *MockTables) LikedAlbums() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedAlbums")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedAlbums indicates an expected call of LikedAlbums.
+func (mr *MockTablesMockRecorder) LikedAlbums() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedAlbums", reflect.TypeOf((*MockTables)(nil).LikedAlbums))
+}
+
+// LikedArtists mocks base method.
+func (m *MockTables) LikedArtists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedArtists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedArtists indicates an expected call of LikedArtists.
+func (mr *MockTablesMockRecorder) LikedArtists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedArtists", reflect.TypeOf((*MockTables)(nil).LikedArtists))
+}
+
+// LikedPlaylists mocks base method.
+func (m *MockTables) LikedPlaylists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedPlaylists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedPlaylists indicates an expected call of LikedPlaylists.
+func (mr *MockTablesMockRecorder) LikedPlaylists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedPlaylists", reflect.TypeOf((*MockTables)(nil).LikedPlaylists))
+}
+
+// LikedTracks mocks base method.
+func (m *MockTables) LikedTracks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikedTracks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// LikedTracks indicates an expected call of LikedTracks.
+func (mr *MockTablesMockRecorder) LikedTracks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedTracks", reflect.TypeOf((*MockTables)(nil).LikedTracks))
+}
+
+// Lyrics mocks base method.
+func (m *MockTables) Lyrics() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lyrics")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Lyrics indicates an expected call of Lyrics.
+func (mr *MockTablesMockRecorder) Lyrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lyrics", reflect.TypeOf((*MockTables)(nil).Lyrics))
+}
+
 // Playlists mocks base method.
 func (m *MockTables) Playlists() string {
 	m.ctrl.T.Helper()
@@ -243,6 +865,34 @@ func (mr *MockTablesMockRecorder) Playlists() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Playlists", reflect.TypeOf((*MockTables)(nil).Playlists))
 }
 
+// PlaylistsTracks mocks base method.
+func (m *MockTables) PlaylistsTracks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistsTracks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// PlaylistsTracks indicates an expected call of PlaylistsTracks.
+func (mr *MockTablesMockRecorder) PlaylistsTracks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistsTracks", reflect.TypeOf((*MockTables)(nil).PlaylistsTracks))
+}
+
+// RecentSearches mocks base method.
+func (m *MockTables) RecentSearches() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecentSearches")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// RecentSearches indicates an expected call of RecentSearches.
+func (mr *MockTablesMockRecorder) RecentSearches() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecentSearches", reflect.TypeOf((*MockTables)(nil).RecentSearches))
+}
+
 // Tracks mocks base method.
 func (m *MockTables) Tracks() string {
 	m.ctrl.T.Helper()
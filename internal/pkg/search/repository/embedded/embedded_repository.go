@@ -0,0 +1,468 @@
+package embedded
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search"
+)
+
+// indexedTypes are types of entities stored in index
+var indexedTypes = []string{
+	models.SearchTypeAlbum, models.SearchTypeArtist, models.SearchTypeTrack, models.SearchTypePlaylist,
+}
+
+// Repository implements search.Repository with full-text index stored on disk.
+// Index is built from source, which also serves users' likes and recent searches.
+type Repository struct {
+	source  search.IndexSource
+	weights search.RankWeights
+	path    string
+
+	index *Index
+	// updateMu serializes reindexing, so documents aren't fetched twice
+	updateMu sync.Mutex
+}
+
+func NewRepository(source search.IndexSource, w search.RankWeights, path string) *Repository {
+	return &Repository{
+		source:  source,
+		weights: w,
+		path:    path,
+		index:   NewIndex(),
+	}
+}
+
+// Open loads index saved on disk. If there is no index yet or it's saved
+// in outdated format, it's built from source.
+func (r *Repository) Open(ctx context.Context) error {
+	f, err := os.Open(r.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r.Reindex(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("(repo) can't open search index: %w", err)
+	}
+	defer f.Close()
+
+	if err := r.index.Load(f); err != nil {
+		if errors.Is(err, errIndexVersion) {
+			return r.Reindex(ctx)
+		}
+		return fmt.Errorf("(repo) can't load search index: %w", err)
+	}
+
+	return nil
+}
+
+// Reindex rebuilds index from scratch and saves it, so renamed
+// and deleted entities, changed popularity and lyrics are handled
+func (r *Repository) Reindex(ctx context.Context) error {
+	r.updateMu.Lock()
+	defer r.updateMu.Unlock()
+
+	var docs []models.SearchDocument
+	for _, entityType := range indexedTypes {
+		found, err := r.source.GetSearchDocuments(ctx, entityType, 0)
+		if err != nil {
+			return fmt.Errorf("(repo) can't get search documents of type %s: %w", entityType, err)
+		}
+		docs = append(docs, found...)
+	}
+	r.index.Replace(docs)
+
+	return r.save()
+}
+
+// Update adds entities created since previous update to index and saves it if anything was added
+func (r *Repository) Update(ctx context.Context) error {
+	r.updateMu.Lock()
+	defer r.updateMu.Unlock()
+
+	var docs []models.SearchDocument
+	for _, entityType := range indexedTypes {
+		found, err := r.source.GetSearchDocuments(ctx, entityType, r.index.LastID(entityType))
+		if err != nil {
+			return fmt.Errorf("(repo) can't get search documents of type %s: %w", entityType, err)
+		}
+		docs = append(docs, found...)
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+	r.index.Add(docs)
+
+	return r.save()
+}
+
+// save writes index to temporary file and renames it, so index on disk is never half-written
+func (r *Repository) save() error {
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("(repo) can't create search index file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := r.index.Save(tmp); err != nil {
+		tmp.Close()
+		return fmt.Errorf("(repo) can't save search index: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("(repo) can't save search index: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), r.path); err != nil {
+		return fmt.Errorf("(repo) can't replace search index file: %w", err)
+	}
+
+	return nil
+}
+
+// rankedHit is hit with relevance mixed with popularity
+type rankedHit struct {
+	hit
+	rank float64
+}
+
+// find filters hits and returns up to limit of them with the greatest rank,
+// which mixes relevance with popularity the same way database search does
+func (r *Repository) find(ctx context.Context, hits []hit, entityType string,
+	limit uint32, userID uint32, f models.SearchFilters) ([]rankedHit, error) {
+
+	liked, err := r.likedSet(ctx, entityType, userID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]rankedHit, 0, len(hits))
+	for _, h := range hits {
+		_, isLiked := liked[h.doc.ID]
+		if !passes(h.doc, f, isLiked) {
+			continue
+		}
+
+		rank := h.relevance +
+			r.weights.Listens*math.Log1p(float64(h.doc.Listens)) +
+			r.weights.Likes*math.Log1p(float64(h.doc.Likes))
+		if isLiked {
+			rank += r.weights.Personal
+		}
+		ranked = append(ranked, rankedHit{hit: h, rank: rank})
+	}
+
+	sort.Slice(ranked, func(a, b int) bool {
+		if ranked[a].rank != ranked[b].rank {
+			return ranked[a].rank > ranked[b].rank
+		}
+		return ranked[a].doc.ID < ranked[b].doc.ID
+	})
+	if uint32(len(ranked)) > limit {
+		ranked = ranked[:limit]
+	}
+
+	return ranked, nil
+}
+
+// likedSet returns IDs of entities liked by user if they are needed for ranking or filtering
+func (r *Repository) likedSet(ctx context.Context,
+	entityType string, userID uint32, f models.SearchFilters) (map[uint32]struct{}, error) {
+
+	if userID == 0 || (r.weights.Personal == 0 && !f.OnlyLiked) {
+		return nil, nil
+	}
+
+	ids, err := r.source.GetLikedIDs(ctx, entityType, userID)
+	if err != nil {
+		return nil, fmt.Errorf("(repo) can't get liked entities: %w", err)
+	}
+
+	liked := make(map[uint32]struct{}, len(ids))
+	for _, id := range ids {
+		liked[id] = struct{}{}
+	}
+
+	return liked, nil
+}
+
+// passes checks document against filters applicable to its type
+func passes(doc *models.SearchDocument, f models.SearchFilters, liked bool) bool {
+	if f.OnlyLiked && !liked {
+		return false
+	}
+	if f.AddedAfter != nil && !doc.CreatedAt.After(*f.AddedAfter) {
+		return false
+	}
+
+	if f.ArtistID != 0 && (doc.Type == models.SearchTypeAlbum || doc.Type == models.SearchTypeTrack) {
+		found := false
+		for _, artistID := range doc.ArtistIDs {
+			if artistID == f.ArtistID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if doc.Type == models.SearchTypeTrack {
+		if f.MinDuration != 0 && doc.Track.Duration < f.MinDuration {
+			return false
+		}
+		if f.MaxDuration != 0 && doc.Track.Duration > f.MaxDuration {
+			return false
+		}
+		switch f.TrackKind {
+		case models.SearchTrackKindAlbum:
+			return doc.Track.AlbumID != nil
+		case models.SearchTrackKindSingle:
+			return doc.Track.AlbumID == nil
+		}
+	}
+
+	return true
+}
+
+func (r *Repository) searchAlbums(ctx context.Context, hits []hit,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Album, error) {
+
+	ranked, err := r.find(ctx, hits, models.SearchTypeAlbum, limit, userID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	albums := make([]models.Album, 0, len(ranked))
+	for _, h := range ranked {
+		album := *h.doc.Album
+		album.Highlight = highlight(album.Name, h.matched)
+		albums = append(albums, album)
+	}
+
+	return albums, nil
+}
+
+func (r *Repository) searchArtists(ctx context.Context, hits []hit,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Artist, error) {
+
+	ranked, err := r.find(ctx, hits, models.SearchTypeArtist, limit, userID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	artists := make([]models.Artist, 0, len(ranked))
+	for _, h := range ranked {
+		artist := *h.doc.Artist
+		artist.Highlight = highlight(artist.Name, h.matched)
+		artists = append(artists, artist)
+	}
+
+	return artists, nil
+}
+
+func (r *Repository) searchTracks(ctx context.Context, hits []hit,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Track, error) {
+
+	ranked, err := r.find(ctx, hits, models.SearchTypeTrack, limit, userID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := make([]models.Track, 0, len(ranked))
+	for _, h := range ranked {
+		track := *h.doc.Track
+		track.Highlight = highlight(track.Name, h.matched)
+		track.LyricsSnippet = h.snippet
+		tracks = append(tracks, track)
+	}
+
+	return tracks, nil
+}
+
+func (r *Repository) searchPlaylists(ctx context.Context, hits []hit,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Playlist, error) {
+
+	ranked, err := r.find(ctx, hits, models.SearchTypePlaylist, limit, userID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	playlists := make([]models.Playlist, 0, len(ranked))
+	for _, h := range ranked {
+		playlist := *h.doc.Playlist
+		playlist.Highlight = highlight(playlist.Name, h.matched)
+		playlists = append(playlists, playlist)
+	}
+
+	return playlists, nil
+}
+
+func (r *Repository) FullTextSearchAlbums(ctx context.Context, query string,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Album, error) {
+
+	return r.searchAlbums(ctx, r.index.Match(models.SearchTypeAlbum, query), limit, userID, f)
+}
+
+func (r *Repository) FullTextSearchArtists(ctx context.Context, query string,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Artist, error) {
+
+	return r.searchArtists(ctx, r.index.Match(models.SearchTypeArtist, query), limit, userID, f)
+}
+
+func (r *Repository) FullTextSearchTracks(ctx context.Context, query string,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Track, error) {
+
+	return r.searchTracks(ctx, r.index.Match(models.SearchTypeTrack, query), limit, userID, f)
+}
+
+func (r *Repository) FullTextSearchPlaylists(ctx context.Context, query string,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Playlist, error) {
+
+	return r.searchPlaylists(ctx, r.index.Match(models.SearchTypePlaylist, query), limit, userID, f)
+}
+
+func (r *Repository) FuzzySearchAlbums(ctx context.Context, query string,
+	limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Album, error) {
+
+	return r.searchAlbums(ctx, r.index.FuzzyMatch(models.SearchTypeAlbum, query, threshold), limit, userID, f)
+}
+
+func (r *Repository) FuzzySearchArtists(ctx context.Context, query string,
+	limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Artist, error) {
+
+	return r.searchArtists(ctx, r.index.FuzzyMatch(models.SearchTypeArtist, query, threshold), limit, userID, f)
+}
+
+func (r *Repository) FuzzySearchTracks(ctx context.Context, query string,
+	limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Track, error) {
+
+	return r.searchTracks(ctx, r.index.FuzzyMatch(models.SearchTypeTrack, query, threshold), limit, userID, f)
+}
+
+func (r *Repository) FuzzySearchPlaylists(ctx context.Context, query string,
+	limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Playlist, error) {
+
+	return r.searchPlaylists(ctx, r.index.FuzzyMatch(models.SearchTypePlaylist, query, threshold), limit, userID, f)
+}
+
+func (r *Repository) GetFacets(ctx context.Context, query string,
+	userID uint32, f models.SearchFilters, artistsLimit uint32) (*models.SearchFacets, error) {
+
+	matched := make(map[string][]*models.SearchDocument, len(indexedTypes))
+	for _, entityType := range indexedTypes {
+		if !f.Supports(entityType) {
+			continue
+		}
+
+		liked, err := r.likedSet(ctx, entityType, userID, f)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range r.index.Match(entityType, query) {
+			_, isLiked := liked[h.doc.ID]
+			if passes(h.doc, f, isLiked) {
+				matched[entityType] = append(matched[entityType], h.doc)
+			}
+		}
+	}
+
+	facets := &models.SearchFacets{
+		Counts: models.SearchCounts{
+			Albums:    uint32(len(matched[models.SearchTypeAlbum])),
+			Artists:   uint32(len(matched[models.SearchTypeArtist])),
+			Tracks:    uint32(len(matched[models.SearchTypeTrack])),
+			Playlists: uint32(len(matched[models.SearchTypePlaylist])),
+		},
+		Artists: []models.SearchArtistFacet{},
+	}
+	if !f.Supports(models.SearchTypeTrack) {
+		return facets, nil
+	}
+
+	// found tracks and albums of each artist
+	counts := make(map[uint32]uint32)
+	for _, entityType := range []string{models.SearchTypeTrack, models.SearchTypeAlbum} {
+		for _, doc := range matched[entityType] {
+			for _, artistID := range doc.ArtistIDs {
+				counts[artistID]++
+			}
+		}
+	}
+	for artistID, count := range counts {
+		artist, ok := r.index.Get(models.SearchTypeArtist, artistID)
+		if !ok {
+			continue
+		}
+		facets.Artists = append(facets.Artists, models.SearchArtistFacet{
+			ArtistID: artistID,
+			Name:     artist.Name,
+			Count:    count,
+		})
+	}
+	sort.Slice(facets.Artists, func(a, b int) bool {
+		if facets.Artists[a].Count != facets.Artists[b].Count {
+			return facets.Artists[a].Count > facets.Artists[b].Count
+		}
+		return facets.Artists[a].ArtistID < facets.Artists[b].ArtistID
+	})
+	if uint32(len(facets.Artists)) > artistsLimit {
+		facets.Artists = facets.Artists[:artistsLimit]
+	}
+
+	return facets, nil
+}
+
+func (r *Repository) GetSuggestions(ctx context.Context,
+	entityType string, afterID uint32) ([]models.SearchSuggestion, error) {
+
+	switch entityType {
+	case models.SearchTypeArtist, models.SearchTypeTrack, models.SearchTypeAlbum:
+	default:
+		return nil, fmt.Errorf("(repo) suggestions of type %q aren't supported", entityType)
+	}
+
+	docs := r.index.Documents(entityType, afterID)
+	suggestions := make([]models.SearchSuggestion, 0, len(docs))
+	for _, doc := range docs {
+		suggestions = append(suggestions, models.SearchSuggestion{
+			Type: doc.Type,
+			ID:   doc.ID,
+			Name: doc.Name,
+		})
+	}
+
+	return suggestions, nil
+}
+
+// Recent searches aren't indexed and are stored in source
+
+func (r *Repository) AddRecentSearch(ctx context.Context,
+	userID uint32, recent models.RecentSearch, keep uint32) error {
+
+	return r.source.AddRecentSearch(ctx, userID, recent, keep)
+}
+
+func (r *Repository) GetRecentSearches(ctx context.Context,
+	userID uint32, limit uint32) ([]models.RecentSearch, error) {
+
+	return r.source.GetRecentSearches(ctx, userID, limit)
+}
+
+func (r *Repository) DeleteRecentSearch(ctx context.Context, recentSearchID uint32, userID uint32) error {
+	return r.source.DeleteRecentSearch(ctx, recentSearchID, userID)
+}
+
+func (r *Repository) ClearRecentSearches(ctx context.Context, userID uint32) error {
+	return r.source.ClearRecentSearches(ctx, userID)
+}
+
+func (r *Repository) GetClickedEntities(ctx context.Context, userID uint32) ([]models.SearchEntity, error) {
+	return r.source.GetClickedEntities(ctx, userID)
+}
@@ -0,0 +1,464 @@
+package embedded
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search"
+
+	searchMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search/mocks"
+)
+
+var ctx = context.Background()
+
+func TestPasses(t *testing.T) {
+	albumID := uint32(3)
+	createdAt := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+	before := createdAt.Add(-time.Hour)
+	after := createdAt.Add(time.Hour)
+
+	albumTrack := &models.SearchDocument{
+		Type:      models.SearchTypeTrack,
+		ID:        1,
+		Track:     &models.Track{ID: 1, AlbumID: &albumID, Duration: 180},
+		ArtistIDs: []uint32{10, 11},
+		CreatedAt: createdAt,
+	}
+	single := &models.SearchDocument{
+		Type:      models.SearchTypeTrack,
+		ID:        2,
+		Track:     &models.Track{ID: 2, Duration: 180},
+		ArtistIDs: []uint32{10},
+		CreatedAt: createdAt,
+	}
+	artist := &models.SearchDocument{
+		Type:      models.SearchTypeArtist,
+		ID:        10,
+		Artist:    &models.Artist{ID: 10},
+		CreatedAt: createdAt,
+	}
+
+	testTable := []struct {
+		name     string
+		doc      *models.SearchDocument
+		filters  models.SearchFilters
+		liked    bool
+		expected bool
+	}{
+		{
+			name:     "No Filters",
+			doc:      albumTrack,
+			expected: true,
+		},
+		{
+			name:     "Only Liked (not liked)",
+			doc:      albumTrack,
+			filters:  models.SearchFilters{OnlyLiked: true},
+			expected: false,
+		},
+		{
+			name:     "Only Liked (liked)",
+			doc:      albumTrack,
+			filters:  models.SearchFilters{OnlyLiked: true},
+			liked:    true,
+			expected: true,
+		},
+		{
+			name:     "Added After (earlier)",
+			doc:      albumTrack,
+			filters:  models.SearchFilters{AddedAfter: &before},
+			expected: true,
+		},
+		{
+			name:     "Added After (later)",
+			doc:      albumTrack,
+			filters:  models.SearchFilters{AddedAfter: &after},
+			expected: false,
+		},
+		{
+			name:     "Artist (found)",
+			doc:      albumTrack,
+			filters:  models.SearchFilters{ArtistID: 11},
+			expected: true,
+		},
+		{
+			name:     "Artist (not found)",
+			doc:      single,
+			filters:  models.SearchFilters{ArtistID: 11},
+			expected: false,
+		},
+		{
+			name:     "Artist (ignored by artist)",
+			doc:      artist,
+			filters:  models.SearchFilters{ArtistID: 11},
+			expected: true,
+		},
+		{
+			name:     "Min Duration",
+			doc:      albumTrack,
+			filters:  models.SearchFilters{MinDuration: 181},
+			expected: false,
+		},
+		{
+			name:     "Max Duration",
+			doc:      albumTrack,
+			filters:  models.SearchFilters{MaxDuration: 179},
+			expected: false,
+		},
+		{
+			name:     "Duration In Range",
+			doc:      albumTrack,
+			filters:  models.SearchFilters{MinDuration: 180, MaxDuration: 180},
+			expected: true,
+		},
+		{
+			name:     "Album Kind (album track)",
+			doc:      albumTrack,
+			filters:  models.SearchFilters{TrackKind: models.SearchTrackKindAlbum},
+			expected: true,
+		},
+		{
+			name:     "Album Kind (single)",
+			doc:      single,
+			filters:  models.SearchFilters{TrackKind: models.SearchTrackKindAlbum},
+			expected: false,
+		},
+		{
+			name:     "Single Kind (album track)",
+			doc:      albumTrack,
+			filters:  models.SearchFilters{TrackKind: models.SearchTrackKindSingle},
+			expected: false,
+		},
+		{
+			name:     "Single Kind (single)",
+			doc:      single,
+			filters:  models.SearchFilters{TrackKind: models.SearchTrackKindSingle},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, passes(tc.doc, tc.filters, tc.liked))
+		})
+	}
+}
+
+func TestRepository_likedSet(t *testing.T) {
+	// Init
+	type mockBehavior func(s *searchMocks.MockIndexSource)
+
+	c := gomock.NewController(t)
+
+	s := searchMocks.NewMockIndexSource(c)
+
+	// Test filling
+	const userID uint32 = 1
+
+	testTable := []struct {
+		name          string
+		weights       search.RankWeights
+		userID        uint32
+		filters       models.SearchFilters
+		mockBehavior  mockBehavior
+		expected      map[uint32]struct{}
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name:    "Personal Ranking",
+			weights: search.RankWeights{Personal: 1},
+			userID:  userID,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeTrack, userID).Return([]uint32{1, 2}, nil)
+			},
+			expected: map[uint32]struct{}{1: {}, 2: {}},
+		},
+		{
+			name:    "Only Liked",
+			userID:  userID,
+			filters: models.SearchFilters{OnlyLiked: true},
+			mockBehavior: func(s *searchMocks.MockIndexSource) {
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeTrack, userID).Return([]uint32{}, nil)
+			},
+			expected: map[uint32]struct{}{},
+		},
+		{
+			name:         "Unauthorized",
+			weights:      search.RankWeights{Personal: 1},
+			mockBehavior: func(s *searchMocks.MockIndexSource) {},
+		},
+		{
+			name:         "Not Needed",
+			userID:       userID,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {},
+		},
+		{
+			name:    "Source Issue",
+			weights: search.RankWeights{Personal: 1},
+			userID:  userID,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeTrack, userID).Return(nil, errors.New(""))
+			},
+			expectError:   true,
+			expectedError: "(repo) can't get liked entities",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(s)
+
+			r := NewRepository(s, tc.weights, "")
+
+			// Test
+			liked, err := r.likedSet(ctx, models.SearchTypeTrack, tc.userID, tc.filters)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, liked)
+			}
+		})
+	}
+}
+
+func TestRepository_find(t *testing.T) {
+	// Init
+	type mockBehavior func(s *searchMocks.MockIndexSource)
+
+	c := gomock.NewController(t)
+
+	s := searchMocks.NewMockIndexSource(c)
+
+	r := NewRepository(s, search.RankWeights{Listens: 0.1, Personal: 1}, "")
+
+	// Test filling
+	const userID uint32 = 1
+
+	// rank of #1 is 1, of #2 is 0.9 + 0.1 * ln(101) ≈ 1.36, of liked #3 is 0.5 + 1
+	hits := []hit{
+		{doc: &models.SearchDocument{Type: models.SearchTypeTrack, ID: 1, Track: &models.Track{ID: 1}},
+			relevance: 1},
+		{doc: &models.SearchDocument{Type: models.SearchTypeTrack, ID: 2, Track: &models.Track{ID: 2}, Listens: 100},
+			relevance: 0.9},
+		{doc: &models.SearchDocument{Type: models.SearchTypeTrack, ID: 3, Track: &models.Track{ID: 3}},
+			relevance: 0.5},
+	}
+
+	testTable := []struct {
+		name          string
+		userID        uint32
+		limit         uint32
+		filters       models.SearchFilters
+		mockBehavior  mockBehavior
+		expectedIDs   []uint32
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name:   "Personal Ranking",
+			userID: userID,
+			limit:  10,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeTrack, userID).Return([]uint32{3}, nil)
+			},
+			expectedIDs: []uint32{3, 2, 1},
+		},
+		{
+			name:         "Unauthorized",
+			limit:        10,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {},
+			expectedIDs:  []uint32{2, 1, 3},
+		},
+		{
+			name:   "Limit",
+			userID: userID,
+			limit:  2,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeTrack, userID).Return([]uint32{3}, nil)
+			},
+			expectedIDs: []uint32{3, 2},
+		},
+		{
+			name:    "Only Liked",
+			userID:  userID,
+			limit:   10,
+			filters: models.SearchFilters{OnlyLiked: true},
+			mockBehavior: func(s *searchMocks.MockIndexSource) {
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeTrack, userID).Return([]uint32{1, 3}, nil)
+			},
+			expectedIDs: []uint32{1, 3},
+		},
+		{
+			name:   "Source Issue",
+			userID: userID,
+			limit:  10,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeTrack, userID).Return(nil, errors.New(""))
+			},
+			expectError:   true,
+			expectedError: "(repo) can't get liked entities",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(s)
+
+			// Test
+			ranked, err := r.find(ctx, hits, models.SearchTypeTrack, tc.limit, tc.userID, tc.filters)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			ids := []uint32{}
+			for _, h := range ranked {
+				ids = append(ids, h.doc.ID)
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestRepository_GetFacets(t *testing.T) {
+	// Init
+	type mockBehavior func(s *searchMocks.MockIndexSource)
+
+	c := gomock.NewController(t)
+
+	s := searchMocks.NewMockIndexSource(c)
+
+	r := NewRepository(s, search.RankWeights{}, "")
+
+	// Test filling
+	const userID uint32 = 1
+
+	r.index.Replace([]models.SearchDocument{
+		{Type: models.SearchTypeArtist, ID: 10, Name: "Metallica", Artist: &models.Artist{ID: 10}},
+		{Type: models.SearchTypeArtist, ID: 11, Name: "Master Boot Record", Artist: &models.Artist{ID: 11}},
+		{Type: models.SearchTypeTrack, ID: 1, Name: "Master of Puppets",
+			Track: &models.Track{ID: 1, Duration: 515}, ArtistIDs: []uint32{10}},
+		{Type: models.SearchTypeTrack, ID: 2, Name: "Puppet Master",
+			Track: &models.Track{ID: 2, Duration: 200}, ArtistIDs: []uint32{10, 11}},
+		{Type: models.SearchTypeAlbum, ID: 5, Name: "Master of Puppets",
+			Album: &models.Album{ID: 5}, ArtistIDs: []uint32{10}},
+		{Type: models.SearchTypePlaylist, ID: 7, Name: "Master Mix", Playlist: &models.Playlist{ID: 7}},
+	})
+
+	testTable := []struct {
+		name           string
+		userID         uint32
+		filters        models.SearchFilters
+		artistsLimit   uint32
+		mockBehavior   mockBehavior
+		expectedFacets *models.SearchFacets
+		expectError    bool
+		expectedError  string
+	}{
+		{
+			name:         "Common",
+			artistsLimit: 10,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {},
+			expectedFacets: &models.SearchFacets{
+				Counts: models.SearchCounts{Albums: 1, Artists: 1, Tracks: 2, Playlists: 1},
+				Artists: []models.SearchArtistFacet{
+					{ArtistID: 10, Name: "Metallica", Count: 3},
+					{ArtistID: 11, Name: "Master Boot Record", Count: 1},
+				},
+			},
+		},
+		{
+			name:         "Artists Limit",
+			artistsLimit: 1,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {},
+			expectedFacets: &models.SearchFacets{
+				Counts: models.SearchCounts{Albums: 1, Artists: 1, Tracks: 2, Playlists: 1},
+				Artists: []models.SearchArtistFacet{
+					{ArtistID: 10, Name: "Metallica", Count: 3},
+				},
+			},
+		},
+		{
+			name:         "Tracks Only Filter",
+			filters:      models.SearchFilters{MaxDuration: 300},
+			artistsLimit: 10,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {},
+			expectedFacets: &models.SearchFacets{
+				Counts: models.SearchCounts{Tracks: 1},
+				Artists: []models.SearchArtistFacet{
+					{ArtistID: 10, Name: "Metallica", Count: 1},
+					{ArtistID: 11, Name: "Master Boot Record", Count: 1},
+				},
+			},
+		},
+		{
+			name:         "Artist Filter",
+			filters:      models.SearchFilters{ArtistID: 11},
+			artistsLimit: 10,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {},
+			expectedFacets: &models.SearchFacets{
+				Counts: models.SearchCounts{Tracks: 1},
+				Artists: []models.SearchArtistFacet{
+					{ArtistID: 10, Name: "Metallica", Count: 1},
+					{ArtistID: 11, Name: "Master Boot Record", Count: 1},
+				},
+			},
+		},
+		{
+			name:         "Only Liked",
+			userID:       userID,
+			filters:      models.SearchFilters{OnlyLiked: true},
+			artistsLimit: 10,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeAlbum, userID).Return([]uint32{}, nil)
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeArtist, userID).Return([]uint32{11}, nil)
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeTrack, userID).Return([]uint32{1}, nil)
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypePlaylist, userID).Return([]uint32{}, nil)
+			},
+			expectedFacets: &models.SearchFacets{
+				Counts: models.SearchCounts{Artists: 1, Tracks: 1},
+				Artists: []models.SearchArtistFacet{
+					{ArtistID: 10, Name: "Metallica", Count: 1},
+				},
+			},
+		},
+		{
+			name:         "Source Issue",
+			userID:       userID,
+			filters:      models.SearchFilters{OnlyLiked: true},
+			artistsLimit: 10,
+			mockBehavior: func(s *searchMocks.MockIndexSource) {
+				s.EXPECT().GetLikedIDs(ctx, models.SearchTypeAlbum, userID).Return(nil, errors.New(""))
+			},
+			expectError:   true,
+			expectedError: "(repo) can't get liked entities",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(s)
+
+			// Test
+			facets, err := r.GetFacets(ctx, "master", tc.userID, tc.filters, tc.artistsLimit)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFacets, facets)
+			}
+		})
+	}
+}
@@ -0,0 +1,514 @@
+package embedded
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+// BM25 parameters: saturation of term frequency and normalization by name length
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Parts of relevance of exact match, which sum up to 1
+const (
+	termsWeight    = 0.5  // BM25 score of matched terms
+	phraseWeight   = 0.25 // name contains query as a whole
+	equalityWeight = 0.25 // name is query
+)
+
+// prefixWeight is share of term's score when it's matched by prefix of query's word
+const prefixWeight = 0.5
+
+// minPrefixLen is minimal length of query's word in letters to match terms by prefix
+const minPrefixLen = 2
+
+// lyricsWeight is share of relevance of track matched by its lyrics,
+// so tracks matched by name go before ones matched only by lyrics
+const lyricsWeight = 0.1
+
+// hit is document matching query
+type hit struct {
+	doc       *models.SearchDocument
+	relevance float64
+	// matched are terms of name to highlight
+	matched map[string]struct{}
+	// snippet is set for tracks matched by lyrics, see snippet
+	snippet string
+}
+
+type indexedDoc struct {
+	doc    models.SearchDocument
+	terms  []string
+	phrase string // normalized name
+}
+
+// typeIndex is inverted index of names of entities of one type
+type typeIndex struct {
+	docs map[uint32]*indexedDoc
+	// postings are frequencies of terms in documents
+	postings map[string]map[uint32]int
+	// vocabulary is sorted list of all terms for prefix matching
+	vocabulary []string
+	// byTrigram are terms containing trigram for fuzzy matching
+	byTrigram map[string]map[string]struct{}
+	totalLen  int
+}
+
+func newTypeIndex() *typeIndex {
+	return &typeIndex{
+		docs:      make(map[uint32]*indexedDoc),
+		postings:  make(map[string]map[uint32]int),
+		byTrigram: make(map[string]map[string]struct{}),
+	}
+}
+
+func (ti *typeIndex) add(doc models.SearchDocument) {
+	ti.remove(doc.ID)
+
+	d := &indexedDoc{
+		doc:   doc,
+		terms: terms(doc.Name),
+	}
+	d.phrase = strings.Join(d.terms, " ")
+	ti.docs[doc.ID] = d
+	ti.totalLen += len(d.terms)
+
+	for _, term := range d.terms {
+		docs, ok := ti.postings[term]
+		if !ok {
+			docs = make(map[uint32]int)
+			ti.postings[term] = docs
+			ti.addToVocabulary(term)
+		}
+		docs[doc.ID]++
+	}
+}
+
+func (ti *typeIndex) addToVocabulary(term string) {
+	i := sort.SearchStrings(ti.vocabulary, term)
+	ti.vocabulary = append(ti.vocabulary, "")
+	copy(ti.vocabulary[i+1:], ti.vocabulary[i:])
+	ti.vocabulary[i] = term
+
+	for trigram := range trigrams(term) {
+		terms, ok := ti.byTrigram[trigram]
+		if !ok {
+			terms = make(map[string]struct{})
+			ti.byTrigram[trigram] = terms
+		}
+		terms[term] = struct{}{}
+	}
+}
+
+// remove deletes document from index. Terms which are left without documents
+// stay in vocabulary, as they are dropped by the next full reindex anyway.
+func (ti *typeIndex) remove(id uint32) {
+	d, ok := ti.docs[id]
+	if !ok {
+		return
+	}
+
+	for _, term := range d.terms {
+		delete(ti.postings[term], id)
+	}
+	ti.totalLen -= len(d.terms)
+	delete(ti.docs, id)
+}
+
+// bm25 scores term by its frequency in document and rarity among documents
+func (ti *typeIndex) bm25(term string, d *indexedDoc) float64 {
+	docs := ti.postings[term]
+	freq := float64(docs[d.doc.ID])
+	if freq == 0 {
+		return 0
+	}
+
+	n := float64(len(ti.docs))
+	df := float64(len(docs))
+	idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+	avgLen := float64(ti.totalLen) / n
+	norm := 1 - bm25B + bm25B*float64(len(d.terms))/avgLen
+
+	return idf * freq * (bm25K1 + 1) / (freq + bm25K1*norm)
+}
+
+// expand returns terms matching query's word with their weights
+func (ti *typeIndex) expand(word string) map[string]float64 {
+	expanded := make(map[string]float64)
+	if _, ok := ti.postings[word]; ok {
+		expanded[word] = 1
+	}
+
+	if len([]rune(word)) < minPrefixLen {
+		return expanded
+	}
+	for i := sort.SearchStrings(ti.vocabulary, word); i < len(ti.vocabulary); i++ {
+		term := ti.vocabulary[i]
+		if !strings.HasPrefix(term, word) {
+			break
+		}
+		if term != word {
+			expanded[term] = prefixWeight
+		}
+	}
+
+	return expanded
+}
+
+// match finds documents containing all words of query, exactly or as prefixes,
+// and ones containing query as substring
+func (ti *typeIndex) match(query string) []hit {
+	words := terms(query)
+	phrase := strings.Join(words, " ")
+	if phrase == "" {
+		return nil
+	}
+
+	scores := make(map[uint32]float64)
+	matched := make(map[uint32]map[string]struct{})
+	for i, word := range words {
+		// best score of word in each document
+		wordScores := make(map[uint32]float64)
+		for term, weight := range ti.expand(word) {
+			for id := range ti.postings[term] {
+				if i > 0 {
+					if _, ok := scores[id]; !ok {
+						continue
+					}
+				}
+
+				if score := weight * ti.bm25(term, ti.docs[id]); score >= wordScores[id] {
+					wordScores[id] = score
+				}
+				if matched[id] == nil {
+					matched[id] = make(map[string]struct{})
+				}
+				matched[id][term] = struct{}{}
+			}
+		}
+
+		for id := range scores {
+			if _, ok := wordScores[id]; !ok {
+				delete(scores, id)
+			}
+		}
+		for id, score := range wordScores {
+			scores[id] += score
+		}
+	}
+
+	var hits []hit
+	for id, d := range ti.docs {
+		score, termsMatched := scores[id]
+		containsPhrase := strings.Contains(d.phrase, phrase)
+		if !termsMatched && !containsPhrase {
+			continue
+		}
+
+		relevance := termsWeight * score / (score + 1)
+		if containsPhrase {
+			relevance += phraseWeight
+		}
+		if d.phrase == phrase {
+			relevance += equalityWeight
+		}
+
+		h := hit{doc: &d.doc, relevance: relevance}
+		if termsMatched {
+			h.matched = matched[id]
+		}
+		hits = append(hits, h)
+	}
+
+	return hits
+}
+
+// fuzzyMatch finds documents which words are similar to words of query
+// at least by threshold on average in terms of trigram similarity
+func (ti *typeIndex) fuzzyMatch(query string, threshold float64) []hit {
+	words := terms(query)
+	if len(words) == 0 {
+		return nil
+	}
+
+	// similarities of words' similar terms
+	similar := make([]map[string]float64, len(words))
+	candidates := make(map[uint32]struct{})
+	for i, word := range words {
+		similar[i] = make(map[string]float64)
+
+		wordTrigrams := trigrams(word)
+		for trigram := range wordTrigrams {
+			for term := range ti.byTrigram[trigram] {
+				if _, ok := similar[i][term]; ok {
+					continue
+				}
+				sim := similarity(wordTrigrams, trigrams(term))
+				if sim < threshold {
+					continue
+				}
+
+				similar[i][term] = sim
+				for id := range ti.postings[term] {
+					candidates[id] = struct{}{}
+				}
+			}
+		}
+	}
+
+	var hits []hit
+	for id := range candidates {
+		d := ti.docs[id]
+
+		total := 0.0
+		matched := make(map[string]struct{})
+		for i := range words {
+			best := 0.0
+			for _, term := range d.terms {
+				if sim, ok := similar[i][term]; ok {
+					matched[term] = struct{}{}
+					if sim > best {
+						best = sim
+					}
+				}
+			}
+			total += best
+		}
+
+		if relevance := total / float64(len(words)); relevance >= threshold {
+			hits = append(hits, hit{doc: &d.doc, relevance: relevance, matched: matched})
+		}
+	}
+
+	return hits
+}
+
+// Index is concurrency-safe in-memory full-text index of names of entities
+// and lyrics of tracks
+type Index struct {
+	mu    sync.RWMutex
+	types map[string]*typeIndex
+	// lyrics are indexed as documents which names are lyrics of tracks with the same IDs
+	lyrics *typeIndex
+}
+
+func NewIndex() *Index {
+	return &Index{
+		types:  make(map[string]*typeIndex),
+		lyrics: newTypeIndex(),
+	}
+}
+
+// addDocument inserts document into index of its type and its lyrics into lyrics index
+func addDocument(types map[string]*typeIndex, lyrics *typeIndex, doc models.SearchDocument) {
+	ti, ok := types[doc.Type]
+	if !ok {
+		ti = newTypeIndex()
+		types[doc.Type] = ti
+	}
+	ti.add(doc)
+
+	if doc.Type != models.SearchTypeTrack {
+		return
+	}
+	if doc.Lyrics == "" {
+		lyrics.remove(doc.ID)
+		return
+	}
+	lyrics.add(models.SearchDocument{
+		Type: doc.Type,
+		ID:   doc.ID,
+		Name: doc.Lyrics,
+	})
+}
+
+// Replace rebuilds index from scratch
+func (i *Index) Replace(docs []models.SearchDocument) {
+	types := make(map[string]*typeIndex)
+	lyrics := newTypeIndex()
+	for _, doc := range docs {
+		addDocument(types, lyrics, doc)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.types = types
+	i.lyrics = lyrics
+}
+
+// Add inserts documents into index replacing ones with the same type and ID
+func (i *Index) Add(docs []models.SearchDocument) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, doc := range docs {
+		addDocument(i.types, i.lyrics, doc)
+	}
+}
+
+// Match returns documents of given type matching query, see typeIndex.match.
+// Tracks are also matched by lyrics with lyricsWeight of relevance.
+func (i *Index) Match(entityType string, query string) []hit {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	ti, ok := i.types[entityType]
+	if !ok {
+		return nil
+	}
+
+	hits := ti.match(query)
+	if entityType != models.SearchTypeTrack {
+		return hits
+	}
+
+	byID := make(map[uint32]int, len(hits))
+	for n, h := range hits {
+		byID[h.doc.ID] = n
+	}
+	for _, lh := range i.lyrics.match(query) {
+		n, ok := byID[lh.doc.ID]
+		if !ok {
+			d, ok := ti.docs[lh.doc.ID]
+			if !ok {
+				continue
+			}
+			n = len(hits)
+			hits = append(hits, hit{doc: &d.doc})
+		}
+
+		hits[n].relevance += lyricsWeight * lh.relevance
+		hits[n].snippet = snippet(lh.doc.Name, query, lh.matched)
+	}
+
+	return hits
+}
+
+// FuzzyMatch returns documents of given type similar to query, see typeIndex.fuzzyMatch
+func (i *Index) FuzzyMatch(entityType string, query string, threshold float64) []hit {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	ti, ok := i.types[entityType]
+	if !ok {
+		return nil
+	}
+	return ti.fuzzyMatch(query, threshold)
+}
+
+// Get returns document by its type and ID
+func (i *Index) Get(entityType string, id uint32) (*models.SearchDocument, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	ti, ok := i.types[entityType]
+	if !ok {
+		return nil, false
+	}
+	d, ok := ti.docs[id]
+	if !ok {
+		return nil, false
+	}
+	return &d.doc, true
+}
+
+// Documents returns documents of given type with IDs greater than afterID ordered by ID
+func (i *Index) Documents(entityType string, afterID uint32) []models.SearchDocument {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	ti, ok := i.types[entityType]
+	if !ok {
+		return nil
+	}
+
+	var docs []models.SearchDocument
+	for id, d := range ti.docs {
+		if id > afterID {
+			docs = append(docs, d.doc)
+		}
+	}
+	sort.Slice(docs, func(a, b int) bool {
+		return docs[a].ID < docs[b].ID
+	})
+
+	return docs
+}
+
+// LastID returns the greatest ID of indexed document of given type
+func (i *Index) LastID(entityType string) uint32 {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var last uint32
+	if ti, ok := i.types[entityType]; ok {
+		for id := range ti.docs {
+			if id > last {
+				last = id
+			}
+		}
+	}
+
+	return last
+}
+
+// indexFormatVersion is changed along with layout of stored documents
+const indexFormatVersion = 2
+
+// errIndexVersion means that index was saved in another format and should be rebuilt
+var errIndexVersion = errors.New("index version isn't supported")
+
+// Save writes all documents of index. Terms aren't stored, as they are rebuilt on load.
+func (i *Index) Save(w io.Writer) error {
+	i.mu.RLock()
+	var docs []models.SearchDocument
+	for _, ti := range i.types {
+		for _, d := range ti.docs {
+			docs = append(docs, d.doc)
+		}
+	}
+	i.mu.RUnlock()
+
+	enc := gob.NewEncoder(w)
+	if err := enc.Encode(indexFormatVersion); err != nil {
+		return fmt.Errorf("can't encode index version: %w", err)
+	}
+	if err := enc.Encode(docs); err != nil {
+		return fmt.Errorf("can't encode documents: %w", err)
+	}
+
+	return nil
+}
+
+// Load replaces index with documents saved by Save
+func (i *Index) Load(r io.Reader) error {
+	dec := gob.NewDecoder(r)
+
+	var version int
+	if err := dec.Decode(&version); err != nil {
+		return fmt.Errorf("can't decode index version: %w", err)
+	}
+	if version != indexFormatVersion {
+		return fmt.Errorf("%w: %d", errIndexVersion, version)
+	}
+
+	var docs []models.SearchDocument
+	if err := dec.Decode(&docs); err != nil {
+		return fmt.Errorf("can't decode documents: %w", err)
+	}
+	i.Replace(docs)
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package embedded
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+func trackDoc(id uint32, name string) models.SearchDocument {
+	return models.SearchDocument{
+		Type:  models.SearchTypeTrack,
+		ID:    id,
+		Name:  name,
+		Track: &models.Track{ID: id, Name: name},
+	}
+}
+
+// matchedIDs returns IDs of hits ordered by relevance
+func matchedIDs(hits []hit) []uint32 {
+	sort.Slice(hits, func(a, b int) bool {
+		if hits[a].relevance != hits[b].relevance {
+			return hits[a].relevance > hits[b].relevance
+		}
+		return hits[a].doc.ID < hits[b].doc.ID
+	})
+
+	ids := []uint32{}
+	for _, h := range hits {
+		ids = append(ids, h.doc.ID)
+	}
+	return ids
+}
+
+func TestIndex_Match(t *testing.T) {
+	index := NewIndex()
+	index.Replace([]models.SearchDocument{
+		trackDoc(1, "Master of Puppets"),
+		trackDoc(2, "Puppet Master"),
+		trackDoc(3, "Ёлка"),
+	})
+	index.Add([]models.SearchDocument{
+		trackDoc(4, "Master"),
+	})
+
+	testTable := []struct {
+		name        string
+		query       string
+		expectedIDs []uint32
+	}{
+		{
+			name:        "Equal Name First",
+			query:       "master",
+			expectedIDs: []uint32{4, 2, 1},
+		},
+		{
+			name:        "All Words Required",
+			query:       "master puppets",
+			expectedIDs: []uint32{1},
+		},
+		{
+			name:        "Prefix Shorter Name First",
+			query:       "pup",
+			expectedIDs: []uint32{2, 1},
+		},
+		{
+			name:        "Yo Normalized",
+			query:       "елка",
+			expectedIDs: []uint32{3},
+		},
+		{
+			name:        "Nothing Found",
+			query:       "saluki",
+			expectedIDs: []uint32{},
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			hits := index.Match(models.SearchTypeTrack, tc.query)
+
+			assert.Equal(t, tc.expectedIDs, matchedIDs(hits))
+		})
+	}
+}
+
+func TestIndex_FuzzyMatch(t *testing.T) {
+	index := NewIndex()
+	index.Replace([]models.SearchDocument{
+		trackDoc(1, "Master of Puppets"),
+		trackDoc(2, "Enter Sandman"),
+	})
+
+	hits := index.FuzzyMatch(models.SearchTypeTrack, "mastr", 0.3)
+
+	require.Equal(t, []uint32{1}, matchedIDs(hits))
+	assert.Equal(t, models.HighlightStart+"Master"+models.HighlightStop+" of Puppets",
+		highlight(hits[0].doc.Name, hits[0].matched))
+}
+
+func TestIndex_SaveLoad(t *testing.T) {
+	index := NewIndex()
+	index.Replace([]models.SearchDocument{
+		trackDoc(1, "Master of Puppets"),
+		trackDoc(7, "Enter Sandman"),
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, index.Save(&buf))
+
+	loaded := NewIndex()
+	require.NoError(t, loaded.Load(&buf))
+
+	assert.Equal(t, []uint32{7}, matchedIDs(loaded.Match(models.SearchTypeTrack, "sandman")))
+	assert.Equal(t, uint32(7), loaded.LastID(models.SearchTypeTrack))
+}
+
+func TestIndex_MatchLyrics(t *testing.T) {
+	withLyrics := trackDoc(2, "Battery")
+	withLyrics.Lyrics = "Lashing out the action\nReturning the reaction\nSmashing through the boundaries"
+
+	index := NewIndex()
+	index.Replace([]models.SearchDocument{
+		trackDoc(1, "Smashing Pumpkins"),
+		withLyrics,
+	})
+
+	hits := index.Match(models.SearchTypeTrack, "smashing boundaries")
+	require.Equal(t, []uint32{2}, matchedIDs(hits))
+	assert.Equal(t, models.HighlightStart+"Smashing"+models.HighlightStop+" through the "+
+		models.HighlightStart+"boundaries"+models.HighlightStop, hits[0].snippet)
+
+	hits = index.Match(models.SearchTypeTrack, "smashing")
+	require.Equal(t, []uint32{1, 2}, matchedIDs(hits))
+	assert.Empty(t, hits[0].snippet)
+}
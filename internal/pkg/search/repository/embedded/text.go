@@ -0,0 +1,144 @@
+package embedded
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+// token is normalized word of text with its position in the original text
+type token struct {
+	term       string
+	start, end int // bytes
+}
+
+// tokenize splits text into words of letters and digits.
+// Terms are lowercased and russian "ё" is treated as "е".
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, token{term: normalizeTerm(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{term: normalizeTerm(text[start:]), start: start, end: len(text)})
+	}
+
+	return tokens
+}
+
+func terms(text string) []string {
+	tokens := tokenize(text)
+	terms := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		terms = append(terms, t.term)
+	}
+
+	return terms
+}
+
+func normalizeTerm(word string) string {
+	return strings.ReplaceAll(strings.ToLower(word), "ё", "е")
+}
+
+// normalizeText joins terms of text by single spaces, so texts can be compared as phrases
+func normalizeText(text string) string {
+	return strings.Join(terms(text), " ")
+}
+
+// trigrams returns set of trigrams of term padded the same way pg_trgm does
+func trigrams(term string) map[string]struct{} {
+	runes := []rune("  " + term + " ")
+	set := make(map[string]struct{}, len(runes))
+	for i := 0; i+3 <= len(runes); i++ {
+		set[string(runes[i:i+3])] = struct{}{}
+	}
+
+	return set
+}
+
+// similarity is share of common trigrams among all trigrams of both terms
+func similarity(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	common := 0
+	for t := range a {
+		if _, ok := b[t]; ok {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(a)+len(b)-common)
+}
+
+// highlight wraps words of text which terms are in matched set into highlight markers.
+// Text is stored HTML-escaped: words are searched in unescaped text, so markers
+// never split HTML entities, and highlight stays escaped like the text.
+func highlight(text string, matched map[string]struct{}) string {
+	if len(matched) == 0 || !utf8.ValidString(text) {
+		return text
+	}
+
+	raw := html.UnescapeString(text)
+
+	var b strings.Builder
+	prev := 0
+	for _, t := range tokenize(raw) {
+		if _, ok := matched[t.term]; !ok {
+			continue
+		}
+		b.WriteString(html.EscapeString(raw[prev:t.start]))
+		b.WriteString(models.HighlightStart)
+		b.WriteString(html.EscapeString(raw[t.start:t.end]))
+		b.WriteString(models.HighlightStop)
+		prev = t.end
+	}
+	b.WriteString(html.EscapeString(raw[prev:]))
+
+	return b.String()
+}
+
+// snippet returns line of text with the most of matched terms highlighted.
+// If no terms are matched, it's the first line containing query as substring.
+func snippet(text string, query string, matched map[string]struct{}) string {
+	lines := strings.Split(text, "\n")
+
+	best, bestCount := "", 0
+	for _, line := range lines {
+		count := 0
+		for _, term := range terms(line) {
+			if _, ok := matched[term]; ok {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = line, count
+		}
+	}
+	if bestCount > 0 {
+		return highlight(strings.TrimSpace(best), matched)
+	}
+
+	phrase := normalizeText(query)
+	for _, line := range lines {
+		if strings.Contains(normalizeText(line), phrase) {
+			return strings.TrimSpace(line)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,45 @@
+package embedded
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlight(t *testing.T) {
+	testTable := []struct {
+		name              string
+		text              string
+		matched           []string
+		expectedHighlight string
+	}{
+		{
+			name:              "Words",
+			text:              "Master of Puppets",
+			matched:           []string{"master", "puppets"},
+			expectedHighlight: "\x02Master\x03 of \x02Puppets\x03",
+		},
+		{
+			name:              "Escaped Text",
+			text:              "Rock &amp; Roll",
+			matched:           []string{"amp", "roll"},
+			expectedHighlight: "Rock &amp; \x02Roll\x03",
+		},
+		{
+			name:              "Nothing Matched",
+			text:              "Rock &amp; Roll",
+			expectedHighlight: "Rock &amp; Roll",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			matched := make(map[string]struct{}, len(tc.matched))
+			for _, term := range tc.matched {
+				matched[term] = struct{}{}
+			}
+
+			assert.Equal(t, tc.expectedHighlight, highlight(tc.text, matched))
+		})
+	}
+}
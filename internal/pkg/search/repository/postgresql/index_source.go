@@ -0,0 +1,207 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/lib/pq"
+)
+
+// documentStats are columns of search document besides entity's own ones
+type documentStats struct {
+	ArtistIDs pq.Int64Array `db:"artist_ids"`
+	Listens   uint32        `db:"listens"`
+	Likes     uint32        `db:"likes"`
+	CreatedAt time.Time     `db:"created_at"`
+}
+
+func (s documentStats) document(entityType string, id uint32, name string) models.SearchDocument {
+	doc := models.SearchDocument{
+		Type:      entityType,
+		ID:        id,
+		Name:      name,
+		Listens:   s.Listens,
+		Likes:     s.Likes,
+		CreatedAt: s.CreatedAt,
+	}
+	for _, artistID := range s.ArtistIDs {
+		doc.ArtistIDs = append(doc.ArtistIDs, uint32(artistID))
+	}
+
+	return doc
+}
+
+func (p *PostgreSQL) GetSearchDocuments(ctx context.Context,
+	entityType string, afterID uint32) ([]models.SearchDocument, error) {
+
+	switch entityType {
+	case models.SearchTypeAlbum:
+		return p.getAlbumDocuments(ctx, afterID)
+	case models.SearchTypeArtist:
+		return p.getArtistDocuments(ctx, afterID)
+	case models.SearchTypeTrack:
+		return p.getTrackDocuments(ctx, afterID)
+	case models.SearchTypePlaylist:
+		return p.getPlaylistDocuments(ctx, afterID)
+	}
+
+	return nil, fmt.Errorf("(repo) search documents of type %q aren't supported", entityType)
+}
+
+func (p *PostgreSQL) getAlbumDocuments(ctx context.Context, afterID uint32) ([]models.SearchDocument, error) {
+	query := fmt.Sprintf(
+		`SELECT a.id, a.name, a.description, a.cover_src, a.created_at,
+			ARRAY(SELECT aal.artist_id FROM %[2]s aal WHERE aal.album_id = a.id) AS artist_ids,
+			(SELECT COALESCE(SUM(t.listens), 0) FROM %[3]s t WHERE t.album_id = a.id) AS listens,
+			(SELECT COUNT(*) FROM %[4]s l WHERE l.album_id = a.id) AS likes
+		FROM %[1]s a
+		WHERE a.id > $1
+		ORDER BY a.id;`,
+		p.tables.Albums(), p.tables.ArtistsAlbums(), p.tables.Tracks(), p.tables.LikedAlbums(),
+	)
+
+	var rows []struct {
+		models.Album
+		documentStats
+	}
+	if err := p.db.SelectContext(ctx, &rows, query, afterID); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	docs := make([]models.SearchDocument, 0, len(rows))
+	for i := range rows {
+		doc := rows[i].document(models.SearchTypeAlbum, rows[i].ID, rows[i].Name)
+		doc.Album = &rows[i].Album
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+func (p *PostgreSQL) getArtistDocuments(ctx context.Context, afterID uint32) ([]models.SearchDocument, error) {
+	query := fmt.Sprintf(
+		`SELECT a.id, a.name, a.avatar_src, a.created_at,
+			(SELECT COALESCE(SUM(t.listens), 0)
+				FROM %[2]s atr INNER JOIN %[3]s t ON t.id = atr.track_id
+				WHERE atr.artist_id = a.id) AS listens,
+			(SELECT COUNT(*) FROM %[4]s l WHERE l.artist_id = a.id) AS likes
+		FROM %[1]s a
+		WHERE a.id > $1
+		ORDER BY a.id;`,
+		p.tables.Artists(), p.tables.ArtistsTracks(), p.tables.Tracks(), p.tables.LikedArtists(),
+	)
+
+	var rows []struct {
+		models.Artist
+		documentStats
+	}
+	if err := p.db.SelectContext(ctx, &rows, query, afterID); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	docs := make([]models.SearchDocument, 0, len(rows))
+	for i := range rows {
+		doc := rows[i].document(models.SearchTypeArtist, rows[i].ID, rows[i].Name)
+		doc.Artist = &rows[i].Artist
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+func (p *PostgreSQL) getTrackDocuments(ctx context.Context, afterID uint32) ([]models.SearchDocument, error) {
+	query := fmt.Sprintf(
+		`SELECT t.id, t.name, t.album_id, t.cover_src, t.record_src, t.duration, t.listens, t.created_at,
+			ARRAY(SELECT atr.artist_id FROM %[2]s atr WHERE atr.track_id = t.id) AS artist_ids,
+			(SELECT COUNT(*) FROM %[3]s l WHERE l.track_id = t.id) AS likes,
+			COALESCE((SELECT ly.text FROM %[4]s ly WHERE ly.track_id = t.id), '') AS lyrics
+		FROM %[1]s t
+		WHERE t.id > $1
+		ORDER BY t.id;`,
+		p.tables.Tracks(), p.tables.ArtistsTracks(), p.tables.LikedTracks(), p.tables.Lyrics(),
+	)
+
+	// listens column is scanned into track itself
+	var rows []struct {
+		models.Track
+		documentStats
+		Lyrics string `db:"lyrics"`
+	}
+	if err := p.db.SelectContext(ctx, &rows, query, afterID); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	docs := make([]models.SearchDocument, 0, len(rows))
+	for i := range rows {
+		doc := rows[i].document(models.SearchTypeTrack, rows[i].ID, rows[i].Name)
+		doc.Listens = rows[i].Track.Listens
+		doc.Track = &rows[i].Track
+		doc.Lyrics = rows[i].Lyrics
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+func (p *PostgreSQL) getPlaylistDocuments(ctx context.Context, afterID uint32) ([]models.SearchDocument, error) {
+	query := fmt.Sprintf(
+		`SELECT p.id, p.name, p.description, p.cover_src, p.created_at,
+			(SELECT COALESCE(SUM(t.listens), 0)
+				FROM %[2]s ptr INNER JOIN %[3]s t ON t.id = ptr.track_id
+				WHERE ptr.playlist_id = p.id) AS listens,
+			(SELECT COUNT(*) FROM %[4]s l WHERE l.playlist_id = p.id) AS likes
+		FROM %[1]s p
+		WHERE p.id > $1
+		ORDER BY p.id;`,
+		p.tables.Playlists(), p.tables.PlaylistsTracks(), p.tables.Tracks(), p.tables.LikedPlaylists(),
+	)
+
+	var rows []struct {
+		models.Playlist
+		documentStats
+	}
+	if err := p.db.SelectContext(ctx, &rows, query, afterID); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	docs := make([]models.SearchDocument, 0, len(rows))
+	for i := range rows {
+		doc := rows[i].document(models.SearchTypePlaylist, rows[i].ID, rows[i].Name)
+		doc.Playlist = &rows[i].Playlist
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+func (p *PostgreSQL) GetLikedIDs(ctx context.Context, entityType string, userID uint32) ([]uint32, error) {
+	var table, column string
+	switch entityType {
+	case models.SearchTypeAlbum:
+		table, column = p.tables.LikedAlbums(), "album_id"
+	case models.SearchTypeArtist:
+		table, column = p.tables.LikedArtists(), "artist_id"
+	case models.SearchTypeTrack:
+		table, column = p.tables.LikedTracks(), "track_id"
+	case models.SearchTypePlaylist:
+		table, column = p.tables.LikedPlaylists(), "playlist_id"
+	default:
+		return nil, fmt.Errorf("(repo) likes of type %q aren't supported", entityType)
+	}
+
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s
+		WHERE user_id = $1;`,
+		column, table,
+	)
+
+	var ids []uint32
+	if err := p.db.SelectContext(ctx, &ids, query, userID); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return ids, nil
+}
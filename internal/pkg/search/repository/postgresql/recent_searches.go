@@ -0,0 +1,137 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	commonSQL "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/db"
+)
+
+func (p *PostgreSQL) AddRecentSearch(ctx context.Context,
+	userID uint32, recent models.RecentSearch, keep uint32) (repoErr error) {
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx, &repoErr)
+
+	// The same search is moved to the top instead of being duplicated
+	deleteQuery := fmt.Sprintf(
+		`DELETE FROM %s
+		WHERE user_id = $1
+			AND LOWER(query) = LOWER($2)
+			AND entity_type IS NOT DISTINCT FROM $3
+			AND entity_id IS NOT DISTINCT FROM $4;`,
+		p.tables.RecentSearches())
+	if _, err := tx.ExecContext(ctx, deleteQuery,
+		userID, recent.Query, recent.EntityType, recent.EntityID); err != nil {
+
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	insertQuery := fmt.Sprintf(
+		`INSERT INTO %s (user_id, query, entity_type, entity_id)
+		VALUES ($1, $2, $3, $4);`,
+		p.tables.RecentSearches())
+	if _, err := tx.ExecContext(ctx, insertQuery,
+		userID, recent.Query, recent.EntityType, recent.EntityID); err != nil {
+
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	trimQuery := fmt.Sprintf(
+		`DELETE FROM %[1]s
+		WHERE user_id = $1 AND id NOT IN (
+			SELECT id FROM %[1]s
+			WHERE user_id = $1
+			ORDER BY searched_at DESC, id DESC
+			LIMIT $2
+		);`,
+		p.tables.RecentSearches())
+	if _, err := tx.ExecContext(ctx, trimQuery, userID, keep); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) GetRecentSearches(ctx context.Context,
+	userID uint32, limit uint32) ([]models.RecentSearch, error) {
+
+	query := fmt.Sprintf(
+		`SELECT rs.id, rs.query, rs.entity_type, rs.entity_id, rs.searched_at,
+			COALESCE(al.name, ar.name, t.name, p.name) AS entity_name
+		FROM %[1]s rs
+			LEFT JOIN %[2]s al ON rs.entity_type = '%[6]s' AND al.id = rs.entity_id
+			LEFT JOIN %[3]s ar ON rs.entity_type = '%[7]s' AND ar.id = rs.entity_id
+			LEFT JOIN %[4]s t ON rs.entity_type = '%[8]s' AND t.id = rs.entity_id
+			LEFT JOIN %[5]s p ON rs.entity_type = '%[9]s' AND p.id = rs.entity_id
+		WHERE rs.user_id = $1
+		ORDER BY rs.searched_at DESC, rs.id DESC
+		LIMIT $2;`,
+		p.tables.RecentSearches(), p.tables.Albums(), p.tables.Artists(), p.tables.Tracks(), p.tables.Playlists(),
+		models.SearchTypeAlbum, models.SearchTypeArtist, models.SearchTypeTrack, models.SearchTypePlaylist,
+	)
+
+	recents := []models.RecentSearch{}
+	if err := p.db.SelectContext(ctx, &recents, query, userID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return recents, nil
+}
+
+func (p *PostgreSQL) DeleteRecentSearch(ctx context.Context, recentSearchID uint32, userID uint32) error {
+	query := fmt.Sprintf(
+		`DELETE
+		FROM %s
+		WHERE id = $1 AND user_id = $2;`,
+		p.tables.RecentSearches())
+
+	resExec, err := p.db.ExecContext(ctx, query, recentSearchID, userID)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+	deleted, err := resExec.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("(repo) failed to check RowsAffected: %w", err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("(repo): %w", &models.NoSuchRecentSearchError{RecentSearchID: recentSearchID})
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) ClearRecentSearches(ctx context.Context, userID uint32) error {
+	query := fmt.Sprintf(
+		`DELETE
+		FROM %s
+		WHERE user_id = $1;`,
+		p.tables.RecentSearches())
+
+	if _, err := p.db.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) GetClickedEntities(ctx context.Context, userID uint32) ([]models.SearchEntity, error) {
+	query := fmt.Sprintf(
+		`SELECT DISTINCT entity_type, entity_id
+		FROM %s
+		WHERE user_id = $1 AND entity_type IS NOT NULL AND entity_id IS NOT NULL;`,
+		p.tables.RecentSearches())
+
+	var entities []models.SearchEntity
+	if err := p.db.SelectContext(ctx, &entities, query, userID); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return entities, nil
+}
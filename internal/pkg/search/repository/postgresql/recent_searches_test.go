@@ -0,0 +1,437 @@
+package postgresql
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search"
+	searchMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search/mocks"
+)
+
+func TestSearchRepositoryPostgreSQL_AddRecentSearch(t *testing.T) {
+	// Init
+	type mockBehavior func(userID uint32, recent models.RecentSearch, keep uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := searchMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, search.RankWeights{})
+
+	// Test filling
+	const defaultUserID uint32 = 1
+	const defaultKeep uint32 = 50
+
+	entityType := models.SearchTypeArtist
+	var entityID uint32 = 2
+
+	testTable := []struct {
+		name          string
+		recent        models.RecentSearch
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name:   "Query",
+			recent: models.RecentSearch{Query: "oxxxymiron"},
+			mockBehavior: func(userID uint32, recent models.RecentSearch, keep uint32) {
+				tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable).Times(3)
+
+				sqlxMock.ExpectBegin()
+				sqlxMock.ExpectExec("DELETE FROM "+recentSearchesTable).
+					WithArgs(userID, recent.Query, nil, nil).
+					WillReturnResult(driver.RowsAffected(0))
+				sqlxMock.ExpectExec("INSERT INTO "+recentSearchesTable).
+					WithArgs(userID, recent.Query, nil, nil).
+					WillReturnResult(driver.RowsAffected(1))
+				sqlxMock.ExpectExec("DELETE FROM "+recentSearchesTable+" WHERE (.+) NOT IN").
+					WithArgs(userID, keep).
+					WillReturnResult(driver.RowsAffected(0))
+				sqlxMock.ExpectCommit()
+			},
+		},
+		{
+			name:   "Clicked Result",
+			recent: models.RecentSearch{Query: "oxxxymiron", EntityType: &entityType, EntityID: &entityID},
+			mockBehavior: func(userID uint32, recent models.RecentSearch, keep uint32) {
+				tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable).Times(3)
+
+				sqlxMock.ExpectBegin()
+				sqlxMock.ExpectExec("DELETE FROM "+recentSearchesTable).
+					WithArgs(userID, recent.Query, entityType, entityID).
+					WillReturnResult(driver.RowsAffected(1))
+				sqlxMock.ExpectExec("INSERT INTO "+recentSearchesTable).
+					WithArgs(userID, recent.Query, entityType, entityID).
+					WillReturnResult(driver.RowsAffected(1))
+				sqlxMock.ExpectExec("DELETE FROM "+recentSearchesTable+" WHERE (.+) NOT IN").
+					WithArgs(userID, keep).
+					WillReturnResult(driver.RowsAffected(1))
+				sqlxMock.ExpectCommit()
+			},
+		},
+		{
+			name:   "Insert Issue",
+			recent: models.RecentSearch{Query: "oxxxymiron"},
+			mockBehavior: func(userID uint32, recent models.RecentSearch, keep uint32) {
+				tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable).Times(2)
+
+				sqlxMock.ExpectBegin()
+				sqlxMock.ExpectExec("DELETE FROM "+recentSearchesTable).
+					WithArgs(userID, recent.Query, nil, nil).
+					WillReturnResult(driver.RowsAffected(0))
+				sqlxMock.ExpectExec("INSERT INTO "+recentSearchesTable).
+					WithArgs(userID, recent.Query, nil, nil).
+					WillReturnError(errPqInternal)
+				sqlxMock.ExpectRollback()
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultUserID, tc.recent, defaultKeep)
+
+			err := repo.AddRecentSearch(ctx, defaultUserID, tc.recent, defaultKeep)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSearchRepositoryPostgreSQL_GetRecentSearches(t *testing.T) {
+	// Init
+	type mockBehavior func(userID, limit uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := searchMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, search.RankWeights{})
+
+	// Test filling
+	const defaultUserID uint32 = 1
+	const defaultLimit uint32 = 10
+
+	searchedAt := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+	entityType := models.SearchTypeArtist
+	var entityID uint32 = 2
+	entityName := "Oxxxymiron"
+
+	expectedRecents := []models.RecentSearch{
+		{ID: 2, Query: "oxxx", EntityType: &entityType, EntityID: &entityID, EntityName: &entityName, SearchedAt: searchedAt},
+		{ID: 1, Query: "oxxxymiron &amp; co", SearchedAt: searchedAt},
+	}
+
+	expectTables := func() {
+		tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable)
+		tablesMock.EXPECT().Albums().Return(albumsTable)
+		tablesMock.EXPECT().Artists().Return(artistsTable)
+		tablesMock.EXPECT().Tracks().Return(tracksTable)
+		tablesMock.EXPECT().Playlists().Return(playlistsTable)
+	}
+
+	testTable := []struct {
+		name            string
+		mockBehavior    mockBehavior
+		expectedRecents []models.RecentSearch
+		expectError     bool
+		expectedError   error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID, limit uint32) {
+				expectTables()
+
+				rows := sqlxMock.NewRows([]string{"id", "query", "entity_type", "entity_id", "searched_at", "entity_name"})
+				for _, r := range expectedRecents {
+					rows.AddRow(r.ID, r.Query, r.EntityType, r.EntityID, r.SearchedAt, r.EntityName)
+				}
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+recentSearchesTable).
+					WithArgs(userID, limit).
+					WillReturnRows(rows)
+			},
+			expectedRecents: expectedRecents,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID, limit uint32) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+recentSearchesTable).
+					WithArgs(userID, limit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultUserID, defaultLimit)
+
+			recents, err := repo.GetRecentSearches(ctx, defaultUserID, defaultLimit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRecents, recents)
+			}
+		})
+	}
+}
+
+func TestSearchRepositoryPostgreSQL_DeleteRecentSearch(t *testing.T) {
+	// Init
+	type mockBehavior func(recentSearchID, userID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := searchMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, search.RankWeights{})
+
+	// Test filling
+	const defaultRecentSearchID uint32 = 2
+	const defaultUserID uint32 = 1
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(recentSearchID, userID uint32) {
+				tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable)
+
+				sqlxMock.ExpectExec("DELETE FROM "+recentSearchesTable).
+					WithArgs(recentSearchID, userID).
+					WillReturnResult(driver.RowsAffected(1))
+			},
+		},
+		{
+			name: "No Such Recent Search",
+			mockBehavior: func(recentSearchID, userID uint32) {
+				tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable)
+
+				sqlxMock.ExpectExec("DELETE FROM "+recentSearchesTable).
+					WithArgs(recentSearchID, userID).
+					WillReturnResult(driver.RowsAffected(0))
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchRecentSearchError{RecentSearchID: defaultRecentSearchID},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(recentSearchID, userID uint32) {
+				tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable)
+
+				sqlxMock.ExpectExec("DELETE FROM "+recentSearchesTable).
+					WithArgs(recentSearchID, userID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultRecentSearchID, defaultUserID)
+
+			err := repo.DeleteRecentSearch(ctx, defaultRecentSearchID, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSearchRepositoryPostgreSQL_ClearRecentSearches(t *testing.T) {
+	// Init
+	type mockBehavior func(userID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := searchMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, search.RankWeights{})
+
+	// Test filling
+	const defaultUserID uint32 = 1
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID uint32) {
+				tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable)
+
+				sqlxMock.ExpectExec("DELETE FROM " + recentSearchesTable).
+					WithArgs(userID).
+					WillReturnResult(driver.RowsAffected(3))
+			},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID uint32) {
+				tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable)
+
+				sqlxMock.ExpectExec("DELETE FROM " + recentSearchesTable).
+					WithArgs(userID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultUserID)
+
+			err := repo.ClearRecentSearches(ctx, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSearchRepositoryPostgreSQL_GetClickedEntities(t *testing.T) {
+	// Init
+	type mockBehavior func(userID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := searchMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock, search.RankWeights{})
+
+	// Test filling
+	const defaultUserID uint32 = 1
+
+	expectedEntities := []models.SearchEntity{
+		{Type: models.SearchTypeArtist, ID: 2},
+		{Type: models.SearchTypeTrack, ID: 3},
+	}
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectedEntities []models.SearchEntity
+		expectError      bool
+		expectedError    error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID uint32) {
+				tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable)
+
+				rows := sqlxMock.NewRows([]string{"entity_type", "entity_id"})
+				for _, e := range expectedEntities {
+					rows.AddRow(e.Type, e.ID)
+				}
+				sqlxMock.ExpectQuery("SELECT DISTINCT (.+) FROM " + recentSearchesTable).
+					WithArgs(userID).
+					WillReturnRows(rows)
+			},
+			expectedEntities: expectedEntities,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID uint32) {
+				tablesMock.EXPECT().RecentSearches().Return(recentSearchesTable)
+
+				sqlxMock.ExpectQuery("SELECT DISTINCT (.+) FROM " + recentSearchesTable).
+					WithArgs(userID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultUserID)
+
+			entities, err := repo.GetClickedEntities(ctx, defaultUserID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedEntities, entities)
+			}
+		})
+	}
+}
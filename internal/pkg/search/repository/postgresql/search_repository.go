@@ -3,105 +3,539 @@ package postgresql
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search"
 	"github.com/jmoiron/sqlx"
+
+	commonSQL "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/db"
 )
 
-// PostgreSQL implements artist.Repository
+// PostgreSQL implements search.Repository
 type PostgreSQL struct {
-	db     *sqlx.DB
-	tables search.Tables
+	db      *sqlx.DB
+	tables  search.Tables
+	weights search.RankWeights
 }
 
-func NewPostgreSQL(db *sqlx.DB, t search.Tables) *PostgreSQL {
+func NewPostgreSQL(db *sqlx.DB, t search.Tables, w search.RankWeights) *PostgreSQL {
 	return &PostgreSQL{
-		db:     db,
-		tables: t,
+		db:      db,
+		tables:  t,
+		weights: w,
+	}
+}
+
+// searchTsQuery parses query with both english and russian configurations,
+// as language of query isn't known, while names are indexed with their own one.
+// Query is always the first argument.
+const searchTsQuery = `(plainto_tsquery('english', $1) || plainto_tsquery('russian', $1))`
+
+// searchRank merges full-text rank with trigram similarity into a single score
+const searchRank = `ts_rank(to_tsvector(lang, name), ` + searchTsQuery + `)
+	+ word_similarity(LOWER($1), LOWER(name))`
+
+// searchHighlight is name with words matching query between
+// models.HighlightStart and models.HighlightStop markers
+const searchHighlight = `ts_headline(lang, name, ` + searchTsQuery + `,
+			'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', HighlightAll=true')`
+
+// exactSearchCondition matches names containing words or substring of query
+const exactSearchCondition = `(to_tsvector(lang, name) @@ ` + searchTsQuery + `
+			OR LOWER(name) LIKE LOWER('%' || $1 || '%'))`
+
+// fuzzySearchCondition matches names containing word similar to query,
+// so misspelled queries find something. It is served by trigram GIN indexes.
+const fuzzySearchCondition = `LOWER($1) <% LOWER(name)`
+
+// popularityRank adds to searchRank log-scaled amounts of entity's listens and likes
+// and bonus for being liked by user. User ID and weights of listens, likes and
+// personal bonus are expected to be $3-$6 arguments. User ID 0 gets no bonus.
+func popularityRank(table, listens, likedTable, likedColumn string) string {
+	return fmt.Sprintf(
+		`%[1]s
+		+ $4 * LN(1 + %[3]s)
+		+ $5 * LN(1 + (SELECT COUNT(*) FROM %[4]s l WHERE l.%[5]s = %[2]s.id))
+		+ $6 * (EXISTS (SELECT 1 FROM %[4]s l WHERE l.%[5]s = %[2]s.id AND l.user_id = $3))::INT`,
+		searchRank, table, listens, likedTable, likedColumn,
+	)
+}
+
+// Ranks of entities. Listens of albums, artists and playlists are sums of their tracks' ones.
+
+func (p *PostgreSQL) albumsRank() string {
+	listens := fmt.Sprintf(
+		`(SELECT COALESCE(SUM(t.listens), 0) FROM %s t WHERE t.album_id = %s.id)`,
+		p.tables.Tracks(), p.tables.Albums(),
+	)
+	return popularityRank(p.tables.Albums(), listens, p.tables.LikedAlbums(), "album_id")
+}
+
+func (p *PostgreSQL) artistsRank() string {
+	listens := fmt.Sprintf(
+		`(SELECT COALESCE(SUM(t.listens), 0)
+			FROM %s atr INNER JOIN %s t ON t.id = atr.track_id
+			WHERE atr.artist_id = %s.id)`,
+		p.tables.ArtistsTracks(), p.tables.Tracks(), p.tables.Artists(),
+	)
+	return popularityRank(p.tables.Artists(), listens, p.tables.LikedArtists(), "artist_id")
+}
+
+func (p *PostgreSQL) tracksRank() string {
+	listens := fmt.Sprintf(`%s.listens`, p.tables.Tracks())
+	return popularityRank(p.tables.Tracks(), listens, p.tables.LikedTracks(), "track_id")
+}
+
+// tracksSearchCondition is exactSearchCondition extended to tracks which lyrics match query
+func (p *PostgreSQL) tracksSearchCondition() string {
+	return fmt.Sprintf(
+		`(%s
+			OR EXISTS (SELECT 1 FROM %s ly
+				WHERE ly.track_id = %s.id AND to_tsvector(ly.lang, ly.text) @@ %s))`,
+		exactSearchCondition, p.tables.Lyrics(), p.tables.Tracks(), searchTsQuery,
+	)
+}
+
+// lyricsSnippet is fragment of track's lyrics with words matching query highlighted
+// like in searchHighlight, it's empty if lyrics don't match
+func (p *PostgreSQL) lyricsSnippet() string {
+	return fmt.Sprintf(
+		`COALESCE((SELECT ts_headline(ly.lang, ly.text, %[3]s,
+				'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=1, MaxWords=12, MinWords=4')
+			FROM %[1]s ly
+			WHERE ly.track_id = %[2]s.id AND to_tsvector(ly.lang, ly.text) @@ %[3]s), '')`,
+		p.tables.Lyrics(), p.tables.Tracks(), searchTsQuery,
+	)
+}
+
+// lyricsRank is full-text rank of track's lyrics. It's scaled down,
+// so tracks matched by name go before ones matched only by lyrics.
+func (p *PostgreSQL) lyricsRank() string {
+	return fmt.Sprintf(
+		`0.1 * COALESCE((SELECT ts_rank(to_tsvector(ly.lang, ly.text), %[3]s)
+			FROM %[1]s ly WHERE ly.track_id = %[2]s.id), 0)`,
+		p.tables.Lyrics(), p.tables.Tracks(), searchTsQuery,
+	)
+}
+
+func (p *PostgreSQL) playlistsRank() string {
+	listens := fmt.Sprintf(
+		`(SELECT COALESCE(SUM(t.listens), 0)
+			FROM %s ptr INNER JOIN %s t ON t.id = ptr.track_id
+			WHERE ptr.playlist_id = %s.id)`,
+		p.tables.PlaylistsTracks(), p.tables.Tracks(), p.tables.Playlists(),
+	)
+	return popularityRank(p.tables.Playlists(), listens, p.tables.LikedPlaylists(), "playlist_id")
+}
+
+// rankArgs are arguments of search queries ordered by popularityRank
+func (p *PostgreSQL) rankArgs(query string, limit uint32, userID uint32) []interface{} {
+	return []interface{}{query, limit, userID, p.weights.Listens, p.weights.Likes, p.weights.Personal}
+}
+
+// filterConditions builds conditions of filters applicable to entities of given type,
+// which are joined to WHERE clause by AND. Their arguments are appended to args.
+func (p *PostgreSQL) filterConditions(entityType string, userID uint32,
+	f models.SearchFilters, args []interface{}) (string, []interface{}) {
+
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	var table, likedTable, likedColumn string
+	var conditions []string
+	switch entityType {
+	case models.SearchTypeAlbum:
+		table, likedTable, likedColumn = p.tables.Albums(), p.tables.LikedAlbums(), "album_id"
+
+		if f.ArtistID != 0 {
+			conditions = append(conditions, fmt.Sprintf(
+				`EXISTS (SELECT 1 FROM %s aal WHERE aal.album_id = %s.id AND aal.artist_id = %s)`,
+				p.tables.ArtistsAlbums(), table, arg(f.ArtistID)))
+		}
+
+	case models.SearchTypeArtist:
+		table, likedTable, likedColumn = p.tables.Artists(), p.tables.LikedArtists(), "artist_id"
+
+	case models.SearchTypeTrack:
+		table, likedTable, likedColumn = p.tables.Tracks(), p.tables.LikedTracks(), "track_id"
+
+		if f.MinDuration != 0 {
+			conditions = append(conditions, fmt.Sprintf(`%s.duration >= %s`, table, arg(f.MinDuration)))
+		}
+		if f.MaxDuration != 0 {
+			conditions = append(conditions, fmt.Sprintf(`%s.duration <= %s`, table, arg(f.MaxDuration)))
+		}
+		switch f.TrackKind {
+		case models.SearchTrackKindAlbum:
+			conditions = append(conditions, fmt.Sprintf(`%s.album_id IS NOT NULL`, table))
+		case models.SearchTrackKindSingle:
+			conditions = append(conditions, fmt.Sprintf(`%s.album_id IS NULL`, table))
+		}
+		if f.ArtistID != 0 {
+			conditions = append(conditions, fmt.Sprintf(
+				`EXISTS (SELECT 1 FROM %s atr WHERE atr.track_id = %s.id AND atr.artist_id = %s)`,
+				p.tables.ArtistsTracks(), table, arg(f.ArtistID)))
+		}
+
+	case models.SearchTypePlaylist:
+		table, likedTable, likedColumn = p.tables.Playlists(), p.tables.LikedPlaylists(), "playlist_id"
+	}
+
+	if f.OnlyLiked {
+		conditions = append(conditions, fmt.Sprintf(
+			`EXISTS (SELECT 1 FROM %s l WHERE l.%s = %s.id AND l.user_id = %s)`,
+			likedTable, likedColumn, table, arg(userID)))
+	}
+	if f.AddedAfter != nil {
+		conditions = append(conditions, fmt.Sprintf(`%s.created_at > %s`, table, arg(*f.AddedAfter)))
 	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return " AND " + strings.Join(conditions, " AND "), args
 }
 
-func (p *PostgreSQL) FullTextSearchAlbums(
-	ctx context.Context, ftsQuery string, limit uint32) ([]models.Album, error) {
+func (p *PostgreSQL) FullTextSearchAlbums(ctx context.Context, ftsQuery string,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Album, error) {
+
+	filters, args := p.filterConditions(models.SearchTypeAlbum, userID, f, p.rankArgs(ftsQuery, limit, userID))
 
 	query := fmt.Sprintf(
-		`SELECT id, name, description, cover_src
+		`SELECT id, name, description, cover_src, %s AS highlight
 		FROM %s
-		WHERE to_tsvector(lang, name) @@ plainto_tsquery(lang, $1)
-			OR LOWER(name) LIKE LOWER('%%' || $1 || '%%')
-		ORDER BY ts_rank(to_tsvector(lang, name), plainto_tsquery(lang, $1)) DESC
+		WHERE %s%s
+		ORDER BY %s DESC
 		LIMIT $2;`,
-		p.tables.Albums(),
+		searchHighlight, p.tables.Albums(), exactSearchCondition, filters, p.albumsRank(),
 	)
 
 	var albums []models.Album
-	if err := p.db.SelectContext(ctx, &albums, query, ftsQuery, limit); err != nil {
+	if err := p.db.SelectContext(ctx, &albums, query, args...); err != nil {
 		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
 
 	return albums, nil
 }
 
-func (p *PostgreSQL) FullTextSearchArtists(
-	ctx context.Context, ftsQuery string, limit uint32) ([]models.Artist, error) {
+func (p *PostgreSQL) FullTextSearchArtists(ctx context.Context, ftsQuery string,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Artist, error) {
+
+	filters, args := p.filterConditions(models.SearchTypeArtist, userID, f, p.rankArgs(ftsQuery, limit, userID))
 
 	query := fmt.Sprintf(
-		`SELECT id, name, avatar_src
+		`SELECT id, name, avatar_src, %s AS highlight
 		FROM %s
-		WHERE to_tsvector(lang, name) @@ plainto_tsquery(lang, $1)
-			OR LOWER(name) LIKE LOWER('%%' || $1 || '%%')
-		ORDER BY ts_rank(to_tsvector(lang, name), plainto_tsquery(lang, $1)) DESC
+		WHERE %s%s
+		ORDER BY %s DESC
 		LIMIT $2;`,
-		p.tables.Artists(),
+		searchHighlight, p.tables.Artists(), exactSearchCondition, filters, p.artistsRank(),
 	)
 
 	var artists []models.Artist
-	if err := p.db.SelectContext(ctx, &artists, query, ftsQuery, limit); err != nil {
+	if err := p.db.SelectContext(ctx, &artists, query, args...); err != nil {
 		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
 
 	return artists, nil
 }
 
-func (p *PostgreSQL) FullTextSearchTracks(
-	ctx context.Context, ftsQuery string, limit uint32) ([]models.Track, error) {
+func (p *PostgreSQL) FullTextSearchTracks(ctx context.Context, ftsQuery string,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Track, error) {
+
+	filters, args := p.filterConditions(models.SearchTypeTrack, userID, f, p.rankArgs(ftsQuery, limit, userID))
 
 	query := fmt.Sprintf(
-		`SELECT id, name, album_id, cover_src, record_src, duration, listens
+		`SELECT id, name, album_id, cover_src, record_src, duration, listens, %s AS highlight,
+			%s AS lyrics_snippet
 		FROM %s
-		WHERE to_tsvector(lang, name) @@ plainto_tsquery(lang, $1)
-			OR LOWER(name) LIKE LOWER('%%' || $1 || '%%')
-		ORDER BY ts_rank(to_tsvector(lang, name), plainto_tsquery(lang, $1)) DESC
+		WHERE %s%s
+		ORDER BY %s + %s DESC
 		LIMIT $2;`,
-		p.tables.Tracks(),
+		searchHighlight, p.lyricsSnippet(), p.tables.Tracks(), p.tracksSearchCondition(), filters,
+		p.tracksRank(), p.lyricsRank(),
 	)
 
 	var tracks []models.Track
-	if err := p.db.SelectContext(ctx, &tracks, query, ftsQuery, limit); err != nil {
+	if err := p.db.SelectContext(ctx, &tracks, query, args...); err != nil {
 		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
 
 	return tracks, nil
 }
 
-func (p *PostgreSQL) FullTextSearchPlaylists(
-	ctx context.Context, ftsQuery string, limit uint32) ([]models.Playlist, error) {
+func (p *PostgreSQL) FullTextSearchPlaylists(ctx context.Context, ftsQuery string,
+	limit uint32, userID uint32, f models.SearchFilters) ([]models.Playlist, error) {
+
+	filters, args := p.filterConditions(models.SearchTypePlaylist, userID, f, p.rankArgs(ftsQuery, limit, userID))
 
 	query := fmt.Sprintf(
-		`SELECT id, name, description, cover_src
+		`SELECT id, name, description, cover_src, %s AS highlight
 		FROM %s
-		WHERE to_tsvector(lang, name) @@ plainto_tsquery(lang, $1)
-			OR LOWER(name) LIKE LOWER('%%' || $1 || '%%')
-		ORDER BY ts_rank(to_tsvector(lang, name), plainto_tsquery(lang, $1)) DESC
+		WHERE %s%s
+		ORDER BY %s DESC
 		LIMIT $2;`,
-		p.tables.Playlists(),
+		searchHighlight, p.tables.Playlists(), exactSearchCondition, filters, p.playlistsRank(),
 	)
 
 	var playlists []models.Playlist
-	if err := p.db.SelectContext(ctx, &playlists, query, ftsQuery, limit); err != nil {
+	if err := p.db.SelectContext(ctx, &playlists, query, args...); err != nil {
 		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
 
 	return playlists, nil
 }
+
+// fuzzySelect runs select with given word similarity threshold of trigram matching
+func (p *PostgreSQL) fuzzySelect(ctx context.Context, dest interface{},
+	threshold float64, query string, args ...interface{}) (repoErr error) {
+
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("(repo) failed to begin transaction: %w", err)
+	}
+	defer commonSQL.CheckTransaction(tx.Tx, &repoErr)
+
+	// threshold is set only for current transaction
+	if _, err := tx.ExecContext(ctx,
+		`SELECT set_config('pg_trgm.word_similarity_threshold', $1, true);`,
+		strconv.FormatFloat(threshold, 'f', -1, 64)); err != nil {
+
+		return fmt.Errorf("(repo) failed to set similarity threshold: %w", err)
+	}
+
+	if err := tx.SelectContext(ctx, dest, query, args...); err != nil {
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) FuzzySearchAlbums(ctx context.Context, fuzzyQuery string,
+	limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Album, error) {
+
+	filters, args := p.filterConditions(models.SearchTypeAlbum, userID, f, p.rankArgs(fuzzyQuery, limit, userID))
+
+	query := fmt.Sprintf(
+		`SELECT id, name, description, cover_src, %s AS highlight
+		FROM %s
+		WHERE %s%s
+		ORDER BY %s DESC
+		LIMIT $2;`,
+		searchHighlight, p.tables.Albums(), fuzzySearchCondition, filters, p.albumsRank(),
+	)
+
+	var albums []models.Album
+	if err := p.fuzzySelect(ctx, &albums, threshold, query, args...); err != nil {
+		return nil, err
+	}
+
+	return albums, nil
+}
+
+func (p *PostgreSQL) FuzzySearchArtists(ctx context.Context, fuzzyQuery string,
+	limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Artist, error) {
+
+	filters, args := p.filterConditions(models.SearchTypeArtist, userID, f, p.rankArgs(fuzzyQuery, limit, userID))
+
+	query := fmt.Sprintf(
+		`SELECT id, name, avatar_src, %s AS highlight
+		FROM %s
+		WHERE %s%s
+		ORDER BY %s DESC
+		LIMIT $2;`,
+		searchHighlight, p.tables.Artists(), fuzzySearchCondition, filters, p.artistsRank(),
+	)
+
+	var artists []models.Artist
+	if err := p.fuzzySelect(ctx, &artists, threshold, query, args...); err != nil {
+		return nil, err
+	}
+
+	return artists, nil
+}
+
+func (p *PostgreSQL) FuzzySearchTracks(ctx context.Context, fuzzyQuery string,
+	limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Track, error) {
+
+	filters, args := p.filterConditions(models.SearchTypeTrack, userID, f, p.rankArgs(fuzzyQuery, limit, userID))
+
+	query := fmt.Sprintf(
+		`SELECT id, name, album_id, cover_src, record_src, duration, listens, %s AS highlight
+		FROM %s
+		WHERE %s%s
+		ORDER BY %s DESC
+		LIMIT $2;`,
+		searchHighlight, p.tables.Tracks(), fuzzySearchCondition, filters, p.tracksRank(),
+	)
+
+	var tracks []models.Track
+	if err := p.fuzzySelect(ctx, &tracks, threshold, query, args...); err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
+
+func (p *PostgreSQL) FuzzySearchPlaylists(ctx context.Context, fuzzyQuery string,
+	limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Playlist, error) {
+
+	filters, args := p.filterConditions(models.SearchTypePlaylist, userID, f, p.rankArgs(fuzzyQuery, limit, userID))
+
+	query := fmt.Sprintf(
+		`SELECT id, name, description, cover_src, %s AS highlight
+		FROM %s
+		WHERE %s%s
+		ORDER BY %s DESC
+		LIMIT $2;`,
+		searchHighlight, p.tables.Playlists(), fuzzySearchCondition, filters, p.playlistsRank(),
+	)
+
+	var playlists []models.Playlist
+	if err := p.fuzzySelect(ctx, &playlists, threshold, query, args...); err != nil {
+		return nil, err
+	}
+
+	return playlists, nil
+}
+
+func (p *PostgreSQL) GetFacets(ctx context.Context, ftsQuery string,
+	userID uint32, f models.SearchFilters, artistsLimit uint32) (*models.SearchFacets, error) {
+
+	counts, err := p.getSearchCounts(ctx, ftsQuery, userID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	artists, err := p.getSearchArtistFacets(ctx, ftsQuery, userID, f, artistsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SearchFacets{
+		Counts:  *counts,
+		Artists: artists,
+	}, nil
+}
+
+func (p *PostgreSQL) getSearchCounts(ctx context.Context,
+	ftsQuery string, userID uint32, f models.SearchFilters) (*models.SearchCounts, error) {
+
+	args := []interface{}{ftsQuery}
+	count := func(entityType, table string) string {
+		if !f.Supports(entityType) {
+			return "0"
+		}
+
+		condition := exactSearchCondition
+		if entityType == models.SearchTypeTrack {
+			condition = p.tracksSearchCondition()
+		}
+
+		var filters string
+		filters, args = p.filterConditions(entityType, userID, f, args)
+		return fmt.Sprintf(`(SELECT COUNT(*) FROM %s WHERE %s%s)`, table, condition, filters)
+	}
+
+	query := fmt.Sprintf(
+		`SELECT %s AS albums,
+			%s AS artists,
+			%s AS tracks,
+			%s AS playlists;`,
+		count(models.SearchTypeAlbum, p.tables.Albums()),
+		count(models.SearchTypeArtist, p.tables.Artists()),
+		count(models.SearchTypeTrack, p.tables.Tracks()),
+		count(models.SearchTypePlaylist, p.tables.Playlists()),
+	)
+
+	var counts models.SearchCounts
+	if err := p.db.GetContext(ctx, &counts, query, args...); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return &counts, nil
+}
+
+// getSearchArtistFacets counts found tracks and albums of each artist
+func (p *PostgreSQL) getSearchArtistFacets(ctx context.Context, ftsQuery string,
+	userID uint32, f models.SearchFilters, limit uint32) ([]models.SearchArtistFacet, error) {
+
+	if !f.Supports(models.SearchTypeTrack) {
+		return []models.SearchArtistFacet{}, nil
+	}
+
+	args := []interface{}{ftsQuery, limit}
+
+	var trackFilters string
+	trackFilters, args = p.filterConditions(models.SearchTypeTrack, userID, f, args)
+	matched := fmt.Sprintf(
+		`SELECT atr.artist_id
+		FROM %[1]s INNER JOIN %[2]s atr ON atr.track_id = %[1]s.id
+		WHERE %[3]s%[4]s`,
+		p.tables.Tracks(), p.tables.ArtistsTracks(), p.tracksSearchCondition(), trackFilters,
+	)
+
+	if f.Supports(models.SearchTypeAlbum) {
+		var albumFilters string
+		albumFilters, args = p.filterConditions(models.SearchTypeAlbum, userID, f, args)
+		matched += fmt.Sprintf(
+			`
+		UNION ALL
+		SELECT aal.artist_id
+		FROM %[1]s INNER JOIN %[2]s aal ON aal.album_id = %[1]s.id
+		WHERE %[3]s%[4]s`,
+			p.tables.Albums(), p.tables.ArtistsAlbums(), exactSearchCondition, albumFilters,
+		)
+	}
+
+	query := fmt.Sprintf(
+		`SELECT a.id AS artist_id, a.name, COUNT(*) AS count
+		FROM (%s) m INNER JOIN %s a ON a.id = m.artist_id
+		GROUP BY a.id, a.name
+		ORDER BY count DESC, a.id
+		LIMIT $2;`,
+		matched, p.tables.Artists(),
+	)
+
+	artists := []models.SearchArtistFacet{}
+	if err := p.db.SelectContext(ctx, &artists, query, args...); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return artists, nil
+}
+
+func (p *PostgreSQL) GetSuggestions(ctx context.Context,
+	entityType string, afterID uint32) ([]models.SearchSuggestion, error) {
+
+	var table string
+	switch entityType {
+	case models.SearchTypeArtist:
+		table = p.tables.Artists()
+	case models.SearchTypeTrack:
+		table = p.tables.Tracks()
+	case models.SearchTypeAlbum:
+		table = p.tables.Albums()
+	default:
+		return nil, fmt.Errorf("(repo) suggestions of type %q aren't supported", entityType)
+	}
+
+	query := fmt.Sprintf(
+		`SELECT $1::TEXT AS type, id, name
+		FROM %s
+		WHERE id > $2
+		ORDER BY id;`,
+		table,
+	)
+
+	var suggestions []models.SearchSuggestion
+	if err := p.db.SelectContext(ctx, &suggestions, query, entityType, afterID); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return suggestions, nil
+}
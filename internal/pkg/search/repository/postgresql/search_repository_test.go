@@ -1 +1,16 @@
 package postgresql
+
+import (
+	"context"
+	"errors"
+)
+
+var ctx = context.Background()
+
+const albumsTable = "Albums"
+const artistsTable = "Artists"
+const tracksTable = "Tracks"
+const playlistsTable = "Playlists"
+const recentSearchesTable = "Recent_searches"
+
+var errPqInternal = errors.New("postgres is dead")
@@ -8,20 +8,122 @@ import (
 
 //go:generate mockgen -source=search.go -destination=mocks/mock.go
 
-// Usecase includes bussiness logics methods to work with search
+// Usecase includes bussiness logics methods to work with search.
+// userID is 0 for unauthorized user, so search results aren't personalized.
+// Entities of types not supporting some of filters aren't found.
 type Usecase interface {
-	FindAlbums(ctx context.Context, query string, amount uint32) ([]models.Album, error)
-	FindArtists(ctx context.Context, query string, amount uint32) ([]models.Artist, error)
-	FindTracks(ctx context.Context, query string, amount uint32) ([]models.Track, error)
-	FindPlaylists(ctx context.Context, query string, amount uint32) ([]models.Playlist, error)
+	FindAlbums(ctx context.Context,
+		query string, amount uint32, userID uint32, f models.SearchFilters) ([]models.Album, error)
+	FindArtists(ctx context.Context,
+		query string, amount uint32, userID uint32, f models.SearchFilters) ([]models.Artist, error)
+	FindTracks(ctx context.Context,
+		query string, amount uint32, userID uint32, f models.SearchFilters) ([]models.Track, error)
+	FindPlaylists(ctx context.Context,
+		query string, amount uint32, userID uint32, f models.SearchFilters) ([]models.Playlist, error)
+
+	// Search finds up to amount entities of each type, picks the most relevant of them
+	// and counts facets of all found entities
+	Search(ctx context.Context,
+		query string, amount uint32, userID uint32, f models.SearchFilters) (*models.SearchResult, error)
+
+	// Suggest returns names of artists, tracks and albums starting with prefix.
+	// Entities user clicked in search results before go first.
+	Suggest(ctx context.Context, prefix string, amount uint32, userID uint32) ([]models.SearchSuggestion, error)
+
+	// AddRecentSearch remembers query searched by user or result clicked by user
+	AddRecentSearch(ctx context.Context, userID uint32, recent models.RecentSearch) error
+	// GetRecentSearches returns up to limit user's recent searches, the latest first
+	GetRecentSearches(ctx context.Context, userID uint32, limit uint32) ([]models.RecentSearch, error)
+	DeleteRecentSearch(ctx context.Context, recentSearchID uint32, userID uint32) error
+	ClearRecentSearches(ctx context.Context, userID uint32) error
 }
 
-// Repository includes DBMS-relatable methods to work with search
+// Repository includes DBMS-relatable methods to work with search.
+// Found entities are ordered by relevance to query mixed with their popularity
+// and, if userID isn't 0, with user's likes.
 type Repository interface {
-	FullTextSearchAlbums(ctx context.Context, query string, limit uint32) ([]models.Album, error)
-	FullTextSearchArtists(ctx context.Context, query string, limit uint32) ([]models.Artist, error)
-	FullTextSearchTracks(ctx context.Context, query string, limit uint32) ([]models.Track, error)
-	FullTextSearchPlaylists(ctx context.Context, query string, limit uint32) ([]models.Playlist, error)
+	FullTextSearchAlbums(ctx context.Context,
+		query string, limit uint32, userID uint32, f models.SearchFilters) ([]models.Album, error)
+	FullTextSearchArtists(ctx context.Context,
+		query string, limit uint32, userID uint32, f models.SearchFilters) ([]models.Artist, error)
+	// FullTextSearchTracks also finds tracks which lyrics match query, LyricsSnippet of them is set
+	FullTextSearchTracks(ctx context.Context,
+		query string, limit uint32, userID uint32, f models.SearchFilters) ([]models.Track, error)
+	FullTextSearchPlaylists(ctx context.Context,
+		query string, limit uint32, userID uint32, f models.SearchFilters) ([]models.Playlist, error)
+
+	// FuzzySearch* find entities which names contain word similar to query
+	// at least by threshold in terms of trigram similarity
+	FuzzySearchAlbums(ctx context.Context, query string,
+		limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Album, error)
+	FuzzySearchArtists(ctx context.Context, query string,
+		limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Artist, error)
+	FuzzySearchTracks(ctx context.Context, query string,
+		limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Track, error)
+	FuzzySearchPlaylists(ctx context.Context, query string,
+		limit uint32, userID uint32, f models.SearchFilters, threshold float64) ([]models.Playlist, error)
+
+	// GetFacets counts entities matching query exactly and up to artistsLimit
+	// artists with the most of tracks and albums among them
+	GetFacets(ctx context.Context, query string,
+		userID uint32, f models.SearchFilters, artistsLimit uint32) (*models.SearchFacets, error)
+
+	// GetSuggestions returns names of entities of given type with IDs greater than afterID
+	GetSuggestions(ctx context.Context, entityType string, afterID uint32) ([]models.SearchSuggestion, error)
+
+	// AddRecentSearch inserts user's recent search, replacing the same one,
+	// and deletes all but keep the latest ones
+	AddRecentSearch(ctx context.Context, userID uint32, recent models.RecentSearch, keep uint32) error
+	// GetRecentSearches returns up to limit user's recent searches, the latest first,
+	// with names of clicked entities
+	GetRecentSearches(ctx context.Context, userID uint32, limit uint32) ([]models.RecentSearch, error)
+	DeleteRecentSearch(ctx context.Context, recentSearchID uint32, userID uint32) error
+	ClearRecentSearches(ctx context.Context, userID uint32) error
+	// GetClickedEntities returns entities user clicked among recent searches
+	GetClickedEntities(ctx context.Context, userID uint32) ([]models.SearchEntity, error)
+}
+
+// IndexSource is database which search index outside of it is built from.
+// Data which isn't indexed, like users' likes and recent searches, is taken from it too.
+type IndexSource interface {
+	Repository
+
+	// GetSearchDocuments returns entities of given type with IDs greater than afterID ordered by ID
+	GetSearchDocuments(ctx context.Context, entityType string, afterID uint32) ([]models.SearchDocument, error)
+	// GetLikedIDs returns IDs of entities of given type liked by user
+	GetLikedIDs(ctx context.Context, entityType string, userID uint32) ([]uint32, error)
+}
+
+// Config includes tunable parameters of search
+type Config struct {
+	// FuzzyThreshold is minimal trigram similarity of name's word to query in fuzzy search
+	FuzzyThreshold float64
+	// FuzzyFallbackMin is amount of exact matches below which fuzzy ones are appended
+	FuzzyFallbackMin uint32
+	// Rank is how popularity affects order of results
+	Rank RankWeights
+}
+
+// RankWeights are weights of popularity in search ranking
+// relatively to text relevance, which is roughly between 0 and 1
+type RankWeights struct {
+	// Listens and Likes are multiplied by natural logarithm of amount of listens and likes
+	Listens float64
+	Likes   float64
+	// Personal is added if user liked entity
+	Personal float64
+}
+
+func DefaultConfig() Config {
+	return Config{
+		FuzzyThreshold:   0.4,
+		FuzzyFallbackMin: 5,
+		Rank: RankWeights{
+			Listens:  0.02,
+			Likes:    0.05,
+			Personal: 0.3,
+		},
+	}
 }
 
 // Tables includes methods which return needed tables
@@ -31,4 +133,13 @@ type Tables interface {
 	Artists() string
 	Tracks() string
 	Playlists() string
+	ArtistsAlbums() string
+	ArtistsTracks() string
+	PlaylistsTracks() string
+	Lyrics() string
+	LikedAlbums() string
+	LikedArtists() string
+	LikedTracks() string
+	LikedPlaylists() string
+	RecentSearches() string
 }
@@ -3,50 +3,409 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"html"
+	"strings"
+	"sync"
 
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search/autocomplete"
 )
 
 // Usecase implements search.Usecase
 type Usecase struct {
 	searchRepo search.Repository
+	cfg        search.Config
+
+	suggestions *autocomplete.Index
+	// lastSuggested holds the greatest ID of indexed entity of each type
+	lastSuggested   map[string]uint32
+	lastSuggestedMu sync.Mutex
 }
 
-func NewUsecase(sr search.Repository) *Usecase {
+func NewUsecase(sr search.Repository, cfg search.Config) *Usecase {
 	return &Usecase{
-		searchRepo: sr,
+		searchRepo:    sr,
+		cfg:           cfg,
+		suggestions:   autocomplete.NewIndex(),
+		lastSuggested: make(map[string]uint32),
 	}
 }
 
-func (u *Usecase) FindAlbums(ctx context.Context, query string, amount uint32) ([]models.Album, error) {
-	albums, err := u.searchRepo.FullTextSearchAlbums(ctx, query, amount)
+func (u *Usecase) FindAlbums(ctx context.Context, query string,
+	amount uint32, userID uint32, f models.SearchFilters) ([]models.Album, error) {
+
+	if !f.Supports(models.SearchTypeAlbum) {
+		return []models.Album{}, nil
+	}
+
+	albums, err := u.searchRepo.FullTextSearchAlbums(ctx, query, amount, userID, f)
 	if err != nil {
 		return nil, fmt.Errorf("(usecase) can't find albums by query: %w", err)
 	}
+	if u.needFuzzy(len(albums), amount) {
+		fuzzyAlbums, err := u.searchRepo.FuzzySearchAlbums(ctx, query, amount, userID, f, u.cfg.FuzzyThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("(usecase) can't find albums by fuzzy query: %w", err)
+		}
+
+		albums = appendUnique(albums, fuzzyAlbums, amount, func(e models.Album) uint32 { return e.ID })
+	}
+
+	for i := range albums {
+		albums[i].Highlight = highlightName(albums[i].Highlight, albums[i].Name, query)
+	}
+
 	return albums, nil
 }
 
-func (u *Usecase) FindArtists(ctx context.Context, query string, amount uint32) ([]models.Artist, error) {
-	artists, err := u.searchRepo.FullTextSearchArtists(ctx, query, amount)
+func (u *Usecase) FindArtists(ctx context.Context, query string,
+	amount uint32, userID uint32, f models.SearchFilters) ([]models.Artist, error) {
+
+	if !f.Supports(models.SearchTypeArtist) {
+		return []models.Artist{}, nil
+	}
+
+	artists, err := u.searchRepo.FullTextSearchArtists(ctx, query, amount, userID, f)
 	if err != nil {
 		return nil, fmt.Errorf("(usecase) can't find artists by query: %w", err)
 	}
+	if u.needFuzzy(len(artists), amount) {
+		fuzzyArtists, err := u.searchRepo.FuzzySearchArtists(ctx, query, amount, userID, f, u.cfg.FuzzyThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("(usecase) can't find artists by fuzzy query: %w", err)
+		}
+
+		artists = appendUnique(artists, fuzzyArtists, amount, func(e models.Artist) uint32 { return e.ID })
+	}
+
+	for i := range artists {
+		artists[i].Highlight = highlightName(artists[i].Highlight, artists[i].Name, query)
+	}
+
 	return artists, nil
 }
 
-func (u *Usecase) FindTracks(ctx context.Context, query string, amount uint32) ([]models.Track, error) {
-	tracks, err := u.searchRepo.FullTextSearchTracks(ctx, query, amount)
+func (u *Usecase) FindTracks(ctx context.Context, query string,
+	amount uint32, userID uint32, f models.SearchFilters) ([]models.Track, error) {
+
+	if !f.Supports(models.SearchTypeTrack) {
+		return []models.Track{}, nil
+	}
+
+	tracks, err := u.searchRepo.FullTextSearchTracks(ctx, query, amount, userID, f)
 	if err != nil {
 		return nil, fmt.Errorf("(usecase) can't find tracks by query: %w", err)
 	}
+	if u.needFuzzy(len(tracks), amount) {
+		fuzzyTracks, err := u.searchRepo.FuzzySearchTracks(ctx, query, amount, userID, f, u.cfg.FuzzyThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("(usecase) can't find tracks by fuzzy query: %w", err)
+		}
+
+		tracks = appendUnique(tracks, fuzzyTracks, amount, func(e models.Track) uint32 { return e.ID })
+	}
+
+	for i := range tracks {
+		tracks[i].Highlight = highlightName(tracks[i].Highlight, tracks[i].Name, query)
+	}
+
 	return tracks, nil
 }
 
-func (u *Usecase) FindPlaylists(ctx context.Context, query string, amount uint32) ([]models.Playlist, error) {
-	playlists, err := u.searchRepo.FullTextSearchPlaylists(ctx, query, amount)
+func (u *Usecase) FindPlaylists(ctx context.Context, query string,
+	amount uint32, userID uint32, f models.SearchFilters) ([]models.Playlist, error) {
+
+	if !f.Supports(models.SearchTypePlaylist) {
+		return []models.Playlist{}, nil
+	}
+
+	playlists, err := u.searchRepo.FullTextSearchPlaylists(ctx, query, amount, userID, f)
 	if err != nil {
 		return nil, fmt.Errorf("(usecase) can't find playlists by query: %w", err)
 	}
+	if u.needFuzzy(len(playlists), amount) {
+		fuzzyPlaylists, err := u.searchRepo.FuzzySearchPlaylists(ctx, query, amount, userID, f, u.cfg.FuzzyThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("(usecase) can't find playlists by fuzzy query: %w", err)
+		}
+
+		playlists = appendUnique(playlists, fuzzyPlaylists, amount, func(e models.Playlist) uint32 { return e.ID })
+	}
+
+	for i := range playlists {
+		playlists[i].Highlight = highlightName(playlists[i].Highlight, playlists[i].Name, query)
+	}
+
 	return playlists, nil
 }
+
+// needFuzzy reports if there are too few exact matches
+func (u *Usecase) needFuzzy(found int, amount uint32) bool {
+	if found >= int(amount) {
+		return false
+	}
+	return uint32(found) < u.cfg.FuzzyFallbackMin
+}
+
+// appendUnique adds fuzzy matches which aren't among exact ones until amount is reached
+func appendUnique[T any](exact, fuzzy []T, amount uint32, id func(T) uint32) []T {
+	seen := make(map[uint32]struct{}, len(exact))
+	for _, e := range exact {
+		seen[id(e)] = struct{}{}
+	}
+
+	for _, e := range fuzzy {
+		if uint32(len(exact)) >= amount {
+			break
+		}
+		if _, ok := seen[id(e)]; ok {
+			continue
+		}
+		seen[id(e)] = struct{}{}
+		exact = append(exact, e)
+	}
+
+	return exact
+}
+
+// searchFacetArtistsLimit is max amount of artists in facets of search result
+const searchFacetArtistsLimit uint32 = 10
+
+func (u *Usecase) Search(ctx context.Context, query string,
+	amount uint32, userID uint32, f models.SearchFilters) (*models.SearchResult, error) {
+
+	var (
+		result models.SearchResult
+		wg     sync.WaitGroup
+
+		albumsErr, artistsErr, tracksErr, playlistsErr, facetsErr error
+	)
+
+	wg.Add(5)
+	go func() {
+		defer wg.Done()
+		result.Albums, albumsErr = u.FindAlbums(ctx, query, amount, userID, f)
+	}()
+	go func() {
+		defer wg.Done()
+		result.Artists, artistsErr = u.FindArtists(ctx, query, amount, userID, f)
+	}()
+	go func() {
+		defer wg.Done()
+		result.Tracks, tracksErr = u.FindTracks(ctx, query, amount, userID, f)
+	}()
+	go func() {
+		defer wg.Done()
+		result.Playlists, playlistsErr = u.FindPlaylists(ctx, query, amount, userID, f)
+	}()
+	go func() {
+		defer wg.Done()
+		result.Facets, facetsErr = u.searchRepo.GetFacets(ctx, query, userID, f, searchFacetArtistsLimit)
+		if facetsErr != nil {
+			facetsErr = fmt.Errorf("(usecase) can't get search facets: %w", facetsErr)
+		}
+	}()
+	wg.Wait()
+
+	for _, err := range []error{albumsErr, artistsErr, tracksErr, playlistsErr, facetsErr} {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.Top = topResult(query, &result)
+
+	return &result, nil
+}
+
+// highlightName returns highlight made by repository if something is marked there.
+// Otherwise name was found by substring or fuzzy matching, so occurrences of query are marked.
+// Name is stored HTML-escaped: query is matched against unescaped name, so markers
+// never split HTML entities, and highlight stays escaped like the name.
+func highlightName(highlight, name, query string) string {
+	if strings.Contains(highlight, models.HighlightStart) {
+		return highlight
+	}
+
+	queryRunes := []rune(strings.TrimSpace(query))
+	nameRunes := []rune(html.UnescapeString(name))
+	if len(queryRunes) == 0 {
+		return name
+	}
+
+	var b strings.Builder
+	unmatched := 0
+	for i := 0; i < len(nameRunes); {
+		end := i + len(queryRunes)
+		if end <= len(nameRunes) && strings.EqualFold(string(nameRunes[i:end]), string(queryRunes)) {
+			b.WriteString(html.EscapeString(string(nameRunes[unmatched:i])))
+			b.WriteString(models.HighlightStart)
+			b.WriteString(html.EscapeString(string(nameRunes[i:end])))
+			b.WriteString(models.HighlightStop)
+			i = end
+			unmatched = end
+			continue
+		}
+
+		i++
+	}
+	b.WriteString(html.EscapeString(string(nameRunes[unmatched:])))
+
+	return b.String()
+}
+
+// Relevance of entity's name to search query
+const (
+	relevanceNone = iota
+	relevanceWords
+	relevanceContains
+	relevancePrefix
+	relevanceExact
+)
+
+func nameRelevance(name, query string) int {
+	name = strings.ToLower(strings.TrimSpace(name))
+	query = strings.ToLower(strings.TrimSpace(query))
+
+	switch {
+	case name == query:
+		return relevanceExact
+	case strings.HasPrefix(name, query):
+		return relevancePrefix
+	case strings.Contains(name, query):
+		return relevanceContains
+	}
+
+	return relevanceWords
+}
+
+// topResult chooses the most relevant among first entities of each group,
+// as groups are already sorted by rank. On equal relevance artists win
+// over tracks, tracks over albums and albums over playlists.
+func topResult(query string, result *models.SearchResult) *models.SearchTopResult {
+	var top *models.SearchTopResult
+	bestRelevance := relevanceNone
+
+	consider := func(entityType string, id uint32, name string) {
+		if relevance := nameRelevance(name, query); relevance > bestRelevance {
+			bestRelevance = relevance
+			top = &models.SearchTopResult{Type: entityType, ID: id}
+		}
+	}
+
+	if len(result.Artists) > 0 {
+		consider(models.SearchTypeArtist, result.Artists[0].ID, result.Artists[0].Name)
+	}
+	if len(result.Tracks) > 0 {
+		consider(models.SearchTypeTrack, result.Tracks[0].ID, result.Tracks[0].Name)
+	}
+	if len(result.Albums) > 0 {
+		consider(models.SearchTypeAlbum, result.Albums[0].ID, result.Albums[0].Name)
+	}
+	if len(result.Playlists) > 0 {
+		consider(models.SearchTypePlaylist, result.Playlists[0].ID, result.Playlists[0].Name)
+	}
+
+	return top
+}
+
+func (u *Usecase) Suggest(ctx context.Context,
+	prefix string, amount uint32, userID uint32) ([]models.SearchSuggestion, error) {
+
+	if userID == 0 {
+		return u.suggestions.Find(prefix, int(amount), nil), nil
+	}
+
+	clicked, err := u.searchRepo.GetClickedEntities(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get entities clicked by user: %w", err)
+	}
+	clickedSet := make(map[models.SearchEntity]struct{}, len(clicked))
+	for _, e := range clicked {
+		clickedSet[e] = struct{}{}
+	}
+
+	return u.suggestions.Find(prefix, int(amount), func(s models.SearchSuggestion) bool {
+		_, ok := clickedSet[models.SearchEntity{Type: s.Type, ID: s.ID}]
+		return ok
+	}), nil
+}
+
+var suggestedTypes = []string{models.SearchTypeArtist, models.SearchTypeTrack, models.SearchTypeAlbum}
+
+// RefreshSuggestions adds entities created since previous refresh to autocomplete index.
+// Full refresh rebuilds index from scratch, so renamed and deleted entities are handled too.
+func (u *Usecase) RefreshSuggestions(ctx context.Context, full bool) error {
+	u.lastSuggestedMu.Lock()
+	defer u.lastSuggestedMu.Unlock()
+
+	lastSuggested := make(map[string]uint32, len(suggestedTypes))
+	var suggestions []models.SearchSuggestion
+	for _, entityType := range suggestedTypes {
+		var afterID uint32
+		if !full {
+			afterID = u.lastSuggested[entityType]
+		}
+
+		found, err := u.searchRepo.GetSuggestions(ctx, entityType, afterID)
+		if err != nil {
+			return fmt.Errorf("(usecase) can't get suggestions of type %s: %w", entityType, err)
+		}
+
+		// suggestions are ordered by ID
+		lastSuggested[entityType] = afterID
+		if len(found) > 0 {
+			lastSuggested[entityType] = found[len(found)-1].ID
+		}
+		suggestions = append(suggestions, found...)
+	}
+
+	if full {
+		u.suggestions.Replace(suggestions)
+	} else {
+		u.suggestions.Add(suggestions)
+	}
+	u.lastSuggested = lastSuggested
+
+	return nil
+}
+
+// recentSearchesKept is max amount of recent searches stored per user
+const recentSearchesKept uint32 = 50
+
+func (u *Usecase) AddRecentSearch(ctx context.Context, userID uint32, recent models.RecentSearch) error {
+	recent.Query = strings.TrimSpace(recent.Query)
+	if err := u.searchRepo.AddRecentSearch(ctx, userID, recent, recentSearchesKept); err != nil {
+		return fmt.Errorf("(usecase) can't add recent search: %w", err)
+	}
+
+	return nil
+}
+
+func (u *Usecase) GetRecentSearches(ctx context.Context,
+	userID uint32, limit uint32) ([]models.RecentSearch, error) {
+
+	recents, err := u.searchRepo.GetRecentSearches(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get recent searches: %w", err)
+	}
+
+	return recents, nil
+}
+
+func (u *Usecase) DeleteRecentSearch(ctx context.Context, recentSearchID uint32, userID uint32) error {
+	if err := u.searchRepo.DeleteRecentSearch(ctx, recentSearchID, userID); err != nil {
+		return fmt.Errorf("(usecase) can't delete recent search: %w", err)
+	}
+
+	return nil
+}
+
+func (u *Usecase) ClearRecentSearches(ctx context.Context, userID uint32) error {
+	if err := u.searchRepo.ClearRecentSearches(ctx, userID); err != nil {
+		return fmt.Errorf("(usecase) can't clear recent searches: %w", err)
+	}
+
+	return nil
+}
@@ -1 +1,535 @@
 package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search"
+	searchMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/search/mocks"
+)
+
+var ctx = context.Background()
+
+func TestSearchUsecase_Search(t *testing.T) {
+	type mockBehavior func(sr *searchMocks.MockRepository, query string, amount uint32, f models.SearchFilters)
+
+	c := gomock.NewController(t)
+
+	sr := searchMocks.NewMockRepository(c)
+
+	// fuzzy fallback is disabled
+	u := NewUsecase(sr, search.Config{})
+
+	const defaultAmount uint32 = 5
+	const userID uint32 = 0 // unauthorized
+
+	albums := []models.Album{{ID: 1, Name: "Горгород"}}
+	artists := []models.Artist{{ID: 2, Name: "Oxxxymiron"}}
+	tracks := []models.Track{{ID: 3, Name: "Где нас нет"}}
+	playlists := []models.Playlist{{ID: 4, Name: "Oxxxymiron best"}}
+	facets := &models.SearchFacets{
+		Counts:  models.SearchCounts{Albums: 1, Artists: 1, Tracks: 1, Playlists: 1},
+		Artists: []models.SearchArtistFacet{{ArtistID: 2, Name: "Oxxxymiron", Count: 2}},
+	}
+
+	testTable := []struct {
+		name             string
+		query            string
+		filters          models.SearchFilters
+		mockBehavior     mockBehavior
+		expectedTop      *models.SearchTopResult
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:  "Exact Artist",
+			query: "oxxxymiron",
+			mockBehavior: func(sr *searchMocks.MockRepository, query string, amount uint32, f models.SearchFilters) {
+				sr.EXPECT().FullTextSearchAlbums(gomock.Any(), query, amount, userID, f).Return(albums, nil)
+				sr.EXPECT().FullTextSearchArtists(gomock.Any(), query, amount, userID, f).Return(artists, nil)
+				sr.EXPECT().FullTextSearchTracks(gomock.Any(), query, amount, userID, f).Return(nil, nil)
+				sr.EXPECT().FullTextSearchPlaylists(gomock.Any(), query, amount, userID, f).Return(playlists, nil)
+				sr.EXPECT().GetFacets(gomock.Any(), query, userID, f, searchFacetArtistsLimit).Return(facets, nil)
+			},
+			expectedTop: &models.SearchTopResult{Type: models.SearchTypeArtist, ID: 2},
+		},
+		{
+			name:  "Exact Album",
+			query: "Горгород",
+			mockBehavior: func(sr *searchMocks.MockRepository, query string, amount uint32, f models.SearchFilters) {
+				sr.EXPECT().FullTextSearchAlbums(gomock.Any(), query, amount, userID, f).Return(albums, nil)
+				sr.EXPECT().FullTextSearchArtists(gomock.Any(), query, amount, userID, f).Return(artists, nil)
+				sr.EXPECT().FullTextSearchTracks(gomock.Any(), query, amount, userID, f).Return(tracks, nil)
+				sr.EXPECT().FullTextSearchPlaylists(gomock.Any(), query, amount, userID, f).Return(nil, nil)
+				sr.EXPECT().GetFacets(gomock.Any(), query, userID, f, searchFacetArtistsLimit).Return(facets, nil)
+			},
+			expectedTop: &models.SearchTopResult{Type: models.SearchTypeAlbum, ID: 1},
+		},
+		{
+			name:    "Tracks Filtered By Duration",
+			query:   "где нас нет",
+			filters: models.SearchFilters{MinDuration: 120, MaxDuration: 300},
+			mockBehavior: func(sr *searchMocks.MockRepository, query string, amount uint32, f models.SearchFilters) {
+				sr.EXPECT().FullTextSearchTracks(gomock.Any(), query, amount, userID, f).Return(tracks, nil)
+				sr.EXPECT().GetFacets(gomock.Any(), query, userID, f, searchFacetArtistsLimit).Return(facets, nil)
+			},
+			expectedTop: &models.SearchTopResult{Type: models.SearchTypeTrack, ID: 3},
+		},
+		{
+			name:  "Nothing Found",
+			query: "nothing",
+			mockBehavior: func(sr *searchMocks.MockRepository, query string, amount uint32, f models.SearchFilters) {
+				sr.EXPECT().FullTextSearchAlbums(gomock.Any(), query, amount, userID, f).Return(nil, nil)
+				sr.EXPECT().FullTextSearchArtists(gomock.Any(), query, amount, userID, f).Return(nil, nil)
+				sr.EXPECT().FullTextSearchTracks(gomock.Any(), query, amount, userID, f).Return(nil, nil)
+				sr.EXPECT().FullTextSearchPlaylists(gomock.Any(), query, amount, userID, f).Return(nil, nil)
+				sr.EXPECT().GetFacets(gomock.Any(), query, userID, f, searchFacetArtistsLimit).Return(facets, nil)
+			},
+		},
+		{
+			name:  "Tracks Issue",
+			query: "oxxxymiron",
+			mockBehavior: func(sr *searchMocks.MockRepository, query string, amount uint32, f models.SearchFilters) {
+				sr.EXPECT().FullTextSearchAlbums(gomock.Any(), query, amount, userID, f).Return(albums, nil)
+				sr.EXPECT().FullTextSearchArtists(gomock.Any(), query, amount, userID, f).Return(artists, nil)
+				sr.EXPECT().FullTextSearchTracks(gomock.Any(), query, amount, userID, f).Return(nil, errors.New(""))
+				sr.EXPECT().FullTextSearchPlaylists(gomock.Any(), query, amount, userID, f).Return(playlists, nil)
+				sr.EXPECT().GetFacets(gomock.Any(), query, userID, f, searchFacetArtistsLimit).Return(facets, nil)
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't find tracks by query",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(sr, tc.query, defaultAmount, tc.filters)
+
+			result, err := u.Search(ctx, tc.query, defaultAmount, userID, tc.filters)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTop, result.Top)
+				assert.Equal(t, facets, result.Facets)
+			}
+		})
+	}
+}
+
+func TestSearchUsecase_FindTracks(t *testing.T) {
+	type mockBehavior func(sr *searchMocks.MockRepository, query string, amount uint32)
+
+	c := gomock.NewController(t)
+
+	sr := searchMocks.NewMockRepository(c)
+
+	cfg := search.Config{
+		FuzzyThreshold:   0.5,
+		FuzzyFallbackMin: 2,
+	}
+	u := NewUsecase(sr, cfg)
+
+	const defaultAmount uint32 = 3
+	const userID uint32 = 1
+	noFilters := models.SearchFilters{}
+
+	// repository returns new slices on every call, as usecase fills their highlights
+	exactTracks := func() []models.Track {
+		return []models.Track{
+			{ID: 1, Name: "Master of Puppets", Highlight: "\x02Master\x03 of Puppets"},
+			{ID: 2, Name: "Masters of War", Highlight: "Masters of War"},
+		}
+	}
+	fuzzyTracks := func() []models.Track {
+		return []models.Track{
+			{ID: 1, Name: "Master of Puppets", Highlight: "Master of Puppets"},
+			{ID: 3, Name: "Mastermind", Highlight: "Mastermind"},
+			{ID: 4, Name: "Mast", Highlight: "Mast"},
+		}
+	}
+
+	testTable := []struct {
+		name             string
+		query            string
+		mockBehavior     mockBehavior
+		expectedTracks   []models.Track
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:  "Enough Exact Matches",
+			query: "master",
+			mockBehavior: func(sr *searchMocks.MockRepository, query string, amount uint32) {
+				sr.EXPECT().FullTextSearchTracks(ctx, query, amount, userID, noFilters).Return(exactTracks(), nil)
+			},
+			expectedTracks: []models.Track{
+				{ID: 1, Name: "Master of Puppets", Highlight: "\x02Master\x03 of Puppets"},
+				{ID: 2, Name: "Masters of War", Highlight: "\x02Master\x03s of War"},
+			},
+		},
+		{
+			name:  "Escaped Names",
+			query: "amp",
+			mockBehavior: func(sr *searchMocks.MockRepository, query string, amount uint32) {
+				sr.EXPECT().FullTextSearchTracks(ctx, query, amount, userID, noFilters).Return([]models.Track{
+					{ID: 5, Name: "Rock &amp; Roll", Highlight: "Rock &amp; Roll"},
+					{ID: 6, Name: "Amp &lt;3", Highlight: "Amp &lt;3"},
+				}, nil)
+			},
+			expectedTracks: []models.Track{
+				{ID: 5, Name: "Rock &amp; Roll", Highlight: "Rock &amp; Roll"},
+				{ID: 6, Name: "Amp &lt;3", Highlight: "\x02Amp\x03 &lt;3"},
+			},
+		},
+		{
+			name:  "Fuzzy Fallback",
+			query: "mastr",
+			mockBehavior: func(sr *searchMocks.MockRepository, query string, amount uint32) {
+				sr.EXPECT().FullTextSearchTracks(ctx, query, amount, userID, noFilters).Return(exactTracks()[:1], nil)
+				sr.EXPECT().FuzzySearchTracks(ctx, query, amount, userID, noFilters, cfg.FuzzyThreshold).Return(fuzzyTracks(), nil)
+			},
+			expectedTracks: []models.Track{
+				{ID: 1, Name: "Master of Puppets", Highlight: "\x02Master\x03 of Puppets"},
+				{ID: 3, Name: "Mastermind", Highlight: "Mastermind"},
+				{ID: 4, Name: "Mast", Highlight: "Mast"},
+			},
+		},
+		{
+			name:  "Fuzzy Issue",
+			query: "mastr",
+			mockBehavior: func(sr *searchMocks.MockRepository, query string, amount uint32) {
+				sr.EXPECT().FullTextSearchTracks(ctx, query, amount, userID, noFilters).Return(nil, nil)
+				sr.EXPECT().FuzzySearchTracks(ctx, query, amount, userID, noFilters, cfg.FuzzyThreshold).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't find tracks by fuzzy query",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(sr, tc.query, defaultAmount)
+
+			tracks, err := u.FindTracks(ctx, tc.query, defaultAmount, userID, noFilters)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTracks, tracks)
+			}
+		})
+	}
+}
+
+func TestSearchUsecase_Suggest(t *testing.T) {
+	type mockBehavior func(sr *searchMocks.MockRepository, userID uint32)
+
+	c := gomock.NewController(t)
+
+	sr := searchMocks.NewMockRepository(c)
+
+	u := NewUsecase(sr, search.Config{})
+
+	const defaultAmount uint32 = 3
+
+	artistSuggestion := models.SearchSuggestion{Type: models.SearchTypeArtist, ID: 1, Name: "Oxxxymiron"}
+	trackSuggestion := models.SearchSuggestion{Type: models.SearchTypeTrack, ID: 2, Name: "Oxxxymiron Freestyle"}
+	albumSuggestion := models.SearchSuggestion{Type: models.SearchTypeAlbum, ID: 3, Name: "Oxxxymiron Live"}
+
+	sr.EXPECT().GetSuggestions(ctx, models.SearchTypeArtist, uint32(0)).
+		Return([]models.SearchSuggestion{artistSuggestion}, nil)
+	sr.EXPECT().GetSuggestions(ctx, models.SearchTypeTrack, uint32(0)).
+		Return([]models.SearchSuggestion{trackSuggestion}, nil)
+	sr.EXPECT().GetSuggestions(ctx, models.SearchTypeAlbum, uint32(0)).
+		Return([]models.SearchSuggestion{albumSuggestion}, nil)
+	if err := u.RefreshSuggestions(ctx, true); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	testTable := []struct {
+		name                string
+		userID              uint32
+		mockBehavior        mockBehavior
+		expectedSuggestions []models.SearchSuggestion
+		expectError         bool
+		expectedErrorMsg    string
+	}{
+		{
+			name:         "Unauthorized",
+			userID:       0,
+			mockBehavior: func(sr *searchMocks.MockRepository, userID uint32) {},
+			expectedSuggestions: []models.SearchSuggestion{
+				artistSuggestion, albumSuggestion, trackSuggestion,
+			},
+		},
+		{
+			name:   "Clicked Go First",
+			userID: 1,
+			mockBehavior: func(sr *searchMocks.MockRepository, userID uint32) {
+				sr.EXPECT().GetClickedEntities(ctx, userID).Return([]models.SearchEntity{
+					{Type: models.SearchTypeTrack, ID: trackSuggestion.ID},
+					{Type: models.SearchTypeArtist, ID: 100},
+				}, nil)
+			},
+			expectedSuggestions: []models.SearchSuggestion{
+				trackSuggestion, artistSuggestion, albumSuggestion,
+			},
+		},
+		{
+			name:   "Nothing Clicked",
+			userID: 1,
+			mockBehavior: func(sr *searchMocks.MockRepository, userID uint32) {
+				sr.EXPECT().GetClickedEntities(ctx, userID).Return(nil, nil)
+			},
+			expectedSuggestions: []models.SearchSuggestion{
+				artistSuggestion, albumSuggestion, trackSuggestion,
+			},
+		},
+		{
+			name:   "Clicked Issue",
+			userID: 1,
+			mockBehavior: func(sr *searchMocks.MockRepository, userID uint32) {
+				sr.EXPECT().GetClickedEntities(ctx, userID).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get entities clicked by user",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(sr, tc.userID)
+
+			suggestions, err := u.Suggest(ctx, "oxxx", defaultAmount, tc.userID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSuggestions, suggestions)
+			}
+		})
+	}
+}
+
+func TestSearchUsecase_AddRecentSearch(t *testing.T) {
+	type mockBehavior func(sr *searchMocks.MockRepository, userID uint32)
+
+	c := gomock.NewController(t)
+
+	sr := searchMocks.NewMockRepository(c)
+
+	u := NewUsecase(sr, search.Config{})
+
+	const userID uint32 = 1
+
+	entityType := models.SearchTypeArtist
+	var entityID uint32 = 2
+
+	testTable := []struct {
+		name             string
+		recent           models.RecentSearch
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:   "Query Is Trimmed",
+			recent: models.RecentSearch{Query: "  oxxxymiron "},
+			mockBehavior: func(sr *searchMocks.MockRepository, userID uint32) {
+				sr.EXPECT().AddRecentSearch(ctx, userID,
+					models.RecentSearch{Query: "oxxxymiron"}, recentSearchesKept).Return(nil)
+			},
+		},
+		{
+			name:   "Clicked Result",
+			recent: models.RecentSearch{Query: "oxxxymiron", EntityType: &entityType, EntityID: &entityID},
+			mockBehavior: func(sr *searchMocks.MockRepository, userID uint32) {
+				sr.EXPECT().AddRecentSearch(ctx, userID,
+					models.RecentSearch{Query: "oxxxymiron", EntityType: &entityType, EntityID: &entityID},
+					recentSearchesKept).Return(nil)
+			},
+		},
+		{
+			name:   "Insert Issue",
+			recent: models.RecentSearch{Query: "oxxxymiron"},
+			mockBehavior: func(sr *searchMocks.MockRepository, userID uint32) {
+				sr.EXPECT().AddRecentSearch(ctx, userID, gomock.Any(), recentSearchesKept).Return(errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't add recent search",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(sr, userID)
+
+			err := u.AddRecentSearch(ctx, userID, tc.recent)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSearchUsecase_GetRecentSearches(t *testing.T) {
+	type mockBehavior func(sr *searchMocks.MockRepository, userID, limit uint32)
+
+	c := gomock.NewController(t)
+
+	sr := searchMocks.NewMockRepository(c)
+
+	u := NewUsecase(sr, search.Config{})
+
+	const userID uint32 = 1
+	const limit uint32 = 10
+
+	recents := []models.RecentSearch{{ID: 2, Query: "oxxxymiron"}}
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(sr *searchMocks.MockRepository, userID, limit uint32) {
+				sr.EXPECT().GetRecentSearches(ctx, userID, limit).Return(recents, nil)
+			},
+		},
+		{
+			name: "Get Issue",
+			mockBehavior: func(sr *searchMocks.MockRepository, userID, limit uint32) {
+				sr.EXPECT().GetRecentSearches(ctx, userID, limit).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get recent searches",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(sr, userID, limit)
+
+			got, err := u.GetRecentSearches(ctx, userID, limit)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, recents, got)
+			}
+		})
+	}
+}
+
+func TestSearchUsecase_DeleteRecentSearch(t *testing.T) {
+	type mockBehavior func(sr *searchMocks.MockRepository, recentSearchID, userID uint32)
+
+	c := gomock.NewController(t)
+
+	sr := searchMocks.NewMockRepository(c)
+
+	u := NewUsecase(sr, search.Config{})
+
+	const userID uint32 = 1
+	const recentSearchID uint32 = 2
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(sr *searchMocks.MockRepository, recentSearchID, userID uint32) {
+				sr.EXPECT().DeleteRecentSearch(ctx, recentSearchID, userID).Return(nil)
+			},
+		},
+		{
+			name: "No Such Recent Search",
+			mockBehavior: func(sr *searchMocks.MockRepository, recentSearchID, userID uint32) {
+				sr.EXPECT().DeleteRecentSearch(ctx, recentSearchID, userID).
+					Return(&models.NoSuchRecentSearchError{RecentSearchID: recentSearchID})
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchRecentSearchError{RecentSearchID: recentSearchID},
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(sr, recentSearchID, userID)
+
+			err := u.DeleteRecentSearch(ctx, recentSearchID, userID)
+
+			if tc.expectError {
+				assert.ErrorAs(t, err, &tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSearchUsecase_ClearRecentSearches(t *testing.T) {
+	type mockBehavior func(sr *searchMocks.MockRepository, userID uint32)
+
+	c := gomock.NewController(t)
+
+	sr := searchMocks.NewMockRepository(c)
+
+	u := NewUsecase(sr, search.Config{})
+
+	const userID uint32 = 1
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(sr *searchMocks.MockRepository, userID uint32) {
+				sr.EXPECT().ClearRecentSearches(ctx, userID).Return(nil)
+			},
+		},
+		{
+			name: "Clear Issue",
+			mockBehavior: func(sr *searchMocks.MockRepository, userID uint32) {
+				sr.EXPECT().ClearRecentSearches(ctx, userID).Return(errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't clear recent searches",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(sr, userID)
+
+			err := u.ClearRecentSearches(ctx, userID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
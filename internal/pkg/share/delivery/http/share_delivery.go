@@ -0,0 +1,350 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	easyjson "github.com/mailru/easyjson"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/share"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+)
+
+type Handler struct {
+	shareServices    share.Usecase
+	trackServices    track.Usecase
+	albumServices    album.Usecase
+	artistServices   artist.Usecase
+	playlistServices playlist.Usecase
+	userServices     user.Usecase
+
+	// siteURL is where pages of entities are, covers are served from mediaURL
+	siteURL  string
+	mediaURL string
+
+	logger logger.Logger
+}
+
+func NewHandler(su share.Usecase, tu track.Usecase, alu album.Usecase, aru artist.Usecase,
+	pu playlist.Usecase, uu user.Usecase, siteURL, mediaURL string, l logger.Logger) *Handler {
+
+	return &Handler{
+		shareServices:    su,
+		trackServices:    tu,
+		albumServices:    alu,
+		artistServices:   aru,
+		playlistServices: pu,
+		userServices:     uu,
+
+		siteURL:  siteURL,
+		mediaURL: mediaURL,
+
+		logger: l,
+	}
+}
+
+// @Summary		Share Link
+// @Tags		Share
+// @Description	Get short link of track, album, artist or playlist. All users get the same link of entity.
+// @Accept		json
+// @Produce		json
+// @Param		entity	body		shareLinkInput				true	"Type and ID of entity"
+// @Success		200		{object}	models.ShareLinkTransfer	"Link got"
+// @Failure		400		{object}	http.Error					"Client error"
+// @Failure		401		{object}	http.Error					"User unathorized"
+// @Failure		500		{object}	http.Error					"Server error"
+// @Router		/api/share [post]
+func (h *Handler) GetLink(w http.ResponseWriter, r *http.Request) {
+	if _, err := commonHTTP.GetUserFromRequest(r); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	var sli shareLinkInput
+	if err := easyjson.UnmarshalFromReader(r.Body, &sli); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	link, err := h.shareServices.GetLink(r.Context(), sli.Type, sli.ID)
+	if err != nil {
+		var errInvalidLink *models.InvalidShareLinkError
+		if errors.As(err, &errInvalidLink) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				shareTypeInvalid, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		if isNoSuchEntity(err) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				shareEntityAbsent, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			shareLinkServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	commonHTTP.SuccessResponse(w, r, models.ShareLinkTransferFromEntry(*link, h.siteURL), h.logger)
+}
+
+// @Summary		Resolve Share Link
+// @Tags		Share
+// @Description	Get type and ID of entity shared by link with chosen code. Opening isn't counted.
+// @Produce		json
+// @Param		shareCode	path		string						true	"Share code"
+// @Success		200			{object}	models.ShareLinkTransfer	"Link got"
+// @Failure		404			{object}	http.Error					"No such link"
+// @Failure		500			{object}	http.Error					"Server error"
+// @Router		/api/share/{shareCode} [get]
+func (h *Handler) Resolve(w http.ResponseWriter, r *http.Request) {
+	link, err := h.shareServices.Resolve(r.Context(), commonHTTP.GetShareCodeFromRequest(r))
+	if err != nil {
+		h.linkErrorResponse(w, r, err)
+		return
+	}
+
+	commonHTTP.SuccessResponse(w, r, models.ShareLinkTransferFromEntry(*link, h.siteURL), h.logger)
+}
+
+// @Summary		Open Share Link
+// @Tags		Share
+// @Description	Count opening of link and get preview page of shared entity with Open Graph and Twitter card
+// @Description	metadata. Browsers are redirected to page of entity on site. Fetches of link previews
+// @Description	by messengers and crawlers aren't counted.
+// @Produce		html
+// @Param		shareCode	path		string		true	"Share code"
+// @Success		200			{string}	string		"Preview page"
+// @Failure		404			{object}	http.Error	"No such link"
+// @Failure		500			{object}	http.Error	"Server error"
+// @Router		/share/{shareCode} [get]
+func (h *Handler) Open(w http.ResponseWriter, r *http.Request) {
+	openLink := h.shareServices.Click
+	if isPreviewAgent(r.UserAgent()) {
+		openLink = h.shareServices.Resolve
+	}
+
+	link, err := openLink(r.Context(), commonHTTP.GetShareCodeFromRequest(r))
+	if err != nil {
+		h.linkErrorResponse(w, r, err)
+		return
+	}
+
+	h.previewResponse(w, r, link.EntityType, link.EntityID)
+}
+
+// @Summary		Track Preview
+// @Tags		Share
+// @Description	Get preview page of track with Open Graph and Twitter card metadata
+// @Produce		html
+// @Success		200		{string}	string		"Preview page"
+// @Failure		400		{object}	http.Error	"Invalid ID"
+// @Failure		404		{object}	http.Error	"No such track"
+// @Failure		500		{object}	http.Error	"Server error"
+// @Router		/share/tracks/{trackID} [get]
+func (h *Handler) TrackPreview(w http.ResponseWriter, r *http.Request) {
+	trackID, err := commonHTTP.GetTrackIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	h.previewResponse(w, r, models.ShareTypeTrack, trackID)
+}
+
+// @Summary		Album Preview
+// @Tags		Share
+// @Description	Get preview page of album with Open Graph and Twitter card metadata
+// @Produce		html
+// @Success		200		{string}	string		"Preview page"
+// @Failure		400		{object}	http.Error	"Invalid ID"
+// @Failure		404		{object}	http.Error	"No such album"
+// @Failure		500		{object}	http.Error	"Server error"
+// @Router		/share/albums/{albumID} [get]
+func (h *Handler) AlbumPreview(w http.ResponseWriter, r *http.Request) {
+	albumID, err := commonHTTP.GetAlbumIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	h.previewResponse(w, r, models.ShareTypeAlbum, albumID)
+}
+
+// @Summary		Artist Preview
+// @Tags		Share
+// @Description	Get preview page of artist with Open Graph and Twitter card metadata
+// @Produce		html
+// @Success		200		{string}	string		"Preview page"
+// @Failure		400		{object}	http.Error	"Invalid ID"
+// @Failure		404		{object}	http.Error	"No such artist"
+// @Failure		500		{object}	http.Error	"Server error"
+// @Router		/share/artists/{artistID} [get]
+func (h *Handler) ArtistPreview(w http.ResponseWriter, r *http.Request) {
+	artistID, err := commonHTTP.GetArtistIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	h.previewResponse(w, r, models.ShareTypeArtist, artistID)
+}
+
+// @Summary		Playlist Preview
+// @Tags		Share
+// @Description	Get preview page of playlist with Open Graph and Twitter card metadata
+// @Produce		html
+// @Success		200		{string}	string		"Preview page"
+// @Failure		400		{object}	http.Error	"Invalid ID"
+// @Failure		404		{object}	http.Error	"No such playlist"
+// @Failure		500		{object}	http.Error	"Server error"
+// @Router		/share/playlists/{playlistID} [get]
+func (h *Handler) PlaylistPreview(w http.ResponseWriter, r *http.Request) {
+	playlistID, err := commonHTTP.GetPlaylistIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	h.previewResponse(w, r, models.ShareTypePlaylist, playlistID)
+}
+
+func (h *Handler) linkErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	var errInvalidLink *models.InvalidShareLinkError
+	var errNoSuchLink *models.NoSuchShareLinkError
+	if errors.As(err, &errInvalidLink) || errors.As(err, &errNoSuchLink) {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			shareLinkNotFound, http.StatusNotFound, h.logger, err)
+		return
+	}
+
+	commonHTTP.ErrorResponseWithErrLogging(w, r,
+		shareLinkServerError, http.StatusInternalServerError, h.logger, err)
+}
+
+// previewResponse writes preview page of entity. Page is public, so transfers are made without user.
+func (h *Handler) previewResponse(w http.ResponseWriter, r *http.Request, entityType string, entityID uint32) {
+	page, err := h.preview(r.Context(), entityType, entityID)
+	if err != nil {
+		if isNoSuchEntity(err) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				shareEntityAbsent, http.StatusNotFound, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			sharePageServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := page.render(&buf); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			sharePageServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		h.logger.Errorf("failed to write response: %v", err)
+	}
+}
+
+func (h *Handler) preview(ctx context.Context, entityType string, entityID uint32) (previewPage, error) {
+	switch entityType {
+	case models.ShareTypeTrack:
+		track, err := h.trackServices.GetByID(ctx, entityID)
+		if err != nil {
+			return previewPage{}, err
+		}
+		tt, err := models.TrackTransferFromEntry(ctx, *track, nil,
+			h.trackServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByTrack)
+		if err != nil {
+			return previewPage{}, err
+		}
+		return h.trackPage(tt), nil
+
+	case models.ShareTypeAlbum:
+		album, err := h.albumServices.GetByID(ctx, entityID)
+		if err != nil {
+			return previewPage{}, err
+		}
+		at, err := models.AlbumTransferFromEntry(ctx, *album, nil,
+			h.albumServices.IsLiked, h.artistServices.IsLiked, h.artistServices.GetByAlbum)
+		if err != nil {
+			return previewPage{}, err
+		}
+		return h.albumPage(at), nil
+
+	case models.ShareTypeArtist:
+		artist, err := h.artistServices.GetByID(ctx, entityID)
+		if err != nil {
+			return previewPage{}, err
+		}
+		at, err := models.ArtistTransferFromEntry(ctx, *artist, nil, h.artistServices.IsLiked)
+		if err != nil {
+			return previewPage{}, err
+		}
+		return h.artistPage(at), nil
+
+	case models.ShareTypePlaylist:
+		playlist, err := h.playlistServices.GetByID(ctx, entityID)
+		if err != nil {
+			return previewPage{}, err
+		}
+		pt, err := models.PlaylistTransferFromEntry(ctx, *playlist, nil,
+			h.playlistServices.IsLiked, h.userServices.GetByPlaylist)
+		if err != nil {
+			return previewPage{}, err
+		}
+		return h.playlistPage(pt), nil
+	}
+
+	return previewPage{}, fmt.Errorf("entity of type %q can't be previewed", entityType)
+}
+
+func isNoSuchEntity(err error) bool {
+	var errNoSuchTrack *models.NoSuchTrackError
+	var errNoSuchAlbum *models.NoSuchAlbumError
+	var errNoSuchArtist *models.NoSuchArtistError
+	var errNoSuchPlaylist *models.NoSuchPlaylistError
+
+	return errors.As(err, &errNoSuchTrack) || errors.As(err, &errNoSuchAlbum) ||
+		errors.As(err, &errNoSuchArtist) || errors.As(err, &errNoSuchPlaylist)
+}
+
+// previewAgents are parts of User-Agent of messengers and crawlers which fetch pages to show their previews
+var previewAgents = []string{
+	"bot", "crawler", "spider", "preview",
+	"facebookexternalhit", "facebookcatalog", "whatsapp", "vkshare", "skypeuripreview", "embedly",
+}
+
+func isPreviewAgent(userAgent string) bool {
+	userAgent = strings.ToLower(userAgent)
+	for _, agent := range previewAgents {
+		if strings.Contains(userAgent, agent) {
+			return true
+		}
+	}
+
+	return false
+}
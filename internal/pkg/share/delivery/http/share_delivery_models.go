@@ -0,0 +1,19 @@
+package http
+
+//go:generate easyjson -no_std_marshalers share_delivery_models.go
+
+// Response messages
+const (
+	shareTypeInvalid  = "entity of such type can't be shared"
+	shareEntityAbsent = "no such entity"
+	shareLinkNotFound = "no such share link"
+
+	shareLinkServerError = "can't get share link"
+	sharePageServerError = "can't render share page"
+)
+
+//easyjson:json
+type shareLinkInput struct {
+	Type string `json:"type"`
+	ID   uint32 `json:"id"`
+}
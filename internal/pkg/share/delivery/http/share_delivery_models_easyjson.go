@@ -0,0 +1,78 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package http
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonB9d57eb7DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgShareDeliveryHttp(in *jlexer.Lexer, out *shareLinkInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = uint32(in.Uint32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonB9d57eb7EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgShareDeliveryHttp(out *jwriter.Writer, in shareLinkInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v shareLinkInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonB9d57eb7EncodeGithubComGoParkMailRu20231TechnokaifInternalPkgShareDeliveryHttp(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *shareLinkInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonB9d57eb7DecodeGithubComGoParkMailRu20231TechnokaifInternalPkgShareDeliveryHttp(l, v)
+}
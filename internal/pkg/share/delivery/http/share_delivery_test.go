@@ -0,0 +1,470 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	albumMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/mocks"
+	artistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/mocks"
+	playlistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/mocks"
+	shareMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/share/mocks"
+	trackMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/mocks"
+	userMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/mocks"
+)
+
+const (
+	siteURL  = "https://fluire.ru"
+	mediaURL = "https://media.fluire.ru"
+)
+
+func TestShareDeliveryHTTP_GetLink(t *testing.T) {
+	// Init
+	type mockBehavior func(su *shareMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	su := shareMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(su, trackMocks.NewMockUsecase(c), albumMocks.NewMockUsecase(c), artistMocks.NewMockUsecase(c),
+		playlistMocks.NewMockUsecase(c), userMocks.NewMockUsecase(c), siteURL, mediaURL, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Post("/api/share", h.GetLink)
+
+	// Test filling
+	user := &models.User{ID: 1}
+	const playlistID uint32 = 2
+
+	link := &models.ShareLink{
+		Code:       "aZ09bY18",
+		EntityType: models.ShareTypePlaylist,
+		EntityID:   playlistID,
+		Clicks:     3,
+	}
+
+	correctRequestBody := `{"type": "playlist", "id": 2}`
+
+	correctResponse := `{
+		"code": "aZ09bY18",
+		"url": "https://fluire.ru/share/aZ09bY18",
+		"type": "playlist",
+		"id": 2,
+		"clicks": 3
+	}`
+
+	testTable := []struct {
+		name             string
+		user             *models.User
+		requestBody      string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:        "Common",
+			user:        user,
+			requestBody: correctRequestBody,
+			mockBehavior: func(su *shareMocks.MockUsecase) {
+				su.EXPECT().GetLink(gomock.Any(), models.ShareTypePlaylist, playlistID).Return(link, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:             "Unauthorized",
+			user:             nil,
+			requestBody:      correctRequestBody,
+			mockBehavior:     func(su *shareMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:             "Incorrect Body",
+			user:             user,
+			requestBody:      `{"type": "playlist", "id": "two"}`,
+			mockBehavior:     func(su *shareMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:        "Invalid Type",
+			user:        user,
+			requestBody: `{"type": "user", "id": 2}`,
+			mockBehavior: func(su *shareMocks.MockUsecase) {
+				su.EXPECT().GetLink(gomock.Any(), "user", playlistID).
+					Return(nil, &models.InvalidShareLinkError{Reason: `unknown type "user"`})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(shareTypeInvalid),
+		},
+		{
+			name:        "No Such Playlist",
+			user:        user,
+			requestBody: correctRequestBody,
+			mockBehavior: func(su *shareMocks.MockUsecase) {
+				su.EXPECT().GetLink(gomock.Any(), models.ShareTypePlaylist, playlistID).
+					Return(nil, &models.NoSuchPlaylistError{PlaylistID: playlistID})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(shareEntityAbsent),
+		},
+		{
+			name:        "Server Error",
+			user:        user,
+			requestBody: correctRequestBody,
+			mockBehavior: func(su *shareMocks.MockUsecase) {
+				su.EXPECT().GetLink(gomock.Any(), models.ShareTypePlaylist, playlistID).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(shareLinkServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(su)
+
+			commonTests.DeliveryTestPost(t, r, "/api/share", tc.requestBody, tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestShareDeliveryHTTP_Resolve(t *testing.T) {
+	// Init
+	type mockBehavior func(su *shareMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	su := shareMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(su, trackMocks.NewMockUsecase(c), albumMocks.NewMockUsecase(c), artistMocks.NewMockUsecase(c),
+		playlistMocks.NewMockUsecase(c), userMocks.NewMockUsecase(c), siteURL, mediaURL, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/share/{shareCode}", h.Resolve)
+
+	// Test filling
+	const code = "aZ09bY18"
+
+	link := &models.ShareLink{
+		Code:       code,
+		EntityType: models.ShareTypeTrack,
+		EntityID:   2,
+		Clicks:     3,
+	}
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(su *shareMocks.MockUsecase) {
+				su.EXPECT().Resolve(gomock.Any(), code).Return(link, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: `{
+				"code": "aZ09bY18",
+				"url": "https://fluire.ru/share/aZ09bY18",
+				"type": "track",
+				"id": 2,
+				"clicks": 3
+			}`,
+		},
+		{
+			name: "No Such Link",
+			mockBehavior: func(su *shareMocks.MockUsecase) {
+				su.EXPECT().Resolve(gomock.Any(), code).Return(nil, &models.NoSuchShareLinkError{Code: code})
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: commonTests.ErrorResponse(shareLinkNotFound),
+		},
+		{
+			name: "Server Error",
+			mockBehavior: func(su *shareMocks.MockUsecase) {
+				su.EXPECT().Resolve(gomock.Any(), code).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(shareLinkServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(su)
+
+			commonTests.DeliveryTestGet(t, r, "/api/share/"+code, tc.expectedStatus, tc.expectedResponse,
+				commonTests.NoWrapUserFunc())
+		})
+	}
+}
+
+func TestShareDeliveryHTTP_Open(t *testing.T) {
+	// Init
+	type mockBehavior func(su *shareMocks.MockUsecase, pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	su := shareMocks.NewMockUsecase(c)
+	pu := playlistMocks.NewMockUsecase(c)
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(su, trackMocks.NewMockUsecase(c), albumMocks.NewMockUsecase(c), artistMocks.NewMockUsecase(c),
+		pu, uu, siteURL, mediaURL, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/share/{shareCode}", h.Open)
+
+	// Test filling
+	const code = "aZ09bY18"
+	const playlistID uint32 = 2
+
+	link := &models.ShareLink{
+		Code:       code,
+		EntityType: models.ShareTypePlaylist,
+		EntityID:   playlistID,
+		Clicks:     4,
+	}
+
+	// Names and descriptions are stored escaped
+	description := "Songs for &lt;night&gt; &amp; rain"
+	playlist := &models.Playlist{
+		ID:          playlistID,
+		Name:        "&#34;Chill&#34;",
+		Description: &description,
+		CoverSrc:    "/playlists/chill.png",
+	}
+	authors := []models.User{{ID: 1, Username: "yarik_tri"}}
+
+	const browserAgent = "Mozilla/5.0 (X11; Linux x86_64; rv:109.0) Gecko/20100101 Firefox/112.0"
+
+	testTable := []struct {
+		name              string
+		userAgent         string
+		mockBehavior      mockBehavior
+		expectedStatus    int
+		expectedFragments []string
+	}{
+		{
+			name:      "Common",
+			userAgent: browserAgent,
+			mockBehavior: func(su *shareMocks.MockUsecase, pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				su.EXPECT().Click(gomock.Any(), code).Return(link, nil)
+				pu.EXPECT().GetByID(gomock.Any(), playlistID).Return(playlist, nil)
+				uu.EXPECT().GetByPlaylist(gomock.Any(), playlistID).Return(authors, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedFragments: []string{
+				`<title>&#34;Chill&#34;</title>`,
+				`<meta property="og:type" content="music.playlist">`,
+				`<meta property="og:title" content="&#34;Chill&#34;">`,
+				`<meta property="og:description" content="Playlist · yarik_tri. Songs for &lt;night&gt; &amp; rain">`,
+				`<meta property="og:url" content="https://fluire.ru/playlist/2">`,
+				`<meta property="og:image" content="https://media.fluire.ru/playlists/chill.png">`,
+				`<meta name="twitter:card" content="summary">`,
+				`<meta name="twitter:image" content="https://media.fluire.ru/playlists/chill.png">`,
+			},
+		},
+		{
+			name:      "Messenger Preview",
+			userAgent: "TelegramBot (like TwitterBot)",
+			mockBehavior: func(su *shareMocks.MockUsecase, pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				su.EXPECT().Resolve(gomock.Any(), code).Return(link, nil)
+				pu.EXPECT().GetByID(gomock.Any(), playlistID).Return(playlist, nil)
+				uu.EXPECT().GetByPlaylist(gomock.Any(), playlistID).Return(authors, nil)
+			},
+			expectedStatus:    http.StatusOK,
+			expectedFragments: []string{`<meta property="og:title" content="&#34;Chill&#34;">`},
+		},
+		{
+			name:      "Social Crawler Preview",
+			userAgent: "facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
+			mockBehavior: func(su *shareMocks.MockUsecase, pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				su.EXPECT().Resolve(gomock.Any(), code).Return(link, nil)
+				pu.EXPECT().GetByID(gomock.Any(), playlistID).Return(playlist, nil)
+				uu.EXPECT().GetByPlaylist(gomock.Any(), playlistID).Return(authors, nil)
+			},
+			expectedStatus:    http.StatusOK,
+			expectedFragments: []string{`<meta property="og:title" content="&#34;Chill&#34;">`},
+		},
+		{
+			name:      "No Such Link",
+			userAgent: browserAgent,
+			mockBehavior: func(su *shareMocks.MockUsecase, pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				su.EXPECT().Click(gomock.Any(), code).Return(nil, &models.NoSuchShareLinkError{Code: code})
+			},
+			expectedStatus:    http.StatusNotFound,
+			expectedFragments: []string{shareLinkNotFound},
+		},
+		{
+			name:      "Playlist Was Deleted",
+			userAgent: browserAgent,
+			mockBehavior: func(su *shareMocks.MockUsecase, pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				su.EXPECT().Click(gomock.Any(), code).Return(link, nil)
+				pu.EXPECT().GetByID(gomock.Any(), playlistID).
+					Return(nil, &models.NoSuchPlaylistError{PlaylistID: playlistID})
+			},
+			expectedStatus:    http.StatusNotFound,
+			expectedFragments: []string{shareEntityAbsent},
+		},
+		{
+			name:      "Server Error",
+			userAgent: browserAgent,
+			mockBehavior: func(su *shareMocks.MockUsecase, pu *playlistMocks.MockUsecase, uu *userMocks.MockUsecase) {
+				su.EXPECT().Click(gomock.Any(), code).Return(link, nil)
+				pu.EXPECT().GetByID(gomock.Any(), playlistID).Return(playlist, nil)
+				uu.EXPECT().GetByPlaylist(gomock.Any(), playlistID).Return(nil, errors.New(""))
+			},
+			expectedStatus:    http.StatusInternalServerError,
+			expectedFragments: []string{sharePageServerError},
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(su, pu, uu)
+
+			req := httptest.NewRequest(http.MethodGet, "/share/"+code, nil)
+			req.Header.Set("User-Agent", tc.userAgent)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			for _, fragment := range tc.expectedFragments {
+				assert.Contains(t, w.Body.String(), fragment)
+			}
+		})
+	}
+}
+
+func TestShareDeliveryHTTP_TrackPreview(t *testing.T) {
+	// Init
+	type mockBehavior func(tu *trackMocks.MockUsecase, aru *artistMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	tu := trackMocks.NewMockUsecase(c)
+	aru := artistMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(shareMocks.NewMockUsecase(c), tu, albumMocks.NewMockUsecase(c), aru,
+		playlistMocks.NewMockUsecase(c), userMocks.NewMockUsecase(c), siteURL, mediaURL, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/share/tracks/{trackID}", h.TrackPreview)
+
+	// Test filling
+	const trackID uint32 = 1
+
+	track := &models.Track{
+		ID:       trackID,
+		Name:     "Не с начала",
+		CoverSrc: "/tracks/gorgorod.jpg",
+		Duration: 125,
+	}
+	artists := []models.Artist{{ID: 1, Name: "Oxxxymiron"}, {ID: 2, Name: "SALUKI"}}
+
+	escapedTrack := &models.Track{
+		ID:   trackID,
+		Name: "Rock &amp; Roll",
+	}
+	escapedArtists := []models.Artist{{ID: 3, Name: "Simon &amp; Garfunkel"}}
+
+	testTable := []struct {
+		name              string
+		trackID           string
+		mockBehavior      mockBehavior
+		expectedStatus    int
+		expectedFragments []string
+	}{
+		{
+			name:    "Common",
+			trackID: "1",
+			mockBehavior: func(tu *trackMocks.MockUsecase, aru *artistMocks.MockUsecase) {
+				tu.EXPECT().GetByID(gomock.Any(), trackID).Return(track, nil)
+				aru.EXPECT().GetByTrack(gomock.Any(), trackID).Return(artists, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedFragments: []string{
+				`<meta property="og:type" content="music.song">`,
+				`<meta property="og:title" content="Не с начала">`,
+				`<meta property="og:description" content="Track · Oxxxymiron, SALUKI">`,
+				`<meta property="og:url" content="https://fluire.ru/track/1">`,
+				`<meta property="og:image" content="https://media.fluire.ru/tracks/gorgorod.jpg">`,
+				`<meta property="music:duration" content="125">`,
+			},
+		},
+		{
+			name:    "Escaped Names",
+			trackID: "1",
+			mockBehavior: func(tu *trackMocks.MockUsecase, aru *artistMocks.MockUsecase) {
+				tu.EXPECT().GetByID(gomock.Any(), trackID).Return(escapedTrack, nil)
+				aru.EXPECT().GetByTrack(gomock.Any(), trackID).Return(escapedArtists, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedFragments: []string{
+				`<title>Rock &amp; Roll</title>`,
+				`<meta property="og:title" content="Rock &amp; Roll">`,
+				`<meta property="og:description" content="Track · Simon &amp; Garfunkel">`,
+			},
+		},
+		{
+			name:              "Incorrect ID",
+			trackID:           "0",
+			mockBehavior:      func(tu *trackMocks.MockUsecase, aru *artistMocks.MockUsecase) {},
+			expectedStatus:    http.StatusBadRequest,
+			expectedFragments: []string{commonHTTP.InvalidURLParameter},
+		},
+		{
+			name:    "No Such Track",
+			trackID: "1",
+			mockBehavior: func(tu *trackMocks.MockUsecase, aru *artistMocks.MockUsecase) {
+				tu.EXPECT().GetByID(gomock.Any(), trackID).Return(nil, &models.NoSuchTrackError{TrackID: trackID})
+			},
+			expectedStatus:    http.StatusNotFound,
+			expectedFragments: []string{shareEntityAbsent},
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tu, aru)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/share/tracks/"+tc.trackID, nil))
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			for _, fragment := range tc.expectedFragments {
+				assert.Contains(t, w.Body.String(), fragment)
+			}
+		})
+	}
+}
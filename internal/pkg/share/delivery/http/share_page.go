@@ -0,0 +1,144 @@
+package http
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+const siteName = "Fluire"
+
+// previewPage is metadata of shared entity which messengers and social networks
+// show in link preview: Open Graph and Twitter card
+type previewPage struct {
+	SiteName    string
+	Type        string
+	Title       string
+	Description string
+	// URL is page of entity on site, browsers are redirected to it
+	URL   string
+	Image string
+	// Duration of song in seconds
+	Duration uint32
+}
+
+var previewTemplate = template.Must(template.New("preview").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<meta name="description" content="{{.Description}}">
+<meta property="og:site_name" content="{{.SiteName}}">
+<meta property="og:type" content="{{.Type}}">
+<meta property="og:title" content="{{.Title}}">
+<meta property="og:description" content="{{.Description}}">
+<meta property="og:url" content="{{.URL}}">
+{{- if .Image}}
+<meta property="og:image" content="{{.Image}}">
+{{- end}}
+{{- if .Duration}}
+<meta property="music:duration" content="{{.Duration}}">
+{{- end}}
+<meta name="twitter:card" content="summary">
+<meta name="twitter:title" content="{{.Title}}">
+<meta name="twitter:description" content="{{.Description}}">
+{{- if .Image}}
+<meta name="twitter:image" content="{{.Image}}">
+{{- end}}
+<link rel="canonical" href="{{.URL}}">
+<meta http-equiv="refresh" content="0; url={{.URL}}">
+</head>
+<body>
+<a href="{{.URL}}">{{.Title}}</a>
+</body>
+</html>
+`))
+
+// render writes page as HTML. Names and descriptions are stored escaped,
+// so they are unescaped first: template escapes them itself.
+func (p previewPage) render(w io.Writer) error {
+	p.Title = html.UnescapeString(p.Title)
+	p.Description = html.UnescapeString(p.Description)
+
+	return previewTemplate.Execute(w, p)
+}
+
+// mediaSrc returns absolute URL of cover, covers without source aren't shown
+func (h *Handler) mediaSrc(src string) string {
+	if src == "" {
+		return ""
+	}
+
+	return h.mediaURL + src
+}
+
+func (h *Handler) trackPage(t models.TrackTransfer) previewPage {
+	return previewPage{
+		SiteName:    siteName,
+		Type:        "music.song",
+		Title:       t.Name,
+		Description: "Track · " + artistsNames(t.Artists),
+		URL:         fmt.Sprintf("%s/track/%d", h.siteURL, t.ID),
+		Image:       h.mediaSrc(t.CoverSrc),
+		Duration:    t.Duration,
+	}
+}
+
+func (h *Handler) albumPage(a models.AlbumTransfer) previewPage {
+	return previewPage{
+		SiteName:    siteName,
+		Type:        "music.album",
+		Title:       a.Name,
+		Description: withDescription("Album · "+artistsNames(a.Artists), a.Description),
+		URL:         fmt.Sprintf("%s/album/%d", h.siteURL, a.ID),
+		Image:       h.mediaSrc(a.CoverSrc),
+	}
+}
+
+func (h *Handler) artistPage(a models.ArtistTransfer) previewPage {
+	return previewPage{
+		SiteName:    siteName,
+		Type:        "profile",
+		Title:       a.Name,
+		Description: "Artist",
+		URL:         fmt.Sprintf("%s/artist/%d", h.siteURL, a.ID),
+		Image:       h.mediaSrc(a.AvatarSrc),
+	}
+}
+
+func (h *Handler) playlistPage(p models.PlaylistTransfer) previewPage {
+	usernames := make([]string, 0, len(p.Users))
+	for _, u := range p.Users {
+		usernames = append(usernames, u.Username)
+	}
+
+	return previewPage{
+		SiteName:    siteName,
+		Type:        "music.playlist",
+		Title:       p.Name,
+		Description: withDescription("Playlist · "+strings.Join(usernames, ", "), p.Description),
+		URL:         fmt.Sprintf("%s/playlist/%d", h.siteURL, p.ID),
+		Image:       h.mediaSrc(p.CoverSrc),
+	}
+}
+
+func artistsNames(artists models.ArtistTransfers) string {
+	names := make([]string, 0, len(artists))
+	for _, a := range artists {
+		names = append(names, a.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func withDescription(summary string, description *string) string {
+	if description == nil || *description == "" {
+		return summary
+	}
+
+	return summary + ". " + *description
+}
@@ -0,0 +1,201 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: share.go
+
+// Package mock_share is a generated GoMock package.
+package mock_share
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Click mocks base method.
+func (m *MockUsecase) Click(ctx context.Context, code string) (*models.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Click", ctx, code)
+	ret0, _ := ret[0].(*models.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Click indicates an expected call of Click.
+func (mr *MockUsecaseMockRecorder) Click(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Click", reflect.TypeOf((*MockUsecase)(nil).Click), ctx, code)
+}
+
+// GetLink mocks base method.
+func (m *MockUsecase) GetLink(ctx context.Context, entityType string, entityID uint32) (*models.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLink", ctx, entityType, entityID)
+	ret0, _ := ret[0].(*models.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLink indicates an expected call of GetLink.
+func (mr *MockUsecaseMockRecorder) GetLink(ctx, entityType, entityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLink", reflect.TypeOf((*MockUsecase)(nil).GetLink), ctx, entityType, entityID)
+}
+
+// Resolve mocks base method.
+func (m *MockUsecase) Resolve(ctx context.Context, code string) (*models.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, code)
+	ret0, _ := ret[0].(*models.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockUsecaseMockRecorder) Resolve(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockUsecase)(nil).Resolve), ctx, code)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByCode mocks base method.
+func (m *MockRepository) GetByCode(ctx context.Context, code string) (*models.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, code)
+	ret0, _ := ret[0].(*models.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockRepositoryMockRecorder) GetByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockRepository)(nil).GetByCode), ctx, code)
+}
+
+// GetByEntity mocks base method.
+func (m *MockRepository) GetByEntity(ctx context.Context, entityType string, entityID uint32) (*models.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEntity", ctx, entityType, entityID)
+	ret0, _ := ret[0].(*models.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEntity indicates an expected call of GetByEntity.
+func (mr *MockRepositoryMockRecorder) GetByEntity(ctx, entityType, entityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEntity", reflect.TypeOf((*MockRepository)(nil).GetByEntity), ctx, entityType, entityID)
+}
+
+// IncrementClicks mocks base method.
+func (m *MockRepository) IncrementClicks(ctx context.Context, code string) (*models.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementClicks", ctx, code)
+	ret0, _ := ret[0].(*models.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementClicks indicates an expected call of IncrementClicks.
+func (mr *MockRepositoryMockRecorder) IncrementClicks(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementClicks", reflect.TypeOf((*MockRepository)(nil).IncrementClicks), ctx, code)
+}
+
+// Insert mocks base method.
+func (m *MockRepository) Insert(ctx context.Context, link models.ShareLink) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, link)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockRepositoryMockRecorder) Insert(ctx, link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockRepository)(nil).Insert), ctx, link)
+}
+
+// MockTables is a mock of Tables interface.
+type MockTables struct {
+	ctrl     *gomock.Controller
+	recorder *MockTablesMockRecorder
+}
+
+// MockTablesMockRecorder is the mock recorder for MockTables.
+type MockTablesMockRecorder struct {
+	mock *MockTables
+}
+
+// NewMockTables creates a new mock instance.
+func NewMockTables(ctrl *gomock.Controller) *MockTables {
+	mock := &MockTables{ctrl: ctrl}
+	mock.recorder = &MockTablesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTables) EXPECT() *MockTablesMockRecorder {
+	return m.recorder
+}
+
+// ShareLinks mocks base method.
+func (m *MockTables) ShareLinks() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareLinks")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ShareLinks indicates an expected call of ShareLinks.
+func (mr *MockTablesMockRecorder) ShareLinks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareLinks", reflect.TypeOf((*MockTables)(nil).ShareLinks))
+}
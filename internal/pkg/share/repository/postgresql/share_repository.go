@@ -0,0 +1,105 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/share"
+)
+
+// PostgreSQL implements share.Repository
+type PostgreSQL struct {
+	db     *sqlx.DB
+	tables share.Tables
+}
+
+func NewPostgreSQL(db *sqlx.DB, t share.Tables) *PostgreSQL {
+	return &PostgreSQL{
+		db:     db,
+		tables: t,
+	}
+}
+
+func (p *PostgreSQL) Insert(ctx context.Context, link models.ShareLink) (bool, error) {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (code, entity_type, entity_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING;`,
+		p.tables.ShareLinks())
+
+	resExec, err := p.db.ExecContext(ctx, query, link.Code, link.EntityType, link.EntityID)
+	if err != nil {
+		return false, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+	inserted, err := resExec.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("(repo) failed to check query result: %w", err)
+	}
+
+	return inserted != 0, nil
+}
+
+func (p *PostgreSQL) GetByEntity(ctx context.Context,
+	entityType string, entityID uint32) (*models.ShareLink, error) {
+
+	query := fmt.Sprintf(
+		`SELECT code, entity_type, entity_id, clicks, created_at
+		FROM %s
+		WHERE entity_type = $1 AND entity_id = $2;`,
+		p.tables.ShareLinks())
+
+	var link models.ShareLink
+	if err := p.db.GetContext(ctx, &link, query, entityType, entityID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchShareLinkError{}, err)
+		}
+
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return &link, nil
+}
+
+func (p *PostgreSQL) GetByCode(ctx context.Context, code string) (*models.ShareLink, error) {
+	query := fmt.Sprintf(
+		`SELECT code, entity_type, entity_id, clicks, created_at
+		FROM %s
+		WHERE code = $1;`,
+		p.tables.ShareLinks())
+
+	var link models.ShareLink
+	if err := p.db.GetContext(ctx, &link, query, code); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchShareLinkError{Code: code}, err)
+		}
+
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return &link, nil
+}
+
+func (p *PostgreSQL) IncrementClicks(ctx context.Context, code string) (*models.ShareLink, error) {
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET clicks = clicks + 1
+		WHERE code = $1
+		RETURNING code, entity_type, entity_id, clicks, created_at;`,
+		p.tables.ShareLinks())
+
+	var link models.ShareLink
+	if err := p.db.GetContext(ctx, &link, query, code); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchShareLinkError{Code: code}, err)
+		}
+
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return &link, nil
+}
@@ -0,0 +1,280 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	shareMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/share/mocks"
+)
+
+var ctx = context.Background()
+
+const shareLinksTable = "Share_links"
+
+var errPqInternal = errors.New("postgres is dead")
+
+var defaultLink = models.ShareLink{
+	Code:       "aZ09bY18",
+	EntityType: models.ShareTypePlaylist,
+	EntityID:   2,
+	Clicks:     4,
+	CreatedAt:  time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC),
+}
+
+func shareLinkRows(sqlxMock sqlmock.Sqlmock, link models.ShareLink) *sqlmock.Rows {
+	return sqlxMock.NewRows([]string{"code", "entity_type", "entity_id", "clicks", "created_at"}).
+		AddRow(link.Code, link.EntityType, link.EntityID, link.Clicks, link.CreatedAt)
+}
+
+func TestShareRepositoryPostgreSQL_Insert(t *testing.T) {
+	// Init
+	type mockBehavior func(link models.ShareLink)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := shareMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectedInserted bool
+		expectError      bool
+		expectedError    error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(link models.ShareLink) {
+				tablesMock.EXPECT().ShareLinks().Return(shareLinksTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+shareLinksTable+"(.+)ON CONFLICT DO NOTHING").
+					WithArgs(link.Code, link.EntityType, link.EntityID).
+					WillReturnResult(driver.RowsAffected(1))
+			},
+			expectedInserted: true,
+		},
+		{
+			name: "Entity Has Link Or Code Is Taken",
+			mockBehavior: func(link models.ShareLink) {
+				tablesMock.EXPECT().ShareLinks().Return(shareLinksTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+shareLinksTable+"(.+)ON CONFLICT DO NOTHING").
+					WithArgs(link.Code, link.EntityType, link.EntityID).
+					WillReturnResult(driver.RowsAffected(0))
+			},
+			expectedInserted: false,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(link models.ShareLink) {
+				tablesMock.EXPECT().ShareLinks().Return(shareLinksTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+shareLinksTable).
+					WithArgs(link.Code, link.EntityType, link.EntityID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultLink)
+
+			inserted, err := repo.Insert(ctx, defaultLink)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedInserted, inserted)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestShareRepositoryPostgreSQL_GetByEntity(t *testing.T) {
+	// Init
+	type mockBehavior func(entityType string, entityID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := shareMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectedLink  *models.ShareLink
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(entityType string, entityID uint32) {
+				tablesMock.EXPECT().ShareLinks().Return(shareLinksTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+shareLinksTable+
+					"(.+)WHERE entity_type = \\$1 AND entity_id = \\$2").
+					WithArgs(entityType, entityID).
+					WillReturnRows(shareLinkRows(sqlxMock, defaultLink))
+			},
+			expectedLink: &defaultLink,
+		},
+		{
+			name: "No Link",
+			mockBehavior: func(entityType string, entityID uint32) {
+				tablesMock.EXPECT().ShareLinks().Return(shareLinksTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+shareLinksTable).
+					WithArgs(entityType, entityID).
+					WillReturnRows(sqlxMock.NewRows([]string{"code"}))
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchShareLinkError{},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(entityType string, entityID uint32) {
+				tablesMock.EXPECT().ShareLinks().Return(shareLinksTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+shareLinksTable).
+					WithArgs(entityType, entityID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultLink.EntityType, defaultLink.EntityID)
+
+			link, err := repo.GetByEntity(ctx, defaultLink.EntityType, defaultLink.EntityID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedLink, link)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestShareRepositoryPostgreSQL_IncrementClicks(t *testing.T) {
+	// Init
+	type mockBehavior func(code string)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := shareMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	clickedLink := defaultLink
+	clickedLink.Clicks++
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectedLink  *models.ShareLink
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(code string) {
+				tablesMock.EXPECT().ShareLinks().Return(shareLinksTable)
+
+				sqlxMock.ExpectQuery("UPDATE " + shareLinksTable + "(.+)SET clicks = clicks \\+ 1" +
+					"(.+)WHERE code = \\$1(.+)RETURNING").
+					WithArgs(code).
+					WillReturnRows(shareLinkRows(sqlxMock, clickedLink))
+			},
+			expectedLink: &clickedLink,
+		},
+		{
+			name: "No Such Link",
+			mockBehavior: func(code string) {
+				tablesMock.EXPECT().ShareLinks().Return(shareLinksTable)
+
+				sqlxMock.ExpectQuery("UPDATE " + shareLinksTable).
+					WithArgs(code).
+					WillReturnRows(sqlxMock.NewRows([]string{"code"}))
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchShareLinkError{Code: defaultLink.Code},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(code string) {
+				tablesMock.EXPECT().ShareLinks().Return(shareLinksTable)
+
+				sqlxMock.ExpectQuery("UPDATE " + shareLinksTable).
+					WithArgs(code).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultLink.Code)
+
+			link, err := repo.IncrementClicks(ctx, defaultLink.Code)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedLink, link)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
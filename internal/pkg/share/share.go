@@ -0,0 +1,43 @@
+package share
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+//go:generate mockgen -source=share.go -destination=mocks/mock.go
+
+// Usecase includes bussiness logics methods to work with share links of tracks, albums, artists and playlists
+type Usecase interface {
+	// GetLink returns share link of entity, it's created on first call.
+	// It returns models.InvalidShareLinkError if entity of such type can't be shared.
+	GetLink(ctx context.Context, entityType string, entityID uint32) (*models.ShareLink, error)
+
+	// Resolve returns models.NoSuchShareLinkError if there is no link with such code
+	Resolve(ctx context.Context, code string) (*models.ShareLink, error)
+
+	// Click resolves link and counts its opening
+	Click(ctx context.Context, code string) (*models.ShareLink, error)
+}
+
+// Repository includes DBMS-relatable methods to work with share links
+type Repository interface {
+	// Insert returns false if entity already has link or code is taken
+	Insert(ctx context.Context, link models.ShareLink) (bool, error)
+
+	// GetByEntity returns models.NoSuchShareLinkError if entity has no link
+	GetByEntity(ctx context.Context, entityType string, entityID uint32) (*models.ShareLink, error)
+
+	// GetByCode returns models.NoSuchShareLinkError if there is no link with such code
+	GetByCode(ctx context.Context, code string) (*models.ShareLink, error)
+
+	// IncrementClicks returns models.NoSuchShareLinkError if there is no link with such code
+	IncrementClicks(ctx context.Context, code string) (*models.ShareLink, error)
+}
+
+// Tables includes methods which return needed tables
+// to work with share links on repository layer
+type Tables interface {
+	ShareLinks() string
+}
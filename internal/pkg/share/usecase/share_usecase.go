@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/share"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track"
+)
+
+// maxCodeAttempts is amount of generated codes tried before giving up: collisions are rare
+// while there are much less links than 62^8
+const maxCodeAttempts = 3
+
+// Usecase implements share.Usecase
+type Usecase struct {
+	shareRepo    share.Repository
+	trackRepo    track.Repository
+	albumRepo    album.Repository
+	artistRepo   artist.Repository
+	playlistRepo playlist.Repository
+}
+
+func NewUsecase(sr share.Repository, tr track.Repository, alr album.Repository,
+	arr artist.Repository, pr playlist.Repository) *Usecase {
+
+	return &Usecase{
+		shareRepo:    sr,
+		trackRepo:    tr,
+		albumRepo:    alr,
+		artistRepo:   arr,
+		playlistRepo: pr,
+	}
+}
+
+func (u *Usecase) GetLink(ctx context.Context, entityType string, entityID uint32) (*models.ShareLink, error) {
+	if err := models.ValidateShareType(entityType); err != nil {
+		return nil, fmt.Errorf("(usecase) %w", err)
+	}
+
+	if err := u.checkEntity(ctx, entityType, entityID); err != nil {
+		return nil, fmt.Errorf("(usecase) can't find %s with id #%d: %w", entityType, entityID, err)
+	}
+
+	// Link is inserted only once: if code is taken or link was created concurrently, it's looked up again
+	for attempt := 0; ; attempt++ {
+		link, err := u.shareRepo.GetByEntity(ctx, entityType, entityID)
+		if err == nil {
+			return link, nil
+		}
+		var errNoSuchLink *models.NoSuchShareLinkError
+		if !errors.As(err, &errNoSuchLink) {
+			return nil, fmt.Errorf("(usecase) can't get share link from repository: %w", err)
+		}
+
+		if attempt == maxCodeAttempts {
+			return nil, errors.New("(usecase) can't generate unique share code")
+		}
+
+		code, err := generateShareCode()
+		if err != nil {
+			return nil, fmt.Errorf("(usecase) can't generate share code: %w", err)
+		}
+
+		if _, err := u.shareRepo.Insert(ctx, models.ShareLink{
+			Code:       code,
+			EntityType: entityType,
+			EntityID:   entityID,
+		}); err != nil {
+			return nil, fmt.Errorf("(usecase) can't insert share link into repository: %w", err)
+		}
+	}
+}
+
+func (u *Usecase) Resolve(ctx context.Context, code string) (*models.ShareLink, error) {
+	if err := models.ValidateShareCode(code); err != nil {
+		return nil, fmt.Errorf("(usecase) %w", err)
+	}
+
+	link, err := u.shareRepo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get share link from repository: %w", err)
+	}
+
+	return link, nil
+}
+
+func (u *Usecase) Click(ctx context.Context, code string) (*models.ShareLink, error) {
+	if err := models.ValidateShareCode(code); err != nil {
+		return nil, fmt.Errorf("(usecase) %w", err)
+	}
+
+	link, err := u.shareRepo.IncrementClicks(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't count click of share link: %w", err)
+	}
+
+	return link, nil
+}
+
+func (u *Usecase) checkEntity(ctx context.Context, entityType string, entityID uint32) error {
+	switch entityType {
+	case models.ShareTypeTrack:
+		return u.trackRepo.Check(ctx, entityID)
+	case models.ShareTypeAlbum:
+		return u.albumRepo.Check(ctx, entityID)
+	case models.ShareTypeArtist:
+		return u.artistRepo.Check(ctx, entityID)
+	case models.ShareTypePlaylist:
+		return u.playlistRepo.Check(ctx, entityID)
+	}
+
+	return &models.InvalidShareLinkError{Reason: fmt.Sprintf("unknown type %q", entityType)}
+}
+
+func generateShareCode() (string, error) {
+	alphabetLen := big.NewInt(int64(len(models.ShareCodeAlphabet)))
+
+	code := make([]byte, models.ShareCodeLen)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, alphabetLen)
+		if err != nil {
+			return "", err
+		}
+		code[i] = models.ShareCodeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	albumMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/mocks"
+	artistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/mocks"
+	playlistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/mocks"
+	shareMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/share/mocks"
+	trackMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/mocks"
+)
+
+var ctx = context.Background()
+
+// newLinkMatcher matches link of entity with any valid generated code
+type newLinkMatcher struct {
+	entityType string
+	entityID   uint32
+}
+
+func (m newLinkMatcher) Matches(x interface{}) bool {
+	link, ok := x.(models.ShareLink)
+	return ok && models.ValidateShareCode(link.Code) == nil &&
+		link.EntityType == m.entityType && link.EntityID == m.entityID
+}
+
+func (m newLinkMatcher) String() string {
+	return "is new share link of " + m.entityType
+}
+
+func TestShareUsecase_GetLink(t *testing.T) {
+	type mockBehavior func(sr *shareMocks.MockRepository, pr *playlistMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	sr := shareMocks.NewMockRepository(c)
+	tr := trackMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
+	arr := artistMocks.NewMockRepository(c)
+	pr := playlistMocks.NewMockRepository(c)
+
+	u := NewUsecase(sr, tr, alr, arr, pr)
+
+	const playlistID uint32 = 1
+
+	link := &models.ShareLink{
+		Code:       "aZ09bY18",
+		EntityType: models.ShareTypePlaylist,
+		EntityID:   playlistID,
+		Clicks:     3,
+	}
+	noLink := &models.NoSuchShareLinkError{}
+
+	isNewLink := newLinkMatcher{entityType: models.ShareTypePlaylist, entityID: playlistID}
+
+	testTable := []struct {
+		name             string
+		entityType       string
+		mockBehavior     mockBehavior
+		expectedLink     *models.ShareLink
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:       "Existing Link",
+			entityType: models.ShareTypePlaylist,
+			mockBehavior: func(sr *shareMocks.MockRepository, pr *playlistMocks.MockRepository) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				sr.EXPECT().GetByEntity(ctx, models.ShareTypePlaylist, playlistID).Return(link, nil)
+			},
+			expectedLink: link,
+		},
+		{
+			name:       "New Link",
+			entityType: models.ShareTypePlaylist,
+			mockBehavior: func(sr *shareMocks.MockRepository, pr *playlistMocks.MockRepository) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				gomock.InOrder(
+					sr.EXPECT().GetByEntity(ctx, models.ShareTypePlaylist, playlistID).Return(nil, noLink),
+					sr.EXPECT().Insert(ctx, isNewLink).Return(true, nil),
+					sr.EXPECT().GetByEntity(ctx, models.ShareTypePlaylist, playlistID).Return(link, nil),
+				)
+			},
+			expectedLink: link,
+		},
+		{
+			name:       "Code Collision",
+			entityType: models.ShareTypePlaylist,
+			mockBehavior: func(sr *shareMocks.MockRepository, pr *playlistMocks.MockRepository) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				gomock.InOrder(
+					sr.EXPECT().GetByEntity(ctx, models.ShareTypePlaylist, playlistID).Return(nil, noLink),
+					sr.EXPECT().Insert(ctx, isNewLink).Return(false, nil),
+					sr.EXPECT().GetByEntity(ctx, models.ShareTypePlaylist, playlistID).Return(nil, noLink),
+					sr.EXPECT().Insert(ctx, isNewLink).Return(true, nil),
+					sr.EXPECT().GetByEntity(ctx, models.ShareTypePlaylist, playlistID).Return(link, nil),
+				)
+			},
+			expectedLink: link,
+		},
+		{
+			name:       "Codes Are Over",
+			entityType: models.ShareTypePlaylist,
+			mockBehavior: func(sr *shareMocks.MockRepository, pr *playlistMocks.MockRepository) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				sr.EXPECT().GetByEntity(ctx, models.ShareTypePlaylist, playlistID).
+					Return(nil, noLink).Times(maxCodeAttempts + 1)
+				sr.EXPECT().Insert(ctx, isNewLink).Return(false, nil).Times(maxCodeAttempts)
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't generate unique share code",
+		},
+		{
+			name:             "Unknown Type",
+			entityType:       "user",
+			mockBehavior:     func(sr *shareMocks.MockRepository, pr *playlistMocks.MockRepository) {},
+			expectError:      true,
+			expectedErrorMsg: `unknown type "user"`,
+		},
+		{
+			name:       "No Such Playlist",
+			entityType: models.ShareTypePlaylist,
+			mockBehavior: func(sr *shareMocks.MockRepository, pr *playlistMocks.MockRepository) {
+				pr.EXPECT().Check(ctx, playlistID).Return(&models.NoSuchPlaylistError{PlaylistID: playlistID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "playlist #1 doesn't exist",
+		},
+		{
+			name:       "Repository Issue",
+			entityType: models.ShareTypePlaylist,
+			mockBehavior: func(sr *shareMocks.MockRepository, pr *playlistMocks.MockRepository) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				sr.EXPECT().GetByEntity(ctx, models.ShareTypePlaylist, playlistID).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get share link from repository",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(sr, pr)
+
+			link, err := u.GetLink(ctx, tc.entityType, playlistID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedLink, link)
+			}
+		})
+	}
+}
+
+func TestShareUsecase_Click(t *testing.T) {
+	type mockBehavior func(sr *shareMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	sr := shareMocks.NewMockRepository(c)
+
+	u := NewUsecase(sr, trackMocks.NewMockRepository(c), albumMocks.NewMockRepository(c),
+		artistMocks.NewMockRepository(c), playlistMocks.NewMockRepository(c))
+
+	const code = "aZ09bY18"
+
+	link := &models.ShareLink{
+		Code:       code,
+		EntityType: models.ShareTypeTrack,
+		EntityID:   1,
+		Clicks:     4,
+	}
+
+	testTable := []struct {
+		name             string
+		code             string
+		mockBehavior     mockBehavior
+		expectedLink     *models.ShareLink
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Common",
+			code: code,
+			mockBehavior: func(sr *shareMocks.MockRepository) {
+				sr.EXPECT().IncrementClicks(ctx, code).Return(link, nil)
+			},
+			expectedLink: link,
+		},
+		{
+			name:             "Invalid Code",
+			code:             "a/b",
+			mockBehavior:     func(sr *shareMocks.MockRepository) {},
+			expectError:      true,
+			expectedErrorMsg: "invalid share link",
+		},
+		{
+			name: "No Such Link",
+			code: code,
+			mockBehavior: func(sr *shareMocks.MockRepository) {
+				sr.EXPECT().IncrementClicks(ctx, code).Return(nil, &models.NoSuchShareLinkError{Code: code})
+			},
+			expectError:      true,
+			expectedErrorMsg: `share link "aZ09bY18" doesn't exist`,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(sr)
+
+			link, err := u.Click(ctx, tc.code)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedLink, link)
+			}
+		})
+	}
+}
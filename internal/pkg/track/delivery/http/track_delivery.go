@@ -3,6 +3,7 @@ package http
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
@@ -327,21 +328,26 @@ func (h *Handler) GetByAlbum(w http.ResponseWriter, r *http.Request) {
 
 // @Summary		Track Feed
 // @Tags		Feed
-// @Description	Feed tracks
+// @Description	Feed tracks: personal ones for logged in user, general ones for anonymous
 // @Produce		json
 // @Success		200		{object}	models.TrackTransfers  "Tracks feed"
 // @Failure		500		{object}	http.Error			   "Server error"
 // @Router		/api/tracks/feed [get]
 func (h *Handler) Feed(w http.ResponseWriter, r *http.Request) {
-	tracks, err := h.trackServices.GetFeed(r.Context())
-	if err != nil {
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			tracksGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
 	}
 
-	user, err := commonHTTP.GetUserFromRequest(r)
-	if err != nil && !errors.Is(err, commonHTTP.ErrUnauthorized) {
+	var tracks []models.Track
+	if user != nil {
+		tracks, err = h.trackServices.GetPersonalFeed(r.Context(), user.ID)
+	} else {
+		tracks, err = h.trackServices.GetFeed(r.Context())
+	}
+	if err != nil {
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			tracksGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
@@ -362,7 +368,13 @@ func (h *Handler) Feed(w http.ResponseWriter, r *http.Request) {
 // @Tags         Favorite
 // @Description  Get ser's avorite tracks
 // @Produce      json
+// @Param		 sort	query		string	false	"Sorting: likedAt, name, artist or duration"
+// @Param		 order	query		string	false	"Order: asc or desc"
+// @Param		 q		query		string	false	"Filter by name of track or artist"
+// @Param		 limit	query		int		false	"Page size"
+// @Param		 offset	query		int		false	"Page offset"
 // @Success      200    {object}  	models.TrackTransfers 	"Tracks got"
+// @Header		 200	{integer}	X-Total-Count			"Total amount of liked tracks"
 // @Failure		 400	{object}	http.Error				"Incorrect input"
 // @Failure      401    {object}  	http.Error  			"Unauthorized user"
 // @Failure      403    {object}  	http.Error  			"Forbidden user"
@@ -376,8 +388,22 @@ func (h *Handler) GetFavorite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	favTracks, err := h.trackServices.GetLikedByUser(r.Context(), user.ID)
+	q, err := commonHTTP.GetFavoritesQueryFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	favTracks, total, err := h.trackServices.GetLikedByUser(r.Context(), user.ID, q)
 	if err != nil {
+		var errInvalidQuery *models.InvalidFavoritesQueryError
+		if errors.As(err, &errInvalidQuery) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
 		commonHTTP.ErrorResponseWithErrLogging(w, r,
 			tracksGetServerError, http.StatusInternalServerError, h.logger, err)
 		return
@@ -391,6 +417,7 @@ func (h *Handler) GetFavorite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set(commonHTTP.TotalCountHeader, strconv.FormatUint(uint64(total), 10))
 	commonHTTP.SuccessResponse(w, r, tt, h.logger)
 }
 
@@ -483,3 +510,116 @@ func (h *Handler) UnLike(w http.ResponseWriter, r *http.Request) {
 	}
 	commonHTTP.SuccessResponse(w, r, tlr, h.logger)
 }
+
+// @Summary		Set Lyrics
+// @Tags		Track
+// @Description	Upload plain and/or synced (LRC) lyrics of track with chosen ID
+// @Accept      json
+// @Produce		json
+// @Param		lyrics	body		lyricsSetInput		true	"Lyrics"
+// @Success		200		{object}	lyricsSetResponse	"Lyrics set"
+// @Failure		400		{object}	http.Error			"Client error"
+// @Failure		401		{object}	http.Error  		"User unathorized"
+// @Failure		403		{object}	http.Error			"User hasn't rights"
+// @Failure		500		{object}	http.Error			"Server error"
+// @Router		/api/tracks/{trackID}/lyrics [put]
+func (h *Handler) SetLyrics(w http.ResponseWriter, r *http.Request) {
+	trackID, err := commonHTTP.GetTrackIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	var lsi lyricsSetInput
+	if err := easyjson.UnmarshalFromReader(r.Body, &lsi); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := lsi.validateAndEscape(); err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	if err := h.trackServices.SetLyrics(r.Context(), lsi.ToLyrics(trackID), user.ID); err != nil {
+		var errInvalidLyrics *models.InvalidLyricsError
+		if errors.As(err, &errInvalidLyrics) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.IncorrectRequestBody, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errNoSuchTrack *models.NoSuchTrackError
+		if errors.As(err, &errNoSuchTrack) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				trackNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errForbiddenUser *models.ForbiddenUserError
+		if errors.As(err, &errForbiddenUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				lyricsSetNoRights, http.StatusForbidden, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			lyricsSetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp := lyricsSetResponse{Status: lyricsSetSuccessfully}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
+
+// @Summary		Get Lyrics
+// @Tags		Track
+// @Description	Get lyrics of track with chosen ID, synced ones are also split into lines
+// @Produce		json
+// @Success		200		{object}	lyricsResponse	"Lyrics got"
+// @Failure		400		{object}	http.Error		"Client error"
+// @Failure		404		{object}	http.Error		"Track has no lyrics"
+// @Failure		500		{object}	http.Error		"Server error"
+// @Router		/api/tracks/{trackID}/lyrics [get]
+func (h *Handler) GetLyrics(w http.ResponseWriter, r *http.Request) {
+	trackID, err := commonHTTP.GetTrackIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	lyrics, err := h.trackServices.GetLyrics(r.Context(), trackID)
+	if err != nil {
+		var errNoSuchLyrics *models.NoSuchLyricsError
+		if errors.As(err, &errNoSuchLyrics) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				lyricsNotFound, http.StatusNotFound, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			lyricsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	resp, err := lyricsResponseFromModel(*lyrics)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			lyricsGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	commonHTTP.SuccessResponse(w, r, resp, h.logger)
+}
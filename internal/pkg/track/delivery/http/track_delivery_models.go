@@ -3,6 +3,8 @@ package http
 import (
 	"errors"
 	"html"
+	"strings"
+	"time"
 
 	valid "github.com/asaskevich/govalidator"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
@@ -16,16 +18,21 @@ const (
 	artistNotFound   = "no such artist"
 	playlistNotFound = "no such playlist"
 	trackNotFound    = "no such track"
+	lyricsNotFound   = "track has no lyrics"
 
 	trackCreateNorights = "no rights to create track"
 	trackDeleteNoRights = "no rights to delete track"
+	lyricsSetNoRights   = "no rights to set lyrics"
 
 	trackCreateServerError = "can't create track"
 	trackGetServerError    = "can't get track"
 	tracksGetServerError   = "can't get tracks"
 	trackDeleteServerError = "can't delete track"
+	lyricsSetServerError   = "can't set lyrics"
+	lyricsGetServerError   = "can't get lyrics"
 
 	trackDeletedSuccessfully = "ok"
+	lyricsSetSuccessfully    = "ok"
 )
 
 //easyjson:json
@@ -76,3 +83,86 @@ type trackDeleteResponse struct {
 type trackLikeResponse struct {
 	Status string `json:"status"`
 }
+
+//easyjson:json
+type lyricsSetInput struct {
+	// Text is plain lyrics, it's taken from synced ones if empty
+	Text string `json:"text"`
+	// Synced are lyrics in LRC format
+	Synced *string `json:"synced"`
+}
+
+func (lsi *lyricsSetInput) validateAndEscape() error {
+	if strings.TrimSpace(lsi.Text) == "" && lsi.Synced == nil {
+		return errors.New("(delivery) neither plain nor synced lyrics are set")
+	}
+	if len(lsi.Text) > models.LyricsMaxLen || (lsi.Synced != nil && len(*lsi.Synced) > models.LyricsMaxLen) {
+		return errors.New("(delivery) lyrics are too long")
+	}
+
+	lsi.escapeHTML()
+
+	return nil
+}
+
+func (lsi *lyricsSetInput) escapeHTML() {
+	lsi.Text = html.EscapeString(lsi.Text)
+	if lsi.Synced != nil {
+		*lsi.Synced = html.EscapeString(*lsi.Synced)
+	}
+}
+
+func (lsi *lyricsSetInput) ToLyrics(trackID uint32) models.Lyrics {
+	return models.Lyrics{
+		TrackID: trackID,
+		Text:    lsi.Text,
+		Synced:  lsi.Synced,
+	}
+}
+
+//easyjson:json
+type lyricsSetResponse struct {
+	Status string `json:"status"`
+}
+
+//easyjson:json
+type lyricsLine struct {
+	TimeMs int64  `json:"timeMs"`
+	Text   string `json:"text"`
+}
+
+//easyjson:json
+type lyricsResponse struct {
+	TrackID   uint32       `json:"trackID"`
+	Text      string       `json:"text"`
+	Synced    *string      `json:"synced,omitempty"`
+	Lines     []lyricsLine `json:"lines,omitempty"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+}
+
+// lyricsResponseFromModel parses synced lyrics into lines, so client doesn't need to parse LRC
+func lyricsResponseFromModel(lyrics models.Lyrics) (lyricsResponse, error) {
+	resp := lyricsResponse{
+		TrackID:   lyrics.TrackID,
+		Text:      lyrics.Text,
+		Synced:    lyrics.Synced,
+		UpdatedAt: lyrics.UpdatedAt,
+	}
+	if lyrics.Synced == nil {
+		return resp, nil
+	}
+
+	lines, err := models.ParseLRC(*lyrics.Synced)
+	if err != nil {
+		return lyricsResponse{}, err
+	}
+	resp.Lines = make([]lyricsLine, 0, len(lines))
+	for _, line := range lines {
+		resp.Lines = append(resp.Lines, lyricsLine{
+			TimeMs: line.Time.Milliseconds(),
+			Text:   line.Text,
+		})
+	}
+
+	return resp, nil
+}
@@ -309,3 +309,305 @@ func (v trackCreateInput) MarshalEasyJSON(w *jwriter.Writer) {
 func (v *trackCreateInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6036cd6fDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp3(l, v)
 }
+func easyjson6036cd6fDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp4(in *jlexer.Lexer, out *lyricsSetResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6036cd6fEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp4(out *jwriter.Writer, in lyricsSetResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v lyricsSetResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6036cd6fEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp4(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *lyricsSetResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6036cd6fDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp4(l, v)
+}
+func easyjson6036cd6fDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp5(in *jlexer.Lexer, out *lyricsSetInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		case "synced":
+			if in.IsNull() {
+				in.Skip()
+				out.Synced = nil
+			} else {
+				if out.Synced == nil {
+					out.Synced = new(string)
+				}
+				*out.Synced = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6036cd6fEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp5(out *jwriter.Writer, in lyricsSetInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"synced\":"
+		out.RawString(prefix)
+		if in.Synced == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Synced))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v lyricsSetInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6036cd6fEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp5(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *lyricsSetInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6036cd6fDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp5(l, v)
+}
+func easyjson6036cd6fDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp6(in *jlexer.Lexer, out *lyricsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "trackID":
+			out.TrackID = uint32(in.Uint32())
+		case "text":
+			out.Text = string(in.String())
+		case "synced":
+			if in.IsNull() {
+				in.Skip()
+				out.Synced = nil
+			} else {
+				if out.Synced == nil {
+					out.Synced = new(string)
+				}
+				*out.Synced = string(in.String())
+			}
+		case "lines":
+			if in.IsNull() {
+				in.Skip()
+				out.Lines = nil
+			} else {
+				in.Delim('[')
+				if out.Lines == nil {
+					if !in.IsDelim(']') {
+						out.Lines = make([]lyricsLine, 0, 2)
+					} else {
+						out.Lines = []lyricsLine{}
+					}
+				} else {
+					out.Lines = (out.Lines)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 lyricsLine
+					(v4).UnmarshalEasyJSON(in)
+					out.Lines = append(out.Lines, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6036cd6fEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp6(out *jwriter.Writer, in lyricsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"trackID\":"
+		out.RawString(prefix[1:])
+		out.Uint32(uint32(in.TrackID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	if in.Synced != nil {
+		const prefix string = ",\"synced\":"
+		out.RawString(prefix)
+		out.String(string(*in.Synced))
+	}
+	if len(in.Lines) != 0 {
+		const prefix string = ",\"lines\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Lines {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v lyricsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6036cd6fEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp6(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *lyricsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6036cd6fDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp6(l, v)
+}
+func easyjson6036cd6fDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp7(in *jlexer.Lexer, out *lyricsLine) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "timeMs":
+			out.TimeMs = int64(in.Int64())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6036cd6fEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp7(out *jwriter.Writer, in lyricsLine) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"timeMs\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TimeMs))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v lyricsLine) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6036cd6fEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp7(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *lyricsLine) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6036cd6fDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgTrackDeliveryHttp7(l, v)
+}
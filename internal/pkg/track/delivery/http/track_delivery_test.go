@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
 
@@ -487,6 +490,7 @@ func TestTrackDeliveryHTTP_Feed(t *testing.T) {
 
 	testTable := []struct {
 		name             string
+		user             *models.User
 		mockBehavior     mockBehavior
 		expectedStatus   int
 		expectedResponse string
@@ -501,6 +505,28 @@ func TestTrackDeliveryHTTP_Feed(t *testing.T) {
 			expectedStatus:   http.StatusOK,
 			expectedResponse: correctResponse,
 		},
+		{
+			name: "Personal",
+			user: &correctUser,
+			mockBehavior: func(tu *trackMocks.MockUsecase, au *artistMocks.MockUsecase) {
+				tu.EXPECT().GetPersonalFeed(gomock.Any(), correctUser.ID).Return(expectedReturnTracks, nil)
+				tu.EXPECT().IsLiked(gomock.Any(), gomock.Any(), correctUser.ID).Return(false, nil).Times(2)
+				au.EXPECT().GetByTrack(gomock.Any(), expectedReturnTracks[0].ID).Return(expectedReturnArtists[0:1], nil)
+				au.EXPECT().GetByTrack(gomock.Any(), expectedReturnTracks[1].ID).Return(expectedReturnArtists[1:3], nil)
+				au.EXPECT().IsLiked(gomock.Any(), gomock.Any(), correctUser.ID).Return(false, nil).Times(3)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name: "Personal Tracks Issues",
+			user: &correctUser,
+			mockBehavior: func(tu *trackMocks.MockUsecase, au *artistMocks.MockUsecase) {
+				tu.EXPECT().GetPersonalFeed(gomock.Any(), correctUser.ID).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(tracksGetServerError),
+		},
 		{
 			name: "No Tracks",
 			mockBehavior: func(tu *trackMocks.MockUsecase, au *artistMocks.MockUsecase) {
@@ -535,7 +561,7 @@ func TestTrackDeliveryHTTP_Feed(t *testing.T) {
 
 			commonTests.DeliveryTestGet(t, r, "/api/tracks/feed",
 				tc.expectedStatus, tc.expectedResponse,
-				commonTests.NoWrapUserFunc())
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
 		})
 	}
 }
@@ -629,18 +655,40 @@ func TestTrackDeliveryHTTP_GetFavorite(t *testing.T) {
 		}
 	]`
 
+	sortedPageResponse := `[
+		{
+			"id": 2,
+			"name": "LAGG OUT",
+			"artists": [
+				{
+					"id": 2,
+					"name": "SALUKI",
+					"isLiked": false,
+					"cover": "/artists/avatars/2.png"
+				}
+			],
+			"cover": "/tracks/covers/2.png",
+			"listens": 4500000,
+			"isLiked": true,
+			"duration": 180,
+			"recordSrc": "/tracks/records/2.wav"
+		}
+	]`
+
 	testTable := []struct {
 		name             string
 		user             *models.User
+		query            string
 		mockBehavior     mockBehavior
 		expectedStatus   int
 		expectedResponse string
+		expectedTotal    string
 	}{
 		{
 			name: "Common",
 			user: &correctUser,
 			mockBehavior: func(tu *trackMocks.MockUsecase, au *artistMocks.MockUsecase, userID uint32) {
-				tu.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(expectedReturnTracks, nil)
+				tu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(expectedReturnTracks, uint32(len(expectedReturnTracks)), nil)
 				for ind, track := range expectedReturnTracks {
 					au.EXPECT().GetByTrack(gomock.Any(), track.ID).Return(expectedReturnArtists[ind:ind+1], nil)
 					tu.EXPECT().IsLiked(gomock.Any(), track.ID, userID).Return(true, nil)
@@ -651,12 +699,53 @@ func TestTrackDeliveryHTTP_GetFavorite(t *testing.T) {
 			},
 			expectedStatus:   http.StatusOK,
 			expectedResponse: correctResponse,
+			expectedTotal:    "2",
+		},
+		{
+			name:  "Sorted Page",
+			user:  &correctUser,
+			query: "?sort=name&order=desc&q=lag&limit=1&offset=1",
+			mockBehavior: func(tu *trackMocks.MockUsecase, au *artistMocks.MockUsecase, userID uint32) {
+				q := models.FavoritesQuery{
+					SortBy: models.FavoritesSortName,
+					Order:  models.FavoritesOrderDesc,
+					Filter: "lag",
+					Limit:  1,
+					Offset: 1,
+				}
+				tu.EXPECT().GetLikedByUser(gomock.Any(), userID, q).Return(expectedReturnTracks[1:], uint32(2), nil)
+				au.EXPECT().GetByTrack(gomock.Any(), expectedReturnTracks[1].ID).Return(expectedReturnArtists[1:], nil)
+				tu.EXPECT().IsLiked(gomock.Any(), expectedReturnTracks[1].ID, userID).Return(true, nil)
+				au.EXPECT().IsLiked(gomock.Any(), expectedReturnArtists[1].ID, userID)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: sortedPageResponse,
+			expectedTotal:    "2",
+		},
+		{
+			name:             "Incorrect Limit",
+			user:             &correctUser,
+			query:            "?limit=many",
+			mockBehavior:     func(tu *trackMocks.MockUsecase, au *artistMocks.MockUsecase, userID uint32) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
+		},
+		{
+			name:  "Unsupported Sorting",
+			user:  &correctUser,
+			query: "?sort=listens",
+			mockBehavior: func(tu *trackMocks.MockUsecase, au *artistMocks.MockUsecase, userID uint32) {
+				tu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{SortBy: "listens"}).
+					Return(nil, uint32(0), &models.InvalidFavoritesQueryError{})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
 		},
 		{
 			name: "Tracks Issue",
 			user: &correctUser,
 			mockBehavior: func(tu *trackMocks.MockUsecase, au *artistMocks.MockUsecase, userID uint32) {
-				tu.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(nil, errors.New(""))
+				tu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(nil, uint32(0), errors.New(""))
 			},
 			expectedStatus:   http.StatusInternalServerError,
 			expectedResponse: commonTests.ErrorResponse(tracksGetServerError),
@@ -665,7 +754,7 @@ func TestTrackDeliveryHTTP_GetFavorite(t *testing.T) {
 			name: "Artists Issue",
 			user: &correctUser,
 			mockBehavior: func(tu *trackMocks.MockUsecase, au *artistMocks.MockUsecase, userID uint32) {
-				tu.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(expectedReturnTracks, nil)
+				tu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(expectedReturnTracks, uint32(len(expectedReturnTracks)), nil)
 				au.EXPECT().GetByTrack(gomock.Any(), expectedReturnTracks[0].ID).Return(nil, errors.New(""))
 			},
 			expectedStatus:   http.StatusInternalServerError,
@@ -675,7 +764,7 @@ func TestTrackDeliveryHTTP_GetFavorite(t *testing.T) {
 			name: "Likes Issue",
 			user: &correctUser,
 			mockBehavior: func(tu *trackMocks.MockUsecase, au *artistMocks.MockUsecase, userID uint32) {
-				tu.EXPECT().GetLikedByUser(gomock.Any(), userID).Return(expectedReturnTracks, nil)
+				tu.EXPECT().GetLikedByUser(gomock.Any(), userID, models.FavoritesQuery{}).Return(expectedReturnTracks, uint32(len(expectedReturnTracks)), nil)
 				au.EXPECT().GetByTrack(gomock.Any(), expectedReturnTracks[0].ID).Return(expectedReturnArtists[0:1], nil)
 				tu.EXPECT().IsLiked(gomock.Any(), expectedReturnTracks[0].ID, userID).Return(false, errors.New(""))
 			},
@@ -689,9 +778,13 @@ func TestTrackDeliveryHTTP_GetFavorite(t *testing.T) {
 			// Call mock
 			tc.mockBehavior(tu, aru, tc.user.ID)
 
-			commonTests.DeliveryTestGet(t, r, "/api/users/"+correctUserIDPath+"/tracks",
+			w := commonTests.DeliveryTestGet(t, r, "/api/users/"+correctUserIDPath+"/tracks"+tc.query,
 				tc.expectedStatus, tc.expectedResponse,
 				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, tc.expectedTotal, w.Header().Get(commonHTTP.TotalCountHeader))
+			}
 		})
 	}
 }
@@ -891,3 +984,299 @@ func TestTrackDeliveryHTTP_UnLike(t *testing.T) {
 		})
 	}
 }
+
+func TestTrackDeliveryHTTP_SetLyrics(t *testing.T) {
+	// Init
+	type mockBehavior func(tu *trackMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	tu := trackMocks.NewMockUsecase(c)
+	au := artistMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(tu, au, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Put("/api/tracks/{trackID}/lyrics", h.SetLyrics)
+
+	// Test filling
+	correctRequestBody := `{
+		"text": "Rock & Roll <3",
+		"synced": "[00:01.00]Rock & Roll <3"
+	}`
+
+	escapedSynced := "[00:01.00]Rock &amp; Roll &lt;3"
+	escapedLyrics := models.Lyrics{
+		TrackID: correctTrackID,
+		Text:    "Rock &amp; Roll &lt;3",
+		Synced:  &escapedSynced,
+	}
+
+	testTable := []struct {
+		name             string
+		trackIDPath      string
+		user             *models.User
+		requestBody      string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:        "Common",
+			trackIDPath: correctTrackIDPath,
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().SetLyrics(gomock.Any(), escapedLyrics, correctUser.ID).Return(nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(lyricsSetSuccessfully),
+		},
+		{
+			name:             "Incorrect ID In Path",
+			trackIDPath:      "0",
+			user:             &correctUser,
+			requestBody:      correctRequestBody,
+			mockBehavior:     func(tu *trackMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidURLParameter),
+		},
+		{
+			name:             "No User",
+			trackIDPath:      correctTrackIDPath,
+			user:             nil,
+			requestBody:      correctRequestBody,
+			mockBehavior:     func(tu *trackMocks.MockUsecase) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:             "Incorrect Body",
+			trackIDPath:      correctTrackIDPath,
+			user:             &correctUser,
+			requestBody:      `{"text": 1}`,
+			mockBehavior:     func(tu *trackMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:             "No Lyrics In Body",
+			trackIDPath:      correctTrackIDPath,
+			user:             &correctUser,
+			requestBody:      `{"text": "  "}`,
+			mockBehavior:     func(tu *trackMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:             "Too Long Lyrics",
+			trackIDPath:      correctTrackIDPath,
+			user:             &correctUser,
+			requestBody:      `{"text": "` + strings.Repeat("a", models.LyricsMaxLen+1) + `"}`,
+			mockBehavior:     func(tu *trackMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:        "Longest Lyrics Escaped",
+			trackIDPath: correctTrackIDPath,
+			user:        &correctUser,
+			requestBody: `{"text": "` + strings.Repeat("<", models.LyricsMaxLen) + `"}`,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().SetLyrics(gomock.Any(), models.Lyrics{
+					TrackID: correctTrackID,
+					Text:    strings.Repeat("&lt;", models.LyricsMaxLen),
+				}, correctUser.ID).Return(nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(lyricsSetSuccessfully),
+		},
+		{
+			name:        "Invalid Lyrics",
+			trackIDPath: correctTrackIDPath,
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().SetLyrics(gomock.Any(), escapedLyrics, correctUser.ID).
+					Return(&models.InvalidLyricsError{Reason: "no text"})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.IncorrectRequestBody),
+		},
+		{
+			name:        "No Such Track",
+			trackIDPath: correctTrackIDPath,
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().SetLyrics(gomock.Any(), escapedLyrics, correctUser.ID).
+					Return(&models.NoSuchTrackError{TrackID: correctTrackID})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(trackNotFound),
+		},
+		{
+			name:        "User Isn't Artist",
+			trackIDPath: correctTrackIDPath,
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().SetLyrics(gomock.Any(), escapedLyrics, correctUser.ID).
+					Return(&models.ForbiddenUserError{})
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: commonTests.ErrorResponse(lyricsSetNoRights),
+		},
+		{
+			name:        "Server Error",
+			trackIDPath: correctTrackIDPath,
+			user:        &correctUser,
+			requestBody: correctRequestBody,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().SetLyrics(gomock.Any(), escapedLyrics, correctUser.ID).Return(errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(lyricsSetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tu)
+
+			commonTests.DeliveryTestPut(t, r, "/api/tracks/"+tc.trackIDPath+"/lyrics", tc.requestBody,
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.user))
+		})
+	}
+}
+
+func TestTrackDeliveryHTTP_GetLyrics(t *testing.T) {
+	// Init
+	type mockBehavior func(tu *trackMocks.MockUsecase)
+
+	c := gomock.NewController(t)
+
+	tu := trackMocks.NewMockUsecase(c)
+	au := artistMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(tu, au, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/tracks/{trackID}/lyrics", h.GetLyrics)
+
+	// Test filling
+	updatedAt := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	synced := "[00:02.00]Second\n[00:01.50]First"
+	syncedLyrics := &models.Lyrics{
+		TrackID:   correctTrackID,
+		Text:      "First\nSecond",
+		Synced:    &synced,
+		UpdatedAt: updatedAt,
+	}
+
+	brokenSynced := "First"
+	brokenLyrics := &models.Lyrics{
+		TrackID: correctTrackID,
+		Text:    "First",
+		Synced:  &brokenSynced,
+	}
+
+	testTable := []struct {
+		name             string
+		trackIDPath      string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:        "Common",
+			trackIDPath: correctTrackIDPath,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().GetLyrics(gomock.Any(), correctTrackID).Return(&models.Lyrics{
+					TrackID:   correctTrackID,
+					Text:      "Rock &amp; Roll",
+					UpdatedAt: updatedAt,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: `{
+				"trackID": 1,
+				"text": "Rock &amp; Roll",
+				"updatedAt": "2023-05-01T00:00:00Z"
+			}`,
+		},
+		{
+			name:        "Synced",
+			trackIDPath: correctTrackIDPath,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().GetLyrics(gomock.Any(), correctTrackID).Return(syncedLyrics, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: `{
+				"trackID": 1,
+				"text": "First\nSecond",
+				"synced": "[00:02.00]Second\n[00:01.50]First",
+				"lines": [
+					{"timeMs": 1500, "text": "First"},
+					{"timeMs": 2000, "text": "Second"}
+				],
+				"updatedAt": "2023-05-01T00:00:00Z"
+			}`,
+		},
+		{
+			name:             "Incorrect ID In Path",
+			trackIDPath:      "0",
+			mockBehavior:     func(tu *trackMocks.MockUsecase) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidURLParameter),
+		},
+		{
+			name:        "No Lyrics",
+			trackIDPath: correctTrackIDPath,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().GetLyrics(gomock.Any(), correctTrackID).
+					Return(nil, &models.NoSuchLyricsError{TrackID: correctTrackID})
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: commonTests.ErrorResponse(lyricsNotFound),
+		},
+		{
+			name:        "Broken Synced Lyrics",
+			trackIDPath: correctTrackIDPath,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().GetLyrics(gomock.Any(), correctTrackID).Return(brokenLyrics, nil)
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(lyricsGetServerError),
+		},
+		{
+			name:        "Server Error",
+			trackIDPath: correctTrackIDPath,
+			mockBehavior: func(tu *trackMocks.MockUsecase) {
+				tu.EXPECT().GetLyrics(gomock.Any(), correctTrackID).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(lyricsGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tu)
+
+			commonTests.DeliveryTestGet(t, r, "/api/tracks/"+tc.trackIDPath+"/lyrics",
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.NoWrapUserFunc())
+		})
+	}
+}
@@ -140,18 +140,49 @@ func (mr *MockUsecaseMockRecorder) GetFeed(ctx interface{}) *gomock.Call {
 }
 
 // GetLikedByUser mocks base method.
-func (m *MockUsecase) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Track, error) {
+func (m *MockUsecase) GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Track, uint32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID)
+	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID, q)
 	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLikedByUser indicates an expected call of GetLikedByUser.
+func (mr *MockUsecaseMockRecorder) GetLikedByUser(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockUsecase)(nil).GetLikedByUser), ctx, userID, q)
+}
+
+// GetLyrics mocks base method.
+func (m *MockUsecase) GetLyrics(ctx context.Context, trackID uint32) (*models.Lyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLyrics", ctx, trackID)
+	ret0, _ := ret[0].(*models.Lyrics)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLikedByUser indicates an expected call of GetLikedByUser.
-func (mr *MockUsecaseMockRecorder) GetLikedByUser(ctx, userID interface{}) *gomock.Call {
+// GetLyrics indicates an expected call of GetLyrics.
+func (mr *MockUsecaseMockRecorder) GetLyrics(ctx, trackID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockUsecase)(nil).GetLikedByUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLyrics", reflect.TypeOf((*MockUsecase)(nil).GetLyrics), ctx, trackID)
+}
+
+// GetPersonalFeed mocks base method.
+func (m *MockUsecase) GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalFeed", ctx, userID)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalFeed indicates an expected call of GetPersonalFeed.
+func (mr *MockUsecaseMockRecorder) GetPersonalFeed(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalFeed", reflect.TypeOf((*MockUsecase)(nil).GetPersonalFeed), ctx, userID)
 }
 
 // IsLiked mocks base method.
@@ -184,6 +215,20 @@ func (mr *MockUsecaseMockRecorder) SetLike(ctx, trackID, userID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLike", reflect.TypeOf((*MockUsecase)(nil).SetLike), ctx, trackID, userID)
 }
 
+// SetLyrics mocks base method.
+func (m *MockUsecase) SetLyrics(ctx context.Context, lyrics models.Lyrics, userID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLyrics", ctx, lyrics, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLyrics indicates an expected call of SetLyrics.
+func (mr *MockUsecaseMockRecorder) SetLyrics(ctx, lyrics, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLyrics", reflect.TypeOf((*MockUsecase)(nil).SetLyrics), ctx, lyrics, userID)
+}
+
 // UnLike mocks base method.
 func (m *MockUsecase) UnLike(ctx context.Context, trackID, userID uint32) (bool, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +281,20 @@ func (mr *MockRepositoryMockRecorder) Check(ctx, trackID interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockRepository)(nil).Check), ctx, trackID)
 }
 
+// CheckMany mocks base method.
+func (m *MockRepository) CheckMany(ctx context.Context, tracksID []uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckMany", ctx, tracksID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckMany indicates an expected call of CheckMany.
+func (mr *MockRepositoryMockRecorder) CheckMany(ctx, tracksID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckMany", reflect.TypeOf((*MockRepository)(nil).CheckMany), ctx, tracksID)
+}
+
 // DeleteByID mocks base method.
 func (m *MockRepository) DeleteByID(ctx context.Context, trackID uint32) error {
 	m.ctrl.T.Helper()
@@ -341,18 +400,49 @@ func (mr *MockRepositoryMockRecorder) GetFeed(ctx, limit interface{}) *gomock.Ca
 }
 
 // GetLikedByUser mocks base method.
-func (m *MockRepository) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Track, error) {
+func (m *MockRepository) GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Track, uint32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID)
+	ret := m.ctrl.Call(m, "GetLikedByUser", ctx, userID, q)
 	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLikedByUser indicates an expected call of GetLikedByUser.
+func (mr *MockRepositoryMockRecorder) GetLikedByUser(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockRepository)(nil).GetLikedByUser), ctx, userID, q)
+}
+
+// GetLyrics mocks base method.
+func (m *MockRepository) GetLyrics(ctx context.Context, trackID uint32) (*models.Lyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLyrics", ctx, trackID)
+	ret0, _ := ret[0].(*models.Lyrics)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLikedByUser indicates an expected call of GetLikedByUser.
-func (mr *MockRepositoryMockRecorder) GetLikedByUser(ctx, userID interface{}) *gomock.Call {
+// GetLyrics indicates an expected call of GetLyrics.
+func (mr *MockRepositoryMockRecorder) GetLyrics(ctx, trackID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLyrics", reflect.TypeOf((*MockRepository)(nil).GetLyrics), ctx, trackID)
+}
+
+// GetPersonalFeed mocks base method.
+func (m *MockRepository) GetPersonalFeed(ctx context.Context, userID, limit uint32) ([]models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalFeed", ctx, userID, limit)
+	ret0, _ := ret[0].([]models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalFeed indicates an expected call of GetPersonalFeed.
+func (mr *MockRepositoryMockRecorder) GetPersonalFeed(ctx, userID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLikedByUser", reflect.TypeOf((*MockRepository)(nil).GetLikedByUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalFeed", reflect.TypeOf((*MockRepository)(nil).GetPersonalFeed), ctx, userID, limit)
 }
 
 // Insert mocks base method.
@@ -400,6 +490,20 @@ func (mr *MockRepositoryMockRecorder) IsLiked(ctx, trackID, userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLiked", reflect.TypeOf((*MockRepository)(nil).IsLiked), ctx, trackID, userID)
 }
 
+// UpsertLyrics mocks base method.
+func (m *MockRepository) UpsertLyrics(ctx context.Context, lyrics models.Lyrics) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertLyrics", ctx, lyrics)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertLyrics indicates an expected call of UpsertLyrics.
+func (mr *MockRepositoryMockRecorder) UpsertLyrics(ctx, lyrics interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertLyrics", reflect.TypeOf((*MockRepository)(nil).UpsertLyrics), ctx, lyrics)
+}
+
 // MockTables is a mock of Tables interface.
 type MockTables struct {
 	ctrl     *gomock.Controller
@@ -423,6 +527,48 @@ func (m *MockTables) EXPECT() *MockTablesMockRecorder {
 	return m.recorder
 }
 
+// Activities mocks base method.
+func (m *MockTables) Activities() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activities")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Activities indicates an expected call of Activities.
+func (mr *MockTablesMockRecorder) Activities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activities", reflect.TypeOf((*MockTables)(nil).Activities))
+}
+
+// ActivityTimeline mocks base method.
+func (m *MockTables) ActivityTimeline() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivityTimeline")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ActivityTimeline indicates an expected call of ActivityTimeline.
+func (mr *MockTablesMockRecorder) ActivityTimeline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivityTimeline", reflect.TypeOf((*MockTables)(nil).ActivityTimeline))
+}
+
+// Artists mocks base method.
+func (m *MockTables) Artists() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Artists")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Artists indicates an expected call of Artists.
+func (mr *MockTablesMockRecorder) Artists() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Artists", reflect.TypeOf((*MockTables)(nil).Artists))
+}
+
 // ArtistsTracks mocks base method.
 func (m *MockTables) ArtistsTracks() string {
 	m.ctrl.T.Helper()
@@ -451,6 +597,20 @@ func (mr *MockTablesMockRecorder) LikedTracks() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikedTracks", reflect.TypeOf((*MockTables)(nil).LikedTracks))
 }
 
+// Lyrics mocks base method.
+func (m *MockTables) Lyrics() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lyrics")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Lyrics indicates an expected call of Lyrics.
+func (mr *MockTablesMockRecorder) Lyrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lyrics", reflect.TypeOf((*MockTables)(nil).Lyrics))
+}
+
 // PlaylistsTracks mocks base method.
 func (m *MockTables) PlaylistsTracks() string {
 	m.ctrl.T.Helper()
@@ -0,0 +1,51 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	commonSQL "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/db"
+)
+
+func (p *PostgreSQL) UpsertLyrics(ctx context.Context, lyrics models.Lyrics) error {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (track_id, text, synced, lang)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (track_id) DO UPDATE
+		SET text = EXCLUDED.text,
+			synced = EXCLUDED.synced,
+			lang = EXCLUDED.lang,
+			updated_at = NOW();`,
+		p.tables.Lyrics())
+
+	if _, err := p.db.ExecContext(ctx, query,
+		lyrics.TrackID, lyrics.Text, lyrics.Synced, commonSQL.DetectLang(lyrics.Text)); err != nil {
+
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgreSQL) GetLyrics(ctx context.Context, trackID uint32) (*models.Lyrics, error) {
+	query := fmt.Sprintf(
+		`SELECT track_id, text, synced, updated_at
+		FROM %s
+		WHERE track_id = $1;`,
+		p.tables.Lyrics())
+
+	var lyrics models.Lyrics
+	if err := p.db.GetContext(ctx, &lyrics, query, trackID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchLyricsError{TrackID: trackID}, err)
+		}
+
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return &lyrics, nil
+}
@@ -0,0 +1,210 @@
+package postgresql
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	commonSQL "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/db"
+	trackMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track/mocks"
+)
+
+const lyricsTable = "Lyrics"
+
+func TestTrackRepositoryPostgreSQL_UpsertLyrics(t *testing.T) {
+	// Init
+	type mockBehavior func(l models.Lyrics, lang string)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := trackMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	synced := "[00:01.00]Master of puppets"
+
+	testTable := []struct {
+		name          string
+		lyrics        models.Lyrics
+		lang          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			lyrics: models.Lyrics{
+				TrackID: 1,
+				Text:    "Master of puppets",
+				Synced:  &synced,
+			},
+			lang: commonSQL.LangEnglish,
+			mockBehavior: func(l models.Lyrics, lang string) {
+				tablesMock.EXPECT().Lyrics().Return(lyricsTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+lyricsTable+"(.+)ON CONFLICT \\(track_id\\) DO UPDATE").
+					WithArgs(l.TrackID, l.Text, l.Synced, lang).
+					WillReturnResult(driver.ResultNoRows)
+			},
+		},
+		{
+			name: "Russian Lyrics",
+			lyrics: models.Lyrics{
+				TrackID: 2,
+				Text:    "Накануне",
+			},
+			lang: commonSQL.LangRussian,
+			mockBehavior: func(l models.Lyrics, lang string) {
+				tablesMock.EXPECT().Lyrics().Return(lyricsTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+lyricsTable).
+					WithArgs(l.TrackID, l.Text, l.Synced, lang).
+					WillReturnResult(driver.ResultNoRows)
+			},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			lyrics: models.Lyrics{
+				TrackID: 1,
+				Text:    "Master of puppets",
+			},
+			lang: commonSQL.LangEnglish,
+			mockBehavior: func(l models.Lyrics, lang string) {
+				tablesMock.EXPECT().Lyrics().Return(lyricsTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+lyricsTable).
+					WithArgs(l.TrackID, l.Text, l.Synced, lang).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tc.lyrics, tc.lang)
+
+			err := repo.UpsertLyrics(ctx, tc.lyrics)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTrackRepositoryPostgreSQL_GetLyrics(t *testing.T) {
+	// Init
+	type mockBehavior func(trackID uint32, l models.Lyrics)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := trackMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultTrackID uint32 = 1
+
+	synced := "[00:01.00]Master of puppets"
+	defaultLyrics := models.Lyrics{
+		TrackID:   defaultTrackID,
+		Text:      "Master of puppets",
+		Synced:    &synced,
+		UpdatedAt: time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	testTable := []struct {
+		name           string
+		trackID        uint32
+		mockBehavior   mockBehavior
+		expectedLyrics models.Lyrics
+		expectError    bool
+		expectedError  error
+	}{
+		{
+			name:    "Common",
+			trackID: defaultTrackID,
+			mockBehavior: func(trackID uint32, l models.Lyrics) {
+				tablesMock.EXPECT().Lyrics().Return(lyricsTable)
+
+				row := sqlxMock.NewRows([]string{"track_id", "text", "synced", "updated_at"}).
+					AddRow(l.TrackID, l.Text, l.Synced, l.UpdatedAt)
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + lyricsTable).
+					WithArgs(trackID).
+					WillReturnRows(row)
+			},
+			expectedLyrics: defaultLyrics,
+		},
+		{
+			name:    "No Lyrics",
+			trackID: defaultTrackID,
+			mockBehavior: func(trackID uint32, l models.Lyrics) {
+				tablesMock.EXPECT().Lyrics().Return(lyricsTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + lyricsTable).
+					WithArgs(trackID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchLyricsError{TrackID: defaultTrackID},
+		},
+		{
+			name:    "Internal PostgreSQL Error",
+			trackID: defaultTrackID,
+			mockBehavior: func(trackID uint32, l models.Lyrics) {
+				tablesMock.EXPECT().Lyrics().Return(lyricsTable)
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM " + lyricsTable).
+					WithArgs(trackID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(tc.trackID, tc.expectedLyrics)
+
+			l, err := repo.GetLyrics(ctx, tc.trackID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedLyrics, *l)
+			}
+		})
+	}
+}
@@ -50,6 +50,29 @@ func (p *PostgreSQL) Check(ctx context.Context, trackID uint32) error {
 	return nil
 }
 
+func (p *PostgreSQL) CheckMany(ctx context.Context, tracksID []uint32) error {
+	query := fmt.Sprintf(
+		`SELECT q.id
+		FROM unnest($1::INT[]) WITH ORDINALITY AS q(id, position)
+		LEFT JOIN %s t ON t.id = q.id
+		WHERE t.id IS NULL
+		ORDER BY q.position
+		LIMIT 1;`,
+		p.tables.Tracks())
+
+	var missingID uint32
+	err := p.db.GetContext(ctx, &missingID, query, pq.Array(tracksID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return fmt.Errorf("(repo) %w", &models.NoSuchTrackError{TrackID: missingID})
+}
+
 func (p *PostgreSQL) Insert(ctx context.Context, track models.Track, artistsID []uint32) (_ uint32, repoErr error) {
 	tx, err := p.db.Begin()
 	if err != nil {
@@ -58,13 +81,13 @@ func (p *PostgreSQL) Insert(ctx context.Context, track models.Track, artistsID [
 	defer commonSQL.CheckTransaction(tx, &repoErr)
 
 	insertTrackQuery := fmt.Sprintf(
-		`INSERT INTO %s (name, album_id, album_position, cover_src, record_src, duration) 
-		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id;`,
+		`INSERT INTO %s (name, album_id, album_position, cover_src, record_src, duration, lang) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id;`,
 		p.tables.Tracks())
 
 	var trackID uint32
 	row := tx.QueryRowContext(ctx, insertTrackQuery, track.Name, track.AlbumID,
-		track.AlbumPosition, track.CoverSrc, track.RecordSrc, track.Duration)
+		track.AlbumPosition, track.CoverSrc, track.RecordSrc, track.Duration, commonSQL.DetectLang(track.Name))
 	if err := row.Scan(&trackID); err != nil {
 		return 0, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
@@ -142,6 +165,29 @@ func (p *PostgreSQL) GetFeed(ctx context.Context, limit uint32) ([]models.Track,
 	return tracks, nil
 }
 
+func (p *PostgreSQL) GetPersonalFeed(ctx context.Context, userID uint32, limit uint32) ([]models.Track, error) {
+	query := fmt.Sprintf(
+		`SELECT t.id, t.name, t.album_id, t.cover_src, t.record_src, t.listens, t.duration
+		FROM %s t
+			INNER JOIN (
+				SELECT ac.track_id, MAX(tl.created_at) AS active_at
+				FROM %s tl
+					INNER JOIN %s ac ON ac.id = tl.activity_id
+				WHERE tl.user_id = $1 AND ac.track_id IS NOT NULL
+				GROUP BY ac.track_id
+			) f ON f.track_id = t.id
+		ORDER BY f.active_at DESC, t.id DESC
+		LIMIT $2;`,
+		p.tables.Tracks(), p.tables.ActivityTimeline(), p.tables.Activities())
+
+	tracks := []models.Track{}
+	if err := p.db.SelectContext(ctx, &tracks, query, userID, limit); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return tracks, nil
+}
+
 func (p *PostgreSQL) GetByAlbum(ctx context.Context, albumID uint32) ([]models.Track, error) {
 	query := fmt.Sprintf(
 		`SELECT id, name, album_id, album_position, cover_src, record_src, listens, duration
@@ -203,25 +249,71 @@ func (p *PostgreSQL) GetByArtist(ctx context.Context, artistID uint32) ([]models
 	return tracks, nil
 }
 
-func (p *PostgreSQL) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Track, error) {
+func (p *PostgreSQL) GetLikedByUser(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.Track, uint32, error) {
+
+	filter := fmt.Sprintf(
+		`ut.user_id = $1 AND ($2 = ''
+			OR STRPOS(LOWER(t.name), LOWER($2)) > 0
+			OR EXISTS (
+				SELECT 1
+				FROM %s at
+					INNER JOIN %s a ON at.artist_id = a.id
+				WHERE at.track_id = t.id AND STRPOS(LOWER(a.name), LOWER($2)) > 0
+			))`,
+		p.tables.ArtistsTracks(), p.tables.Artists())
+
+	countQuery := fmt.Sprintf(
+		`SELECT COUNT(*)
+		FROM %s t
+			INNER JOIN %s ut ON t.id = ut.track_id
+		WHERE %s;`,
+		p.tables.Tracks(), p.tables.LikedTracks(), filter)
+
+	var total uint32
+	if err := p.db.GetContext(ctx, &total, countQuery, userID, q.Filter); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
 	query := fmt.Sprintf(
-		`SELECT t.id, name, t.album_id, t.cover_src, t.record_src, t.listens, t.duration
-		FROM %s t 
-			INNER JOIN %s ut ON t.id = ut.track_id 
-		WHERE ut.user_id = $1
-		ORDER BY liked_at DESC;`,
-		p.tables.Tracks(), p.tables.LikedTracks())
+		`SELECT t.id, t.name, t.album_id, t.cover_src, t.record_src, t.listens, t.duration, ut.liked_at
+		FROM %s t
+			INNER JOIN %s ut ON t.id = ut.track_id
+		WHERE %s
+		ORDER BY %s %s, t.id
+		LIMIT $3 OFFSET $4;`,
+		p.tables.Tracks(), p.tables.LikedTracks(), filter, p.favoritesSortColumn(q.SortBy), q.OrderSQL())
 
 	var tracks []models.Track
-	if err := p.db.SelectContext(ctx, &tracks, query, userID); err != nil {
+	if err := p.db.SelectContext(ctx, &tracks, query, userID, q.Filter, q.LimitValue(), q.Offset); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("(repo) %w: %w", &models.NoSuchUserError{UserID: userID}, err)
+			return nil, 0, fmt.Errorf("(repo) %w: %w", &models.NoSuchUserError{UserID: userID}, err)
 		}
 
-		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
 	}
 
-	return tracks, nil
+	return tracks, total, nil
+}
+
+// favoritesSortColumn returns expression to order liked tracks by.
+// Track with several artists is sorted by the first of them alphabetically.
+func (p *PostgreSQL) favoritesSortColumn(sortBy string) string {
+	switch sortBy {
+	case models.FavoritesSortName:
+		return "t.name"
+	case models.FavoritesSortDuration:
+		return "t.duration"
+	case models.FavoritesSortArtist:
+		return fmt.Sprintf(
+			`(SELECT MIN(a.name)
+			FROM %s at
+				INNER JOIN %s a ON at.artist_id = a.id
+			WHERE at.track_id = t.id)`,
+			p.tables.ArtistsTracks(), p.tables.Artists())
+	}
+
+	return "ut.liked_at"
 }
 
 const errorLikeExists = "unique_violation"
@@ -11,6 +11,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/golang/mock/gomock"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
@@ -22,6 +23,9 @@ var ctx = context.Background()
 const trackTable = "Tracks"
 const artistsTracksTable = "Artists_Tracks"
 const likedTracksTable = "Liked_tracks"
+const artistTable = "Artists"
+const activityTimelineTable = "Activity_timeline"
+const activitiesTable = "Activities"
 
 var errPqInternal = errors.New("postgres is dead")
 
@@ -132,6 +136,86 @@ func TestTrackRepositoryPostgreSQL_Check(t *testing.T) {
 	}
 }
 
+func TestTrackRepositoryPostgreSQL_CheckMany(t *testing.T) {
+	// Init
+	type mockBehavior func(tracksID []uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := trackMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	defaultTracksToCheckID := []uint32{1, 2, 3}
+
+	testTable := []struct {
+		name          string
+		mockBehavior  mockBehavior
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(tracksID []uint32) {
+				tablesMock.EXPECT().Tracks().Return(trackTable)
+
+				sqlxMock.ExpectQuery("SELECT q.id(.+)FROM unnest(.+)LEFT JOIN " + trackTable + " t(.+)WHERE t.id IS NULL").
+					WithArgs(pq.Array(tracksID)).
+					WillReturnRows(sqlxMock.NewRows([]string{"id"}))
+			},
+		},
+		{
+			name: "No Such Track",
+			mockBehavior: func(tracksID []uint32) {
+				tablesMock.EXPECT().Tracks().Return(trackTable)
+
+				row := sqlxMock.NewRows([]string{"id"}).AddRow(tracksID[1])
+				sqlxMock.ExpectQuery("SELECT q.id").
+					WithArgs(pq.Array(tracksID)).
+					WillReturnRows(row)
+			},
+			expectError:   true,
+			expectedError: &models.NoSuchTrackError{TrackID: defaultTracksToCheckID[1]},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(tracksID []uint32) {
+				tablesMock.EXPECT().Tracks().Return(trackTable)
+
+				sqlxMock.ExpectQuery("SELECT q.id").
+					WithArgs(pq.Array(tracksID)).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultTracksToCheckID)
+
+			err := repo.CheckMany(ctx, defaultTracksToCheckID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestTrackRepositoryPostgreSQL_Insert(t *testing.T) {
 	// Init
 	type mockBehavior func(track models.Track, artistsID []uint32, id uint32)
@@ -184,7 +268,7 @@ func TestTrackRepositoryPostgreSQL_Insert(t *testing.T) {
 
 				row := sqlxMock.NewRows([]string{"id"}).AddRow(id)
 				sqlxMock.ExpectQuery("INSERT INTO "+trackTable).
-					WithArgs(t.Name, t.AlbumID, t.AlbumPosition, t.CoverSrc, t.RecordSrc, t.Duration).
+					WithArgs(t.Name, t.AlbumID, t.AlbumPosition, t.CoverSrc, t.RecordSrc, t.Duration, "english").
 					WillReturnRows(row)
 
 				for _, artistID := range artistsID {
@@ -209,7 +293,7 @@ func TestTrackRepositoryPostgreSQL_Insert(t *testing.T) {
 
 				row := sqlxMock.NewRows([]string{"id"}).AddRow(id)
 				sqlxMock.ExpectQuery("INSERT INTO "+trackTable).
-					WithArgs(t.Name, t.AlbumID, t.AlbumPosition, t.CoverSrc, t.RecordSrc, t.Duration).
+					WithArgs(t.Name, t.AlbumID, t.AlbumPosition, t.CoverSrc, t.RecordSrc, t.Duration, "english").
 					WillReturnRows(row)
 
 				sqlxMock.ExpectExec("INSERT INTO "+artistsTracksTable).
@@ -232,7 +316,7 @@ func TestTrackRepositoryPostgreSQL_Insert(t *testing.T) {
 				sqlxMock.ExpectBegin()
 
 				sqlxMock.ExpectQuery("INSERT INTO "+trackTable).
-					WithArgs(t.Name, t.AlbumID, t.AlbumPosition, t.CoverSrc, t.RecordSrc, t.Duration).
+					WithArgs(t.Name, t.AlbumID, t.AlbumPosition, t.CoverSrc, t.RecordSrc, t.Duration, "english").
 					WillReturnError(errPqInternal)
 
 				sqlxMock.ExpectRollback()
@@ -513,6 +597,101 @@ func TestTrackRepositoryPostgreSQL_GetFeed(t *testing.T) {
 	}
 }
 
+func TestTrackRepositoryPostgreSQL_GetPersonalFeed(t *testing.T) {
+	// Init
+	type mockBehavior func(userID uint32, tracks []models.Track)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := trackMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const userID uint32 = 1
+	const feedAmountLimit uint32 = 100
+
+	trackColumns := []string{"id", "name", "album_id", "cover_src", "record_src", "listens", "duration"}
+
+	expectTables := func() {
+		tablesMock.EXPECT().Tracks().Return(trackTable)
+		tablesMock.EXPECT().ActivityTimeline().Return(activityTimelineTable)
+		tablesMock.EXPECT().Activities().Return(activitiesTable)
+	}
+
+	testTable := []struct {
+		name           string
+		mockBehavior   mockBehavior
+		expectedTracks []models.Track
+		expectError    bool
+		expectedError  error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID uint32, tracks []models.Track) {
+				expectTables()
+
+				rows := sqlxMock.NewRows(trackColumns)
+				for _, tr := range tracks {
+					rows.AddRow(tr.ID, tr.Name, tr.AlbumID, tr.CoverSrc, tr.RecordSrc, tr.Listens, tr.Duration)
+				}
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+trackTable+"(.+)"+activityTimelineTable+
+					"(.+)ORDER BY f.active_at DESC").
+					WithArgs(userID, feedAmountLimit).
+					WillReturnRows(rows)
+			},
+			expectedTracks: defaultTracks,
+		},
+		{
+			name: "No Activity",
+			mockBehavior: func(userID uint32, tracks []models.Track) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+trackTable).
+					WithArgs(userID, feedAmountLimit).
+					WillReturnRows(sqlxMock.NewRows(trackColumns))
+			},
+			expectedTracks: []models.Track{},
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID uint32, tracks []models.Track) {
+				expectTables()
+
+				sqlxMock.ExpectQuery("SELECT (.+) FROM "+trackTable).
+					WithArgs(userID, feedAmountLimit).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(userID, tc.expectedTracks)
+
+			tracks, err := repo.GetPersonalFeed(ctx, userID, feedAmountLimit)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTracks, tracks)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestTrackRepositoryPostgreSQL_GetByArtist(t *testing.T) {
 	// Init
 	type mockBehavior func(artistID uint32, tracks []models.Track)
@@ -734,6 +913,7 @@ func TestTrackRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 	testTable := []struct {
 		name           string
 		userID         uint32
+		query          models.FavoritesQuery
 		mockBehavior   mockBehavior
 		expectedTracks []models.Track
 		expectError    bool
@@ -743,8 +923,10 @@ func TestTrackRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "Common",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, t []models.Track) {
-				tablesMock.EXPECT().Tracks().Return(trackTable)
-				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable)
+				tablesMock.EXPECT().Tracks().Return(trackTable).Times(2)
+				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable).Times(2)
+				tablesMock.EXPECT().ArtistsTracks().Return(artistsTracksTable)
+				tablesMock.EXPECT().Artists().Return(artistTable)
 
 				rows := sqlxMock.NewRows(
 					[]string{"id", "name", "album_id", "cover_src", "record_src", "listens", "duration"})
@@ -753,9 +935,46 @@ func TestTrackRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 						t[ind].CoverSrc, t[ind].RecordSrc, t[ind].Listens, t[ind].Duration)
 				}
 
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s t INNER JOIN %s",
+					trackTable, likedTracksTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(t)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s t INNER JOIN %s",
 					trackTable, likedTracksTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
+					WillReturnRows(rows)
+			},
+			expectedTracks: defaultTracks,
+		},
+		{
+			name:   "Sorted By Artist Page",
+			userID: defaultUserID,
+			query: models.FavoritesQuery{
+				SortBy: models.FavoritesSortArtist,
+				Order:  models.FavoritesOrderAsc,
+				Filter: "ox",
+				Limit:  2,
+			},
+			mockBehavior: func(userID uint32, t []models.Track) {
+				tablesMock.EXPECT().Tracks().Return(trackTable).Times(2)
+				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable).Times(2)
+				tablesMock.EXPECT().ArtistsTracks().Return(artistsTracksTable).Times(2)
+				tablesMock.EXPECT().Artists().Return(artistTable).Times(2)
+
+				rows := sqlxMock.NewRows(
+					[]string{"id", "name", "album_id", "cover_src", "record_src", "listens", "duration"})
+				for ind := range t {
+					rows.AddRow(t[ind].ID, t[ind].Name, t[ind].AlbumID,
+						t[ind].CoverSrc, t[ind].RecordSrc, t[ind].Listens, t[ind].Duration)
+				}
+
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s t INNER JOIN %s",
+					trackTable, likedTracksTable)).
+					WithArgs(userID, "ox").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(t)))
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s t INNER JOIN %s (.+) ORDER BY \\(SELECT MIN(.+)\\) ASC",
+					trackTable, likedTracksTable)).
+					WithArgs(userID, "ox", 2, 0).
 					WillReturnRows(rows)
 			},
 			expectedTracks: defaultTracks,
@@ -764,12 +983,18 @@ func TestTrackRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "No Such User",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, t []models.Track) {
-				tablesMock.EXPECT().Tracks().Return(trackTable)
-				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable)
+				tablesMock.EXPECT().Tracks().Return(trackTable).Times(2)
+				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable).Times(2)
+				tablesMock.EXPECT().ArtistsTracks().Return(artistsTracksTable)
+				tablesMock.EXPECT().Artists().Return(artistTable)
 
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s t INNER JOIN %s",
+					trackTable, likedTracksTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(t)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s t INNER JOIN %s",
 					trackTable, likedTracksTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expectError:   true,
@@ -779,12 +1004,18 @@ func TestTrackRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			name:   "Internal PostgreSQL Error",
 			userID: defaultUserID,
 			mockBehavior: func(userID uint32, t []models.Track) {
-				tablesMock.EXPECT().Tracks().Return(trackTable)
-				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable)
+				tablesMock.EXPECT().Tracks().Return(trackTable).Times(2)
+				tablesMock.EXPECT().LikedTracks().Return(likedTracksTable).Times(2)
+				tablesMock.EXPECT().ArtistsTracks().Return(artistsTracksTable)
+				tablesMock.EXPECT().Artists().Return(artistTable)
 
+				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT COUNT(.+) FROM %s t INNER JOIN %s",
+					trackTable, likedTracksTable)).
+					WithArgs(userID, "").
+					WillReturnRows(sqlxMock.NewRows([]string{"count"}).AddRow(len(t)))
 				sqlxMock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s t INNER JOIN %s",
 					trackTable, likedTracksTable)).
-					WithArgs(userID).
+					WithArgs(userID, "", nil, 0).
 					WillReturnError(errPqInternal)
 			},
 			expectError:   true,
@@ -797,7 +1028,7 @@ func TestTrackRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			// Call mock
 			tc.mockBehavior(tc.userID, tc.expectedTracks)
 
-			tr, err := repo.GetLikedByUser(ctx, tc.userID)
+			tr, total, err := repo.GetLikedByUser(ctx, tc.userID, tc.query)
 
 			// Test
 			if tc.expectError {
@@ -805,6 +1036,7 @@ func TestTrackRepositoryPostgreSQL_GetLikedByUser(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tc.expectedTracks, tr)
+				assert.Equal(t, uint32(len(tc.expectedTracks)), total)
 			}
 		})
 	}
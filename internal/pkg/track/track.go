@@ -14,30 +14,48 @@ type Usecase interface {
 	GetByID(ctx context.Context, trackID uint32) (*models.Track, error)
 	Delete(ctx context.Context, trackID uint32, userID uint32) error
 	GetFeed(ctx context.Context) ([]models.Track, error)
+	// GetPersonalFeed returns feed for logged in user: tracks from user's activity timeline go first,
+	// the rest is filled with general feed
+	GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Track, error)
 	GetByAlbum(ctx context.Context, albumID uint32) ([]models.Track, error)
 	GetByPlaylist(ctx context.Context, playlistID uint32) ([]models.Track, error)
 	GetByArtist(ctx context.Context, artistID uint32) ([]models.Track, error)
-	GetLikedByUser(ctx context.Context, userID uint32) ([]models.Track, error)
+	GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Track, uint32, error)
 	SetLike(ctx context.Context, trackID, userID uint32) (bool, error)
 	UnLike(ctx context.Context, trackID, userID uint32) (bool, error)
 	IsLiked(ctx context.Context, trackID, userID uint32) (bool, error)
+
+	// SetLyrics replaces lyrics of track, which can be done only by one of track's artists
+	SetLyrics(ctx context.Context, lyrics models.Lyrics, userID uint32) error
+	// GetLyrics returns models.NoSuchLyricsError if track has no lyrics
+	GetLyrics(ctx context.Context, trackID uint32) (*models.Lyrics, error)
 }
 
 // Repository includes DBMS-relatable methods to work with tracks
 type Repository interface {
 	// Check returns models.NoSuchTrackError if track-entry with given ID doesn't exist in DB
 	Check(ctx context.Context, trackID uint32) error
+	// CheckMany returns models.NoSuchTrackError of the first of tracks which doesn't exist in DB
+	CheckMany(ctx context.Context, tracksID []uint32) error
 	Insert(ctx context.Context, track models.Track, artistsID []uint32) (uint32, error)
 	GetByID(ctx context.Context, trackID uint32) (*models.Track, error)
 	DeleteByID(ctx context.Context, trackID uint32) error
 	GetFeed(ctx context.Context, limit uint32) ([]models.Track, error)
+	// GetPersonalFeed returns tracks from activity timeline of user: releases of liked artists
+	// and tracks liked by followed users, the latest first
+	GetPersonalFeed(ctx context.Context, userID uint32, limit uint32) ([]models.Track, error)
 	GetByAlbum(ctx context.Context, albumID uint32) ([]models.Track, error)
 	GetByPlaylist(ctx context.Context, playlistID uint32) ([]models.Track, error)
 	GetByArtist(ctx context.Context, artistID uint32) ([]models.Track, error)
-	GetLikedByUser(ctx context.Context, userID uint32) ([]models.Track, error)
+	// GetLikedByUser returns page of user's liked tracks and their total amount
+	GetLikedByUser(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.Track, uint32, error)
 	InsertLike(ctx context.Context, trackID, userID uint32) (bool, error)
 	DeleteLike(ctx context.Context, trackID, userID uint32) (bool, error)
 	IsLiked(ctx context.Context, trackID, userID uint32) (bool, error)
+
+	UpsertLyrics(ctx context.Context, lyrics models.Lyrics) error
+	// GetLyrics returns models.NoSuchLyricsError if track has no lyrics
+	GetLyrics(ctx context.Context, trackID uint32) (*models.Lyrics, error)
 }
 
 // Tables includes methods which return needed tables
@@ -47,4 +65,8 @@ type Tables interface {
 	ArtistsTracks() string
 	PlaylistsTracks() string
 	LikedTracks() string
+	Artists() string
+	Lyrics() string
+	Activities() string
+	ActivityTimeline() string
 }
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+func (u *Usecase) SetLyrics(ctx context.Context, lyrics models.Lyrics, userID uint32) error {
+	if err := u.trackRepo.Check(ctx, lyrics.TrackID); err != nil {
+		return fmt.Errorf("(usecase) can't find track with id #%d: %w", lyrics.TrackID, err)
+	}
+
+	artists, err := u.artistRepo.GetByTrack(ctx, lyrics.TrackID)
+	if err != nil {
+		return fmt.Errorf("(usecase) can't get artists of track: %w", err)
+	}
+	userInArtists := false
+	for _, artist := range artists {
+		if artist.UserID != nil && *artist.UserID == userID {
+			userInArtists = true
+			break
+		}
+	}
+	if !userInArtists {
+		return fmt.Errorf("(usecase) lyrics can't be set by user: %w", &models.ForbiddenUserError{})
+	}
+
+	if err := lyrics.Validate(); err != nil {
+		return fmt.Errorf("(usecase) %w", err)
+	}
+
+	if err := u.trackRepo.UpsertLyrics(ctx, lyrics); err != nil {
+		return fmt.Errorf("(usecase) can't save lyrics: %w", err)
+	}
+
+	return nil
+}
+
+func (u *Usecase) GetLyrics(ctx context.Context, trackID uint32) (*models.Lyrics, error) {
+	lyrics, err := u.trackRepo.GetLyrics(ctx, trackID)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get lyrics of track #%d: %w", trackID, err)
+	}
+
+	return lyrics, nil
+}
@@ -2,33 +2,54 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/cache"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/track"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
 )
 
 const feedTracksAmountLimit uint32 = 100
 
+const smartTracksCacheTTL = time.Minute
+
+// smartTracks are evaluated tracks of smart playlist with rules of given version
+type smartTracks struct {
+	rulesVersion time.Time
+	tracks       []models.Track
+}
+
 // Usecase implements track.Usecase
 type Usecase struct {
 	trackRepo    track.Repository
 	artistRepo   artist.Repository
 	albumRepo    album.Repository
 	playlistRepo playlist.Repository
+	activityRepo activity.Repository
+	logger       logger.Logger
+
+	smartTracksCache *cache.TTL[uint32, smartTracks]
 }
 
 func NewUsecase(tr track.Repository, arr artist.Repository,
-	alr album.Repository, pr playlist.Repository) *Usecase {
+	alr album.Repository, pr playlist.Repository, acr activity.Repository, l logger.Logger) *Usecase {
 
 	return &Usecase{
 		trackRepo:    tr,
 		artistRepo:   arr,
 		albumRepo:    alr,
 		playlistRepo: pr,
+		activityRepo: acr,
+		logger:       l,
+
+		smartTracksCache: cache.NewTTL[uint32, smartTracks](smartTracksCacheTTL),
 	}
 }
 
@@ -55,6 +76,14 @@ func (u *Usecase) Create(ctx context.Context,
 		return 0, fmt.Errorf("(usecase) can't insert track into repository: %w", err)
 	}
 
+	// Tracks of albums are announced as release of album.
+	if track.AlbumID == nil {
+		release := models.Activity{Type: models.ActivityTypeRelease, TrackID: &trackID}
+		if err := u.activityRepo.AddForArtistsFans(ctx, release, artistsID); err != nil {
+			u.logger.ErrorfReqID(ctx, "can't add release of track #%d to activity feed: %v", trackID, err)
+		}
+	}
+
 	return trackID, nil
 }
 
@@ -102,6 +131,36 @@ func (u *Usecase) GetFeed(ctx context.Context) ([]models.Track, error) {
 	return tracks, nil
 }
 
+func (u *Usecase) GetPersonalFeed(ctx context.Context, userID uint32) ([]models.Track, error) {
+	tracks, err := u.trackRepo.GetPersonalFeed(ctx, userID, feedTracksAmountLimit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get personal feed tracks from repository: %w", err)
+	}
+	if len(tracks) >= int(feedTracksAmountLimit) {
+		return tracks, nil
+	}
+
+	general, err := u.trackRepo.GetFeed(ctx, feedTracksAmountLimit)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get feed tracks from repository: %w", err)
+	}
+
+	inFeed := make(map[uint32]struct{}, len(tracks))
+	for _, t := range tracks {
+		inFeed[t.ID] = struct{}{}
+	}
+	for _, t := range general {
+		if len(tracks) >= int(feedTracksAmountLimit) {
+			break
+		}
+		if _, ok := inFeed[t.ID]; !ok {
+			tracks = append(tracks, t)
+		}
+	}
+
+	return tracks, nil
+}
+
 func (u *Usecase) GetByAlbum(ctx context.Context, albumID uint32) ([]models.Track, error) {
 	if err := u.albumRepo.Check(ctx, albumID); err != nil {
 		return nil, fmt.Errorf("(usecase) can't find album with id #%d: %w", albumID, err)
@@ -120,6 +179,15 @@ func (u *Usecase) GetByPlaylist(ctx context.Context, playlistID uint32) ([]model
 		return nil, fmt.Errorf("(usecase) can't find playlist with id #%d: %w", playlistID, err)
 	}
 
+	smart, err := u.playlistRepo.GetSmart(ctx, playlistID)
+	if err == nil {
+		return u.getSmartTracks(ctx, *smart)
+	}
+	var errNotSmart *models.NotSmartPlaylistError
+	if !errors.As(err, &errNotSmart) {
+		return nil, fmt.Errorf("(usecase) can't check if playlist is smart: %w", err)
+	}
+
 	tracks, err := u.trackRepo.GetByPlaylist(ctx, playlistID)
 	if err != nil {
 		return nil, fmt.Errorf("(usecase) can't get tracks from repository: %w", err)
@@ -128,6 +196,27 @@ func (u *Usecase) GetByPlaylist(ctx context.Context, playlistID uint32) ([]model
 	return tracks, nil
 }
 
+// getSmartTracks evaluates rules of smart playlist lazily:
+// result is cached until rules are updated or cache entry expires.
+// Callers get copy of cached tracks, so they can't change cache entry.
+func (u *Usecase) getSmartTracks(ctx context.Context, smart models.SmartPlaylist) ([]models.Track, error) {
+	if cached, ok := u.smartTracksCache.Get(smart.PlaylistID); ok && cached.rulesVersion.Equal(smart.UpdatedAt) {
+		return append([]models.Track(nil), cached.tracks...), nil
+	}
+
+	tracks, err := u.playlistRepo.GetSmartTracks(ctx, smart)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't evaluate rules of smart playlist: %w", err)
+	}
+
+	u.smartTracksCache.Set(smart.PlaylistID, smartTracks{
+		rulesVersion: smart.UpdatedAt,
+		tracks:       tracks,
+	})
+
+	return append([]models.Track(nil), tracks...), nil
+}
+
 func (u *Usecase) GetByArtist(ctx context.Context, artistID uint32) ([]models.Track, error) {
 	if err := u.artistRepo.Check(ctx, artistID); err != nil {
 		return nil, fmt.Errorf("(usecase) can't find artist with id #%d: %w", artistID, err)
@@ -141,13 +230,21 @@ func (u *Usecase) GetByArtist(ctx context.Context, artistID uint32) ([]models.Tr
 	return tracks, nil
 }
 
-func (u *Usecase) GetLikedByUser(ctx context.Context, userID uint32) ([]models.Track, error) {
-	tracks, err := u.trackRepo.GetLikedByUser(ctx, userID)
+func (u *Usecase) GetLikedByUser(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.Track, uint32, error) {
+
+	if err := q.Validate(models.FavoritesSortLikedAt, models.FavoritesSortName,
+		models.FavoritesSortArtist, models.FavoritesSortDuration); err != nil {
+
+		return nil, 0, fmt.Errorf("(usecase) %w", err)
+	}
+
+	tracks, total, err := u.trackRepo.GetLikedByUser(ctx, userID, q)
 	if err != nil {
-		return nil, fmt.Errorf("(usecase) can't get tracks from repository: %w", err)
+		return nil, 0, fmt.Errorf("(usecase) can't get tracks from repository: %w", err)
 	}
 
-	return tracks, nil
+	return tracks, total, nil
 }
 
 func (u *Usecase) SetLike(ctx context.Context, trackID, userID uint32) (bool, error) {
@@ -160,6 +257,13 @@ func (u *Usecase) SetLike(ctx context.Context, trackID, userID uint32) (bool, er
 		return false, fmt.Errorf("(usecase) failed to set like: %w", err)
 	}
 
+	if isInserted {
+		liked := models.Activity{Type: models.ActivityTypeTrackLiked, UserID: &userID, TrackID: &trackID}
+		if err := u.activityRepo.AddForFollowers(ctx, liked); err != nil {
+			u.logger.ErrorfReqID(ctx, "can't add like of track #%d to activity feed: %v", trackID, err)
+		}
+	}
+
 	return isInserted, nil
 }
 
@@ -173,6 +277,13 @@ func (u *Usecase) UnLike(ctx context.Context, trackID, userID uint32) (bool, err
 		return false, fmt.Errorf("(usecase) failed to unset like: %w", err)
 	}
 
+	if isDeleted {
+		liked := models.Activity{Type: models.ActivityTypeTrackLiked, UserID: &userID, TrackID: &trackID}
+		if err := u.activityRepo.Delete(ctx, liked); err != nil {
+			u.logger.ErrorfReqID(ctx, "can't delete like of track #%d from activity feed: %v", trackID, err)
+		}
+	}
+
 	return isDeleted, nil
 }
 
@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	activityMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/activity/mocks"
 	albumMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/album/mocks"
 	artistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/artist/mocks"
 	playlistMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/playlist/mocks"
@@ -26,8 +29,9 @@ func TestTrackUsecase_Create(t *testing.T) {
 	arr := artistMocks.NewMockRepository(c)
 	alr := albumMocks.NewMockRepository(c)
 	pr := playlistMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
 
-	u := NewUsecase(tr, arr, alr, pr)
+	u := NewUsecase(tr, arr, alr, pr, acr, commonTests.MockLogger(c))
 
 	var correctUserID uint32 = 1
 	correctArtists := []models.Artist{
@@ -67,6 +71,7 @@ func TestTrackUsecase_Create(t *testing.T) {
 					arr.EXPECT().GetByID(ctx, id).Return(&correctArtists[ind], nil)
 				}
 				tr.EXPECT().Insert(ctx, track, artistsID).Return(correctTrack.ID, nil)
+				acr.EXPECT().AddForArtistsFans(ctx, gomock.Any(), artistsID).Return(nil)
 			},
 		},
 		{
@@ -115,6 +120,21 @@ func TestTrackUsecase_Create(t *testing.T) {
 			expectError:      true,
 			expectedErrorMsg: "can't insert track",
 		},
+		{
+			name:      "Activity Issue Doesn't Fail Creation",
+			album:     correctTrack,
+			userID:    correctUserID,
+			artistsID: []uint32{1},
+			mockBehavior: func(tr *trackMocks.MockRepository, arr *artistMocks.MockRepository,
+				track models.Track, artistsID []uint32, userID uint32) {
+
+				for ind, id := range artistsID {
+					arr.EXPECT().GetByID(ctx, id).Return(&correctArtists[ind], nil)
+				}
+				tr.EXPECT().Insert(ctx, track, artistsID).Return(correctTrack.ID, nil)
+				acr.EXPECT().AddForArtistsFans(ctx, gomock.Any(), artistsID).Return(errors.New(""))
+			},
+		},
 	}
 
 	for _, tc := range testTable {
@@ -132,3 +152,357 @@ func TestTrackUsecase_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestTrackUsecase_GetByPlaylist(t *testing.T) {
+	type mockBehavior func(tr *trackMocks.MockRepository, pr *playlistMocks.MockRepository, playlistID uint32)
+
+	c := gomock.NewController(t)
+
+	tr := trackMocks.NewMockRepository(c)
+	arr := artistMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
+	pr := playlistMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+
+	u := NewUsecase(tr, arr, alr, pr, acr, commonTests.MockLogger(c))
+
+	var correctPlaylistID uint32 = 1
+
+	correctTracks := []models.Track{
+		{
+			ID:   1,
+			Name: "Горгород",
+		},
+	}
+
+	rulesVersion := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+	correctSmart := models.SmartPlaylist{
+		PlaylistID: correctPlaylistID,
+		OwnerID:    1,
+		UpdatedAt:  rulesVersion,
+	}
+	updatedSmart := correctSmart
+	updatedSmart.UpdatedAt = rulesVersion.Add(time.Hour)
+
+	// Cases are run in order: smart playlist result is cached between them
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(tr *trackMocks.MockRepository, pr *playlistMocks.MockRepository, playlistID uint32) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(nil, &models.NotSmartPlaylistError{PlaylistID: playlistID})
+				tr.EXPECT().GetByPlaylist(ctx, playlistID).Return(correctTracks, nil)
+			},
+		},
+		{
+			name: "Smart Playlist",
+			mockBehavior: func(tr *trackMocks.MockRepository, pr *playlistMocks.MockRepository, playlistID uint32) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(&correctSmart, nil)
+				pr.EXPECT().GetSmartTracks(ctx, correctSmart).Return(correctTracks, nil)
+			},
+		},
+		{
+			name: "Smart Playlist Cached",
+			mockBehavior: func(tr *trackMocks.MockRepository, pr *playlistMocks.MockRepository, playlistID uint32) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(&correctSmart, nil)
+			},
+		},
+		{
+			name: "Smart Playlist Rules Updated",
+			mockBehavior: func(tr *trackMocks.MockRepository, pr *playlistMocks.MockRepository, playlistID uint32) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(&updatedSmart, nil)
+				pr.EXPECT().GetSmartTracks(ctx, updatedSmart).Return(correctTracks, nil)
+			},
+		},
+		{
+			name: "No Such Playlist",
+			mockBehavior: func(tr *trackMocks.MockRepository, pr *playlistMocks.MockRepository, playlistID uint32) {
+				pr.EXPECT().Check(ctx, playlistID).Return(&models.NoSuchPlaylistError{PlaylistID: playlistID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't find playlist",
+		},
+		{
+			name: "Smart Check Issue",
+			mockBehavior: func(tr *trackMocks.MockRepository, pr *playlistMocks.MockRepository, playlistID uint32) {
+				pr.EXPECT().Check(ctx, playlistID).Return(nil)
+				pr.EXPECT().GetSmart(ctx, playlistID).Return(nil, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't check if playlist is smart",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tr, pr, correctPlaylistID)
+
+			tracks, err := u.GetByPlaylist(ctx, correctPlaylistID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, correctTracks, tracks)
+			}
+		})
+	}
+}
+
+func TestTrackUsecase_GetByPlaylistSmartCacheIsolation(t *testing.T) {
+	c := gomock.NewController(t)
+
+	tr := trackMocks.NewMockRepository(c)
+	pr := playlistMocks.NewMockRepository(c)
+
+	u := NewUsecase(tr, artistMocks.NewMockRepository(c), albumMocks.NewMockRepository(c),
+		pr, activityMocks.NewMockRepository(c), commonTests.MockLogger(c))
+
+	const playlistID uint32 = 1
+
+	smart := models.SmartPlaylist{
+		PlaylistID: playlistID,
+		OwnerID:    1,
+		UpdatedAt:  time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	pr.EXPECT().Check(ctx, playlistID).Return(nil).Times(2)
+	pr.EXPECT().GetSmart(ctx, playlistID).Return(&smart, nil).Times(2)
+	pr.EXPECT().GetSmartTracks(ctx, smart).Return([]models.Track{{ID: 1, Name: "Горгород"}}, nil)
+
+	tracks, err := u.GetByPlaylist(ctx, playlistID)
+	assert.NoError(t, err)
+
+	// Caller changes its result, cached tracks must stay the same
+	tracks[0].Name = "Changed"
+
+	tracks, err = u.GetByPlaylist(ctx, playlistID)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Track{{ID: 1, Name: "Горгород"}}, tracks)
+}
+
+func TestTrackUsecase_GetLikedByUser(t *testing.T) {
+	type mockBehavior func(tr *trackMocks.MockRepository, userID uint32)
+
+	c := gomock.NewController(t)
+
+	tr := trackMocks.NewMockRepository(c)
+	arr := artistMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
+	pr := playlistMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+
+	u := NewUsecase(tr, arr, alr, pr, acr, commonTests.MockLogger(c))
+
+	const correctUserID uint32 = 1
+
+	correctTracks := []models.Track{
+		{
+			ID:   1,
+			Name: "Горгород",
+		},
+	}
+
+	testTable := []struct {
+		name             string
+		query            models.FavoritesQuery
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Default Query",
+			mockBehavior: func(tr *trackMocks.MockRepository, userID uint32) {
+				expectedQuery := models.FavoritesQuery{
+					SortBy: models.FavoritesSortLikedAt,
+					Order:  models.FavoritesOrderDesc,
+				}
+				tr.EXPECT().GetLikedByUser(ctx, userID, expectedQuery).Return(correctTracks, uint32(1), nil)
+			},
+		},
+		{
+			name: "Sort By Duration",
+			query: models.FavoritesQuery{
+				SortBy: models.FavoritesSortDuration,
+				Limit:  10,
+			},
+			mockBehavior: func(tr *trackMocks.MockRepository, userID uint32) {
+				expectedQuery := models.FavoritesQuery{
+					SortBy: models.FavoritesSortDuration,
+					Order:  models.FavoritesOrderAsc,
+					Limit:  10,
+				}
+				tr.EXPECT().GetLikedByUser(ctx, userID, expectedQuery).Return(correctTracks, uint32(1), nil)
+			},
+		},
+		{
+			name:             "Unsupported Sorting",
+			query:            models.FavoritesQuery{SortBy: "listens"},
+			mockBehavior:     func(tr *trackMocks.MockRepository, userID uint32) {},
+			expectError:      true,
+			expectedErrorMsg: "isn't supported",
+		},
+		{
+			name:             "Too Big Limit",
+			query:            models.FavoritesQuery{Limit: models.FavoritesMaxLimit + 1},
+			mockBehavior:     func(tr *trackMocks.MockRepository, userID uint32) {},
+			expectError:      true,
+			expectedErrorMsg: "limit is too big",
+		},
+		{
+			name: "Repository Issue",
+			mockBehavior: func(tr *trackMocks.MockRepository, userID uint32) {
+				tr.EXPECT().GetLikedByUser(ctx, userID, gomock.Any()).Return(nil, uint32(0), errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get tracks from repository",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tr, correctUserID)
+
+			tracks, total, err := u.GetLikedByUser(ctx, correctUserID, tc.query)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, correctTracks, tracks)
+				assert.Equal(t, uint32(len(correctTracks)), total)
+			}
+		})
+	}
+}
+
+func TestTrackUsecase_SetLyrics(t *testing.T) {
+	type mockBehavior func(tr *trackMocks.MockRepository, ar *artistMocks.MockRepository,
+		lyrics models.Lyrics)
+
+	c := gomock.NewController(t)
+
+	tr := trackMocks.NewMockRepository(c)
+	arr := artistMocks.NewMockRepository(c)
+	alr := albumMocks.NewMockRepository(c)
+	pr := playlistMocks.NewMockRepository(c)
+	acr := activityMocks.NewMockRepository(c)
+
+	u := NewUsecase(tr, arr, alr, pr, acr, commonTests.MockLogger(c))
+
+	var correctUserID uint32 = 1
+	correctArtists := []models.Artist{
+		{
+			ID:     1,
+			UserID: &correctUserID,
+			Name:   "Oxxxymiron",
+		},
+	}
+
+	synced := "[00:01.00]Где нас нет\n[00:03.50]Там нас нет"
+	syncedLyrics := models.Lyrics{
+		TrackID: 1,
+		Synced:  &synced,
+	}
+	expectedLyrics := models.Lyrics{
+		TrackID: 1,
+		Text:    "Где нас нет\nТам нас нет",
+		Synced:  &synced,
+	}
+
+	testTable := []struct {
+		name             string
+		lyrics           models.Lyrics
+		userID           uint32
+		mockBehavior     mockBehavior
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:   "Common",
+			lyrics: syncedLyrics,
+			userID: correctUserID,
+			mockBehavior: func(tr *trackMocks.MockRepository, arr *artistMocks.MockRepository,
+				lyrics models.Lyrics) {
+
+				tr.EXPECT().Check(ctx, lyrics.TrackID).Return(nil)
+				arr.EXPECT().GetByTrack(ctx, lyrics.TrackID).Return(correctArtists, nil)
+				tr.EXPECT().UpsertLyrics(ctx, expectedLyrics).Return(nil)
+			},
+		},
+		{
+			name:   "No Track",
+			lyrics: syncedLyrics,
+			userID: correctUserID,
+			mockBehavior: func(tr *trackMocks.MockRepository, arr *artistMocks.MockRepository,
+				lyrics models.Lyrics) {
+
+				tr.EXPECT().Check(ctx, lyrics.TrackID).Return(&models.NoSuchTrackError{TrackID: lyrics.TrackID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't find track",
+		},
+		{
+			name:   "Forbidden User",
+			lyrics: syncedLyrics,
+			userID: uint32(2),
+			mockBehavior: func(tr *trackMocks.MockRepository, arr *artistMocks.MockRepository,
+				lyrics models.Lyrics) {
+
+				tr.EXPECT().Check(ctx, lyrics.TrackID).Return(nil)
+				arr.EXPECT().GetByTrack(ctx, lyrics.TrackID).Return(correctArtists, nil)
+			},
+			expectError:      true,
+			expectedErrorMsg: "user has no rights",
+		},
+		{
+			name:   "Invalid Lyrics",
+			lyrics: models.Lyrics{TrackID: 1, Text: " "},
+			userID: correctUserID,
+			mockBehavior: func(tr *trackMocks.MockRepository, arr *artistMocks.MockRepository,
+				lyrics models.Lyrics) {
+
+				tr.EXPECT().Check(ctx, lyrics.TrackID).Return(nil)
+				arr.EXPECT().GetByTrack(ctx, lyrics.TrackID).Return(correctArtists, nil)
+			},
+			expectError:      true,
+			expectedErrorMsg: "invalid lyrics",
+		},
+		{
+			name:   "Upsert Issue",
+			lyrics: syncedLyrics,
+			userID: correctUserID,
+			mockBehavior: func(tr *trackMocks.MockRepository, arr *artistMocks.MockRepository,
+				lyrics models.Lyrics) {
+
+				tr.EXPECT().Check(ctx, lyrics.TrackID).Return(nil)
+				arr.EXPECT().GetByTrack(ctx, lyrics.TrackID).Return(correctArtists, nil)
+				tr.EXPECT().UpsertLyrics(ctx, expectedLyrics).Return(errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't save lyrics",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tr, arr, tc.lyrics)
+
+			err := u.SetLyrics(ctx, tc.lyrics, tc.userID)
+
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
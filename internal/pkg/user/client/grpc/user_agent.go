@@ -145,6 +145,122 @@ func (u *UserAgent) UploadAvatar(ctx context.Context, userID uint32, file io.Rea
 	return nil
 }
 
+func (u *UserAgent) Follow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	resp, err := u.client.Follow(ctx, &proto.FollowMsg{UserId: userID, FollowerId: followerID})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return false, fmt.Errorf("%w: %v", &models.NoSuchUserError{UserID: userID}, err)
+			case codes.InvalidArgument:
+				return false, fmt.Errorf("%w: %v", &models.FollowSelfError{}, err)
+			case codes.Internal:
+				return false, err
+			}
+		}
+		return false, err
+	}
+
+	return resp.Followed, nil
+}
+
+func (u *UserAgent) Unfollow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	resp, err := u.client.Unfollow(ctx, &proto.FollowMsg{UserId: userID, FollowerId: followerID})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return false, fmt.Errorf("%w: %v", &models.NoSuchUserError{UserID: userID}, err)
+			case codes.Internal:
+				return false, err
+			}
+		}
+		return false, err
+	}
+
+	return resp.Unfollowed, nil
+}
+
+func (u *UserAgent) IsFollowed(ctx context.Context, userID, followerID uint32) (bool, error) {
+	resp, err := u.client.IsFollowed(ctx, &proto.FollowMsg{UserId: userID, FollowerId: followerID})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.IsFollowed, nil
+}
+
+func (u *UserAgent) GetFollowCounts(ctx context.Context, userID uint32) (*models.UserFollowCounts, error) {
+	resp, err := u.client.GetFollowCounts(ctx, &proto.Id{Id: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UserFollowCounts{
+		Followers: resp.Followers,
+		Following: resp.Following,
+	}, nil
+}
+
+func (u *UserAgent) GetFollowers(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+
+	resp, err := u.client.GetFollowers(ctx, favoritesQueryToProto(userID, q))
+	if err != nil {
+		return nil, 0, followListError(userID, err)
+	}
+
+	return protoToFollowList(resp)
+}
+
+func (u *UserAgent) GetFollowing(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+
+	resp, err := u.client.GetFollowing(ctx, favoritesQueryToProto(userID, q))
+	if err != nil {
+		return nil, 0, followListError(userID, err)
+	}
+
+	return protoToFollowList(resp)
+}
+
+func favoritesQueryToProto(userID uint32, q models.FavoritesQuery) *proto.FollowListMsg {
+	return &proto.FollowListMsg{
+		UserId: userID,
+		SortBy: q.SortBy,
+		Order:  q.Order,
+		Filter: q.Filter,
+		Limit:  q.Limit,
+		Offset: q.Offset,
+	}
+}
+
+func followListError(userID uint32, err error) error {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound:
+			return fmt.Errorf("%w: %v", &models.NoSuchUserError{UserID: userID}, err)
+		case codes.InvalidArgument:
+			return fmt.Errorf("%w: %v", &models.InvalidFavoritesQueryError{Reason: st.Message()}, err)
+		}
+	}
+	return err
+}
+
+func protoToFollowList(resp *proto.FollowListResponse) ([]models.User, uint32, error) {
+	users := make([]models.User, 0, len(resp.Users))
+	for _, protoUser := range resp.Users {
+		user, err := commonProtoUtils.ProtoToUser(protoUser)
+		if err != nil {
+			return nil, 0, fmt.Errorf("(usecase) convert from proto to user: %w", err)
+		}
+
+		users = append(users, *user)
+	}
+
+	return users, resp.Total, nil
+}
+
 func userToProtoUserInfo(user *models.User) *proto.UpdateInfoMsg {
 	return &proto.UpdateInfoMsg{
 		Id:        user.ID,
@@ -1,9 +1,11 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
+	"strconv"
 
 	commonHTTP "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/http"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
@@ -151,3 +153,235 @@ func (h *Handler) UploadAvatar(w http.ResponseWriter, r *http.Request) {
 
 	commonHTTP.SuccessResponse(w, r, uuar, h.logger)
 }
+
+// @Summary		Get Profile
+// @Tags		User
+// @Description	Get public profile of user with chosen ID
+// @Produce		json
+// @Success		200		{object}	models.UserProfileTransfer	"Profile got"
+// @Failure		400		{object}	http.Error					"Client error"
+// @Failure     500    	{object}  	http.Error  				"Can't get user"
+// @Router	    /api/users/{userID}/profile [get]
+func (h *Handler) GetProfile(w http.ResponseWriter, r *http.Request) {
+	userID, err := commonHTTP.GetUserIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	user, err := h.userServices.GetByID(r.Context(), userID)
+	if err != nil {
+		var errNoSuchUser *models.NoSuchUserError
+		if errors.As(err, &errNoSuchUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				userNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			userGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	counts, err := h.userServices.GetFollowCounts(r.Context(), userID)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			userGetServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	isFollowed := false
+	if viewer, err := commonHTTP.GetUserFromRequest(r); err == nil {
+		isFollowed, err = h.userServices.IsFollowed(r.Context(), userID, viewer.ID)
+		if err != nil {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				userGetServerError, http.StatusInternalServerError, h.logger, err)
+			return
+		}
+	}
+
+	upt := models.UserProfileTransferFromEntry(*user, *counts, isFollowed)
+
+	commonHTTP.SuccessResponse(w, r, upt, h.logger)
+}
+
+// @Summary		Follow
+// @Tags		User
+// @Description	Follow user with chosen ID
+// @Produce		json
+// @Success		200		{object}	userFollowResponse	"User followed"
+// @Failure		400		{object}	http.Error			"Client error"
+// @Failure		401		{object}	http.Error  		"User unathorized"
+// @Failure		500		{object}	http.Error			"Server error"
+// @Router		/api/users/{userID}/follow [post]
+func (h *Handler) Follow(w http.ResponseWriter, r *http.Request) {
+	userID, err := commonHTTP.GetUserIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	follower, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	followed, err := h.userServices.Follow(r.Context(), userID, follower.ID)
+	if err != nil {
+		var errFollowSelf *models.FollowSelfError
+		if errors.As(err, &errFollowSelf) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				userFollowSelf, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errNoSuchUser *models.NoSuchUserError
+		if errors.As(err, &errNoSuchUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				userNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			userFollowServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	ufr := userFollowResponse{Status: userFollowedSuccessfully}
+	if !followed {
+		ufr.Status = userAlreadyFollowed
+	}
+	commonHTTP.SuccessResponse(w, r, ufr, h.logger)
+}
+
+// @Summary		Unfollow
+// @Tags		User
+// @Description	Unfollow user with chosen ID
+// @Produce		json
+// @Success		200		{object}	userFollowResponse	"User unfollowed"
+// @Failure		400		{object}	http.Error			"Client error"
+// @Failure		401		{object}	http.Error  		"User unathorized"
+// @Failure		500		{object}	http.Error			"Server error"
+// @Router		/api/users/{userID}/unfollow [post]
+func (h *Handler) Unfollow(w http.ResponseWriter, r *http.Request) {
+	userID, err := commonHTTP.GetUserIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	follower, err := commonHTTP.GetUserFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.UnathorizedUser, http.StatusUnauthorized, h.logger, err)
+		return
+	}
+
+	unfollowed, err := h.userServices.Unfollow(r.Context(), userID, follower.ID)
+	if err != nil {
+		var errNoSuchUser *models.NoSuchUserError
+		if errors.As(err, &errNoSuchUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				userNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			userUnfollowServerError, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	ufr := userFollowResponse{Status: userUnfollowedSuccessfully}
+	if !unfollowed {
+		ufr.Status = userWasntFollowed
+	}
+	commonHTTP.SuccessResponse(w, r, ufr, h.logger)
+}
+
+// @Summary      Followers
+// @Tags         User
+// @Description  Get users following user with chosen ID
+// @Produce      json
+// @Param		 sort	query		string	false	"Sorting: followedAt or name"
+// @Param		 order	query		string	false	"Order: asc or desc"
+// @Param		 q		query		string	false	"Filter by username or name"
+// @Param		 limit	query		int		false	"Page size"
+// @Param		 offset	query		int		false	"Page offset"
+// @Success      200    {object}  	models.UserPublicTransfers	"Followers got"
+// @Header		 200	{integer}	X-Total-Count				"Total amount of followers"
+// @Failure		 400	{object}	http.Error					"Incorrect input"
+// @Failure      500    {object}  	http.Error  				"Server error"
+// @Router       /api/users/{userID}/followers [get]
+func (h *Handler) GetFollowers(w http.ResponseWriter, r *http.Request) {
+	h.getFollowList(w, r, h.userServices.GetFollowers, userFollowersGetServerError)
+}
+
+// @Summary      Following
+// @Tags         User
+// @Description  Get users followed by user with chosen ID
+// @Produce      json
+// @Param		 sort	query		string	false	"Sorting: followedAt or name"
+// @Param		 order	query		string	false	"Order: asc or desc"
+// @Param		 q		query		string	false	"Filter by username or name"
+// @Param		 limit	query		int		false	"Page size"
+// @Param		 offset	query		int		false	"Page offset"
+// @Success      200    {object}  	models.UserPublicTransfers	"Followed users got"
+// @Header		 200	{integer}	X-Total-Count				"Total amount of followed users"
+// @Failure		 400	{object}	http.Error					"Incorrect input"
+// @Failure      500    {object}  	http.Error  				"Server error"
+// @Router       /api/users/{userID}/following [get]
+func (h *Handler) GetFollowing(w http.ResponseWriter, r *http.Request) {
+	h.getFollowList(w, r, h.userServices.GetFollowing, userFollowingGetServerError)
+}
+
+type followListGetter func(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error)
+
+func (h *Handler) getFollowList(w http.ResponseWriter, r *http.Request,
+	getList followListGetter, serverErrorMsg string) {
+
+	userID, err := commonHTTP.GetUserIDFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidURLParameter, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	q, err := commonHTTP.GetFavoritesQueryFromRequest(r)
+	if err != nil {
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+		return
+	}
+
+	users, total, err := getList(r.Context(), userID, q)
+	if err != nil {
+		var errInvalidQuery *models.InvalidFavoritesQueryError
+		if errors.As(err, &errInvalidQuery) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				commonHTTP.InvalidQueryParam, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		var errNoSuchUser *models.NoSuchUserError
+		if errors.As(err, &errNoSuchUser) {
+			commonHTTP.ErrorResponseWithErrLogging(w, r,
+				userNotFound, http.StatusBadRequest, h.logger, err)
+			return
+		}
+
+		commonHTTP.ErrorResponseWithErrLogging(w, r,
+			serverErrorMsg, http.StatusInternalServerError, h.logger, err)
+		return
+	}
+
+	ut := models.UserPublicTransfers(models.UserPublicTransferFromList(users))
+
+	w.Header().Set(commonHTTP.TotalCountHeader, strconv.FormatUint(uint64(total), 10))
+	commonHTTP.SuccessResponse(w, r, ut, h.logger)
+}
@@ -15,17 +15,27 @@ const MaxAvatarMemory = 5 << 20
 const avatarFormKey = "avatar"
 
 const (
-	userNotFound = "no such user"
+	userNotFound   = "no such user"
+	userFollowSelf = "user can't follow themselves"
 
 	userGetServerError          = "can't get user"
 	userUpdateInfoServerError   = "can't update user info"
 	userAvatarUploadServerError = "can't upload avatar"
+	userFollowServerError       = "can't follow user"
+	userUnfollowServerError     = "can't unfollow user"
+	userFollowersGetServerError = "can't get followers"
+	userFollowingGetServerError = "can't get followed users"
 
 	userAvatarUploadInvalidData     = "invalid avatar data"
 	userAvatarUploadInvalidDataType = "invalid avatar data type"
 
 	userUpdatedInfoSuccessfully    = "ok"
 	userAvatarUploadedSuccessfully = "ok"
+	userFollowedSuccessfully       = "ok"
+	userUnfollowedSuccessfully     = "ok"
+
+	userAlreadyFollowed = "already followed"
+	userWasntFollowed   = "wasn't followed"
 )
 
 //easyjson:json
@@ -72,3 +82,8 @@ func (ui *userInfoInput) ToUser(user *models.User) *models.User {
 type userChangeInfoResponse struct {
 	Status string `json:"status"`
 }
+
+//easyjson:json
+type userFollowResponse struct {
+	Status string `json:"status"`
+}
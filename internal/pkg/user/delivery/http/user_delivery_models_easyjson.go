@@ -144,7 +144,7 @@ func (v userInfoInput) MarshalEasyJSON(w *jwriter.Writer) {
 func (v *userInfoInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson40dcd6ddDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp1(l, v)
 }
-func easyjson40dcd6ddDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp2(in *jlexer.Lexer, out *userChangeInfoResponse) {
+func easyjson40dcd6ddDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp2(in *jlexer.Lexer, out *userFollowResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -175,7 +175,7 @@ func easyjson40dcd6ddDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDe
 		in.Consumed()
 	}
 }
-func easyjson40dcd6ddEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp2(out *jwriter.Writer, in userChangeInfoResponse) {
+func easyjson40dcd6ddEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp2(out *jwriter.Writer, in userFollowResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -188,11 +188,63 @@ func easyjson40dcd6ddEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDe
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v userChangeInfoResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v userFollowResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson40dcd6ddEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp2(w, v)
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *userChangeInfoResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *userFollowResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson40dcd6ddDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp2(l, v)
 }
+func easyjson40dcd6ddDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp3(in *jlexer.Lexer, out *userChangeInfoResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson40dcd6ddEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp3(out *jwriter.Writer, in userChangeInfoResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v userChangeInfoResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson40dcd6ddEncodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp3(w, v)
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *userChangeInfoResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson40dcd6ddDecodeGithubComGoParkMailRu20231TechnokaifInternalPkgUserDeliveryHttp3(l, v)
+}
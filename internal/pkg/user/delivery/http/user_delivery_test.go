@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
@@ -203,3 +204,481 @@ func TestUserDeliveryHTTP_UpdateInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestUserDeliveryHTTP_GetProfile(t *testing.T) {
+	// Init
+	type mockBehavior func(uu *userMocks.MockUsecase, userID uint32)
+
+	c := gomock.NewController(t)
+
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/users/{userID}/profile", h.GetProfile)
+
+	// Test filling
+	const correctUserID uint32 = 1
+	correctUserIDPath := fmt.Sprint(correctUserID)
+
+	viewer := &models.User{ID: 2}
+	counts := &models.UserFollowCounts{Followers: 3, Following: 1}
+
+	correctResponse := `{
+		"id": 1,
+		"username": "yarik_tri",
+		"firstName": "Yaroslav",
+		"lastName": "Kuzmin",
+		"avatarSrc": "/users/avatars/yarik_tri.png",
+		"followers": 3,
+		"following": 1,
+		"isFollowed": true
+	}`
+
+	testTable := []struct {
+		name             string
+		userIDPath       string
+		viewer           *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:       "Common",
+			userIDPath: correctUserIDPath,
+			viewer:     viewer,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID uint32) {
+				uu.EXPECT().GetByID(gomock.Any(), userID).Return(getCorrectUser(t), nil)
+				uu.EXPECT().GetFollowCounts(gomock.Any(), userID).Return(counts, nil)
+				uu.EXPECT().IsFollowed(gomock.Any(), userID, viewer.ID).Return(true, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+		},
+		{
+			name:       "Unauthorized Viewer",
+			userIDPath: correctUserIDPath,
+			viewer:     nil,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID uint32) {
+				uu.EXPECT().GetByID(gomock.Any(), userID).Return(getCorrectUser(t), nil)
+				uu.EXPECT().GetFollowCounts(gomock.Any(), userID).Return(counts, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: `{
+				"id": 1,
+				"username": "yarik_tri",
+				"firstName": "Yaroslav",
+				"lastName": "Kuzmin",
+				"avatarSrc": "/users/avatars/yarik_tri.png",
+				"followers": 3,
+				"following": 1,
+				"isFollowed": false
+			}`,
+		},
+		{
+			name:             "Incorrect ID In Path",
+			userIDPath:       "-1",
+			viewer:           viewer,
+			mockBehavior:     func(uu *userMocks.MockUsecase, userID uint32) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidURLParameter),
+		},
+		{
+			name:       "No Such User",
+			userIDPath: correctUserIDPath,
+			viewer:     viewer,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID uint32) {
+				uu.EXPECT().GetByID(gomock.Any(), userID).Return(nil, &models.NoSuchUserError{UserID: userID})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(userNotFound),
+		},
+		{
+			name:       "Server Error",
+			userIDPath: correctUserIDPath,
+			viewer:     viewer,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID uint32) {
+				uu.EXPECT().GetByID(gomock.Any(), userID).Return(getCorrectUser(t), nil)
+				uu.EXPECT().GetFollowCounts(gomock.Any(), userID).Return(nil, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(userGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(uu, correctUserID)
+
+			commonTests.DeliveryTestGet(t, r, "/api/users/"+tc.userIDPath+"/profile", tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.viewer))
+		})
+	}
+}
+
+func TestUserDeliveryHTTP_Follow(t *testing.T) {
+	// Init
+	type mockBehavior func(uu *userMocks.MockUsecase, userID, followerID uint32)
+
+	c := gomock.NewController(t)
+
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Post("/api/users/{userID}/follow", h.Follow)
+
+	// Test filling
+	const correctUserID uint32 = 1
+	correctUserIDPath := fmt.Sprint(correctUserID)
+
+	follower := &models.User{ID: 2}
+
+	testTable := []struct {
+		name             string
+		userIDPath       string
+		follower         *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:       "Common",
+			userIDPath: correctUserIDPath,
+			follower:   follower,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID, followerID uint32) {
+				uu.EXPECT().Follow(gomock.Any(), userID, followerID).Return(true, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(userFollowedSuccessfully),
+		},
+		{
+			name:       "Already Followed",
+			userIDPath: correctUserIDPath,
+			follower:   follower,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID, followerID uint32) {
+				uu.EXPECT().Follow(gomock.Any(), userID, followerID).Return(false, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(userAlreadyFollowed),
+		},
+		{
+			name:             "Unauthorized",
+			userIDPath:       correctUserIDPath,
+			follower:         nil,
+			mockBehavior:     func(uu *userMocks.MockUsecase, userID, followerID uint32) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:       "Follow Self",
+			userIDPath: correctUserIDPath,
+			follower:   follower,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID, followerID uint32) {
+				uu.EXPECT().Follow(gomock.Any(), userID, followerID).Return(false, &models.FollowSelfError{})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(userFollowSelf),
+		},
+		{
+			name:       "No Such User",
+			userIDPath: correctUserIDPath,
+			follower:   follower,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID, followerID uint32) {
+				uu.EXPECT().Follow(gomock.Any(), userID, followerID).Return(false, &models.NoSuchUserError{UserID: userID})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(userNotFound),
+		},
+		{
+			name:       "Server Error",
+			userIDPath: correctUserIDPath,
+			follower:   follower,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID, followerID uint32) {
+				uu.EXPECT().Follow(gomock.Any(), userID, followerID).Return(false, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(userFollowServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(uu, correctUserID, follower.ID)
+
+			commonTests.DeliveryTestPost(t, r, "/api/users/"+tc.userIDPath+"/follow", "", tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.follower))
+		})
+	}
+}
+
+func TestUserDeliveryHTTP_Unfollow(t *testing.T) {
+	// Init
+	type mockBehavior func(uu *userMocks.MockUsecase, userID, followerID uint32)
+
+	c := gomock.NewController(t)
+
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Post("/api/users/{userID}/unfollow", h.Unfollow)
+
+	// Test filling
+	const correctUserID uint32 = 1
+	correctUserIDPath := fmt.Sprint(correctUserID)
+
+	follower := &models.User{ID: 2}
+
+	testTable := []struct {
+		name             string
+		userIDPath       string
+		follower         *models.User
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+	}{
+		{
+			name:       "Common",
+			userIDPath: correctUserIDPath,
+			follower:   follower,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID, followerID uint32) {
+				uu.EXPECT().Unfollow(gomock.Any(), userID, followerID).Return(true, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(userUnfollowedSuccessfully),
+		},
+		{
+			name:       "Wasn't Followed",
+			userIDPath: correctUserIDPath,
+			follower:   follower,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID, followerID uint32) {
+				uu.EXPECT().Unfollow(gomock.Any(), userID, followerID).Return(false, nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: commonTests.OKResponse(userWasntFollowed),
+		},
+		{
+			name:             "Incorrect ID In Path",
+			userIDPath:       "0",
+			follower:         follower,
+			mockBehavior:     func(uu *userMocks.MockUsecase, userID, followerID uint32) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidURLParameter),
+		},
+		{
+			name:             "Unauthorized",
+			userIDPath:       correctUserIDPath,
+			follower:         nil,
+			mockBehavior:     func(uu *userMocks.MockUsecase, userID, followerID uint32) {},
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.UnathorizedUser),
+		},
+		{
+			name:       "No Such User",
+			userIDPath: correctUserIDPath,
+			follower:   follower,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID, followerID uint32) {
+				uu.EXPECT().Unfollow(gomock.Any(), userID, followerID).
+					Return(false, &models.NoSuchUserError{UserID: userID})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(userNotFound),
+		},
+		{
+			name:       "Server Error",
+			userIDPath: correctUserIDPath,
+			follower:   follower,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID, followerID uint32) {
+				uu.EXPECT().Unfollow(gomock.Any(), userID, followerID).Return(false, errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(userUnfollowServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(uu, correctUserID, follower.ID)
+
+			commonTests.DeliveryTestPost(t, r, "/api/users/"+tc.userIDPath+"/unfollow", "",
+				tc.expectedStatus, tc.expectedResponse,
+				commonTests.WrapRequestWithUserNotNilFunc(tc.follower))
+		})
+	}
+}
+
+func TestUserDeliveryHTTP_GetFollowLists(t *testing.T) {
+	// Init
+	type mockBehavior func(uu *userMocks.MockUsecase, userID uint32)
+
+	c := gomock.NewController(t)
+
+	uu := userMocks.NewMockUsecase(c)
+
+	l := commonTests.MockLogger(c)
+
+	h := NewHandler(uu, l)
+
+	// Routing
+	r := chi.NewRouter()
+	r.Get("/api/users/{userID}/followers", h.GetFollowers)
+	r.Get("/api/users/{userID}/following", h.GetFollowing)
+
+	// Test filling
+	const correctUserID uint32 = 1
+	correctUserIDPath := fmt.Sprint(correctUserID)
+
+	correctUsers := []models.User{
+		{
+			ID:        2,
+			Username:  "yarik_tri",
+			Email:     "yarik1448kuzmin@gmail.com",
+			FirstName: "Yaroslav",
+			LastName:  "Kuzmin",
+		},
+	}
+	correctResponse := `[
+		{
+			"id": 2,
+			"username": "yarik_tri",
+			"firstName": "Yaroslav",
+			"lastName": "Kuzmin"
+		}
+	]`
+
+	nameQuery := models.FavoritesQuery{
+		SortBy: models.FavoritesSortName,
+		Order:  models.FavoritesOrderAsc,
+		Filter: "yar",
+		Limit:  10,
+		Offset: 20,
+	}
+
+	testTable := []struct {
+		name             string
+		list             string
+		userIDPath       string
+		query            string
+		mockBehavior     mockBehavior
+		expectedStatus   int
+		expectedResponse string
+		expectedTotal    string
+	}{
+		{
+			name:       "Followers",
+			list:       "followers",
+			userIDPath: correctUserIDPath,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID uint32) {
+				uu.EXPECT().GetFollowers(gomock.Any(), userID, models.FavoritesQuery{}).
+					Return(correctUsers, uint32(5), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+			expectedTotal:    "5",
+		},
+		{
+			name:       "Following With Query",
+			list:       "following",
+			userIDPath: correctUserIDPath,
+			query:      "?sort=name&order=asc&q=yar&limit=10&offset=20",
+			mockBehavior: func(uu *userMocks.MockUsecase, userID uint32) {
+				uu.EXPECT().GetFollowing(gomock.Any(), userID, nameQuery).
+					Return(correctUsers, uint32(21), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: correctResponse,
+			expectedTotal:    "21",
+		},
+		{
+			name:             "Incorrect ID In Path",
+			list:             "followers",
+			userIDPath:       "0",
+			mockBehavior:     func(uu *userMocks.MockUsecase, userID uint32) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidURLParameter),
+		},
+		{
+			name:             "Incorrect Limit",
+			list:             "following",
+			userIDPath:       correctUserIDPath,
+			query:            "?limit=ten",
+			mockBehavior:     func(uu *userMocks.MockUsecase, userID uint32) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
+		},
+		{
+			name:       "Invalid Sorting",
+			list:       "followers",
+			userIDPath: correctUserIDPath,
+			query:      "?sort=duration",
+			mockBehavior: func(uu *userMocks.MockUsecase, userID uint32) {
+				uu.EXPECT().GetFollowers(gomock.Any(), userID, models.FavoritesQuery{SortBy: "duration"}).
+					Return(nil, uint32(0), &models.InvalidFavoritesQueryError{Reason: "unsupported sorting"})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(commonHTTP.InvalidQueryParam),
+		},
+		{
+			name:       "No Such User",
+			list:       "following",
+			userIDPath: correctUserIDPath,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID uint32) {
+				uu.EXPECT().GetFollowing(gomock.Any(), userID, models.FavoritesQuery{}).
+					Return(nil, uint32(0), &models.NoSuchUserError{UserID: userID})
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: commonTests.ErrorResponse(userNotFound),
+		},
+		{
+			name:       "Followers Server Error",
+			list:       "followers",
+			userIDPath: correctUserIDPath,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID uint32) {
+				uu.EXPECT().GetFollowers(gomock.Any(), userID, models.FavoritesQuery{}).
+					Return(nil, uint32(0), errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(userFollowersGetServerError),
+		},
+		{
+			name:       "Following Server Error",
+			list:       "following",
+			userIDPath: correctUserIDPath,
+			mockBehavior: func(uu *userMocks.MockUsecase, userID uint32) {
+				uu.EXPECT().GetFollowing(gomock.Any(), userID, models.FavoritesQuery{}).
+					Return(nil, uint32(0), errors.New(""))
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: commonTests.ErrorResponse(userFollowingGetServerError),
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(uu, correctUserID)
+
+			w := commonTests.DeliveryTestGet(t, r, "/api/users/"+tc.userIDPath+"/"+tc.list+tc.query,
+				tc.expectedStatus, tc.expectedResponse, commonTests.NoWrapUserFunc())
+
+			// Test
+			assert.Equal(t, tc.expectedTotal, w.Header().Get(commonHTTP.TotalCountHeader))
+		})
+	}
+}
@@ -36,6 +36,21 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Follow mocks base method.
+func (m *MockUsecase) Follow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, userID, followerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockUsecaseMockRecorder) Follow(ctx, userID, followerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockUsecase)(nil).Follow), ctx, userID, followerID)
+}
+
 // GetByID mocks base method.
 func (m *MockUsecase) GetByID(ctx context.Context, userID uint32) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +81,83 @@ func (mr *MockUsecaseMockRecorder) GetByPlaylist(ctx, playlistID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPlaylist", reflect.TypeOf((*MockUsecase)(nil).GetByPlaylist), ctx, playlistID)
 }
 
+// GetFollowCounts mocks base method.
+func (m *MockUsecase) GetFollowCounts(ctx context.Context, userID uint32) (*models.UserFollowCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowCounts", ctx, userID)
+	ret0, _ := ret[0].(*models.UserFollowCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowCounts indicates an expected call of GetFollowCounts.
+func (mr *MockUsecaseMockRecorder) GetFollowCounts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowCounts", reflect.TypeOf((*MockUsecase)(nil).GetFollowCounts), ctx, userID)
+}
+
+// GetFollowers mocks base method.
+func (m *MockUsecase) GetFollowers(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowers", ctx, userID, q)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFollowers indicates an expected call of GetFollowers.
+func (mr *MockUsecaseMockRecorder) GetFollowers(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockUsecase)(nil).GetFollowers), ctx, userID, q)
+}
+
+// GetFollowing mocks base method.
+func (m *MockUsecase) GetFollowing(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowing", ctx, userID, q)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFollowing indicates an expected call of GetFollowing.
+func (mr *MockUsecaseMockRecorder) GetFollowing(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockUsecase)(nil).GetFollowing), ctx, userID, q)
+}
+
+// IsFollowed mocks base method.
+func (m *MockUsecase) IsFollowed(ctx context.Context, userID, followerID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFollowed", ctx, userID, followerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFollowed indicates an expected call of IsFollowed.
+func (mr *MockUsecaseMockRecorder) IsFollowed(ctx, userID, followerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFollowed", reflect.TypeOf((*MockUsecase)(nil).IsFollowed), ctx, userID, followerID)
+}
+
+// Unfollow mocks base method.
+func (m *MockUsecase) Unfollow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, userID, followerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockUsecaseMockRecorder) Unfollow(ctx, userID, followerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockUsecase)(nil).Unfollow), ctx, userID, followerID)
+}
+
 // UpdateInfo mocks base method.
 func (m *MockUsecase) UpdateInfo(ctx context.Context, user *models.User) error {
 	m.ctrl.T.Helper()
@@ -146,6 +238,21 @@ func (mr *MockRepositoryMockRecorder) CreateUser(ctx, user interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockRepository)(nil).CreateUser), ctx, user)
 }
 
+// DeleteFollow mocks base method.
+func (m *MockRepository) DeleteFollow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFollow", ctx, userID, followerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFollow indicates an expected call of DeleteFollow.
+func (mr *MockRepositoryMockRecorder) DeleteFollow(ctx, userID, followerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFollow", reflect.TypeOf((*MockRepository)(nil).DeleteFollow), ctx, userID, followerID)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, userID uint32) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +283,53 @@ func (mr *MockRepositoryMockRecorder) GetByPlaylist(ctx, playlistID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPlaylist", reflect.TypeOf((*MockRepository)(nil).GetByPlaylist), ctx, playlistID)
 }
 
+// GetFollowCounts mocks base method.
+func (m *MockRepository) GetFollowCounts(ctx context.Context, userID uint32) (*models.UserFollowCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowCounts", ctx, userID)
+	ret0, _ := ret[0].(*models.UserFollowCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowCounts indicates an expected call of GetFollowCounts.
+func (mr *MockRepositoryMockRecorder) GetFollowCounts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowCounts", reflect.TypeOf((*MockRepository)(nil).GetFollowCounts), ctx, userID)
+}
+
+// GetFollowers mocks base method.
+func (m *MockRepository) GetFollowers(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowers", ctx, userID, q)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFollowers indicates an expected call of GetFollowers.
+func (mr *MockRepositoryMockRecorder) GetFollowers(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockRepository)(nil).GetFollowers), ctx, userID, q)
+}
+
+// GetFollowing mocks base method.
+func (m *MockRepository) GetFollowing(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowing", ctx, userID, q)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFollowing indicates an expected call of GetFollowing.
+func (mr *MockRepositoryMockRecorder) GetFollowing(ctx, userID, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockRepository)(nil).GetFollowing), ctx, userID, q)
+}
+
 // GetUserByUsername mocks base method.
 func (m *MockRepository) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +345,36 @@ func (mr *MockRepositoryMockRecorder) GetUserByUsername(ctx, username interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockRepository)(nil).GetUserByUsername), ctx, username)
 }
 
+// InsertFollow mocks base method.
+func (m *MockRepository) InsertFollow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertFollow", ctx, userID, followerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertFollow indicates an expected call of InsertFollow.
+func (mr *MockRepositoryMockRecorder) InsertFollow(ctx, userID, followerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertFollow", reflect.TypeOf((*MockRepository)(nil).InsertFollow), ctx, userID, followerID)
+}
+
+// IsFollowed mocks base method.
+func (m *MockRepository) IsFollowed(ctx context.Context, userID, followerID uint32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFollowed", ctx, userID, followerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFollowed indicates an expected call of IsFollowed.
+func (mr *MockRepositoryMockRecorder) IsFollowed(ctx, userID, followerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFollowed", reflect.TypeOf((*MockRepository)(nil).IsFollowed), ctx, userID, followerID)
+}
+
 // UpdateAvatarSrc mocks base method.
 func (m *MockRepository) UpdateAvatarSrc(ctx context.Context, userID uint32, avatarSrc string) error {
 	m.ctrl.T.Helper()
@@ -242,6 +426,20 @@ func (m *MockTables) EXPECT() *MockTablesMockRecorder {
 	return m.recorder
 }
 
+// Followers mocks base method.
+func (m *MockTables) Followers() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Followers")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Followers indicates an expected call of Followers.
+func (mr *MockTablesMockRecorder) Followers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Followers", reflect.TypeOf((*MockTables)(nil).Followers))
+}
+
 // Users mocks base method.
 func (m *MockTables) Users() string {
 	m.ctrl.T.Helper()
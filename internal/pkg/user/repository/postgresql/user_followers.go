@@ -0,0 +1,148 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+func (p *PostgreSQL) InsertFollow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	query := fmt.Sprintf(
+		`INSERT INTO %s (user_id, follower_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING;`,
+		p.tables.Followers())
+
+	resExec, err := p.db.ExecContext(ctx, query, userID, followerID)
+	if err != nil {
+		return false, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+	inserted, err := resExec.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("(repo) failed to check query result: %w", err)
+	}
+
+	return inserted != 0, nil
+}
+
+func (p *PostgreSQL) DeleteFollow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	query := fmt.Sprintf(
+		`DELETE
+		FROM %s
+		WHERE user_id = $1 AND follower_id = $2;`,
+		p.tables.Followers())
+
+	resExec, err := p.db.ExecContext(ctx, query, userID, followerID)
+	if err != nil {
+		return false, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+	deleted, err := resExec.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("(repo) failed to check query result: %w", err)
+	}
+
+	return deleted != 0, nil
+}
+
+func (p *PostgreSQL) IsFollowed(ctx context.Context, userID, followerID uint32) (bool, error) {
+	query := fmt.Sprintf(
+		`SELECT EXISTS(
+			SELECT user_id
+			FROM %s
+			WHERE user_id = $1 AND follower_id = $2
+		);`,
+		p.tables.Followers())
+
+	var isFollowed bool
+	if err := p.db.GetContext(ctx, &isFollowed, query, userID, followerID); err != nil {
+		return false, fmt.Errorf("(repo) failed to check if user is followed: %w", err)
+	}
+
+	return isFollowed, nil
+}
+
+func (p *PostgreSQL) GetFollowCounts(ctx context.Context, userID uint32) (*models.UserFollowCounts, error) {
+	query := fmt.Sprintf(
+		`SELECT (SELECT COUNT(*) FROM %[1]s WHERE user_id = $1) AS followers,
+			(SELECT COUNT(*) FROM %[1]s WHERE follower_id = $1) AS following;`,
+		p.tables.Followers())
+
+	var counts models.UserFollowCounts
+	if err := p.db.GetContext(ctx, &counts, query, userID); err != nil {
+		return nil, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	return &counts, nil
+}
+
+func (p *PostgreSQL) GetFollowers(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+
+	return p.getFollowList(ctx, "user_id", "follower_id", userID, q)
+}
+
+func (p *PostgreSQL) GetFollowing(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+
+	return p.getFollowList(ctx, "follower_id", "user_id", userID, q)
+}
+
+// getFollowList returns page of users which IDs are in listed column
+// of follows having userID in owner column. Only public info of users is selected.
+func (p *PostgreSQL) getFollowList(ctx context.Context, ownerColumn, listedColumn string,
+	userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+
+	filter := fmt.Sprintf(
+		`f.%s = $1 AND ($2 = ''
+			OR STRPOS(LOWER(u.username), LOWER($2)) > 0
+			OR STRPOS(LOWER(u.first_name || ' ' || u.last_name), LOWER($2)) > 0)`,
+		ownerColumn)
+
+	countQuery := fmt.Sprintf(
+		`SELECT COUNT(*)
+		FROM %s u
+			INNER JOIN %s f ON u.id = f.%s
+		WHERE %s;`,
+		p.tables.Users(), p.tables.Followers(), listedColumn, filter)
+
+	var total uint32
+	if err := p.db.GetContext(ctx, &total, countQuery, userID, q.Filter); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+
+	sortColumn := "f.followed_at"
+	if q.SortBy == models.FavoritesSortName {
+		sortColumn = "u.username"
+	}
+
+	query := fmt.Sprintf(
+		`SELECT u.id, u.username, u.first_name, u.last_name, u.avatar_src
+		FROM %s u
+			INNER JOIN %s f ON u.id = f.%s
+		WHERE %s
+		ORDER BY %s %s, u.id
+		LIMIT $3 OFFSET $4;`,
+		p.tables.Users(), p.tables.Followers(), listedColumn, filter, sortColumn, q.OrderSQL())
+
+	rows, err := p.db.QueryContext(ctx, query, userID, q.Filter, q.LimitValue(), q.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to exec query: %w", err)
+	}
+	defer rows.Close()
+
+	users := []models.User{}
+	for rows.Next() {
+		var u models.User
+		if err := rows.Scan(&u.ID, &u.Username, &u.FirstName, &u.LastName, &u.AvatarSrc); err != nil {
+			return nil, 0, fmt.Errorf("(repo) failed to scan from query: %w", err)
+		}
+
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("(repo) failed to read rows: %w", err)
+	}
+
+	return users, total, nil
+}
@@ -0,0 +1,320 @@
+package postgresql
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	userMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/mocks"
+)
+
+const followersTable = "Followers"
+
+func TestUserRepositoryPostgreSQL_InsertFollow(t *testing.T) {
+	// Init
+	type mockBehavior func(userID, followerID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := userMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultUserID uint32 = 1
+	const defaultFollowerID uint32 = 2
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectedInserted bool
+		expectError      bool
+		expectedError    error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID, followerID uint32) {
+				tablesMock.EXPECT().Followers().Return(followersTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+followersTable+"(.+)ON CONFLICT DO NOTHING").
+					WithArgs(userID, followerID).
+					WillReturnResult(driver.RowsAffected(1))
+			},
+			expectedInserted: true,
+		},
+		{
+			name: "Already Followed",
+			mockBehavior: func(userID, followerID uint32) {
+				tablesMock.EXPECT().Followers().Return(followersTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+followersTable).
+					WithArgs(userID, followerID).
+					WillReturnResult(driver.RowsAffected(0))
+			},
+			expectedInserted: false,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID, followerID uint32) {
+				tablesMock.EXPECT().Followers().Return(followersTable)
+
+				sqlxMock.ExpectExec("INSERT INTO "+followersTable).
+					WithArgs(userID, followerID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultUserID, defaultFollowerID)
+
+			inserted, err := repo.InsertFollow(ctx, defaultUserID, defaultFollowerID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedInserted, inserted)
+			}
+		})
+	}
+}
+
+func TestUserRepositoryPostgreSQL_DeleteFollow(t *testing.T) {
+	// Init
+	type mockBehavior func(userID, followerID uint32)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := userMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultUserID uint32 = 1
+	const defaultFollowerID uint32 = 2
+
+	testTable := []struct {
+		name            string
+		mockBehavior    mockBehavior
+		expectedDeleted bool
+		expectError     bool
+		expectedError   error
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(userID, followerID uint32) {
+				tablesMock.EXPECT().Followers().Return(followersTable)
+
+				sqlxMock.ExpectExec("DELETE FROM "+followersTable).
+					WithArgs(userID, followerID).
+					WillReturnResult(driver.RowsAffected(1))
+			},
+			expectedDeleted: true,
+		},
+		{
+			name: "Wasn't Followed",
+			mockBehavior: func(userID, followerID uint32) {
+				tablesMock.EXPECT().Followers().Return(followersTable)
+
+				sqlxMock.ExpectExec("DELETE FROM "+followersTable).
+					WithArgs(userID, followerID).
+					WillReturnResult(driver.RowsAffected(0))
+			},
+			expectedDeleted: false,
+		},
+		{
+			name: "Internal PostgreSQL Error",
+			mockBehavior: func(userID, followerID uint32) {
+				tablesMock.EXPECT().Followers().Return(followersTable)
+
+				sqlxMock.ExpectExec("DELETE FROM "+followersTable).
+					WithArgs(userID, followerID).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultUserID, defaultFollowerID)
+
+			deleted, err := repo.DeleteFollow(ctx, defaultUserID, defaultFollowerID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeleted, deleted)
+			}
+		})
+	}
+}
+
+func TestUserRepositoryPostgreSQL_GetFollowList(t *testing.T) {
+	// Init
+	type mockBehavior func(userID uint32, q models.FavoritesQuery, users []models.User)
+
+	dbMock, sqlxMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer dbMock.Close()
+
+	c := gomock.NewController(t)
+
+	tablesMock := userMocks.NewMockTables(c)
+
+	repo := NewPostgreSQL(sqlx.NewDb(dbMock, "postgres"), tablesMock)
+
+	// Test filling
+	const defaultUserID uint32 = 1
+
+	limit := uint32(2)
+	defaultUsers := []models.User{
+		{
+			ID:        2,
+			Username:  "yarik_tri",
+			FirstName: "Yaroslav",
+			LastName:  "Kuzmin",
+			AvatarSrc: "/users/avatars/yarik_tri.png",
+		},
+		{
+			ID:        3,
+			Username:  "oxxxymiron",
+			FirstName: "Miron",
+			LastName:  "Fedorov",
+			AvatarSrc: "/users/avatars/oxxxymiron.png",
+		},
+	}
+
+	expectFollowList := func(ownerColumn, listedColumn, sortColumn string) mockBehavior {
+		return func(userID uint32, q models.FavoritesQuery, users []models.User) {
+			tablesMock.EXPECT().Users().Return(userTable).Times(2)
+			tablesMock.EXPECT().Followers().Return(followersTable).Times(2)
+
+			countRow := sqlxMock.NewRows([]string{"count"}).AddRow(len(users) + 1)
+			sqlxMock.ExpectQuery("SELECT COUNT(.+) FROM "+userTable+" u"+
+				"(.+)JOIN "+followersTable+" f ON u.id = f."+listedColumn+
+				"(.+)WHERE f."+ownerColumn+" = \\$1").
+				WithArgs(userID, q.Filter).
+				WillReturnRows(countRow)
+
+			rows := sqlxMock.NewRows([]string{"id", "username", "first_name", "last_name", "avatar_src"})
+			for _, u := range users {
+				rows.AddRow(u.ID, u.Username, u.FirstName, u.LastName, u.AvatarSrc)
+			}
+			sqlxMock.ExpectQuery("SELECT (.+) FROM "+userTable+" u"+
+				"(.+)JOIN "+followersTable+" f ON u.id = f."+listedColumn+
+				"(.+)ORDER BY "+sortColumn).
+				WithArgs(userID, q.Filter, q.LimitValue(), q.Offset).
+				WillReturnRows(rows)
+		}
+	}
+
+	testTable := []struct {
+		name          string
+		following     bool
+		query         models.FavoritesQuery
+		mockBehavior  mockBehavior
+		expectedUsers []models.User
+		expectedTotal uint32
+		expectError   bool
+		expectedError error
+	}{
+		{
+			name: "Followers",
+			query: models.FavoritesQuery{
+				SortBy: models.FavoritesSortFollowedAt,
+				Order:  models.FavoritesOrderDesc,
+				Limit:  limit,
+			},
+			mockBehavior:  expectFollowList("user_id", "follower_id", "f.followed_at DESC"),
+			expectedUsers: defaultUsers,
+			expectedTotal: uint32(len(defaultUsers) + 1),
+		},
+		{
+			name:      "Following Sorted By Name",
+			following: true,
+			query: models.FavoritesQuery{
+				SortBy: models.FavoritesSortName,
+				Order:  models.FavoritesOrderAsc,
+				Filter: "yar",
+			},
+			mockBehavior:  expectFollowList("follower_id", "user_id", "u.username ASC"),
+			expectedUsers: defaultUsers,
+			expectedTotal: uint32(len(defaultUsers) + 1),
+		},
+		{
+			name:          "Empty Page",
+			query:         models.FavoritesQuery{SortBy: models.FavoritesSortFollowedAt, Offset: 10},
+			mockBehavior:  expectFollowList("user_id", "follower_id", "f.followed_at"),
+			expectedUsers: []models.User{},
+			expectedTotal: 1,
+		},
+		{
+			name:  "Internal PostgreSQL Error",
+			query: models.FavoritesQuery{SortBy: models.FavoritesSortFollowedAt},
+			mockBehavior: func(userID uint32, q models.FavoritesQuery, users []models.User) {
+				tablesMock.EXPECT().Users().Return(userTable)
+				tablesMock.EXPECT().Followers().Return(followersTable)
+
+				sqlxMock.ExpectQuery("SELECT COUNT").
+					WithArgs(userID, q.Filter).
+					WillReturnError(errPqInternal)
+			},
+			expectError:   true,
+			expectedError: errPqInternal,
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(defaultUserID, tc.query, tc.expectedUsers)
+
+			getFollowList := repo.GetFollowers
+			if tc.following {
+				getFollowList = repo.GetFollowing
+			}
+			users, total, err := getFollowList(ctx, defaultUserID, tc.query)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedUsers, users)
+				assert.Equal(t, tc.expectedTotal, total)
+			}
+			assert.NoError(t, sqlxMock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+)
+
+func (u *Usecase) Follow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	if userID == followerID {
+		return false, fmt.Errorf("(usecase) %w", &models.FollowSelfError{})
+	}
+
+	if err := u.repo.Check(ctx, userID); err != nil {
+		return false, fmt.Errorf("(usecase) can't find user with id #%d: %w", userID, err)
+	}
+
+	isInserted, err := u.repo.InsertFollow(ctx, userID, followerID)
+	if err != nil {
+		return false, fmt.Errorf("(usecase) failed to follow user: %w", err)
+	}
+
+	if isInserted {
+		followerNotification := models.Notification{Type: models.NotificationTypeFollower, ActorID: &followerID}
+		u.notifier.Notify(ctx, followerNotification, []uint32{userID})
+	}
+
+	return isInserted, nil
+}
+
+func (u *Usecase) Unfollow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	if err := u.repo.Check(ctx, userID); err != nil {
+		return false, fmt.Errorf("(usecase) can't find user with id #%d: %w", userID, err)
+	}
+
+	isDeleted, err := u.repo.DeleteFollow(ctx, userID, followerID)
+	if err != nil {
+		return false, fmt.Errorf("(usecase) failed to unfollow user: %w", err)
+	}
+
+	return isDeleted, nil
+}
+
+func (u *Usecase) IsFollowed(ctx context.Context, userID, followerID uint32) (bool, error) {
+	isFollowed, err := u.repo.IsFollowed(ctx, userID, followerID)
+	if err != nil {
+		return false, fmt.Errorf("(usecase) can't check in repository if user is followed: %w", err)
+	}
+
+	return isFollowed, nil
+}
+
+func (u *Usecase) GetFollowCounts(ctx context.Context, userID uint32) (*models.UserFollowCounts, error) {
+	counts, err := u.repo.GetFollowCounts(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("(usecase) can't get follow counts of user #%d: %w", userID, err)
+	}
+
+	return counts, nil
+}
+
+func (u *Usecase) GetFollowers(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+
+	if err := q.Validate(models.FavoritesSortFollowedAt, models.FavoritesSortName); err != nil {
+		return nil, 0, fmt.Errorf("(usecase) %w", err)
+	}
+
+	if err := u.repo.Check(ctx, userID); err != nil {
+		return nil, 0, fmt.Errorf("(usecase) can't find user with id #%d: %w", userID, err)
+	}
+
+	users, total, err := u.repo.GetFollowers(ctx, userID, q)
+	if err != nil {
+		return nil, 0, fmt.Errorf("(usecase) can't get followers from repository: %w", err)
+	}
+
+	return users, total, nil
+}
+
+func (u *Usecase) GetFollowing(ctx context.Context,
+	userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error) {
+
+	if err := q.Validate(models.FavoritesSortFollowedAt, models.FavoritesSortName); err != nil {
+		return nil, 0, fmt.Errorf("(usecase) %w", err)
+	}
+
+	if err := u.repo.Check(ctx, userID); err != nil {
+		return nil, 0, fmt.Errorf("(usecase) can't find user with id #%d: %w", userID, err)
+	}
+
+	users, total, err := u.repo.GetFollowing(ctx, userID, q)
+	if err != nil {
+		return nil, 0, fmt.Errorf("(usecase) can't get followed users from repository: %w", err)
+	}
+
+	return users, total, nil
+}
@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	notificationMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification/mocks"
+	userMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/mocks"
+)
+
+var ctx = context.Background()
+
+func TestUserUsecase_Follow(t *testing.T) {
+	// Init
+	type mockBehavior func(r *userMocks.MockRepository, n *notificationMocks.MockNotifier)
+
+	c := gomock.NewController(t)
+
+	r := userMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
+
+	u := NewUsecase(r, nil, n)
+
+	// Test filling
+	var correctUserID uint32 = 1
+	var correctFollowerID uint32 = 2
+
+	followerNotification := models.Notification{
+		Type:    models.NotificationTypeFollower,
+		ActorID: &correctFollowerID,
+	}
+
+	testTable := []struct {
+		name             string
+		followerID       uint32
+		mockBehavior     mockBehavior
+		expectedInserted bool
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:       "Common",
+			followerID: correctFollowerID,
+			mockBehavior: func(r *userMocks.MockRepository, n *notificationMocks.MockNotifier) {
+				r.EXPECT().Check(ctx, correctUserID).Return(nil)
+				r.EXPECT().InsertFollow(ctx, correctUserID, correctFollowerID).Return(true, nil)
+				n.EXPECT().Notify(ctx, followerNotification, []uint32{correctUserID})
+			},
+			expectedInserted: true,
+		},
+		{
+			name:       "Already Followed",
+			followerID: correctFollowerID,
+			mockBehavior: func(r *userMocks.MockRepository, n *notificationMocks.MockNotifier) {
+				r.EXPECT().Check(ctx, correctUserID).Return(nil)
+				r.EXPECT().InsertFollow(ctx, correctUserID, correctFollowerID).Return(false, nil)
+			},
+			expectedInserted: false,
+		},
+		{
+			name:             "Self Follow",
+			followerID:       correctUserID,
+			mockBehavior:     func(r *userMocks.MockRepository, n *notificationMocks.MockNotifier) {},
+			expectError:      true,
+			expectedErrorMsg: (&models.FollowSelfError{}).Error(),
+		},
+		{
+			name:       "No Such User",
+			followerID: correctFollowerID,
+			mockBehavior: func(r *userMocks.MockRepository, n *notificationMocks.MockNotifier) {
+				r.EXPECT().Check(ctx, correctUserID).Return(&models.NoSuchUserError{UserID: correctUserID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't find user",
+		},
+		{
+			name:       "Insert Issue",
+			followerID: correctFollowerID,
+			mockBehavior: func(r *userMocks.MockRepository, n *notificationMocks.MockNotifier) {
+				r.EXPECT().Check(ctx, correctUserID).Return(nil)
+				r.EXPECT().InsertFollow(ctx, correctUserID, correctFollowerID).Return(false, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "failed to follow user",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(r, n)
+
+			inserted, err := u.Follow(ctx, correctUserID, tc.followerID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedInserted, inserted)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_Unfollow(t *testing.T) {
+	// Init
+	type mockBehavior func(r *userMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	r := userMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
+
+	u := NewUsecase(r, nil, n)
+
+	// Test filling
+	const correctUserID uint32 = 1
+	const correctFollowerID uint32 = 2
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectedDeleted  bool
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(r *userMocks.MockRepository) {
+				r.EXPECT().Check(ctx, correctUserID).Return(nil)
+				r.EXPECT().DeleteFollow(ctx, correctUserID, correctFollowerID).Return(true, nil)
+			},
+			expectedDeleted: true,
+		},
+		{
+			name: "Wasn't Followed",
+			mockBehavior: func(r *userMocks.MockRepository) {
+				r.EXPECT().Check(ctx, correctUserID).Return(nil)
+				r.EXPECT().DeleteFollow(ctx, correctUserID, correctFollowerID).Return(false, nil)
+			},
+			expectedDeleted: false,
+		},
+		{
+			name: "No Such User",
+			mockBehavior: func(r *userMocks.MockRepository) {
+				r.EXPECT().Check(ctx, correctUserID).Return(&models.NoSuchUserError{UserID: correctUserID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't find user",
+		},
+		{
+			name: "Delete Issue",
+			mockBehavior: func(r *userMocks.MockRepository) {
+				r.EXPECT().Check(ctx, correctUserID).Return(nil)
+				r.EXPECT().DeleteFollow(ctx, correctUserID, correctFollowerID).Return(false, errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "failed to unfollow user",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(r)
+
+			deleted, err := u.Unfollow(ctx, correctUserID, correctFollowerID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeleted, deleted)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_GetFollowers(t *testing.T) {
+	// Init
+	type mockBehavior func(r *userMocks.MockRepository)
+
+	c := gomock.NewController(t)
+
+	r := userMocks.NewMockRepository(c)
+	n := notificationMocks.NewMockNotifier(c)
+
+	u := NewUsecase(r, nil, n)
+
+	// Test filling
+	const correctUserID uint32 = 1
+
+	correctFollowers := []models.User{
+		{ID: 2, Username: "yarik_tri"},
+		{ID: 3, Username: "oxxxymiron"},
+	}
+	defaultQuery := models.FavoritesQuery{
+		SortBy: models.FavoritesSortFollowedAt,
+		Order:  models.FavoritesOrderDesc,
+	}
+
+	testTable := []struct {
+		name             string
+		query            models.FavoritesQuery
+		mockBehavior     mockBehavior
+		expectedUsers    []models.User
+		expectedTotal    uint32
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name:  "Common (the latest followers first by default)",
+			query: models.FavoritesQuery{},
+			mockBehavior: func(r *userMocks.MockRepository) {
+				r.EXPECT().Check(ctx, correctUserID).Return(nil)
+				r.EXPECT().GetFollowers(ctx, correctUserID, defaultQuery).Return(correctFollowers, uint32(2), nil)
+			},
+			expectedUsers: correctFollowers,
+			expectedTotal: 2,
+		},
+		{
+			name:  "Sorted By Name",
+			query: models.FavoritesQuery{SortBy: models.FavoritesSortName},
+			mockBehavior: func(r *userMocks.MockRepository) {
+				r.EXPECT().Check(ctx, correctUserID).Return(nil)
+				r.EXPECT().GetFollowers(ctx, correctUserID, models.FavoritesQuery{
+					SortBy: models.FavoritesSortName,
+					Order:  models.FavoritesOrderAsc,
+				}).Return(correctFollowers, uint32(2), nil)
+			},
+			expectedUsers: correctFollowers,
+			expectedTotal: 2,
+		},
+		{
+			name:             "Unsupported Sorting",
+			query:            models.FavoritesQuery{SortBy: models.FavoritesSortLikedAt},
+			mockBehavior:     func(r *userMocks.MockRepository) {},
+			expectError:      true,
+			expectedErrorMsg: "isn't supported",
+		},
+		{
+			name:             "Too Big Limit",
+			query:            models.FavoritesQuery{Limit: models.FavoritesMaxLimit + 1},
+			mockBehavior:     func(r *userMocks.MockRepository) {},
+			expectError:      true,
+			expectedErrorMsg: "limit is too big",
+		},
+		{
+			name:  "No Such User",
+			query: models.FavoritesQuery{},
+			mockBehavior: func(r *userMocks.MockRepository) {
+				r.EXPECT().Check(ctx, correctUserID).Return(&models.NoSuchUserError{UserID: correctUserID})
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't find user",
+		},
+		{
+			name:  "Repository Issue",
+			query: models.FavoritesQuery{},
+			mockBehavior: func(r *userMocks.MockRepository) {
+				r.EXPECT().Check(ctx, correctUserID).Return(nil)
+				r.EXPECT().GetFollowers(ctx, correctUserID, defaultQuery).Return(nil, uint32(0), errors.New(""))
+			},
+			expectError:      true,
+			expectedErrorMsg: "can't get followers",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(r)
+
+			users, total, err := u.GetFollowers(ctx, correctUserID, tc.query)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedUsers, users)
+				assert.Equal(t, tc.expectedTotal, total)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/pkg/logger"
+)
+
+// PublishingUsecase wraps user.Usecase of user service on API side.
+// User service doesn't share real-time broker with API, so it's API
+// who pushes notification events to followed users.
+type PublishingUsecase struct {
+	user.Usecase
+	publisher realtime.Publisher
+	logger    logger.Logger
+}
+
+func NewPublishingUsecase(uu user.Usecase, p realtime.Publisher, l logger.Logger) *PublishingUsecase {
+	return &PublishingUsecase{
+		Usecase:   uu,
+		publisher: p,
+		logger:    l,
+	}
+}
+
+func (u *PublishingUsecase) Follow(ctx context.Context, userID, followerID uint32) (bool, error) {
+	isInserted, err := u.Usecase.Follow(ctx, userID, followerID)
+	if err != nil || !isInserted {
+		return isInserted, err
+	}
+
+	event := models.RealtimeEvent{
+		Topic: models.NotificationsTopic(userID),
+		Type:  models.RealtimeEventNotification,
+	}
+	if err := u.publisher.Publish(ctx, event); err != nil {
+		u.logger.ErrorfReqID(ctx, "can't publish follower event of user #%d: %v", userID, err)
+	}
+
+	return true, nil
+}
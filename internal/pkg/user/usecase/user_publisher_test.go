@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	commonTests "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/tests"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+
+	realtimeMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/realtime/mocks"
+	userMocks "github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user/mocks"
+)
+
+func TestUserPublishingUsecase_Follow(t *testing.T) {
+	// Init
+	type mockBehavior func(uu *userMocks.MockUsecase, p *realtimeMocks.MockPublisher)
+
+	c := gomock.NewController(t)
+
+	uu := userMocks.NewMockUsecase(c)
+	p := realtimeMocks.NewMockPublisher(c)
+
+	u := NewPublishingUsecase(uu, p, commonTests.MockLogger(c))
+
+	// Test filling
+	const correctUserID uint32 = 1
+	const correctFollowerID uint32 = 2
+
+	followerEvent := models.RealtimeEvent{
+		Topic: models.NotificationsTopic(correctUserID),
+		Type:  models.RealtimeEventNotification,
+	}
+
+	testTable := []struct {
+		name             string
+		mockBehavior     mockBehavior
+		expectedInserted bool
+		expectError      bool
+		expectedErrorMsg string
+	}{
+		{
+			name: "Common",
+			mockBehavior: func(uu *userMocks.MockUsecase, p *realtimeMocks.MockPublisher) {
+				uu.EXPECT().Follow(ctx, correctUserID, correctFollowerID).Return(true, nil)
+				p.EXPECT().Publish(ctx, followerEvent).Return(nil)
+			},
+			expectedInserted: true,
+		},
+		{
+			name: "Already Followed",
+			mockBehavior: func(uu *userMocks.MockUsecase, p *realtimeMocks.MockPublisher) {
+				uu.EXPECT().Follow(ctx, correctUserID, correctFollowerID).Return(false, nil)
+			},
+			expectedInserted: false,
+		},
+		{
+			name: "Publish Issue",
+			mockBehavior: func(uu *userMocks.MockUsecase, p *realtimeMocks.MockPublisher) {
+				uu.EXPECT().Follow(ctx, correctUserID, correctFollowerID).Return(true, nil)
+				p.EXPECT().Publish(ctx, followerEvent).Return(errors.New("broker is down"))
+			},
+			expectedInserted: true,
+		},
+		{
+			name: "Follow Issue",
+			mockBehavior: func(uu *userMocks.MockUsecase, p *realtimeMocks.MockPublisher) {
+				uu.EXPECT().Follow(ctx, correctUserID, correctFollowerID).
+					Return(false, errors.New("failed to follow user"))
+			},
+			expectError:      true,
+			expectedErrorMsg: "failed to follow user",
+		},
+	}
+
+	for _, tc := range testTable {
+		t.Run(tc.name, func(t *testing.T) {
+			// Call mock
+			tc.mockBehavior(uu, p)
+
+			inserted, err := u.Follow(ctx, correctUserID, correctFollowerID)
+
+			// Test
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedInserted, inserted)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	commonFile "github.com/go-park-mail-ru/2023_1_Technokaif/internal/common/file"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/models"
+	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/notification"
 	"github.com/go-park-mail-ru/2023_1_Technokaif/internal/pkg/user"
 )
 
@@ -15,16 +16,18 @@ import (
 type Usecase struct {
 	repo        user.Repository
 	avatarSaver AvatarSaver
+	notifier    notification.Notifier
 }
 
 type AvatarSaver interface {
 	Save(ctx context.Context, avatar io.Reader, objectName string, size int64) error
 }
 
-func NewUsecase(r user.Repository, saver AvatarSaver) *Usecase {
+func NewUsecase(r user.Repository, saver AvatarSaver, n notification.Notifier) *Usecase {
 	return &Usecase{
 		repo:        r,
 		avatarSaver: saver,
+		notifier:    n,
 	}
 }
 
@@ -15,6 +15,18 @@ type Usecase interface {
 	UpdateInfo(ctx context.Context, user *models.User) error
 	UploadAvatar(ctx context.Context, userID uint32, file io.ReadSeeker, size int64, fileExtension string) error
 	GetByPlaylist(ctx context.Context, playlistID uint32) ([]models.User, error)
+
+	// Follow makes follower follow user, it returns false if user is already followed
+	Follow(ctx context.Context, userID, followerID uint32) (bool, error)
+	// Unfollow returns false if user wasn't followed
+	Unfollow(ctx context.Context, userID, followerID uint32) (bool, error)
+	IsFollowed(ctx context.Context, userID, followerID uint32) (bool, error)
+	GetFollowCounts(ctx context.Context, userID uint32) (*models.UserFollowCounts, error)
+
+	// GetFollowers and GetFollowing return page of users and their total amount.
+	// They can be sorted by models.FavoritesSortFollowedAt and by name.
+	GetFollowers(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error)
+	GetFollowing(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error)
 }
 
 // Repository includes DBMS-relatable methods to work with users
@@ -40,6 +52,18 @@ type Repository interface {
 
 	// GetUserByPlaylist returns []models.User of users who are authors of playlist
 	GetByPlaylist(ctx context.Context, playlistID uint32) ([]models.User, error)
+
+	// InsertFollow returns false if follower already follows user
+	InsertFollow(ctx context.Context, userID, followerID uint32) (bool, error)
+	// DeleteFollow returns false if follower didn't follow user
+	DeleteFollow(ctx context.Context, userID, followerID uint32) (bool, error)
+	IsFollowed(ctx context.Context, userID, followerID uint32) (bool, error)
+	GetFollowCounts(ctx context.Context, userID uint32) (*models.UserFollowCounts, error)
+
+	// GetFollowers returns page of users following user and total amount of them
+	GetFollowers(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error)
+	// GetFollowing returns page of users followed by user and total amount of them
+	GetFollowing(ctx context.Context, userID uint32, q models.FavoritesQuery) ([]models.User, uint32, error)
 }
 
 // Tables includes methods which return needed tables
@@ -47,4 +71,5 @@ type Repository interface {
 type Tables interface {
 	Users() string
 	UsersPlaylists() string
+	Followers() string
 }
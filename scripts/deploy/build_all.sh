@@ -6,3 +6,4 @@ $BASEDIR/build_api.sh
 $BASEDIR/build_auth.sh
 $BASEDIR/build_search.sh
 $BASEDIR/build_user.sh
+$BASEDIR/build_recommender.sh
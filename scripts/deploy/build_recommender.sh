@@ -0,0 +1,10 @@
+#!/bin/bash
+
+#TAG="$(git branch --show-current)_$(git rev-parse --short HEAD)"
+if [[ ! $TAG ]]; then
+    echo "Using TAG=latest"
+    TAG=latest
+fi
+
+docker build -t fluire_recommender:$TAG -f Dockerfile.application --build-arg APP=recommender/recommender.go . 
+docker tag fluire_recommender:$TAG technokaif/fluire_recommender:$TAG
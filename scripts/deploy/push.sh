@@ -10,3 +10,4 @@ docker push technokaif/fluire_api:$TAG
 docker push technokaif/fluire_auth:$TAG
 docker push technokaif/fluire_search:$TAG
 docker push technokaif/fluire_user:$TAG
+docker push technokaif/fluire_recommender:$TAG